@@ -63,6 +63,18 @@ func main() {
 
 	logger.Info("Cleanup job started")
 
+	if c.FXService != nil {
+		fxRefreshJob := jobs.NewFXRefreshJob(c.FXService, cfg.FXRefreshInterval)
+		fxRefreshJob.Start()
+		defer fxRefreshJob.Stop()
+	}
+
+	if c.WatchlistService != nil {
+		watchlistJob := jobs.NewWatchlistRecheckJob(c.WatchlistService)
+		watchlistJob.Start()
+		defer watchlistJob.Stop()
+	}
+
 	fiberApp := fiber.New(fiber.Config{
 		AppName:      "MyLittlePrice API",
 		ServerHeader: "Fiber",
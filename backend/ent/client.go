@@ -12,10 +12,17 @@ import (
 	"mylittleprice/ent/migrate"
 
 	"mylittleprice/ent/chatsession"
+	"mylittleprice/ent/conversationshare"
+	"mylittleprice/ent/exchangerate"
 	"mylittleprice/ent/message"
+	"mylittleprice/ent/priceobservation"
 	"mylittleprice/ent/searchhistory"
+	"mylittleprice/ent/tokenusage"
 	"mylittleprice/ent/user"
 	"mylittleprice/ent/userpreference"
+	"mylittleprice/ent/watchlistitem"
+	"mylittleprice/ent/wishlist"
+	"mylittleprice/ent/wishlistitem"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect"
@@ -31,14 +38,28 @@ type Client struct {
 	Schema *migrate.Schema
 	// ChatSession is the client for interacting with the ChatSession builders.
 	ChatSession *ChatSessionClient
+	// ConversationShare is the client for interacting with the ConversationShare builders.
+	ConversationShare *ConversationShareClient
+	// ExchangeRate is the client for interacting with the ExchangeRate builders.
+	ExchangeRate *ExchangeRateClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// PriceObservation is the client for interacting with the PriceObservation builders.
+	PriceObservation *PriceObservationClient
 	// SearchHistory is the client for interacting with the SearchHistory builders.
 	SearchHistory *SearchHistoryClient
+	// TokenUsage is the client for interacting with the TokenUsage builders.
+	TokenUsage *TokenUsageClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserPreference is the client for interacting with the UserPreference builders.
 	UserPreference *UserPreferenceClient
+	// WatchlistItem is the client for interacting with the WatchlistItem builders.
+	WatchlistItem *WatchlistItemClient
+	// Wishlist is the client for interacting with the Wishlist builders.
+	Wishlist *WishlistClient
+	// WishlistItem is the client for interacting with the WishlistItem builders.
+	WishlistItem *WishlistItemClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -51,10 +72,17 @@ func NewClient(opts ...Option) *Client {
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
 	c.ChatSession = NewChatSessionClient(c.config)
+	c.ConversationShare = NewConversationShareClient(c.config)
+	c.ExchangeRate = NewExchangeRateClient(c.config)
 	c.Message = NewMessageClient(c.config)
+	c.PriceObservation = NewPriceObservationClient(c.config)
 	c.SearchHistory = NewSearchHistoryClient(c.config)
+	c.TokenUsage = NewTokenUsageClient(c.config)
 	c.User = NewUserClient(c.config)
 	c.UserPreference = NewUserPreferenceClient(c.config)
+	c.WatchlistItem = NewWatchlistItemClient(c.config)
+	c.Wishlist = NewWishlistClient(c.config)
+	c.WishlistItem = NewWishlistItemClient(c.config)
 }
 
 type (
@@ -145,13 +173,20 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		ChatSession:    NewChatSessionClient(cfg),
-		Message:        NewMessageClient(cfg),
-		SearchHistory:  NewSearchHistoryClient(cfg),
-		User:           NewUserClient(cfg),
-		UserPreference: NewUserPreferenceClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		ChatSession:       NewChatSessionClient(cfg),
+		ConversationShare: NewConversationShareClient(cfg),
+		ExchangeRate:      NewExchangeRateClient(cfg),
+		Message:           NewMessageClient(cfg),
+		PriceObservation:  NewPriceObservationClient(cfg),
+		SearchHistory:     NewSearchHistoryClient(cfg),
+		TokenUsage:        NewTokenUsageClient(cfg),
+		User:              NewUserClient(cfg),
+		UserPreference:    NewUserPreferenceClient(cfg),
+		WatchlistItem:     NewWatchlistItemClient(cfg),
+		Wishlist:          NewWishlistClient(cfg),
+		WishlistItem:      NewWishlistItemClient(cfg),
 	}, nil
 }
 
@@ -169,13 +204,20 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:            ctx,
-		config:         cfg,
-		ChatSession:    NewChatSessionClient(cfg),
-		Message:        NewMessageClient(cfg),
-		SearchHistory:  NewSearchHistoryClient(cfg),
-		User:           NewUserClient(cfg),
-		UserPreference: NewUserPreferenceClient(cfg),
+		ctx:               ctx,
+		config:            cfg,
+		ChatSession:       NewChatSessionClient(cfg),
+		ConversationShare: NewConversationShareClient(cfg),
+		ExchangeRate:      NewExchangeRateClient(cfg),
+		Message:           NewMessageClient(cfg),
+		PriceObservation:  NewPriceObservationClient(cfg),
+		SearchHistory:     NewSearchHistoryClient(cfg),
+		TokenUsage:        NewTokenUsageClient(cfg),
+		User:              NewUserClient(cfg),
+		UserPreference:    NewUserPreferenceClient(cfg),
+		WatchlistItem:     NewWatchlistItemClient(cfg),
+		Wishlist:          NewWishlistClient(cfg),
+		WishlistItem:      NewWishlistItemClient(cfg),
 	}, nil
 }
 
@@ -204,21 +246,25 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.ChatSession.Use(hooks...)
-	c.Message.Use(hooks...)
-	c.SearchHistory.Use(hooks...)
-	c.User.Use(hooks...)
-	c.UserPreference.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.ChatSession, c.ConversationShare, c.ExchangeRate, c.Message,
+		c.PriceObservation, c.SearchHistory, c.TokenUsage, c.User, c.UserPreference,
+		c.WatchlistItem, c.Wishlist, c.WishlistItem,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.ChatSession.Intercept(interceptors...)
-	c.Message.Intercept(interceptors...)
-	c.SearchHistory.Intercept(interceptors...)
-	c.User.Intercept(interceptors...)
-	c.UserPreference.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.ChatSession, c.ConversationShare, c.ExchangeRate, c.Message,
+		c.PriceObservation, c.SearchHistory, c.TokenUsage, c.User, c.UserPreference,
+		c.WatchlistItem, c.Wishlist, c.WishlistItem,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
@@ -226,14 +272,28 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
 	case *ChatSessionMutation:
 		return c.ChatSession.mutate(ctx, m)
+	case *ConversationShareMutation:
+		return c.ConversationShare.mutate(ctx, m)
+	case *ExchangeRateMutation:
+		return c.ExchangeRate.mutate(ctx, m)
 	case *MessageMutation:
 		return c.Message.mutate(ctx, m)
+	case *PriceObservationMutation:
+		return c.PriceObservation.mutate(ctx, m)
 	case *SearchHistoryMutation:
 		return c.SearchHistory.mutate(ctx, m)
+	case *TokenUsageMutation:
+		return c.TokenUsage.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	case *UserPreferenceMutation:
 		return c.UserPreference.mutate(ctx, m)
+	case *WatchlistItemMutation:
+		return c.WatchlistItem.mutate(ctx, m)
+	case *WishlistMutation:
+		return c.Wishlist.mutate(ctx, m)
+	case *WishlistItemMutation:
+		return c.WishlistItem.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("ent: unknown mutation type %T", m)
 	}
@@ -404,6 +464,288 @@ func (c *ChatSessionClient) mutate(ctx context.Context, m *ChatSessionMutation)
 	}
 }
 
+// ConversationShareClient is a client for the ConversationShare schema.
+type ConversationShareClient struct {
+	config
+}
+
+// NewConversationShareClient returns a client for the ConversationShare from the given config.
+func NewConversationShareClient(c config) *ConversationShareClient {
+	return &ConversationShareClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `conversationshare.Hooks(f(g(h())))`.
+func (c *ConversationShareClient) Use(hooks ...Hook) {
+	c.hooks.ConversationShare = append(c.hooks.ConversationShare, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `conversationshare.Intercept(f(g(h())))`.
+func (c *ConversationShareClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ConversationShare = append(c.inters.ConversationShare, interceptors...)
+}
+
+// Create returns a builder for creating a ConversationShare entity.
+func (c *ConversationShareClient) Create() *ConversationShareCreate {
+	mutation := newConversationShareMutation(c.config, OpCreate)
+	return &ConversationShareCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ConversationShare entities.
+func (c *ConversationShareClient) CreateBulk(builders ...*ConversationShareCreate) *ConversationShareCreateBulk {
+	return &ConversationShareCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ConversationShareClient) MapCreateBulk(slice any, setFunc func(*ConversationShareCreate, int)) *ConversationShareCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ConversationShareCreateBulk{err: fmt.Errorf("calling to ConversationShareClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ConversationShareCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ConversationShareCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ConversationShare.
+func (c *ConversationShareClient) Update() *ConversationShareUpdate {
+	mutation := newConversationShareMutation(c.config, OpUpdate)
+	return &ConversationShareUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ConversationShareClient) UpdateOne(_m *ConversationShare) *ConversationShareUpdateOne {
+	mutation := newConversationShareMutation(c.config, OpUpdateOne, withConversationShare(_m))
+	return &ConversationShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ConversationShareClient) UpdateOneID(id uuid.UUID) *ConversationShareUpdateOne {
+	mutation := newConversationShareMutation(c.config, OpUpdateOne, withConversationShareID(id))
+	return &ConversationShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ConversationShare.
+func (c *ConversationShareClient) Delete() *ConversationShareDelete {
+	mutation := newConversationShareMutation(c.config, OpDelete)
+	return &ConversationShareDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ConversationShareClient) DeleteOne(_m *ConversationShare) *ConversationShareDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ConversationShareClient) DeleteOneID(id uuid.UUID) *ConversationShareDeleteOne {
+	builder := c.Delete().Where(conversationshare.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ConversationShareDeleteOne{builder}
+}
+
+// Query returns a query builder for ConversationShare.
+func (c *ConversationShareClient) Query() *ConversationShareQuery {
+	return &ConversationShareQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeConversationShare},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ConversationShare entity by its id.
+func (c *ConversationShareClient) Get(ctx context.Context, id uuid.UUID) (*ConversationShare, error) {
+	return c.Query().Where(conversationshare.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ConversationShareClient) GetX(ctx context.Context, id uuid.UUID) *ConversationShare {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a ConversationShare.
+func (c *ConversationShareClient) QueryUser(_m *ConversationShare) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(conversationshare.Table, conversationshare.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, conversationshare.UserTable, conversationshare.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *ConversationShareClient) Hooks() []Hook {
+	return c.hooks.ConversationShare
+}
+
+// Interceptors returns the client interceptors.
+func (c *ConversationShareClient) Interceptors() []Interceptor {
+	return c.inters.ConversationShare
+}
+
+func (c *ConversationShareClient) mutate(ctx context.Context, m *ConversationShareMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ConversationShareCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ConversationShareUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ConversationShareUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ConversationShareDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ConversationShare mutation op: %q", m.Op())
+	}
+}
+
+// ExchangeRateClient is a client for the ExchangeRate schema.
+type ExchangeRateClient struct {
+	config
+}
+
+// NewExchangeRateClient returns a client for the ExchangeRate from the given config.
+func NewExchangeRateClient(c config) *ExchangeRateClient {
+	return &ExchangeRateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `exchangerate.Hooks(f(g(h())))`.
+func (c *ExchangeRateClient) Use(hooks ...Hook) {
+	c.hooks.ExchangeRate = append(c.hooks.ExchangeRate, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `exchangerate.Intercept(f(g(h())))`.
+func (c *ExchangeRateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ExchangeRate = append(c.inters.ExchangeRate, interceptors...)
+}
+
+// Create returns a builder for creating a ExchangeRate entity.
+func (c *ExchangeRateClient) Create() *ExchangeRateCreate {
+	mutation := newExchangeRateMutation(c.config, OpCreate)
+	return &ExchangeRateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ExchangeRate entities.
+func (c *ExchangeRateClient) CreateBulk(builders ...*ExchangeRateCreate) *ExchangeRateCreateBulk {
+	return &ExchangeRateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *ExchangeRateClient) MapCreateBulk(slice any, setFunc func(*ExchangeRateCreate, int)) *ExchangeRateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &ExchangeRateCreateBulk{err: fmt.Errorf("calling to ExchangeRateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*ExchangeRateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &ExchangeRateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ExchangeRate.
+func (c *ExchangeRateClient) Update() *ExchangeRateUpdate {
+	mutation := newExchangeRateMutation(c.config, OpUpdate)
+	return &ExchangeRateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ExchangeRateClient) UpdateOne(_m *ExchangeRate) *ExchangeRateUpdateOne {
+	mutation := newExchangeRateMutation(c.config, OpUpdateOne, withExchangeRate(_m))
+	return &ExchangeRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ExchangeRateClient) UpdateOneID(id uuid.UUID) *ExchangeRateUpdateOne {
+	mutation := newExchangeRateMutation(c.config, OpUpdateOne, withExchangeRateID(id))
+	return &ExchangeRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ExchangeRate.
+func (c *ExchangeRateClient) Delete() *ExchangeRateDelete {
+	mutation := newExchangeRateMutation(c.config, OpDelete)
+	return &ExchangeRateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ExchangeRateClient) DeleteOne(_m *ExchangeRate) *ExchangeRateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ExchangeRateClient) DeleteOneID(id uuid.UUID) *ExchangeRateDeleteOne {
+	builder := c.Delete().Where(exchangerate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ExchangeRateDeleteOne{builder}
+}
+
+// Query returns a query builder for ExchangeRate.
+func (c *ExchangeRateClient) Query() *ExchangeRateQuery {
+	return &ExchangeRateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeExchangeRate},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ExchangeRate entity by its id.
+func (c *ExchangeRateClient) Get(ctx context.Context, id uuid.UUID) (*ExchangeRate, error) {
+	return c.Query().Where(exchangerate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ExchangeRateClient) GetX(ctx context.Context, id uuid.UUID) *ExchangeRate {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ExchangeRateClient) Hooks() []Hook {
+	return c.hooks.ExchangeRate
+}
+
+// Interceptors returns the client interceptors.
+func (c *ExchangeRateClient) Interceptors() []Interceptor {
+	return c.inters.ExchangeRate
+}
+
+func (c *ExchangeRateClient) mutate(ctx context.Context, m *ExchangeRateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ExchangeRateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ExchangeRateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ExchangeRateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ExchangeRateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ExchangeRate mutation op: %q", m.Op())
+	}
+}
+
 // MessageClient is a client for the Message schema.
 type MessageClient struct {
 	config
@@ -553,6 +895,139 @@ func (c *MessageClient) mutate(ctx context.Context, m *MessageMutation) (Value,
 	}
 }
 
+// PriceObservationClient is a client for the PriceObservation schema.
+type PriceObservationClient struct {
+	config
+}
+
+// NewPriceObservationClient returns a client for the PriceObservation from the given config.
+func NewPriceObservationClient(c config) *PriceObservationClient {
+	return &PriceObservationClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `priceobservation.Hooks(f(g(h())))`.
+func (c *PriceObservationClient) Use(hooks ...Hook) {
+	c.hooks.PriceObservation = append(c.hooks.PriceObservation, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `priceobservation.Intercept(f(g(h())))`.
+func (c *PriceObservationClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PriceObservation = append(c.inters.PriceObservation, interceptors...)
+}
+
+// Create returns a builder for creating a PriceObservation entity.
+func (c *PriceObservationClient) Create() *PriceObservationCreate {
+	mutation := newPriceObservationMutation(c.config, OpCreate)
+	return &PriceObservationCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PriceObservation entities.
+func (c *PriceObservationClient) CreateBulk(builders ...*PriceObservationCreate) *PriceObservationCreateBulk {
+	return &PriceObservationCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PriceObservationClient) MapCreateBulk(slice any, setFunc func(*PriceObservationCreate, int)) *PriceObservationCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PriceObservationCreateBulk{err: fmt.Errorf("calling to PriceObservationClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PriceObservationCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PriceObservationCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PriceObservation.
+func (c *PriceObservationClient) Update() *PriceObservationUpdate {
+	mutation := newPriceObservationMutation(c.config, OpUpdate)
+	return &PriceObservationUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PriceObservationClient) UpdateOne(_m *PriceObservation) *PriceObservationUpdateOne {
+	mutation := newPriceObservationMutation(c.config, OpUpdateOne, withPriceObservation(_m))
+	return &PriceObservationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PriceObservationClient) UpdateOneID(id uuid.UUID) *PriceObservationUpdateOne {
+	mutation := newPriceObservationMutation(c.config, OpUpdateOne, withPriceObservationID(id))
+	return &PriceObservationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PriceObservation.
+func (c *PriceObservationClient) Delete() *PriceObservationDelete {
+	mutation := newPriceObservationMutation(c.config, OpDelete)
+	return &PriceObservationDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PriceObservationClient) DeleteOne(_m *PriceObservation) *PriceObservationDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PriceObservationClient) DeleteOneID(id uuid.UUID) *PriceObservationDeleteOne {
+	builder := c.Delete().Where(priceobservation.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PriceObservationDeleteOne{builder}
+}
+
+// Query returns a query builder for PriceObservation.
+func (c *PriceObservationClient) Query() *PriceObservationQuery {
+	return &PriceObservationQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePriceObservation},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PriceObservation entity by its id.
+func (c *PriceObservationClient) Get(ctx context.Context, id uuid.UUID) (*PriceObservation, error) {
+	return c.Query().Where(priceobservation.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PriceObservationClient) GetX(ctx context.Context, id uuid.UUID) *PriceObservation {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *PriceObservationClient) Hooks() []Hook {
+	return c.hooks.PriceObservation
+}
+
+// Interceptors returns the client interceptors.
+func (c *PriceObservationClient) Interceptors() []Interceptor {
+	return c.inters.PriceObservation
+}
+
+func (c *PriceObservationClient) mutate(ctx context.Context, m *PriceObservationMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PriceObservationCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PriceObservationUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PriceObservationUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PriceObservationDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PriceObservation mutation op: %q", m.Op())
+	}
+}
+
 // SearchHistoryClient is a client for the SearchHistory schema.
 type SearchHistoryClient struct {
 	config
@@ -702,36 +1177,169 @@ func (c *SearchHistoryClient) mutate(ctx context.Context, m *SearchHistoryMutati
 	}
 }
 
-// UserClient is a client for the User schema.
-type UserClient struct {
+// TokenUsageClient is a client for the TokenUsage schema.
+type TokenUsageClient struct {
 	config
 }
 
-// NewUserClient returns a client for the User from the given config.
-func NewUserClient(c config) *UserClient {
-	return &UserClient{config: c}
+// NewTokenUsageClient returns a client for the TokenUsage from the given config.
+func NewTokenUsageClient(c config) *TokenUsageClient {
+	return &TokenUsageClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
-func (c *UserClient) Use(hooks ...Hook) {
-	c.hooks.User = append(c.hooks.User, hooks...)
+// A call to `Use(f, g, h)` equals to `tokenusage.Hooks(f(g(h())))`.
+func (c *TokenUsageClient) Use(hooks ...Hook) {
+	c.hooks.TokenUsage = append(c.hooks.TokenUsage, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
-func (c *UserClient) Intercept(interceptors ...Interceptor) {
-	c.inters.User = append(c.inters.User, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `tokenusage.Intercept(f(g(h())))`.
+func (c *TokenUsageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TokenUsage = append(c.inters.TokenUsage, interceptors...)
 }
 
-// Create returns a builder for creating a User entity.
-func (c *UserClient) Create() *UserCreate {
-	mutation := newUserMutation(c.config, OpCreate)
-	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a TokenUsage entity.
+func (c *TokenUsageClient) Create() *TokenUsageCreate {
+	mutation := newTokenUsageMutation(c.config, OpCreate)
+	return &TokenUsageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of User entities.
-func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
+// CreateBulk returns a builder for creating a bulk of TokenUsage entities.
+func (c *TokenUsageClient) CreateBulk(builders ...*TokenUsageCreate) *TokenUsageCreateBulk {
+	return &TokenUsageCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TokenUsageClient) MapCreateBulk(slice any, setFunc func(*TokenUsageCreate, int)) *TokenUsageCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TokenUsageCreateBulk{err: fmt.Errorf("calling to TokenUsageClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TokenUsageCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TokenUsageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TokenUsage.
+func (c *TokenUsageClient) Update() *TokenUsageUpdate {
+	mutation := newTokenUsageMutation(c.config, OpUpdate)
+	return &TokenUsageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TokenUsageClient) UpdateOne(_m *TokenUsage) *TokenUsageUpdateOne {
+	mutation := newTokenUsageMutation(c.config, OpUpdateOne, withTokenUsage(_m))
+	return &TokenUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TokenUsageClient) UpdateOneID(id uuid.UUID) *TokenUsageUpdateOne {
+	mutation := newTokenUsageMutation(c.config, OpUpdateOne, withTokenUsageID(id))
+	return &TokenUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TokenUsage.
+func (c *TokenUsageClient) Delete() *TokenUsageDelete {
+	mutation := newTokenUsageMutation(c.config, OpDelete)
+	return &TokenUsageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TokenUsageClient) DeleteOne(_m *TokenUsage) *TokenUsageDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TokenUsageClient) DeleteOneID(id uuid.UUID) *TokenUsageDeleteOne {
+	builder := c.Delete().Where(tokenusage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TokenUsageDeleteOne{builder}
+}
+
+// Query returns a query builder for TokenUsage.
+func (c *TokenUsageClient) Query() *TokenUsageQuery {
+	return &TokenUsageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTokenUsage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TokenUsage entity by its id.
+func (c *TokenUsageClient) Get(ctx context.Context, id uuid.UUID) (*TokenUsage, error) {
+	return c.Query().Where(tokenusage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TokenUsageClient) GetX(ctx context.Context, id uuid.UUID) *TokenUsage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TokenUsageClient) Hooks() []Hook {
+	return c.hooks.TokenUsage
+}
+
+// Interceptors returns the client interceptors.
+func (c *TokenUsageClient) Interceptors() []Interceptor {
+	return c.inters.TokenUsage
+}
+
+func (c *TokenUsageClient) mutate(ctx context.Context, m *TokenUsageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TokenUsageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TokenUsageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TokenUsageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TokenUsageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TokenUsage mutation op: %q", m.Op())
+	}
+}
+
+// UserClient is a client for the User schema.
+type UserClient struct {
+	config
+}
+
+// NewUserClient returns a client for the User from the given config.
+func NewUserClient(c config) *UserClient {
+	return &UserClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `user.Hooks(f(g(h())))`.
+func (c *UserClient) Use(hooks ...Hook) {
+	c.hooks.User = append(c.hooks.User, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `user.Intercept(f(g(h())))`.
+func (c *UserClient) Intercept(interceptors ...Interceptor) {
+	c.inters.User = append(c.inters.User, interceptors...)
+}
+
+// Create returns a builder for creating a User entity.
+func (c *UserClient) Create() *UserCreate {
+	mutation := newUserMutation(c.config, OpCreate)
+	return &UserCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of User entities.
+func (c *UserClient) CreateBulk(builders ...*UserCreate) *UserCreateBulk {
 	return &UserCreateBulk{config: c.config, builders: builders}
 }
 
@@ -858,6 +1466,54 @@ func (c *UserClient) QueryPreferences(_m *User) *UserPreferenceQuery {
 	return query
 }
 
+// QueryWatchlist queries the watchlist edge of a User.
+func (c *UserClient) QueryWatchlist(_m *User) *WatchlistItemQuery {
+	query := (&WatchlistItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(watchlistitem.Table, watchlistitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WatchlistTable, user.WatchlistColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryWishlists queries the wishlists edge of a User.
+func (c *UserClient) QueryWishlists(_m *User) *WishlistQuery {
+	query := (&WishlistClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(wishlist.Table, wishlist.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WishlistsTable, user.WishlistsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryConversationShares queries the conversation_shares edge of a User.
+func (c *UserClient) QueryConversationShares(_m *User) *ConversationShareQuery {
+	query := (&ConversationShareClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(conversationshare.Table, conversationshare.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ConversationSharesTable, user.ConversationSharesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -1032,12 +1688,479 @@ func (c *UserPreferenceClient) mutate(ctx context.Context, m *UserPreferenceMuta
 	}
 }
 
+// WatchlistItemClient is a client for the WatchlistItem schema.
+type WatchlistItemClient struct {
+	config
+}
+
+// NewWatchlistItemClient returns a client for the WatchlistItem from the given config.
+func NewWatchlistItemClient(c config) *WatchlistItemClient {
+	return &WatchlistItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `watchlistitem.Hooks(f(g(h())))`.
+func (c *WatchlistItemClient) Use(hooks ...Hook) {
+	c.hooks.WatchlistItem = append(c.hooks.WatchlistItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `watchlistitem.Intercept(f(g(h())))`.
+func (c *WatchlistItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WatchlistItem = append(c.inters.WatchlistItem, interceptors...)
+}
+
+// Create returns a builder for creating a WatchlistItem entity.
+func (c *WatchlistItemClient) Create() *WatchlistItemCreate {
+	mutation := newWatchlistItemMutation(c.config, OpCreate)
+	return &WatchlistItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WatchlistItem entities.
+func (c *WatchlistItemClient) CreateBulk(builders ...*WatchlistItemCreate) *WatchlistItemCreateBulk {
+	return &WatchlistItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WatchlistItemClient) MapCreateBulk(slice any, setFunc func(*WatchlistItemCreate, int)) *WatchlistItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WatchlistItemCreateBulk{err: fmt.Errorf("calling to WatchlistItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WatchlistItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WatchlistItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WatchlistItem.
+func (c *WatchlistItemClient) Update() *WatchlistItemUpdate {
+	mutation := newWatchlistItemMutation(c.config, OpUpdate)
+	return &WatchlistItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WatchlistItemClient) UpdateOne(_m *WatchlistItem) *WatchlistItemUpdateOne {
+	mutation := newWatchlistItemMutation(c.config, OpUpdateOne, withWatchlistItem(_m))
+	return &WatchlistItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WatchlistItemClient) UpdateOneID(id uuid.UUID) *WatchlistItemUpdateOne {
+	mutation := newWatchlistItemMutation(c.config, OpUpdateOne, withWatchlistItemID(id))
+	return &WatchlistItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WatchlistItem.
+func (c *WatchlistItemClient) Delete() *WatchlistItemDelete {
+	mutation := newWatchlistItemMutation(c.config, OpDelete)
+	return &WatchlistItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WatchlistItemClient) DeleteOne(_m *WatchlistItem) *WatchlistItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WatchlistItemClient) DeleteOneID(id uuid.UUID) *WatchlistItemDeleteOne {
+	builder := c.Delete().Where(watchlistitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WatchlistItemDeleteOne{builder}
+}
+
+// Query returns a query builder for WatchlistItem.
+func (c *WatchlistItemClient) Query() *WatchlistItemQuery {
+	return &WatchlistItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWatchlistItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WatchlistItem entity by its id.
+func (c *WatchlistItemClient) Get(ctx context.Context, id uuid.UUID) (*WatchlistItem, error) {
+	return c.Query().Where(watchlistitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WatchlistItemClient) GetX(ctx context.Context, id uuid.UUID) *WatchlistItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a WatchlistItem.
+func (c *WatchlistItemClient) QueryUser(_m *WatchlistItem) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(watchlistitem.Table, watchlistitem.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, watchlistitem.UserTable, watchlistitem.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WatchlistItemClient) Hooks() []Hook {
+	return c.hooks.WatchlistItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *WatchlistItemClient) Interceptors() []Interceptor {
+	return c.inters.WatchlistItem
+}
+
+func (c *WatchlistItemClient) mutate(ctx context.Context, m *WatchlistItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WatchlistItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WatchlistItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WatchlistItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WatchlistItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WatchlistItem mutation op: %q", m.Op())
+	}
+}
+
+// WishlistClient is a client for the Wishlist schema.
+type WishlistClient struct {
+	config
+}
+
+// NewWishlistClient returns a client for the Wishlist from the given config.
+func NewWishlistClient(c config) *WishlistClient {
+	return &WishlistClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `wishlist.Hooks(f(g(h())))`.
+func (c *WishlistClient) Use(hooks ...Hook) {
+	c.hooks.Wishlist = append(c.hooks.Wishlist, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `wishlist.Intercept(f(g(h())))`.
+func (c *WishlistClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Wishlist = append(c.inters.Wishlist, interceptors...)
+}
+
+// Create returns a builder for creating a Wishlist entity.
+func (c *WishlistClient) Create() *WishlistCreate {
+	mutation := newWishlistMutation(c.config, OpCreate)
+	return &WishlistCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Wishlist entities.
+func (c *WishlistClient) CreateBulk(builders ...*WishlistCreate) *WishlistCreateBulk {
+	return &WishlistCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WishlistClient) MapCreateBulk(slice any, setFunc func(*WishlistCreate, int)) *WishlistCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WishlistCreateBulk{err: fmt.Errorf("calling to WishlistClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WishlistCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WishlistCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Wishlist.
+func (c *WishlistClient) Update() *WishlistUpdate {
+	mutation := newWishlistMutation(c.config, OpUpdate)
+	return &WishlistUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WishlistClient) UpdateOne(_m *Wishlist) *WishlistUpdateOne {
+	mutation := newWishlistMutation(c.config, OpUpdateOne, withWishlist(_m))
+	return &WishlistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WishlistClient) UpdateOneID(id uuid.UUID) *WishlistUpdateOne {
+	mutation := newWishlistMutation(c.config, OpUpdateOne, withWishlistID(id))
+	return &WishlistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Wishlist.
+func (c *WishlistClient) Delete() *WishlistDelete {
+	mutation := newWishlistMutation(c.config, OpDelete)
+	return &WishlistDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WishlistClient) DeleteOne(_m *Wishlist) *WishlistDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WishlistClient) DeleteOneID(id uuid.UUID) *WishlistDeleteOne {
+	builder := c.Delete().Where(wishlist.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WishlistDeleteOne{builder}
+}
+
+// Query returns a query builder for Wishlist.
+func (c *WishlistClient) Query() *WishlistQuery {
+	return &WishlistQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWishlist},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Wishlist entity by its id.
+func (c *WishlistClient) Get(ctx context.Context, id uuid.UUID) (*Wishlist, error) {
+	return c.Query().Where(wishlist.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WishlistClient) GetX(ctx context.Context, id uuid.UUID) *Wishlist {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Wishlist.
+func (c *WishlistClient) QueryUser(_m *Wishlist) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wishlist.Table, wishlist.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, wishlist.UserTable, wishlist.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryItems queries the items edge of a Wishlist.
+func (c *WishlistClient) QueryItems(_m *Wishlist) *WishlistItemQuery {
+	query := (&WishlistItemClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wishlist.Table, wishlist.FieldID, id),
+			sqlgraph.To(wishlistitem.Table, wishlistitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, wishlist.ItemsTable, wishlist.ItemsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WishlistClient) Hooks() []Hook {
+	return c.hooks.Wishlist
+}
+
+// Interceptors returns the client interceptors.
+func (c *WishlistClient) Interceptors() []Interceptor {
+	return c.inters.Wishlist
+}
+
+func (c *WishlistClient) mutate(ctx context.Context, m *WishlistMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WishlistCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WishlistUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WishlistUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WishlistDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Wishlist mutation op: %q", m.Op())
+	}
+}
+
+// WishlistItemClient is a client for the WishlistItem schema.
+type WishlistItemClient struct {
+	config
+}
+
+// NewWishlistItemClient returns a client for the WishlistItem from the given config.
+func NewWishlistItemClient(c config) *WishlistItemClient {
+	return &WishlistItemClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `wishlistitem.Hooks(f(g(h())))`.
+func (c *WishlistItemClient) Use(hooks ...Hook) {
+	c.hooks.WishlistItem = append(c.hooks.WishlistItem, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `wishlistitem.Intercept(f(g(h())))`.
+func (c *WishlistItemClient) Intercept(interceptors ...Interceptor) {
+	c.inters.WishlistItem = append(c.inters.WishlistItem, interceptors...)
+}
+
+// Create returns a builder for creating a WishlistItem entity.
+func (c *WishlistItemClient) Create() *WishlistItemCreate {
+	mutation := newWishlistItemMutation(c.config, OpCreate)
+	return &WishlistItemCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of WishlistItem entities.
+func (c *WishlistItemClient) CreateBulk(builders ...*WishlistItemCreate) *WishlistItemCreateBulk {
+	return &WishlistItemCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *WishlistItemClient) MapCreateBulk(slice any, setFunc func(*WishlistItemCreate, int)) *WishlistItemCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &WishlistItemCreateBulk{err: fmt.Errorf("calling to WishlistItemClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*WishlistItemCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &WishlistItemCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for WishlistItem.
+func (c *WishlistItemClient) Update() *WishlistItemUpdate {
+	mutation := newWishlistItemMutation(c.config, OpUpdate)
+	return &WishlistItemUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *WishlistItemClient) UpdateOne(_m *WishlistItem) *WishlistItemUpdateOne {
+	mutation := newWishlistItemMutation(c.config, OpUpdateOne, withWishlistItem(_m))
+	return &WishlistItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *WishlistItemClient) UpdateOneID(id uuid.UUID) *WishlistItemUpdateOne {
+	mutation := newWishlistItemMutation(c.config, OpUpdateOne, withWishlistItemID(id))
+	return &WishlistItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for WishlistItem.
+func (c *WishlistItemClient) Delete() *WishlistItemDelete {
+	mutation := newWishlistItemMutation(c.config, OpDelete)
+	return &WishlistItemDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *WishlistItemClient) DeleteOne(_m *WishlistItem) *WishlistItemDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *WishlistItemClient) DeleteOneID(id uuid.UUID) *WishlistItemDeleteOne {
+	builder := c.Delete().Where(wishlistitem.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &WishlistItemDeleteOne{builder}
+}
+
+// Query returns a query builder for WishlistItem.
+func (c *WishlistItemClient) Query() *WishlistItemQuery {
+	return &WishlistItemQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeWishlistItem},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a WishlistItem entity by its id.
+func (c *WishlistItemClient) Get(ctx context.Context, id uuid.UUID) (*WishlistItem, error) {
+	return c.Query().Where(wishlistitem.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *WishlistItemClient) GetX(ctx context.Context, id uuid.UUID) *WishlistItem {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryWishlist queries the wishlist edge of a WishlistItem.
+func (c *WishlistItemClient) QueryWishlist(_m *WishlistItem) *WishlistQuery {
+	query := (&WishlistClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(wishlistitem.Table, wishlistitem.FieldID, id),
+			sqlgraph.To(wishlist.Table, wishlist.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, wishlistitem.WishlistTable, wishlistitem.WishlistColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *WishlistItemClient) Hooks() []Hook {
+	return c.hooks.WishlistItem
+}
+
+// Interceptors returns the client interceptors.
+func (c *WishlistItemClient) Interceptors() []Interceptor {
+	return c.inters.WishlistItem
+}
+
+func (c *WishlistItemClient) mutate(ctx context.Context, m *WishlistItemMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&WishlistItemCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&WishlistItemUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&WishlistItemUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&WishlistItemDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown WishlistItem mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		ChatSession, Message, SearchHistory, User, UserPreference []ent.Hook
+		ChatSession, ConversationShare, ExchangeRate, Message, PriceObservation,
+		SearchHistory, TokenUsage, User, UserPreference, WatchlistItem, Wishlist,
+		WishlistItem []ent.Hook
 	}
 	inters struct {
-		ChatSession, Message, SearchHistory, User, UserPreference []ent.Interceptor
+		ChatSession, ConversationShare, ExchangeRate, Message, PriceObservation,
+		SearchHistory, TokenUsage, User, UserPreference, WatchlistItem, Wishlist,
+		WishlistItem []ent.Interceptor
 	}
 )
@@ -0,0 +1,397 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mylittleprice/ent/conversationshare"
+	"mylittleprice/ent/user"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ConversationShareCreate is the builder for creating a ConversationShare entity.
+type ConversationShareCreate struct {
+	config
+	mutation *ConversationShareMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ConversationShareCreate) SetUserID(v uuid.UUID) *ConversationShareCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetSessionID sets the "session_id" field.
+func (_c *ConversationShareCreate) SetSessionID(v uuid.UUID) *ConversationShareCreate {
+	_c.mutation.SetSessionID(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *ConversationShareCreate) SetTitle(v string) *ConversationShareCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_c *ConversationShareCreate) SetNillableTitle(v *string) *ConversationShareCreate {
+	if v != nil {
+		_c.SetTitle(*v)
+	}
+	return _c
+}
+
+// SetMessages sets the "messages" field.
+func (_c *ConversationShareCreate) SetMessages(v []map[string]interface{}) *ConversationShareCreate {
+	_c.mutation.SetMessages(v)
+	return _c
+}
+
+// SetMessageCount sets the "message_count" field.
+func (_c *ConversationShareCreate) SetMessageCount(v int) *ConversationShareCreate {
+	_c.mutation.SetMessageCount(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *ConversationShareCreate) SetCurrency(v string) *ConversationShareCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *ConversationShareCreate) SetNillableCurrency(v *string) *ConversationShareCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetViewCount sets the "view_count" field.
+func (_c *ConversationShareCreate) SetViewCount(v int) *ConversationShareCreate {
+	_c.mutation.SetViewCount(v)
+	return _c
+}
+
+// SetNillableViewCount sets the "view_count" field if the given value is not nil.
+func (_c *ConversationShareCreate) SetNillableViewCount(v *int) *ConversationShareCreate {
+	if v != nil {
+		_c.SetViewCount(*v)
+	}
+	return _c
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (_c *ConversationShareCreate) SetExpiresAt(v time.Time) *ConversationShareCreate {
+	_c.mutation.SetExpiresAt(v)
+	return _c
+}
+
+// SetNillableExpiresAt sets the "expires_at" field if the given value is not nil.
+func (_c *ConversationShareCreate) SetNillableExpiresAt(v *time.Time) *ConversationShareCreate {
+	if v != nil {
+		_c.SetExpiresAt(*v)
+	}
+	return _c
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_c *ConversationShareCreate) SetRevokedAt(v time.Time) *ConversationShareCreate {
+	_c.mutation.SetRevokedAt(v)
+	return _c
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_c *ConversationShareCreate) SetNillableRevokedAt(v *time.Time) *ConversationShareCreate {
+	if v != nil {
+		_c.SetRevokedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ConversationShareCreate) SetCreatedAt(v time.Time) *ConversationShareCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ConversationShareCreate) SetNillableCreatedAt(v *time.Time) *ConversationShareCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ConversationShareCreate) SetID(v uuid.UUID) *ConversationShareCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ConversationShareCreate) SetNillableID(v *uuid.UUID) *ConversationShareCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *ConversationShareCreate) SetUser(v *User) *ConversationShareCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the ConversationShareMutation object of the builder.
+func (_c *ConversationShareCreate) Mutation() *ConversationShareMutation {
+	return _c.mutation
+}
+
+// Save creates the ConversationShare in the database.
+func (_c *ConversationShareCreate) Save(ctx context.Context) (*ConversationShare, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ConversationShareCreate) SaveX(ctx context.Context) *ConversationShare {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConversationShareCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConversationShareCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ConversationShareCreate) defaults() {
+	if _, ok := _c.mutation.ViewCount(); !ok {
+		v := conversationshare.DefaultViewCount
+		_c.mutation.SetViewCount(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := conversationshare.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := conversationshare.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ConversationShareCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ConversationShare.user_id"`)}
+	}
+	if _, ok := _c.mutation.SessionID(); !ok {
+		return &ValidationError{Name: "session_id", err: errors.New(`ent: missing required field "ConversationShare.session_id"`)}
+	}
+	if _, ok := _c.mutation.Messages(); !ok {
+		return &ValidationError{Name: "messages", err: errors.New(`ent: missing required field "ConversationShare.messages"`)}
+	}
+	if _, ok := _c.mutation.MessageCount(); !ok {
+		return &ValidationError{Name: "message_count", err: errors.New(`ent: missing required field "ConversationShare.message_count"`)}
+	}
+	if _, ok := _c.mutation.ViewCount(); !ok {
+		return &ValidationError{Name: "view_count", err: errors.New(`ent: missing required field "ConversationShare.view_count"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ConversationShare.created_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "ConversationShare.user"`)}
+	}
+	return nil
+}
+
+func (_c *ConversationShareCreate) sqlSave(ctx context.Context) (*ConversationShare, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ConversationShareCreate) createSpec() (*ConversationShare, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ConversationShare{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(conversationshare.Table, sqlgraph.NewFieldSpec(conversationshare.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.SessionID(); ok {
+		_spec.SetField(conversationshare.FieldSessionID, field.TypeUUID, value)
+		_node.SessionID = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(conversationshare.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Messages(); ok {
+		_spec.SetField(conversationshare.FieldMessages, field.TypeJSON, value)
+		_node.Messages = value
+	}
+	if value, ok := _c.mutation.MessageCount(); ok {
+		_spec.SetField(conversationshare.FieldMessageCount, field.TypeInt, value)
+		_node.MessageCount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(conversationshare.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.ViewCount(); ok {
+		_spec.SetField(conversationshare.FieldViewCount, field.TypeInt, value)
+		_node.ViewCount = value
+	}
+	if value, ok := _c.mutation.ExpiresAt(); ok {
+		_spec.SetField(conversationshare.FieldExpiresAt, field.TypeTime, value)
+		_node.ExpiresAt = &value
+	}
+	if value, ok := _c.mutation.RevokedAt(); ok {
+		_spec.SetField(conversationshare.FieldRevokedAt, field.TypeTime, value)
+		_node.RevokedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(conversationshare.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   conversationshare.UserTable,
+			Columns: []string{conversationshare.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ConversationShareCreateBulk is the builder for creating many ConversationShare entities in bulk.
+type ConversationShareCreateBulk struct {
+	config
+	err      error
+	builders []*ConversationShareCreate
+}
+
+// Save creates the ConversationShare entities in the database.
+func (_c *ConversationShareCreateBulk) Save(ctx context.Context) ([]*ConversationShare, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ConversationShare, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ConversationShareMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ConversationShareCreateBulk) SaveX(ctx context.Context) []*ConversationShare {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ConversationShareCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ConversationShareCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,322 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mylittleprice/ent/conversationshare"
+	"mylittleprice/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// ConversationShareUpdate is the builder for updating ConversationShare entities.
+type ConversationShareUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ConversationShareMutation
+}
+
+// Where appends a list predicates to the ConversationShareUpdate builder.
+func (_u *ConversationShareUpdate) Where(ps ...predicate.ConversationShare) *ConversationShareUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetViewCount sets the "view_count" field.
+func (_u *ConversationShareUpdate) SetViewCount(v int) *ConversationShareUpdate {
+	_u.mutation.ResetViewCount()
+	_u.mutation.SetViewCount(v)
+	return _u
+}
+
+// SetNillableViewCount sets the "view_count" field if the given value is not nil.
+func (_u *ConversationShareUpdate) SetNillableViewCount(v *int) *ConversationShareUpdate {
+	if v != nil {
+		_u.SetViewCount(*v)
+	}
+	return _u
+}
+
+// AddViewCount adds value to the "view_count" field.
+func (_u *ConversationShareUpdate) AddViewCount(v int) *ConversationShareUpdate {
+	_u.mutation.AddViewCount(v)
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *ConversationShareUpdate) SetRevokedAt(v time.Time) *ConversationShareUpdate {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *ConversationShareUpdate) SetNillableRevokedAt(v *time.Time) *ConversationShareUpdate {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *ConversationShareUpdate) ClearRevokedAt() *ConversationShareUpdate {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// Mutation returns the ConversationShareMutation object of the builder.
+func (_u *ConversationShareUpdate) Mutation() *ConversationShareMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ConversationShareUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConversationShareUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ConversationShareUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConversationShareUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConversationShareUpdate) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ConversationShare.user"`)
+	}
+	return nil
+}
+
+func (_u *ConversationShareUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(conversationshare.Table, conversationshare.Columns, sqlgraph.NewFieldSpec(conversationshare.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(conversationshare.FieldTitle, field.TypeString)
+	}
+	if _u.mutation.CurrencyCleared() {
+		_spec.ClearField(conversationshare.FieldCurrency, field.TypeString)
+	}
+	if value, ok := _u.mutation.ViewCount(); ok {
+		_spec.SetField(conversationshare.FieldViewCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedViewCount(); ok {
+		_spec.AddField(conversationshare.FieldViewCount, field.TypeInt, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(conversationshare.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(conversationshare.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(conversationshare.FieldRevokedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversationshare.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ConversationShareUpdateOne is the builder for updating a single ConversationShare entity.
+type ConversationShareUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ConversationShareMutation
+}
+
+// SetViewCount sets the "view_count" field.
+func (_u *ConversationShareUpdateOne) SetViewCount(v int) *ConversationShareUpdateOne {
+	_u.mutation.ResetViewCount()
+	_u.mutation.SetViewCount(v)
+	return _u
+}
+
+// SetNillableViewCount sets the "view_count" field if the given value is not nil.
+func (_u *ConversationShareUpdateOne) SetNillableViewCount(v *int) *ConversationShareUpdateOne {
+	if v != nil {
+		_u.SetViewCount(*v)
+	}
+	return _u
+}
+
+// AddViewCount adds value to the "view_count" field.
+func (_u *ConversationShareUpdateOne) AddViewCount(v int) *ConversationShareUpdateOne {
+	_u.mutation.AddViewCount(v)
+	return _u
+}
+
+// SetRevokedAt sets the "revoked_at" field.
+func (_u *ConversationShareUpdateOne) SetRevokedAt(v time.Time) *ConversationShareUpdateOne {
+	_u.mutation.SetRevokedAt(v)
+	return _u
+}
+
+// SetNillableRevokedAt sets the "revoked_at" field if the given value is not nil.
+func (_u *ConversationShareUpdateOne) SetNillableRevokedAt(v *time.Time) *ConversationShareUpdateOne {
+	if v != nil {
+		_u.SetRevokedAt(*v)
+	}
+	return _u
+}
+
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (_u *ConversationShareUpdateOne) ClearRevokedAt() *ConversationShareUpdateOne {
+	_u.mutation.ClearRevokedAt()
+	return _u
+}
+
+// Mutation returns the ConversationShareMutation object of the builder.
+func (_u *ConversationShareUpdateOne) Mutation() *ConversationShareMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ConversationShareUpdate builder.
+func (_u *ConversationShareUpdateOne) Where(ps ...predicate.ConversationShare) *ConversationShareUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ConversationShareUpdateOne) Select(field string, fields ...string) *ConversationShareUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ConversationShare entity.
+func (_u *ConversationShareUpdateOne) Save(ctx context.Context) (*ConversationShare, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ConversationShareUpdateOne) SaveX(ctx context.Context) *ConversationShare {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ConversationShareUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ConversationShareUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ConversationShareUpdateOne) check() error {
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "ConversationShare.user"`)
+	}
+	return nil
+}
+
+func (_u *ConversationShareUpdateOne) sqlSave(ctx context.Context) (_node *ConversationShare, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(conversationshare.Table, conversationshare.Columns, sqlgraph.NewFieldSpec(conversationshare.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ConversationShare.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, conversationshare.FieldID)
+		for _, f := range fields {
+			if !conversationshare.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != conversationshare.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if _u.mutation.TitleCleared() {
+		_spec.ClearField(conversationshare.FieldTitle, field.TypeString)
+	}
+	if _u.mutation.CurrencyCleared() {
+		_spec.ClearField(conversationshare.FieldCurrency, field.TypeString)
+	}
+	if value, ok := _u.mutation.ViewCount(); ok {
+		_spec.SetField(conversationshare.FieldViewCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedViewCount(); ok {
+		_spec.AddField(conversationshare.FieldViewCount, field.TypeInt, value)
+	}
+	if _u.mutation.ExpiresAtCleared() {
+		_spec.ClearField(conversationshare.FieldExpiresAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.RevokedAt(); ok {
+		_spec.SetField(conversationshare.FieldRevokedAt, field.TypeTime, value)
+	}
+	if _u.mutation.RevokedAtCleared() {
+		_spec.ClearField(conversationshare.FieldRevokedAt, field.TypeTime)
+	}
+	_node = &ConversationShare{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{conversationshare.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -7,10 +7,17 @@ import (
 	"errors"
 	"fmt"
 	"mylittleprice/ent/chatsession"
+	"mylittleprice/ent/conversationshare"
+	"mylittleprice/ent/exchangerate"
 	"mylittleprice/ent/message"
+	"mylittleprice/ent/priceobservation"
 	"mylittleprice/ent/searchhistory"
+	"mylittleprice/ent/tokenusage"
 	"mylittleprice/ent/user"
 	"mylittleprice/ent/userpreference"
+	"mylittleprice/ent/watchlistitem"
+	"mylittleprice/ent/wishlist"
+	"mylittleprice/ent/wishlistitem"
 	"reflect"
 	"sync"
 
@@ -77,11 +84,18 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			chatsession.Table:    chatsession.ValidColumn,
-			message.Table:        message.ValidColumn,
-			searchhistory.Table:  searchhistory.ValidColumn,
-			user.Table:           user.ValidColumn,
-			userpreference.Table: userpreference.ValidColumn,
+			chatsession.Table:       chatsession.ValidColumn,
+			conversationshare.Table: conversationshare.ValidColumn,
+			exchangerate.Table:      exchangerate.ValidColumn,
+			message.Table:           message.ValidColumn,
+			priceobservation.Table:  priceobservation.ValidColumn,
+			searchhistory.Table:     searchhistory.ValidColumn,
+			tokenusage.Table:        tokenusage.ValidColumn,
+			user.Table:              user.ValidColumn,
+			userpreference.Table:    userpreference.ValidColumn,
+			watchlistitem.Table:     watchlistitem.ValidColumn,
+			wishlist.Table:          wishlist.ValidColumn,
+			wishlistitem.Table:      wishlistitem.ValidColumn,
 		})
 	})
 	return columnCheck(t, c)
@@ -20,6 +20,30 @@ func (f ChatSessionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ChatSessionMutation", m)
 }
 
+// The ConversationShareFunc type is an adapter to allow the use of ordinary
+// function as ConversationShare mutator.
+type ConversationShareFunc func(context.Context, *ent.ConversationShareMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ConversationShareFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ConversationShareMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ConversationShareMutation", m)
+}
+
+// The ExchangeRateFunc type is an adapter to allow the use of ordinary
+// function as ExchangeRate mutator.
+type ExchangeRateFunc func(context.Context, *ent.ExchangeRateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ExchangeRateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ExchangeRateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ExchangeRateMutation", m)
+}
+
 // The MessageFunc type is an adapter to allow the use of ordinary
 // function as Message mutator.
 type MessageFunc func(context.Context, *ent.MessageMutation) (ent.Value, error)
@@ -32,6 +56,18 @@ func (f MessageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, err
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.MessageMutation", m)
 }
 
+// The PriceObservationFunc type is an adapter to allow the use of ordinary
+// function as PriceObservation mutator.
+type PriceObservationFunc func(context.Context, *ent.PriceObservationMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PriceObservationFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PriceObservationMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PriceObservationMutation", m)
+}
+
 // The SearchHistoryFunc type is an adapter to allow the use of ordinary
 // function as SearchHistory mutator.
 type SearchHistoryFunc func(context.Context, *ent.SearchHistoryMutation) (ent.Value, error)
@@ -44,6 +80,18 @@ func (f SearchHistoryFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Valu
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SearchHistoryMutation", m)
 }
 
+// The TokenUsageFunc type is an adapter to allow the use of ordinary
+// function as TokenUsage mutator.
+type TokenUsageFunc func(context.Context, *ent.TokenUsageMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TokenUsageFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TokenUsageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TokenUsageMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -68,6 +116,42 @@ func (f UserPreferenceFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Val
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.UserPreferenceMutation", m)
 }
 
+// The WatchlistItemFunc type is an adapter to allow the use of ordinary
+// function as WatchlistItem mutator.
+type WatchlistItemFunc func(context.Context, *ent.WatchlistItemMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WatchlistItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WatchlistItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WatchlistItemMutation", m)
+}
+
+// The WishlistFunc type is an adapter to allow the use of ordinary
+// function as Wishlist mutator.
+type WishlistFunc func(context.Context, *ent.WishlistMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WishlistFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WishlistMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WishlistMutation", m)
+}
+
+// The WishlistItemFunc type is an adapter to allow the use of ordinary
+// function as WishlistItem mutator.
+type WishlistItemFunc func(context.Context, *ent.WishlistItemMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f WishlistItemFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.WishlistItemMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.WishlistItemMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, ent.Mutation) bool
 
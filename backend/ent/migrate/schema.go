@@ -56,6 +56,55 @@ var (
 			},
 		},
 	}
+	// ConversationSharesColumns holds the columns for the "conversation_shares" table.
+	ConversationSharesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "session_id", Type: field.TypeUUID},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "messages", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "message_count", Type: field.TypeInt},
+		{Name: "currency", Type: field.TypeString, Nullable: true},
+		{Name: "view_count", Type: field.TypeInt, Default: 0},
+		{Name: "expires_at", Type: field.TypeTime, Nullable: true},
+		{Name: "revoked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// ConversationSharesTable holds the schema information for the "conversation_shares" table.
+	ConversationSharesTable = &schema.Table{
+		Name:       "conversation_shares",
+		Columns:    ConversationSharesColumns,
+		PrimaryKey: []*schema.Column{ConversationSharesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "conversation_shares_users_conversation_shares",
+				Columns:    []*schema.Column{ConversationSharesColumns[10]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "conversationshare_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{ConversationSharesColumns[10], ConversationSharesColumns[9]},
+			},
+		},
+	}
+	// ExchangeRatesColumns holds the columns for the "exchange_rates" table.
+	ExchangeRatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "currency", Type: field.TypeString, Unique: true},
+		{Name: "rate", Type: field.TypeFloat64},
+		{Name: "source", Type: field.TypeString},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ExchangeRatesTable holds the schema information for the "exchange_rates" table.
+	ExchangeRatesTable = &schema.Table{
+		Name:       "exchange_rates",
+		Columns:    ExchangeRatesColumns,
+		PrimaryKey: []*schema.Column{ExchangeRatesColumns[0]},
+	}
 	// MessagesColumns holds the columns for the "messages" table.
 	MessagesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -89,6 +138,39 @@ var (
 			},
 		},
 	}
+	// PriceObservationsColumns holds the columns for the "price_observations" table.
+	PriceObservationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "product_key", Type: field.TypeString},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "source", Type: field.TypeString},
+		{Name: "product_id", Type: field.TypeString, Nullable: true},
+		{Name: "page_token", Type: field.TypeString, Nullable: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "merchant", Type: field.TypeString, Nullable: true},
+		{Name: "country", Type: field.TypeString, Nullable: true},
+		{Name: "amount", Type: field.TypeInt64},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "observed_at", Type: field.TypeTime},
+	}
+	// PriceObservationsTable holds the schema information for the "price_observations" table.
+	PriceObservationsTable = &schema.Table{
+		Name:       "price_observations",
+		Columns:    PriceObservationsColumns,
+		PrimaryKey: []*schema.Column{PriceObservationsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "priceobservation_product_key_observed_at",
+				Unique:  false,
+				Columns: []*schema.Column{PriceObservationsColumns[1], PriceObservationsColumns[11]},
+			},
+			{
+				Name:    "priceobservation_page_token",
+				Unique:  false,
+				Columns: []*schema.Column{PriceObservationsColumns[5]},
+			},
+		},
+	}
 	// SearchHistoriesColumns holds the columns for the "search_histories" table.
 	SearchHistoriesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -141,6 +223,46 @@ var (
 			},
 		},
 	}
+	// TokenUsagesColumns holds the columns for the "token_usages" table.
+	TokenUsagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "session_id", Type: field.TypeString, Nullable: true},
+		{Name: "provider", Type: field.TypeString},
+		{Name: "model", Type: field.TypeString},
+		{Name: "operation", Type: field.TypeString},
+		{Name: "input_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "cached_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "output_tokens", Type: field.TypeInt, Default: 0},
+		{Name: "grounded", Type: field.TypeBool, Default: false},
+		{Name: "latency_ms", Type: field.TypeInt, Default: 0},
+		{Name: "cost_usd", Type: field.TypeFloat64, Default: 0},
+		{Name: "day", Type: field.TypeString},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TokenUsagesTable holds the schema information for the "token_usages" table.
+	TokenUsagesTable = &schema.Table{
+		Name:       "token_usages",
+		Columns:    TokenUsagesColumns,
+		PrimaryKey: []*schema.Column{TokenUsagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "tokenusage_session_id",
+				Unique:  false,
+				Columns: []*schema.Column{TokenUsagesColumns[2]},
+			},
+			{
+				Name:    "tokenusage_user_id_day",
+				Unique:  false,
+				Columns: []*schema.Column{TokenUsagesColumns[1], TokenUsagesColumns[12]},
+			},
+			{
+				Name:    "tokenusage_day_model",
+				Unique:  false,
+				Columns: []*schema.Column{TokenUsagesColumns[12], TokenUsagesColumns[4]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID},
@@ -150,6 +272,7 @@ var (
 		{Name: "name", Type: field.TypeString, Nullable: true},
 		{Name: "avatar_url", Type: field.TypeString, Nullable: true},
 		{Name: "provider", Type: field.TypeString, Default: "email"},
+		{Name: "plan", Type: field.TypeString, Default: "free"},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "last_login", Type: field.TypeTime, Nullable: true},
@@ -200,19 +323,136 @@ var (
 			},
 		},
 	}
+	// WatchlistItemsColumns holds the columns for the "watchlist_items" table.
+	WatchlistItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "product_key", Type: field.TypeString, Nullable: true},
+		{Name: "page_token", Type: field.TypeString, Size: 2147483647},
+		{Name: "title", Type: field.TypeString},
+		{Name: "link", Type: field.TypeString, Nullable: true},
+		{Name: "image", Type: field.TypeString, Nullable: true},
+		{Name: "target_amount", Type: field.TypeInt64},
+		{Name: "currency", Type: field.TypeString},
+		{Name: "country", Type: field.TypeString, Nullable: true},
+		{Name: "last_amount", Type: field.TypeInt64, Nullable: true},
+		{Name: "last_checked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "notified_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// WatchlistItemsTable holds the schema information for the "watchlist_items" table.
+	WatchlistItemsTable = &schema.Table{
+		Name:       "watchlist_items",
+		Columns:    WatchlistItemsColumns,
+		PrimaryKey: []*schema.Column{WatchlistItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "watchlist_items_users_watchlist",
+				Columns:    []*schema.Column{WatchlistItemsColumns[14]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "watchlistitem_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WatchlistItemsColumns[14], WatchlistItemsColumns[12]},
+			},
+			{
+				Name:    "watchlistitem_last_checked_at",
+				Unique:  false,
+				Columns: []*schema.Column{WatchlistItemsColumns[10]},
+			},
+		},
+	}
+	// WishlistsColumns holds the columns for the "wishlists" table.
+	WishlistsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "name", Type: field.TypeString},
+		{Name: "shared", Type: field.TypeBool, Default: false},
+		{Name: "share_version", Type: field.TypeInt, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// WishlistsTable holds the schema information for the "wishlists" table.
+	WishlistsTable = &schema.Table{
+		Name:       "wishlists",
+		Columns:    WishlistsColumns,
+		PrimaryKey: []*schema.Column{WishlistsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "wishlists_users_wishlists",
+				Columns:    []*schema.Column{WishlistsColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wishlist_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WishlistsColumns[6], WishlistsColumns[4]},
+			},
+		},
+	}
+	// WishlistItemsColumns holds the columns for the "wishlist_items" table.
+	WishlistItemsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID},
+		{Name: "page_token", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "product_key", Type: field.TypeString, Nullable: true},
+		{Name: "product", Type: field.TypeJSON, SchemaType: map[string]string{"postgres": "jsonb"}},
+		{Name: "note", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "wishlist_id", Type: field.TypeUUID},
+	}
+	// WishlistItemsTable holds the schema information for the "wishlist_items" table.
+	WishlistItemsTable = &schema.Table{
+		Name:       "wishlist_items",
+		Columns:    WishlistItemsColumns,
+		PrimaryKey: []*schema.Column{WishlistItemsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "wishlist_items_wishlists_items",
+				Columns:    []*schema.Column{WishlistItemsColumns[6]},
+				RefColumns: []*schema.Column{WishlistsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "wishlistitem_wishlist_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{WishlistItemsColumns[6], WishlistItemsColumns[5]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
 		ChatSessionsTable,
+		ConversationSharesTable,
+		ExchangeRatesTable,
 		MessagesTable,
+		PriceObservationsTable,
 		SearchHistoriesTable,
+		TokenUsagesTable,
 		UsersTable,
 		UserPreferencesTable,
+		WatchlistItemsTable,
+		WishlistsTable,
+		WishlistItemsTable,
 	}
 )
 
 func init() {
 	ChatSessionsTable.ForeignKeys[0].RefTable = UsersTable
+	ConversationSharesTable.ForeignKeys[0].RefTable = UsersTable
 	MessagesTable.ForeignKeys[0].RefTable = ChatSessionsTable
 	SearchHistoriesTable.ForeignKeys[0].RefTable = UsersTable
 	UserPreferencesTable.ForeignKeys[0].RefTable = UsersTable
+	WatchlistItemsTable.ForeignKeys[0].RefTable = UsersTable
+	WishlistsTable.ForeignKeys[0].RefTable = UsersTable
+	WishlistItemsTable.ForeignKeys[0].RefTable = WishlistsTable
 }
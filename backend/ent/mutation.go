@@ -7,11 +7,18 @@ import (
 	"errors"
 	"fmt"
 	"mylittleprice/ent/chatsession"
+	"mylittleprice/ent/conversationshare"
+	"mylittleprice/ent/exchangerate"
 	"mylittleprice/ent/message"
 	"mylittleprice/ent/predicate"
+	"mylittleprice/ent/priceobservation"
 	"mylittleprice/ent/searchhistory"
+	"mylittleprice/ent/tokenusage"
 	"mylittleprice/ent/user"
 	"mylittleprice/ent/userpreference"
+	"mylittleprice/ent/watchlistitem"
+	"mylittleprice/ent/wishlist"
+	"mylittleprice/ent/wishlistitem"
 	"sync"
 	"time"
 
@@ -29,11 +36,18 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeChatSession    = "ChatSession"
-	TypeMessage        = "Message"
-	TypeSearchHistory  = "SearchHistory"
-	TypeUser           = "User"
-	TypeUserPreference = "UserPreference"
+	TypeChatSession       = "ChatSession"
+	TypeConversationShare = "ConversationShare"
+	TypeExchangeRate      = "ExchangeRate"
+	TypeMessage           = "Message"
+	TypePriceObservation  = "PriceObservation"
+	TypeSearchHistory     = "SearchHistory"
+	TypeTokenUsage        = "TokenUsage"
+	TypeUser              = "User"
+	TypeUserPreference    = "UserPreference"
+	TypeWatchlistItem     = "WatchlistItem"
+	TypeWishlist          = "Wishlist"
+	TypeWishlistItem      = "WishlistItem"
 )
 
 // ChatSessionMutation represents an operation that mutates the ChatSession nodes in the graph.
@@ -1178,40 +1192,43 @@ func (m *ChatSessionMutation) ResetEdge(name string) error {
 	return fmt.Errorf("unknown ChatSession edge %s", name)
 }
 
-// MessageMutation represents an operation that mutates the Message nodes in the graph.
-type MessageMutation struct {
+// ConversationShareMutation represents an operation that mutates the ConversationShare nodes in the graph.
+type ConversationShareMutation struct {
 	config
-	op                  Op
-	typ                 string
-	id                  *uuid.UUID
-	role                *string
-	content             *string
-	response_type       *string
-	quick_replies       *[]string
-	appendquick_replies []string
-	products            *[]map[string]interface{}
-	appendproducts      []map[string]interface{}
-	search_info         *map[string]interface{}
-	created_at          *time.Time
-	clearedFields       map[string]struct{}
-	session             *uuid.UUID
-	clearedsession      bool
-	done                bool
-	oldValue            func(context.Context) (*Message, error)
-	predicates          []predicate.Message
-}
-
-var _ ent.Mutation = (*MessageMutation)(nil)
-
-// messageOption allows management of the mutation configuration using functional options.
-type messageOption func(*MessageMutation)
-
-// newMessageMutation creates new mutation for the Message entity.
-func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
-	m := &MessageMutation{
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	session_id       *uuid.UUID
+	title            *string
+	messages         *[]map[string]interface{}
+	appendmessages   []map[string]interface{}
+	message_count    *int
+	addmessage_count *int
+	currency         *string
+	view_count       *int
+	addview_count    *int
+	expires_at       *time.Time
+	revoked_at       *time.Time
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	user             *uuid.UUID
+	cleareduser      bool
+	done             bool
+	oldValue         func(context.Context) (*ConversationShare, error)
+	predicates       []predicate.ConversationShare
+}
+
+var _ ent.Mutation = (*ConversationShareMutation)(nil)
+
+// conversationshareOption allows management of the mutation configuration using functional options.
+type conversationshareOption func(*ConversationShareMutation)
+
+// newConversationShareMutation creates new mutation for the ConversationShare entity.
+func newConversationShareMutation(c config, op Op, opts ...conversationshareOption) *ConversationShareMutation {
+	m := &ConversationShareMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessage,
+		typ:           TypeConversationShare,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1220,20 +1237,20 @@ func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation
 	return m
 }
 
-// withMessageID sets the ID field of the mutation.
-func withMessageID(id uuid.UUID) messageOption {
-	return func(m *MessageMutation) {
+// withConversationShareID sets the ID field of the mutation.
+func withConversationShareID(id uuid.UUID) conversationshareOption {
+	return func(m *ConversationShareMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Message
+			value *ConversationShare
 		)
-		m.oldValue = func(ctx context.Context) (*Message, error) {
+		m.oldValue = func(ctx context.Context) (*ConversationShare, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Message.Get(ctx, id)
+					value, err = m.Client().ConversationShare.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1242,10 +1259,10 @@ func withMessageID(id uuid.UUID) messageOption {
 	}
 }
 
-// withMessage sets the old Message of the mutation.
-func withMessage(node *Message) messageOption {
-	return func(m *MessageMutation) {
-		m.oldValue = func(context.Context) (*Message, error) {
+// withConversationShare sets the old ConversationShare of the mutation.
+func withConversationShare(node *ConversationShare) conversationshareOption {
+	return func(m *ConversationShareMutation) {
+		m.oldValue = func(context.Context) (*ConversationShare, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1254,7 +1271,7 @@ func withMessage(node *Message) messageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageMutation) Client() *Client {
+func (m ConversationShareMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1262,7 +1279,7 @@ func (m MessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageMutation) Tx() (*Tx, error) {
+func (m ConversationShareMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -1272,14 +1289,14 @@ func (m MessageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Message entities.
-func (m *MessageMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ConversationShare entities.
+func (m *ConversationShareMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ConversationShareMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1290,7 +1307,7 @@ func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ConversationShareMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -1299,30 +1316,66 @@ func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ConversationShare.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
+// SetUserID sets the "user_id" field.
+func (m *ConversationShareMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ConversationShareMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ConversationShare entity.
+// If the ConversationShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationShareMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ConversationShareMutation) ResetUserID() {
+	m.user = nil
+}
+
 // SetSessionID sets the "session_id" field.
-func (m *MessageMutation) SetSessionID(u uuid.UUID) {
-	m.session = &u
+func (m *ConversationShareMutation) SetSessionID(u uuid.UUID) {
+	m.session_id = &u
 }
 
 // SessionID returns the value of the "session_id" field in the mutation.
-func (m *MessageMutation) SessionID() (r uuid.UUID, exists bool) {
-	v := m.session
+func (m *ConversationShareMutation) SessionID() (r uuid.UUID, exists bool) {
+	v := m.session_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSessionID returns the old "session_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldSessionID returns the old "session_id" field's value of the ConversationShare entity.
+// If the ConversationShare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldSessionID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ConversationShareMutation) OldSessionID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
 	}
@@ -1337,317 +1390,376 @@ func (m *MessageMutation) OldSessionID(ctx context.Context) (v uuid.UUID, err er
 }
 
 // ResetSessionID resets all changes to the "session_id" field.
-func (m *MessageMutation) ResetSessionID() {
-	m.session = nil
+func (m *ConversationShareMutation) ResetSessionID() {
+	m.session_id = nil
 }
 
-// SetRole sets the "role" field.
-func (m *MessageMutation) SetRole(s string) {
-	m.role = &s
+// SetTitle sets the "title" field.
+func (m *ConversationShareMutation) SetTitle(s string) {
+	m.title = &s
 }
 
-// Role returns the value of the "role" field in the mutation.
-func (m *MessageMutation) Role() (r string, exists bool) {
-	v := m.role
+// Title returns the value of the "title" field in the mutation.
+func (m *ConversationShareMutation) Title() (r string, exists bool) {
+	v := m.title
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRole returns the old "role" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldTitle returns the old "title" field's value of the ConversationShare entity.
+// If the ConversationShare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldRole(ctx context.Context) (v string, err error) {
+func (m *ConversationShareMutation) OldTitle(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRole is only allowed on UpdateOne operations")
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRole requires an ID field in the mutation")
+		return v, errors.New("OldTitle requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRole: %w", err)
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
 	}
-	return oldValue.Role, nil
+	return oldValue.Title, nil
 }
 
-// ResetRole resets all changes to the "role" field.
-func (m *MessageMutation) ResetRole() {
-	m.role = nil
+// ClearTitle clears the value of the "title" field.
+func (m *ConversationShareMutation) ClearTitle() {
+	m.title = nil
+	m.clearedFields[conversationshare.FieldTitle] = struct{}{}
 }
 
-// SetContent sets the "content" field.
-func (m *MessageMutation) SetContent(s string) {
-	m.content = &s
+// TitleCleared returns if the "title" field was cleared in this mutation.
+func (m *ConversationShareMutation) TitleCleared() bool {
+	_, ok := m.clearedFields[conversationshare.FieldTitle]
+	return ok
 }
 
-// Content returns the value of the "content" field in the mutation.
-func (m *MessageMutation) Content() (r string, exists bool) {
-	v := m.content
+// ResetTitle resets all changes to the "title" field.
+func (m *ConversationShareMutation) ResetTitle() {
+	m.title = nil
+	delete(m.clearedFields, conversationshare.FieldTitle)
+}
+
+// SetMessages sets the "messages" field.
+func (m *ConversationShareMutation) SetMessages(value []map[string]interface{}) {
+	m.messages = &value
+	m.appendmessages = nil
+}
+
+// Messages returns the value of the "messages" field in the mutation.
+func (m *ConversationShareMutation) Messages() (r []map[string]interface{}, exists bool) {
+	v := m.messages
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldContent returns the old "content" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldMessages returns the old "messages" field's value of the ConversationShare entity.
+// If the ConversationShare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldContent(ctx context.Context) (v string, err error) {
+func (m *ConversationShareMutation) OldMessages(ctx context.Context) (v []map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldContent is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessages is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldContent requires an ID field in the mutation")
+		return v, errors.New("OldMessages requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldContent: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessages: %w", err)
 	}
-	return oldValue.Content, nil
+	return oldValue.Messages, nil
 }
 
-// ResetContent resets all changes to the "content" field.
-func (m *MessageMutation) ResetContent() {
-	m.content = nil
+// AppendMessages adds value to the "messages" field.
+func (m *ConversationShareMutation) AppendMessages(value []map[string]interface{}) {
+	m.appendmessages = append(m.appendmessages, value...)
 }
 
-// SetResponseType sets the "response_type" field.
-func (m *MessageMutation) SetResponseType(s string) {
-	m.response_type = &s
+// AppendedMessages returns the list of values that were appended to the "messages" field in this mutation.
+func (m *ConversationShareMutation) AppendedMessages() ([]map[string]interface{}, bool) {
+	if len(m.appendmessages) == 0 {
+		return nil, false
+	}
+	return m.appendmessages, true
 }
 
-// ResponseType returns the value of the "response_type" field in the mutation.
-func (m *MessageMutation) ResponseType() (r string, exists bool) {
-	v := m.response_type
+// ResetMessages resets all changes to the "messages" field.
+func (m *ConversationShareMutation) ResetMessages() {
+	m.messages = nil
+	m.appendmessages = nil
+}
+
+// SetMessageCount sets the "message_count" field.
+func (m *ConversationShareMutation) SetMessageCount(i int) {
+	m.message_count = &i
+	m.addmessage_count = nil
+}
+
+// MessageCount returns the value of the "message_count" field in the mutation.
+func (m *ConversationShareMutation) MessageCount() (r int, exists bool) {
+	v := m.message_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResponseType returns the old "response_type" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldMessageCount returns the old "message_count" field's value of the ConversationShare entity.
+// If the ConversationShare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldResponseType(ctx context.Context) (v string, err error) {
+func (m *ConversationShareMutation) OldMessageCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResponseType is only allowed on UpdateOne operations")
+		return v, errors.New("OldMessageCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResponseType requires an ID field in the mutation")
+		return v, errors.New("OldMessageCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResponseType: %w", err)
+		return v, fmt.Errorf("querying old value for OldMessageCount: %w", err)
 	}
-	return oldValue.ResponseType, nil
+	return oldValue.MessageCount, nil
 }
 
-// ClearResponseType clears the value of the "response_type" field.
-func (m *MessageMutation) ClearResponseType() {
-	m.response_type = nil
-	m.clearedFields[message.FieldResponseType] = struct{}{}
+// AddMessageCount adds i to the "message_count" field.
+func (m *ConversationShareMutation) AddMessageCount(i int) {
+	if m.addmessage_count != nil {
+		*m.addmessage_count += i
+	} else {
+		m.addmessage_count = &i
+	}
 }
 
-// ResponseTypeCleared returns if the "response_type" field was cleared in this mutation.
-func (m *MessageMutation) ResponseTypeCleared() bool {
-	_, ok := m.clearedFields[message.FieldResponseType]
-	return ok
+// AddedMessageCount returns the value that was added to the "message_count" field in this mutation.
+func (m *ConversationShareMutation) AddedMessageCount() (r int, exists bool) {
+	v := m.addmessage_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetResponseType resets all changes to the "response_type" field.
-func (m *MessageMutation) ResetResponseType() {
-	m.response_type = nil
-	delete(m.clearedFields, message.FieldResponseType)
+// ResetMessageCount resets all changes to the "message_count" field.
+func (m *ConversationShareMutation) ResetMessageCount() {
+	m.message_count = nil
+	m.addmessage_count = nil
 }
 
-// SetQuickReplies sets the "quick_replies" field.
-func (m *MessageMutation) SetQuickReplies(s []string) {
-	m.quick_replies = &s
-	m.appendquick_replies = nil
+// SetCurrency sets the "currency" field.
+func (m *ConversationShareMutation) SetCurrency(s string) {
+	m.currency = &s
 }
 
-// QuickReplies returns the value of the "quick_replies" field in the mutation.
-func (m *MessageMutation) QuickReplies() (r []string, exists bool) {
-	v := m.quick_replies
+// Currency returns the value of the "currency" field in the mutation.
+func (m *ConversationShareMutation) Currency() (r string, exists bool) {
+	v := m.currency
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuickReplies returns the old "quick_replies" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldCurrency returns the old "currency" field's value of the ConversationShare entity.
+// If the ConversationShare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldQuickReplies(ctx context.Context) (v []string, err error) {
+func (m *ConversationShareMutation) OldCurrency(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuickReplies is only allowed on UpdateOne operations")
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuickReplies requires an ID field in the mutation")
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuickReplies: %w", err)
-	}
-	return oldValue.QuickReplies, nil
-}
-
-// AppendQuickReplies adds s to the "quick_replies" field.
-func (m *MessageMutation) AppendQuickReplies(s []string) {
-	m.appendquick_replies = append(m.appendquick_replies, s...)
-}
-
-// AppendedQuickReplies returns the list of values that were appended to the "quick_replies" field in this mutation.
-func (m *MessageMutation) AppendedQuickReplies() ([]string, bool) {
-	if len(m.appendquick_replies) == 0 {
-		return nil, false
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
 	}
-	return m.appendquick_replies, true
+	return oldValue.Currency, nil
 }
 
-// ClearQuickReplies clears the value of the "quick_replies" field.
-func (m *MessageMutation) ClearQuickReplies() {
-	m.quick_replies = nil
-	m.appendquick_replies = nil
-	m.clearedFields[message.FieldQuickReplies] = struct{}{}
+// ClearCurrency clears the value of the "currency" field.
+func (m *ConversationShareMutation) ClearCurrency() {
+	m.currency = nil
+	m.clearedFields[conversationshare.FieldCurrency] = struct{}{}
 }
 
-// QuickRepliesCleared returns if the "quick_replies" field was cleared in this mutation.
-func (m *MessageMutation) QuickRepliesCleared() bool {
-	_, ok := m.clearedFields[message.FieldQuickReplies]
+// CurrencyCleared returns if the "currency" field was cleared in this mutation.
+func (m *ConversationShareMutation) CurrencyCleared() bool {
+	_, ok := m.clearedFields[conversationshare.FieldCurrency]
 	return ok
 }
 
-// ResetQuickReplies resets all changes to the "quick_replies" field.
-func (m *MessageMutation) ResetQuickReplies() {
-	m.quick_replies = nil
-	m.appendquick_replies = nil
-	delete(m.clearedFields, message.FieldQuickReplies)
+// ResetCurrency resets all changes to the "currency" field.
+func (m *ConversationShareMutation) ResetCurrency() {
+	m.currency = nil
+	delete(m.clearedFields, conversationshare.FieldCurrency)
 }
 
-// SetProducts sets the "products" field.
-func (m *MessageMutation) SetProducts(value []map[string]interface{}) {
-	m.products = &value
-	m.appendproducts = nil
+// SetViewCount sets the "view_count" field.
+func (m *ConversationShareMutation) SetViewCount(i int) {
+	m.view_count = &i
+	m.addview_count = nil
 }
 
-// Products returns the value of the "products" field in the mutation.
-func (m *MessageMutation) Products() (r []map[string]interface{}, exists bool) {
-	v := m.products
+// ViewCount returns the value of the "view_count" field in the mutation.
+func (m *ConversationShareMutation) ViewCount() (r int, exists bool) {
+	v := m.view_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProducts returns the old "products" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldViewCount returns the old "view_count" field's value of the ConversationShare entity.
+// If the ConversationShare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldProducts(ctx context.Context) (v []map[string]interface{}, err error) {
+func (m *ConversationShareMutation) OldViewCount(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProducts is only allowed on UpdateOne operations")
+		return v, errors.New("OldViewCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProducts requires an ID field in the mutation")
+		return v, errors.New("OldViewCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProducts: %w", err)
+		return v, fmt.Errorf("querying old value for OldViewCount: %w", err)
 	}
-	return oldValue.Products, nil
+	return oldValue.ViewCount, nil
 }
 
-// AppendProducts adds value to the "products" field.
-func (m *MessageMutation) AppendProducts(value []map[string]interface{}) {
-	m.appendproducts = append(m.appendproducts, value...)
+// AddViewCount adds i to the "view_count" field.
+func (m *ConversationShareMutation) AddViewCount(i int) {
+	if m.addview_count != nil {
+		*m.addview_count += i
+	} else {
+		m.addview_count = &i
+	}
 }
 
-// AppendedProducts returns the list of values that were appended to the "products" field in this mutation.
-func (m *MessageMutation) AppendedProducts() ([]map[string]interface{}, bool) {
-	if len(m.appendproducts) == 0 {
-		return nil, false
+// AddedViewCount returns the value that was added to the "view_count" field in this mutation.
+func (m *ConversationShareMutation) AddedViewCount() (r int, exists bool) {
+	v := m.addview_count
+	if v == nil {
+		return
 	}
-	return m.appendproducts, true
+	return *v, true
 }
 
-// ClearProducts clears the value of the "products" field.
-func (m *MessageMutation) ClearProducts() {
-	m.products = nil
-	m.appendproducts = nil
-	m.clearedFields[message.FieldProducts] = struct{}{}
+// ResetViewCount resets all changes to the "view_count" field.
+func (m *ConversationShareMutation) ResetViewCount() {
+	m.view_count = nil
+	m.addview_count = nil
 }
 
-// ProductsCleared returns if the "products" field was cleared in this mutation.
-func (m *MessageMutation) ProductsCleared() bool {
-	_, ok := m.clearedFields[message.FieldProducts]
+// SetExpiresAt sets the "expires_at" field.
+func (m *ConversationShareMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *ConversationShareMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the ConversationShare entity.
+// If the ConversationShare object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ConversationShareMutation) OldExpiresAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *ConversationShareMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[conversationshare.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *ConversationShareMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[conversationshare.FieldExpiresAt]
 	return ok
 }
 
-// ResetProducts resets all changes to the "products" field.
-func (m *MessageMutation) ResetProducts() {
-	m.products = nil
-	m.appendproducts = nil
-	delete(m.clearedFields, message.FieldProducts)
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *ConversationShareMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, conversationshare.FieldExpiresAt)
 }
 
-// SetSearchInfo sets the "search_info" field.
-func (m *MessageMutation) SetSearchInfo(value map[string]interface{}) {
-	m.search_info = &value
+// SetRevokedAt sets the "revoked_at" field.
+func (m *ConversationShareMutation) SetRevokedAt(t time.Time) {
+	m.revoked_at = &t
 }
 
-// SearchInfo returns the value of the "search_info" field in the mutation.
-func (m *MessageMutation) SearchInfo() (r map[string]interface{}, exists bool) {
-	v := m.search_info
+// RevokedAt returns the value of the "revoked_at" field in the mutation.
+func (m *ConversationShareMutation) RevokedAt() (r time.Time, exists bool) {
+	v := m.revoked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSearchInfo returns the old "search_info" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldRevokedAt returns the old "revoked_at" field's value of the ConversationShare entity.
+// If the ConversationShare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldSearchInfo(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *ConversationShareMutation) OldRevokedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSearchInfo is only allowed on UpdateOne operations")
+		return v, errors.New("OldRevokedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSearchInfo requires an ID field in the mutation")
+		return v, errors.New("OldRevokedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSearchInfo: %w", err)
+		return v, fmt.Errorf("querying old value for OldRevokedAt: %w", err)
 	}
-	return oldValue.SearchInfo, nil
+	return oldValue.RevokedAt, nil
 }
 
-// ClearSearchInfo clears the value of the "search_info" field.
-func (m *MessageMutation) ClearSearchInfo() {
-	m.search_info = nil
-	m.clearedFields[message.FieldSearchInfo] = struct{}{}
+// ClearRevokedAt clears the value of the "revoked_at" field.
+func (m *ConversationShareMutation) ClearRevokedAt() {
+	m.revoked_at = nil
+	m.clearedFields[conversationshare.FieldRevokedAt] = struct{}{}
 }
 
-// SearchInfoCleared returns if the "search_info" field was cleared in this mutation.
-func (m *MessageMutation) SearchInfoCleared() bool {
-	_, ok := m.clearedFields[message.FieldSearchInfo]
+// RevokedAtCleared returns if the "revoked_at" field was cleared in this mutation.
+func (m *ConversationShareMutation) RevokedAtCleared() bool {
+	_, ok := m.clearedFields[conversationshare.FieldRevokedAt]
 	return ok
 }
 
-// ResetSearchInfo resets all changes to the "search_info" field.
-func (m *MessageMutation) ResetSearchInfo() {
-	m.search_info = nil
-	delete(m.clearedFields, message.FieldSearchInfo)
+// ResetRevokedAt resets all changes to the "revoked_at" field.
+func (m *ConversationShareMutation) ResetRevokedAt() {
+	m.revoked_at = nil
+	delete(m.clearedFields, conversationshare.FieldRevokedAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MessageMutation) SetCreatedAt(t time.Time) {
+func (m *ConversationShareMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ConversationShareMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1655,10 +1767,10 @@ func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the ConversationShare entity.
+// If the ConversationShare object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ConversationShareMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1673,46 +1785,46 @@ func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MessageMutation) ResetCreatedAt() {
+func (m *ConversationShareMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// ClearSession clears the "session" edge to the ChatSession entity.
-func (m *MessageMutation) ClearSession() {
-	m.clearedsession = true
-	m.clearedFields[message.FieldSessionID] = struct{}{}
+// ClearUser clears the "user" edge to the User entity.
+func (m *ConversationShareMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[conversationshare.FieldUserID] = struct{}{}
 }
 
-// SessionCleared reports if the "session" edge to the ChatSession entity was cleared.
-func (m *MessageMutation) SessionCleared() bool {
-	return m.clearedsession
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *ConversationShareMutation) UserCleared() bool {
+	return m.cleareduser
 }
 
-// SessionIDs returns the "session" edge IDs in the mutation.
+// UserIDs returns the "user" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// SessionID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) SessionIDs() (ids []uuid.UUID) {
-	if id := m.session; id != nil {
+// UserID instead. It exists only for internal usage by the builders.
+func (m *ConversationShareMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetSession resets all changes to the "session" edge.
-func (m *MessageMutation) ResetSession() {
-	m.session = nil
-	m.clearedsession = false
+// ResetUser resets all changes to the "user" edge.
+func (m *ConversationShareMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
 }
 
-// Where appends a list predicates to the MessageMutation builder.
-func (m *MessageMutation) Where(ps ...predicate.Message) {
+// Where appends a list predicates to the ConversationShareMutation builder.
+func (m *ConversationShareMutation) Where(ps ...predicate.ConversationShare) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ConversationShareMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Message, len(ps))
+func (m *ConversationShareMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ConversationShare, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1720,48 +1832,54 @@ func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageMutation) Op() Op {
+func (m *ConversationShareMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageMutation) SetOp(op Op) {
+func (m *ConversationShareMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Message).
-func (m *MessageMutation) Type() string {
+// Type returns the node type of this mutation (ConversationShare).
+func (m *ConversationShareMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.session != nil {
-		fields = append(fields, message.FieldSessionID)
+func (m *ConversationShareMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.user != nil {
+		fields = append(fields, conversationshare.FieldUserID)
 	}
-	if m.role != nil {
-		fields = append(fields, message.FieldRole)
+	if m.session_id != nil {
+		fields = append(fields, conversationshare.FieldSessionID)
 	}
-	if m.content != nil {
-		fields = append(fields, message.FieldContent)
+	if m.title != nil {
+		fields = append(fields, conversationshare.FieldTitle)
 	}
-	if m.response_type != nil {
-		fields = append(fields, message.FieldResponseType)
+	if m.messages != nil {
+		fields = append(fields, conversationshare.FieldMessages)
 	}
-	if m.quick_replies != nil {
-		fields = append(fields, message.FieldQuickReplies)
+	if m.message_count != nil {
+		fields = append(fields, conversationshare.FieldMessageCount)
 	}
-	if m.products != nil {
-		fields = append(fields, message.FieldProducts)
+	if m.currency != nil {
+		fields = append(fields, conversationshare.FieldCurrency)
 	}
-	if m.search_info != nil {
-		fields = append(fields, message.FieldSearchInfo)
+	if m.view_count != nil {
+		fields = append(fields, conversationshare.FieldViewCount)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, conversationshare.FieldExpiresAt)
+	}
+	if m.revoked_at != nil {
+		fields = append(fields, conversationshare.FieldRevokedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, message.FieldCreatedAt)
+		fields = append(fields, conversationshare.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1769,23 +1887,27 @@ func (m *MessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+func (m *ConversationShareMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case message.FieldSessionID:
+	case conversationshare.FieldUserID:
+		return m.UserID()
+	case conversationshare.FieldSessionID:
 		return m.SessionID()
-	case message.FieldRole:
-		return m.Role()
-	case message.FieldContent:
-		return m.Content()
-	case message.FieldResponseType:
-		return m.ResponseType()
-	case message.FieldQuickReplies:
-		return m.QuickReplies()
-	case message.FieldProducts:
-		return m.Products()
-	case message.FieldSearchInfo:
-		return m.SearchInfo()
-	case message.FieldCreatedAt:
+	case conversationshare.FieldTitle:
+		return m.Title()
+	case conversationshare.FieldMessages:
+		return m.Messages()
+	case conversationshare.FieldMessageCount:
+		return m.MessageCount()
+	case conversationshare.FieldCurrency:
+		return m.Currency()
+	case conversationshare.FieldViewCount:
+		return m.ViewCount()
+	case conversationshare.FieldExpiresAt:
+		return m.ExpiresAt()
+	case conversationshare.FieldRevokedAt:
+		return m.RevokedAt()
+	case conversationshare.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -1794,83 +1916,101 @@ func (m *MessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ConversationShareMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case message.FieldSessionID:
+	case conversationshare.FieldUserID:
+		return m.OldUserID(ctx)
+	case conversationshare.FieldSessionID:
 		return m.OldSessionID(ctx)
-	case message.FieldRole:
-		return m.OldRole(ctx)
-	case message.FieldContent:
-		return m.OldContent(ctx)
-	case message.FieldResponseType:
-		return m.OldResponseType(ctx)
-	case message.FieldQuickReplies:
-		return m.OldQuickReplies(ctx)
-	case message.FieldProducts:
-		return m.OldProducts(ctx)
-	case message.FieldSearchInfo:
-		return m.OldSearchInfo(ctx)
-	case message.FieldCreatedAt:
+	case conversationshare.FieldTitle:
+		return m.OldTitle(ctx)
+	case conversationshare.FieldMessages:
+		return m.OldMessages(ctx)
+	case conversationshare.FieldMessageCount:
+		return m.OldMessageCount(ctx)
+	case conversationshare.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case conversationshare.FieldViewCount:
+		return m.OldViewCount(ctx)
+	case conversationshare.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	case conversationshare.FieldRevokedAt:
+		return m.OldRevokedAt(ctx)
+	case conversationshare.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Message field %s", name)
+	return nil, fmt.Errorf("unknown ConversationShare field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) SetField(name string, value ent.Value) error {
+func (m *ConversationShareMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case message.FieldSessionID:
+	case conversationshare.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case conversationshare.FieldSessionID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetSessionID(v)
 		return nil
-	case message.FieldRole:
+	case conversationshare.FieldTitle:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetRole(v)
+		m.SetTitle(v)
 		return nil
-	case message.FieldContent:
-		v, ok := value.(string)
+	case conversationshare.FieldMessages:
+		v, ok := value.([]map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetContent(v)
+		m.SetMessages(v)
 		return nil
-	case message.FieldResponseType:
+	case conversationshare.FieldMessageCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageCount(v)
+		return nil
+	case conversationshare.FieldCurrency:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResponseType(v)
+		m.SetCurrency(v)
 		return nil
-	case message.FieldQuickReplies:
-		v, ok := value.([]string)
+	case conversationshare.FieldViewCount:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuickReplies(v)
+		m.SetViewCount(v)
 		return nil
-	case message.FieldProducts:
-		v, ok := value.([]map[string]interface{})
+	case conversationshare.FieldExpiresAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProducts(v)
+		m.SetExpiresAt(v)
 		return nil
-	case message.FieldSearchInfo:
-		v, ok := value.(map[string]interface{})
+	case conversationshare.FieldRevokedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSearchInfo(v)
+		m.SetRevokedAt(v)
 		return nil
-	case message.FieldCreatedAt:
+	case conversationshare.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1878,124 +2018,157 @@ func (m *MessageMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown ConversationShare field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageMutation) AddedFields() []string {
-	return nil
+func (m *ConversationShareMutation) AddedFields() []string {
+	var fields []string
+	if m.addmessage_count != nil {
+		fields = append(fields, conversationshare.FieldMessageCount)
+	}
+	if m.addview_count != nil {
+		fields = append(fields, conversationshare.FieldViewCount)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ConversationShareMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case conversationshare.FieldMessageCount:
+		return m.AddedMessageCount()
+	case conversationshare.FieldViewCount:
+		return m.AddedViewCount()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) AddField(name string, value ent.Value) error {
+func (m *ConversationShareMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case conversationshare.FieldMessageCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMessageCount(v)
+		return nil
+	case conversationshare.FieldViewCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddViewCount(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Message numeric field %s", name)
+	return fmt.Errorf("unknown ConversationShare numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageMutation) ClearedFields() []string {
+func (m *ConversationShareMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(message.FieldResponseType) {
-		fields = append(fields, message.FieldResponseType)
+	if m.FieldCleared(conversationshare.FieldTitle) {
+		fields = append(fields, conversationshare.FieldTitle)
 	}
-	if m.FieldCleared(message.FieldQuickReplies) {
-		fields = append(fields, message.FieldQuickReplies)
+	if m.FieldCleared(conversationshare.FieldCurrency) {
+		fields = append(fields, conversationshare.FieldCurrency)
 	}
-	if m.FieldCleared(message.FieldProducts) {
-		fields = append(fields, message.FieldProducts)
+	if m.FieldCleared(conversationshare.FieldExpiresAt) {
+		fields = append(fields, conversationshare.FieldExpiresAt)
 	}
-	if m.FieldCleared(message.FieldSearchInfo) {
-		fields = append(fields, message.FieldSearchInfo)
+	if m.FieldCleared(conversationshare.FieldRevokedAt) {
+		fields = append(fields, conversationshare.FieldRevokedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageMutation) FieldCleared(name string) bool {
+func (m *ConversationShareMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageMutation) ClearField(name string) error {
+func (m *ConversationShareMutation) ClearField(name string) error {
 	switch name {
-	case message.FieldResponseType:
-		m.ClearResponseType()
+	case conversationshare.FieldTitle:
+		m.ClearTitle()
 		return nil
-	case message.FieldQuickReplies:
-		m.ClearQuickReplies()
+	case conversationshare.FieldCurrency:
+		m.ClearCurrency()
 		return nil
-	case message.FieldProducts:
-		m.ClearProducts()
+	case conversationshare.FieldExpiresAt:
+		m.ClearExpiresAt()
 		return nil
-	case message.FieldSearchInfo:
-		m.ClearSearchInfo()
+	case conversationshare.FieldRevokedAt:
+		m.ClearRevokedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Message nullable field %s", name)
+	return fmt.Errorf("unknown ConversationShare nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *MessageMutation) ResetField(name string) error {
+func (m *ConversationShareMutation) ResetField(name string) error {
 	switch name {
-	case message.FieldSessionID:
+	case conversationshare.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case conversationshare.FieldSessionID:
 		m.ResetSessionID()
 		return nil
-	case message.FieldRole:
-		m.ResetRole()
+	case conversationshare.FieldTitle:
+		m.ResetTitle()
 		return nil
-	case message.FieldContent:
-		m.ResetContent()
+	case conversationshare.FieldMessages:
+		m.ResetMessages()
 		return nil
-	case message.FieldResponseType:
-		m.ResetResponseType()
+	case conversationshare.FieldMessageCount:
+		m.ResetMessageCount()
 		return nil
-	case message.FieldQuickReplies:
-		m.ResetQuickReplies()
+	case conversationshare.FieldCurrency:
+		m.ResetCurrency()
 		return nil
-	case message.FieldProducts:
-		m.ResetProducts()
+	case conversationshare.FieldViewCount:
+		m.ResetViewCount()
 		return nil
-	case message.FieldSearchInfo:
-		m.ResetSearchInfo()
+	case conversationshare.FieldExpiresAt:
+		m.ResetExpiresAt()
 		return nil
-	case message.FieldCreatedAt:
+	case conversationshare.FieldRevokedAt:
+		m.ResetRevokedAt()
+		return nil
+	case conversationshare.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown ConversationShare field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageMutation) AddedEdges() []string {
+func (m *ConversationShareMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.session != nil {
-		edges = append(edges, message.EdgeSession)
+	if m.user != nil {
+		edges = append(edges, conversationshare.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+func (m *ConversationShareMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case message.EdgeSession:
-		if id := m.session; id != nil {
+	case conversationshare.EdgeUser:
+		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -2003,98 +2176,86 @@ func (m *MessageMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageMutation) RemovedEdges() []string {
+func (m *ConversationShareMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *ConversationShareMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageMutation) ClearedEdges() []string {
+func (m *ConversationShareMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedsession {
-		edges = append(edges, message.EdgeSession)
+	if m.cleareduser {
+		edges = append(edges, conversationshare.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageMutation) EdgeCleared(name string) bool {
+func (m *ConversationShareMutation) EdgeCleared(name string) bool {
 	switch name {
-	case message.EdgeSession:
-		return m.clearedsession
+	case conversationshare.EdgeUser:
+		return m.cleareduser
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageMutation) ClearEdge(name string) error {
+func (m *ConversationShareMutation) ClearEdge(name string) error {
 	switch name {
-	case message.EdgeSession:
-		m.ClearSession()
+	case conversationshare.EdgeUser:
+		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Message unique edge %s", name)
+	return fmt.Errorf("unknown ConversationShare unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageMutation) ResetEdge(name string) error {
+func (m *ConversationShareMutation) ResetEdge(name string) error {
 	switch name {
-	case message.EdgeSession:
-		m.ResetSession()
+	case conversationshare.EdgeUser:
+		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown Message edge %s", name)
+	return fmt.Errorf("unknown ConversationShare edge %s", name)
 }
 
-// SearchHistoryMutation represents an operation that mutates the SearchHistory nodes in the graph.
-type SearchHistoryMutation struct {
+// ExchangeRateMutation represents an operation that mutates the ExchangeRate nodes in the graph.
+type ExchangeRateMutation struct {
 	config
-	op                   Op
-	typ                  string
-	id                   *uuid.UUID
-	session_id           *string
-	search_query         *string
-	optimized_query      *string
-	search_type          *string
-	category             *string
-	country_code         *string
-	language_code        *string
-	currency             *string
-	result_count         *int
-	addresult_count      *int
-	products_found       *[]map[string]interface{}
-	appendproducts_found []map[string]interface{}
-	clicked_product_id   *string
-	created_at           *time.Time
-	expires_at           *time.Time
-	clearedFields        map[string]struct{}
-	user                 *uuid.UUID
-	cleareduser          bool
-	done                 bool
-	oldValue             func(context.Context) (*SearchHistory, error)
-	predicates           []predicate.SearchHistory
-}
-
-var _ ent.Mutation = (*SearchHistoryMutation)(nil)
-
-// searchhistoryOption allows management of the mutation configuration using functional options.
-type searchhistoryOption func(*SearchHistoryMutation)
-
-// newSearchHistoryMutation creates new mutation for the SearchHistory entity.
-func newSearchHistoryMutation(c config, op Op, opts ...searchhistoryOption) *SearchHistoryMutation {
-	m := &SearchHistoryMutation{
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	currency      *string
+	rate          *float64
+	addrate       *float64
+	source        *string
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*ExchangeRate, error)
+	predicates    []predicate.ExchangeRate
+}
+
+var _ ent.Mutation = (*ExchangeRateMutation)(nil)
+
+// exchangerateOption allows management of the mutation configuration using functional options.
+type exchangerateOption func(*ExchangeRateMutation)
+
+// newExchangeRateMutation creates new mutation for the ExchangeRate entity.
+func newExchangeRateMutation(c config, op Op, opts ...exchangerateOption) *ExchangeRateMutation {
+	m := &ExchangeRateMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeSearchHistory,
+		typ:           TypeExchangeRate,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2103,20 +2264,20 @@ func newSearchHistoryMutation(c config, op Op, opts ...searchhistoryOption) *Sea
 	return m
 }
 
-// withSearchHistoryID sets the ID field of the mutation.
-func withSearchHistoryID(id uuid.UUID) searchhistoryOption {
-	return func(m *SearchHistoryMutation) {
+// withExchangeRateID sets the ID field of the mutation.
+func withExchangeRateID(id uuid.UUID) exchangerateOption {
+	return func(m *ExchangeRateMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *SearchHistory
+			value *ExchangeRate
 		)
-		m.oldValue = func(ctx context.Context) (*SearchHistory, error) {
+		m.oldValue = func(ctx context.Context) (*ExchangeRate, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().SearchHistory.Get(ctx, id)
+					value, err = m.Client().ExchangeRate.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2125,10 +2286,10 @@ func withSearchHistoryID(id uuid.UUID) searchhistoryOption {
 	}
 }
 
-// withSearchHistory sets the old SearchHistory of the mutation.
-func withSearchHistory(node *SearchHistory) searchhistoryOption {
-	return func(m *SearchHistoryMutation) {
-		m.oldValue = func(context.Context) (*SearchHistory, error) {
+// withExchangeRate sets the old ExchangeRate of the mutation.
+func withExchangeRate(node *ExchangeRate) exchangerateOption {
+	return func(m *ExchangeRateMutation) {
+		m.oldValue = func(context.Context) (*ExchangeRate, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2137,7 +2298,7 @@ func withSearchHistory(node *SearchHistory) searchhistoryOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m SearchHistoryMutation) Client() *Client {
+func (m ExchangeRateMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2145,7 +2306,7 @@ func (m SearchHistoryMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m SearchHistoryMutation) Tx() (*Tx, error) {
+func (m ExchangeRateMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2155,14 +2316,14 @@ func (m SearchHistoryMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of SearchHistory entities.
-func (m *SearchHistoryMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of ExchangeRate entities.
+func (m *ExchangeRateMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *SearchHistoryMutation) ID() (id uuid.UUID, exists bool) {
+func (m *ExchangeRateMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2173,7 +2334,7 @@ func (m *SearchHistoryMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *SearchHistoryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *ExchangeRateMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -2182,783 +2343,6849 @@ func (m *SearchHistoryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().SearchHistory.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ExchangeRate.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *SearchHistoryMutation) SetUserID(u uuid.UUID) {
-	m.user = &u
+// SetCurrency sets the "currency" field.
+func (m *ExchangeRateMutation) SetCurrency(s string) {
+	m.currency = &s
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *SearchHistoryMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.user
+// Currency returns the value of the "currency" field in the mutation.
+func (m *ExchangeRateMutation) Currency() (r string, exists bool) {
+	v := m.currency
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the SearchHistory entity.
-// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldCurrency returns the old "currency" field's value of the ExchangeRate entity.
+// If the ExchangeRate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SearchHistoryMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *ExchangeRateMutation) OldCurrency(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
 	}
-	return oldValue.UserID, nil
-}
-
-// ClearUserID clears the value of the "user_id" field.
-func (m *SearchHistoryMutation) ClearUserID() {
-	m.user = nil
-	m.clearedFields[searchhistory.FieldUserID] = struct{}{}
-}
-
-// UserIDCleared returns if the "user_id" field was cleared in this mutation.
-func (m *SearchHistoryMutation) UserIDCleared() bool {
-	_, ok := m.clearedFields[searchhistory.FieldUserID]
-	return ok
+	return oldValue.Currency, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *SearchHistoryMutation) ResetUserID() {
-	m.user = nil
-	delete(m.clearedFields, searchhistory.FieldUserID)
+// ResetCurrency resets all changes to the "currency" field.
+func (m *ExchangeRateMutation) ResetCurrency() {
+	m.currency = nil
 }
 
-// SetSessionID sets the "session_id" field.
-func (m *SearchHistoryMutation) SetSessionID(s string) {
-	m.session_id = &s
+// SetRate sets the "rate" field.
+func (m *ExchangeRateMutation) SetRate(f float64) {
+	m.rate = &f
+	m.addrate = nil
 }
 
-// SessionID returns the value of the "session_id" field in the mutation.
-func (m *SearchHistoryMutation) SessionID() (r string, exists bool) {
-	v := m.session_id
+// Rate returns the value of the "rate" field in the mutation.
+func (m *ExchangeRateMutation) Rate() (r float64, exists bool) {
+	v := m.rate
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSessionID returns the old "session_id" field's value of the SearchHistory entity.
-// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldRate returns the old "rate" field's value of the ExchangeRate entity.
+// If the ExchangeRate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SearchHistoryMutation) OldSessionID(ctx context.Context) (v string, err error) {
+func (m *ExchangeRateMutation) OldRate(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldRate is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSessionID requires an ID field in the mutation")
+		return v, errors.New("OldRate requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldRate: %w", err)
 	}
-	return oldValue.SessionID, nil
+	return oldValue.Rate, nil
 }
 
-// ClearSessionID clears the value of the "session_id" field.
-func (m *SearchHistoryMutation) ClearSessionID() {
-	m.session_id = nil
-	m.clearedFields[searchhistory.FieldSessionID] = struct{}{}
+// AddRate adds f to the "rate" field.
+func (m *ExchangeRateMutation) AddRate(f float64) {
+	if m.addrate != nil {
+		*m.addrate += f
+	} else {
+		m.addrate = &f
+	}
 }
 
-// SessionIDCleared returns if the "session_id" field was cleared in this mutation.
-func (m *SearchHistoryMutation) SessionIDCleared() bool {
-	_, ok := m.clearedFields[searchhistory.FieldSessionID]
-	return ok
+// AddedRate returns the value that was added to the "rate" field in this mutation.
+func (m *ExchangeRateMutation) AddedRate() (r float64, exists bool) {
+	v := m.addrate
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetSessionID resets all changes to the "session_id" field.
-func (m *SearchHistoryMutation) ResetSessionID() {
-	m.session_id = nil
-	delete(m.clearedFields, searchhistory.FieldSessionID)
+// ResetRate resets all changes to the "rate" field.
+func (m *ExchangeRateMutation) ResetRate() {
+	m.rate = nil
+	m.addrate = nil
 }
 
-// SetSearchQuery sets the "search_query" field.
-func (m *SearchHistoryMutation) SetSearchQuery(s string) {
-	m.search_query = &s
+// SetSource sets the "source" field.
+func (m *ExchangeRateMutation) SetSource(s string) {
+	m.source = &s
 }
 
-// SearchQuery returns the value of the "search_query" field in the mutation.
-func (m *SearchHistoryMutation) SearchQuery() (r string, exists bool) {
-	v := m.search_query
+// Source returns the value of the "source" field in the mutation.
+func (m *ExchangeRateMutation) Source() (r string, exists bool) {
+	v := m.source
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSearchQuery returns the old "search_query" field's value of the SearchHistory entity.
-// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldSource returns the old "source" field's value of the ExchangeRate entity.
+// If the ExchangeRate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SearchHistoryMutation) OldSearchQuery(ctx context.Context) (v string, err error) {
+func (m *ExchangeRateMutation) OldSource(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSearchQuery is only allowed on UpdateOne operations")
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSearchQuery requires an ID field in the mutation")
+		return v, errors.New("OldSource requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSearchQuery: %w", err)
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
 	}
-	return oldValue.SearchQuery, nil
+	return oldValue.Source, nil
 }
 
-// ResetSearchQuery resets all changes to the "search_query" field.
-func (m *SearchHistoryMutation) ResetSearchQuery() {
-	m.search_query = nil
+// ResetSource resets all changes to the "source" field.
+func (m *ExchangeRateMutation) ResetSource() {
+	m.source = nil
 }
 
-// SetOptimizedQuery sets the "optimized_query" field.
-func (m *SearchHistoryMutation) SetOptimizedQuery(s string) {
-	m.optimized_query = &s
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ExchangeRateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// OptimizedQuery returns the value of the "optimized_query" field in the mutation.
-func (m *SearchHistoryMutation) OptimizedQuery() (r string, exists bool) {
-	v := m.optimized_query
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ExchangeRateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldOptimizedQuery returns the old "optimized_query" field's value of the SearchHistory entity.
-// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the ExchangeRate entity.
+// If the ExchangeRate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SearchHistoryMutation) OldOptimizedQuery(ctx context.Context) (v string, err error) {
+func (m *ExchangeRateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldOptimizedQuery is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldOptimizedQuery requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldOptimizedQuery: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.OptimizedQuery, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearOptimizedQuery clears the value of the "optimized_query" field.
-func (m *SearchHistoryMutation) ClearOptimizedQuery() {
-	m.optimized_query = nil
-	m.clearedFields[searchhistory.FieldOptimizedQuery] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ExchangeRateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// OptimizedQueryCleared returns if the "optimized_query" field was cleared in this mutation.
-func (m *SearchHistoryMutation) OptimizedQueryCleared() bool {
-	_, ok := m.clearedFields[searchhistory.FieldOptimizedQuery]
-	return ok
+// Where appends a list predicates to the ExchangeRateMutation builder.
+func (m *ExchangeRateMutation) Where(ps ...predicate.ExchangeRate) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// ResetOptimizedQuery resets all changes to the "optimized_query" field.
-func (m *SearchHistoryMutation) ResetOptimizedQuery() {
-	m.optimized_query = nil
-	delete(m.clearedFields, searchhistory.FieldOptimizedQuery)
+// WhereP appends storage-level predicates to the ExchangeRateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ExchangeRateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ExchangeRate, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
 }
 
-// SetSearchType sets the "search_type" field.
-func (m *SearchHistoryMutation) SetSearchType(s string) {
-	m.search_type = &s
+// Op returns the operation name.
+func (m *ExchangeRateMutation) Op() Op {
+	return m.op
 }
 
-// SearchType returns the value of the "search_type" field in the mutation.
-func (m *SearchHistoryMutation) SearchType() (r string, exists bool) {
-	v := m.search_type
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetOp allows setting the mutation operation.
+func (m *ExchangeRateMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// OldSearchType returns the old "search_type" field's value of the SearchHistory entity.
-// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SearchHistoryMutation) OldSearchType(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSearchType is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSearchType requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSearchType: %w", err)
-	}
-	return oldValue.SearchType, nil
-}
-
-// ResetSearchType resets all changes to the "search_type" field.
-func (m *SearchHistoryMutation) ResetSearchType() {
-	m.search_type = nil
-}
-
-// SetCategory sets the "category" field.
-func (m *SearchHistoryMutation) SetCategory(s string) {
-	m.category = &s
+// Type returns the node type of this mutation (ExchangeRate).
+func (m *ExchangeRateMutation) Type() string {
+	return m.typ
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *SearchHistoryMutation) Category() (r string, exists bool) {
-	v := m.category
-	if v == nil {
-		return
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ExchangeRateMutation) Fields() []string {
+	fields := make([]string, 0, 4)
+	if m.currency != nil {
+		fields = append(fields, exchangerate.FieldCurrency)
 	}
-	return *v, true
-}
-
-// OldCategory returns the old "category" field's value of the SearchHistory entity.
-// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SearchHistoryMutation) OldCategory(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	if m.rate != nil {
+		fields = append(fields, exchangerate.FieldRate)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
+	if m.source != nil {
+		fields = append(fields, exchangerate.FieldSource)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	if m.updated_at != nil {
+		fields = append(fields, exchangerate.FieldUpdatedAt)
 	}
-	return oldValue.Category, nil
+	return fields
 }
 
-// ClearCategory clears the value of the "category" field.
-func (m *SearchHistoryMutation) ClearCategory() {
-	m.category = nil
-	m.clearedFields[searchhistory.FieldCategory] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ExchangeRateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case exchangerate.FieldCurrency:
+		return m.Currency()
+	case exchangerate.FieldRate:
+		return m.Rate()
+	case exchangerate.FieldSource:
+		return m.Source()
+	case exchangerate.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// CategoryCleared returns if the "category" field was cleared in this mutation.
-func (m *SearchHistoryMutation) CategoryCleared() bool {
-	_, ok := m.clearedFields[searchhistory.FieldCategory]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ExchangeRateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case exchangerate.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case exchangerate.FieldRate:
+		return m.OldRate(ctx)
+	case exchangerate.FieldSource:
+		return m.OldSource(ctx)
+	case exchangerate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ExchangeRate field %s", name)
 }
 
-// ResetCategory resets all changes to the "category" field.
-func (m *SearchHistoryMutation) ResetCategory() {
-	m.category = nil
-	delete(m.clearedFields, searchhistory.FieldCategory)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExchangeRateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case exchangerate.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case exchangerate.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRate(v)
+		return nil
+	case exchangerate.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case exchangerate.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ExchangeRate field %s", name)
 }
 
-// SetCountryCode sets the "country_code" field.
-func (m *SearchHistoryMutation) SetCountryCode(s string) {
-	m.country_code = &s
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ExchangeRateMutation) AddedFields() []string {
+	var fields []string
+	if m.addrate != nil {
+		fields = append(fields, exchangerate.FieldRate)
+	}
+	return fields
 }
 
-// CountryCode returns the value of the "country_code" field in the mutation.
-func (m *SearchHistoryMutation) CountryCode() (r string, exists bool) {
-	v := m.country_code
-	if v == nil {
-		return
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ExchangeRateMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case exchangerate.FieldRate:
+		return m.AddedRate()
 	}
-	return *v, true
+	return nil, false
 }
 
-// OldCountryCode returns the old "country_code" field's value of the SearchHistory entity.
-// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SearchHistoryMutation) OldCountryCode(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCountryCode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCountryCode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCountryCode: %w", err)
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ExchangeRateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case exchangerate.FieldRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRate(v)
+		return nil
 	}
-	return oldValue.CountryCode, nil
+	return fmt.Errorf("unknown ExchangeRate numeric field %s", name)
 }
 
-// ResetCountryCode resets all changes to the "country_code" field.
-func (m *SearchHistoryMutation) ResetCountryCode() {
-	m.country_code = nil
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ExchangeRateMutation) ClearedFields() []string {
+	return nil
 }
 
-// SetLanguageCode sets the "language_code" field.
-func (m *SearchHistoryMutation) SetLanguageCode(s string) {
-	m.language_code = &s
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ExchangeRateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// LanguageCode returns the value of the "language_code" field in the mutation.
-func (m *SearchHistoryMutation) LanguageCode() (r string, exists bool) {
-	v := m.language_code
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ExchangeRateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ExchangeRate nullable field %s", name)
 }
 
-// OldLanguageCode returns the old "language_code" field's value of the SearchHistory entity.
-// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SearchHistoryMutation) OldLanguageCode(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLanguageCode is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLanguageCode requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLanguageCode: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ExchangeRateMutation) ResetField(name string) error {
+	switch name {
+	case exchangerate.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case exchangerate.FieldRate:
+		m.ResetRate()
+		return nil
+	case exchangerate.FieldSource:
+		m.ResetSource()
+		return nil
+	case exchangerate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return oldValue.LanguageCode, nil
+	return fmt.Errorf("unknown ExchangeRate field %s", name)
 }
 
-// ResetLanguageCode resets all changes to the "language_code" field.
-func (m *SearchHistoryMutation) ResetLanguageCode() {
-	m.language_code = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ExchangeRateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetCurrency sets the "currency" field.
-func (m *SearchHistoryMutation) SetCurrency(s string) {
-	m.currency = &s
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ExchangeRateMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// Currency returns the value of the "currency" field in the mutation.
-func (m *SearchHistoryMutation) Currency() (r string, exists bool) {
-	v := m.currency
-	if v == nil {
-		return
-	}
-	return *v, true
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ExchangeRateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldCurrency returns the old "currency" field's value of the SearchHistory entity.
-// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SearchHistoryMutation) OldCurrency(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrency requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
-	}
-	return oldValue.Currency, nil
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ExchangeRateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetCurrency resets all changes to the "currency" field.
-func (m *SearchHistoryMutation) ResetCurrency() {
-	m.currency = nil
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ExchangeRateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetResultCount sets the "result_count" field.
-func (m *SearchHistoryMutation) SetResultCount(i int) {
-	m.result_count = &i
-	m.addresult_count = nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ExchangeRateMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ResultCount returns the value of the "result_count" field in the mutation.
-func (m *SearchHistoryMutation) ResultCount() (r int, exists bool) {
-	v := m.result_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ExchangeRateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ExchangeRate unique edge %s", name)
 }
 
-// OldResultCount returns the old "result_count" field's value of the SearchHistory entity.
-// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SearchHistoryMutation) OldResultCount(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResultCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResultCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResultCount: %w", err)
-	}
-	return oldValue.ResultCount, nil
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ExchangeRateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ExchangeRate edge %s", name)
 }
 
-// AddResultCount adds i to the "result_count" field.
-func (m *SearchHistoryMutation) AddResultCount(i int) {
-	if m.addresult_count != nil {
-		*m.addresult_count += i
-	} else {
-		m.addresult_count = &i
-	}
+// MessageMutation represents an operation that mutates the Message nodes in the graph.
+type MessageMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	role                *string
+	content             *string
+	response_type       *string
+	quick_replies       *[]string
+	appendquick_replies []string
+	products            *[]map[string]interface{}
+	appendproducts      []map[string]interface{}
+	search_info         *map[string]interface{}
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	session             *uuid.UUID
+	clearedsession      bool
+	done                bool
+	oldValue            func(context.Context) (*Message, error)
+	predicates          []predicate.Message
 }
 
-// AddedResultCount returns the value that was added to the "result_count" field in this mutation.
-func (m *SearchHistoryMutation) AddedResultCount() (r int, exists bool) {
-	v := m.addresult_count
-	if v == nil {
-		return
-	}
-	return *v, true
-}
+var _ ent.Mutation = (*MessageMutation)(nil)
 
-// ResetResultCount resets all changes to the "result_count" field.
-func (m *SearchHistoryMutation) ResetResultCount() {
-	m.result_count = nil
-	m.addresult_count = nil
-}
+// messageOption allows management of the mutation configuration using functional options.
+type messageOption func(*MessageMutation)
 
-// SetProductsFound sets the "products_found" field.
-func (m *SearchHistoryMutation) SetProductsFound(value []map[string]interface{}) {
-	m.products_found = &value
-	m.appendproducts_found = nil
+// newMessageMutation creates new mutation for the Message entity.
+func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
+	m := &MessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// ProductsFound returns the value of the "products_found" field in the mutation.
-func (m *SearchHistoryMutation) ProductsFound() (r []map[string]interface{}, exists bool) {
-	v := m.products_found
-	if v == nil {
-		return
+// withMessageID sets the ID field of the mutation.
+func withMessageID(id uuid.UUID) messageOption {
+	return func(m *MessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Message
+		)
+		m.oldValue = func(ctx context.Context) (*Message, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Message.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return *v, true
 }
 
-// OldProductsFound returns the old "products_found" field's value of the SearchHistory entity.
-// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SearchHistoryMutation) OldProductsFound(ctx context.Context) (v []map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProductsFound is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProductsFound requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProductsFound: %w", err)
+// withMessage sets the old Message of the mutation.
+func withMessage(node *Message) messageOption {
+	return func(m *MessageMutation) {
+		m.oldValue = func(context.Context) (*Message, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return oldValue.ProductsFound, nil
 }
 
-// AppendProductsFound adds value to the "products_found" field.
-func (m *SearchHistoryMutation) AppendProductsFound(value []map[string]interface{}) {
-	m.appendproducts_found = append(m.appendproducts_found, value...)
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AppendedProductsFound returns the list of values that were appended to the "products_found" field in this mutation.
-func (m *SearchHistoryMutation) AppendedProductsFound() ([]map[string]interface{}, bool) {
-	if len(m.appendproducts_found) == 0 {
-		return nil, false
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return m.appendproducts_found, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearProductsFound clears the value of the "products_found" field.
-func (m *SearchHistoryMutation) ClearProductsFound() {
-	m.products_found = nil
-	m.appendproducts_found = nil
-	m.clearedFields[searchhistory.FieldProductsFound] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Message entities.
+func (m *MessageMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// ProductsFoundCleared returns if the "products_found" field was cleared in this mutation.
-func (m *SearchHistoryMutation) ProductsFoundCleared() bool {
-	_, ok := m.clearedFields[searchhistory.FieldProductsFound]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetProductsFound resets all changes to the "products_found" field.
-func (m *SearchHistoryMutation) ResetProductsFound() {
-	m.products_found = nil
-	m.appendproducts_found = nil
-	delete(m.clearedFields, searchhistory.FieldProductsFound)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetClickedProductID sets the "clicked_product_id" field.
-func (m *SearchHistoryMutation) SetClickedProductID(s string) {
-	m.clicked_product_id = &s
+// SetSessionID sets the "session_id" field.
+func (m *MessageMutation) SetSessionID(u uuid.UUID) {
+	m.session = &u
 }
 
-// ClickedProductID returns the value of the "clicked_product_id" field in the mutation.
-func (m *SearchHistoryMutation) ClickedProductID() (r string, exists bool) {
-	v := m.clicked_product_id
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *MessageMutation) SessionID() (r uuid.UUID, exists bool) {
+	v := m.session
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClickedProductID returns the old "clicked_product_id" field's value of the SearchHistory entity.
-// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldSessionID returns the old "session_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SearchHistoryMutation) OldClickedProductID(ctx context.Context) (v string, err error) {
+func (m *MessageMutation) OldSessionID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClickedProductID is only allowed on UpdateOne operations")
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClickedProductID requires an ID field in the mutation")
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClickedProductID: %w", err)
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
 	}
-	return oldValue.ClickedProductID, nil
-}
-
-// ClearClickedProductID clears the value of the "clicked_product_id" field.
-func (m *SearchHistoryMutation) ClearClickedProductID() {
-	m.clicked_product_id = nil
-	m.clearedFields[searchhistory.FieldClickedProductID] = struct{}{}
-}
-
-// ClickedProductIDCleared returns if the "clicked_product_id" field was cleared in this mutation.
-func (m *SearchHistoryMutation) ClickedProductIDCleared() bool {
-	_, ok := m.clearedFields[searchhistory.FieldClickedProductID]
-	return ok
+	return oldValue.SessionID, nil
 }
 
-// ResetClickedProductID resets all changes to the "clicked_product_id" field.
-func (m *SearchHistoryMutation) ResetClickedProductID() {
-	m.clicked_product_id = nil
-	delete(m.clearedFields, searchhistory.FieldClickedProductID)
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *MessageMutation) ResetSessionID() {
+	m.session = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *SearchHistoryMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetRole sets the "role" field.
+func (m *MessageMutation) SetRole(s string) {
+	m.role = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *SearchHistoryMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Role returns the value of the "role" field in the mutation.
+func (m *MessageMutation) Role() (r string, exists bool) {
+	v := m.role
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the SearchHistory entity.
-// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldRole returns the old "role" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SearchHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *MessageMutation) OldRole(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldRole is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldRole requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldRole: %w", err)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *SearchHistoryMutation) ResetCreatedAt() {
-	m.created_at = nil
+	return oldValue.Role, nil
 }
 
-// SetExpiresAt sets the "expires_at" field.
-func (m *SearchHistoryMutation) SetExpiresAt(t time.Time) {
-	m.expires_at = &t
+// ResetRole resets all changes to the "role" field.
+func (m *MessageMutation) ResetRole() {
+	m.role = nil
 }
 
-// ExpiresAt returns the value of the "expires_at" field in the mutation.
-func (m *SearchHistoryMutation) ExpiresAt() (r time.Time, exists bool) {
-	v := m.expires_at
+// SetContent sets the "content" field.
+func (m *MessageMutation) SetContent(s string) {
+	m.content = &s
+}
+
+// Content returns the value of the "content" field in the mutation.
+func (m *MessageMutation) Content() (r string, exists bool) {
+	v := m.content
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExpiresAt returns the old "expires_at" field's value of the SearchHistory entity.
-// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// OldContent returns the old "content" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *SearchHistoryMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+func (m *MessageMutation) OldContent(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldContent is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+		return v, errors.New("OldContent requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldContent: %w", err)
 	}
-	return oldValue.ExpiresAt, nil
+	return oldValue.Content, nil
 }
 
-// ClearExpiresAt clears the value of the "expires_at" field.
-func (m *SearchHistoryMutation) ClearExpiresAt() {
-	m.expires_at = nil
-	m.clearedFields[searchhistory.FieldExpiresAt] = struct{}{}
+// ResetContent resets all changes to the "content" field.
+func (m *MessageMutation) ResetContent() {
+	m.content = nil
 }
 
-// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
-func (m *SearchHistoryMutation) ExpiresAtCleared() bool {
-	_, ok := m.clearedFields[searchhistory.FieldExpiresAt]
-	return ok
+// SetResponseType sets the "response_type" field.
+func (m *MessageMutation) SetResponseType(s string) {
+	m.response_type = &s
 }
 
-// ResetExpiresAt resets all changes to the "expires_at" field.
-func (m *SearchHistoryMutation) ResetExpiresAt() {
-	m.expires_at = nil
-	delete(m.clearedFields, searchhistory.FieldExpiresAt)
+// ResponseType returns the value of the "response_type" field in the mutation.
+func (m *MessageMutation) ResponseType() (r string, exists bool) {
+	v := m.response_type
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *SearchHistoryMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[searchhistory.FieldUserID] = struct{}{}
+// OldResponseType returns the old "response_type" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldResponseType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResponseType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResponseType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResponseType: %w", err)
+	}
+	return oldValue.ResponseType, nil
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *SearchHistoryMutation) UserCleared() bool {
-	return m.UserIDCleared() || m.cleareduser
+// ClearResponseType clears the value of the "response_type" field.
+func (m *MessageMutation) ClearResponseType() {
+	m.response_type = nil
+	m.clearedFields[message.FieldResponseType] = struct{}{}
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *SearchHistoryMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// ResponseTypeCleared returns if the "response_type" field was cleared in this mutation.
+func (m *MessageMutation) ResponseTypeCleared() bool {
+	_, ok := m.clearedFields[message.FieldResponseType]
+	return ok
+}
+
+// ResetResponseType resets all changes to the "response_type" field.
+func (m *MessageMutation) ResetResponseType() {
+	m.response_type = nil
+	delete(m.clearedFields, message.FieldResponseType)
+}
+
+// SetQuickReplies sets the "quick_replies" field.
+func (m *MessageMutation) SetQuickReplies(s []string) {
+	m.quick_replies = &s
+	m.appendquick_replies = nil
+}
+
+// QuickReplies returns the value of the "quick_replies" field in the mutation.
+func (m *MessageMutation) QuickReplies() (r []string, exists bool) {
+	v := m.quick_replies
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *SearchHistoryMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// OldQuickReplies returns the old "quick_replies" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldQuickReplies(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuickReplies is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuickReplies requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuickReplies: %w", err)
+	}
+	return oldValue.QuickReplies, nil
 }
 
-// Where appends a list predicates to the SearchHistoryMutation builder.
-func (m *SearchHistoryMutation) Where(ps ...predicate.SearchHistory) {
-	m.predicates = append(m.predicates, ps...)
+// AppendQuickReplies adds s to the "quick_replies" field.
+func (m *MessageMutation) AppendQuickReplies(s []string) {
+	m.appendquick_replies = append(m.appendquick_replies, s...)
 }
 
-// WhereP appends storage-level predicates to the SearchHistoryMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *SearchHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.SearchHistory, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AppendedQuickReplies returns the list of values that were appended to the "quick_replies" field in this mutation.
+func (m *MessageMutation) AppendedQuickReplies() ([]string, bool) {
+	if len(m.appendquick_replies) == 0 {
+		return nil, false
 	}
-	m.Where(p...)
+	return m.appendquick_replies, true
 }
 
-// Op returns the operation name.
-func (m *SearchHistoryMutation) Op() Op {
-	return m.op
+// ClearQuickReplies clears the value of the "quick_replies" field.
+func (m *MessageMutation) ClearQuickReplies() {
+	m.quick_replies = nil
+	m.appendquick_replies = nil
+	m.clearedFields[message.FieldQuickReplies] = struct{}{}
 }
 
-// SetOp allows setting the mutation operation.
-func (m *SearchHistoryMutation) SetOp(op Op) {
-	m.op = op
+// QuickRepliesCleared returns if the "quick_replies" field was cleared in this mutation.
+func (m *MessageMutation) QuickRepliesCleared() bool {
+	_, ok := m.clearedFields[message.FieldQuickReplies]
+	return ok
 }
 
-// Type returns the node type of this mutation (SearchHistory).
-func (m *SearchHistoryMutation) Type() string {
-	return m.typ
+// ResetQuickReplies resets all changes to the "quick_replies" field.
+func (m *MessageMutation) ResetQuickReplies() {
+	m.quick_replies = nil
+	m.appendquick_replies = nil
+	delete(m.clearedFields, message.FieldQuickReplies)
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *SearchHistoryMutation) Fields() []string {
-	fields := make([]string, 0, 14)
-	if m.user != nil {
-		fields = append(fields, searchhistory.FieldUserID)
-	}
-	if m.session_id != nil {
-		fields = append(fields, searchhistory.FieldSessionID)
-	}
-	if m.search_query != nil {
-		fields = append(fields, searchhistory.FieldSearchQuery)
-	}
-	if m.optimized_query != nil {
-		fields = append(fields, searchhistory.FieldOptimizedQuery)
-	}
-	if m.search_type != nil {
-		fields = append(fields, searchhistory.FieldSearchType)
-	}
-	if m.category != nil {
-		fields = append(fields, searchhistory.FieldCategory)
-	}
-	if m.country_code != nil {
-		fields = append(fields, searchhistory.FieldCountryCode)
-	}
-	if m.language_code != nil {
-		fields = append(fields, searchhistory.FieldLanguageCode)
-	}
-	if m.currency != nil {
-		fields = append(fields, searchhistory.FieldCurrency)
-	}
-	if m.result_count != nil {
-		fields = append(fields, searchhistory.FieldResultCount)
+// SetProducts sets the "products" field.
+func (m *MessageMutation) SetProducts(value []map[string]interface{}) {
+	m.products = &value
+	m.appendproducts = nil
+}
+
+// Products returns the value of the "products" field in the mutation.
+func (m *MessageMutation) Products() (r []map[string]interface{}, exists bool) {
+	v := m.products
+	if v == nil {
+		return
 	}
-	if m.products_found != nil {
-		fields = append(fields, searchhistory.FieldProductsFound)
+	return *v, true
+}
+
+// OldProducts returns the old "products" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldProducts(ctx context.Context) (v []map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProducts is only allowed on UpdateOne operations")
 	}
-	if m.clicked_product_id != nil {
-		fields = append(fields, searchhistory.FieldClickedProductID)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProducts requires an ID field in the mutation")
 	}
-	if m.created_at != nil {
-		fields = append(fields, searchhistory.FieldCreatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProducts: %w", err)
 	}
-	if m.expires_at != nil {
-		fields = append(fields, searchhistory.FieldExpiresAt)
+	return oldValue.Products, nil
+}
+
+// AppendProducts adds value to the "products" field.
+func (m *MessageMutation) AppendProducts(value []map[string]interface{}) {
+	m.appendproducts = append(m.appendproducts, value...)
+}
+
+// AppendedProducts returns the list of values that were appended to the "products" field in this mutation.
+func (m *MessageMutation) AppendedProducts() ([]map[string]interface{}, bool) {
+	if len(m.appendproducts) == 0 {
+		return nil, false
 	}
-	return fields
+	return m.appendproducts, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
+// ClearProducts clears the value of the "products" field.
+func (m *MessageMutation) ClearProducts() {
+	m.products = nil
+	m.appendproducts = nil
+	m.clearedFields[message.FieldProducts] = struct{}{}
+}
+
+// ProductsCleared returns if the "products" field was cleared in this mutation.
+func (m *MessageMutation) ProductsCleared() bool {
+	_, ok := m.clearedFields[message.FieldProducts]
+	return ok
+}
+
+// ResetProducts resets all changes to the "products" field.
+func (m *MessageMutation) ResetProducts() {
+	m.products = nil
+	m.appendproducts = nil
+	delete(m.clearedFields, message.FieldProducts)
+}
+
+// SetSearchInfo sets the "search_info" field.
+func (m *MessageMutation) SetSearchInfo(value map[string]interface{}) {
+	m.search_info = &value
+}
+
+// SearchInfo returns the value of the "search_info" field in the mutation.
+func (m *MessageMutation) SearchInfo() (r map[string]interface{}, exists bool) {
+	v := m.search_info
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSearchInfo returns the old "search_info" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldSearchInfo(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSearchInfo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSearchInfo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSearchInfo: %w", err)
+	}
+	return oldValue.SearchInfo, nil
+}
+
+// ClearSearchInfo clears the value of the "search_info" field.
+func (m *MessageMutation) ClearSearchInfo() {
+	m.search_info = nil
+	m.clearedFields[message.FieldSearchInfo] = struct{}{}
+}
+
+// SearchInfoCleared returns if the "search_info" field was cleared in this mutation.
+func (m *MessageMutation) SearchInfoCleared() bool {
+	_, ok := m.clearedFields[message.FieldSearchInfo]
+	return ok
+}
+
+// ResetSearchInfo resets all changes to the "search_info" field.
+func (m *MessageMutation) ResetSearchInfo() {
+	m.search_info = nil
+	delete(m.clearedFields, message.FieldSearchInfo)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearSession clears the "session" edge to the ChatSession entity.
+func (m *MessageMutation) ClearSession() {
+	m.clearedsession = true
+	m.clearedFields[message.FieldSessionID] = struct{}{}
+}
+
+// SessionCleared reports if the "session" edge to the ChatSession entity was cleared.
+func (m *MessageMutation) SessionCleared() bool {
+	return m.clearedsession
+}
+
+// SessionIDs returns the "session" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// SessionID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) SessionIDs() (ids []uuid.UUID) {
+	if id := m.session; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetSession resets all changes to the "session" edge.
+func (m *MessageMutation) ResetSession() {
+	m.session = nil
+	m.clearedsession = false
+}
+
+// Where appends a list predicates to the MessageMutation builder.
+func (m *MessageMutation) Where(ps ...predicate.Message) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Message, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Message).
+func (m *MessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.session != nil {
+		fields = append(fields, message.FieldSessionID)
+	}
+	if m.role != nil {
+		fields = append(fields, message.FieldRole)
+	}
+	if m.content != nil {
+		fields = append(fields, message.FieldContent)
+	}
+	if m.response_type != nil {
+		fields = append(fields, message.FieldResponseType)
+	}
+	if m.quick_replies != nil {
+		fields = append(fields, message.FieldQuickReplies)
+	}
+	if m.products != nil {
+		fields = append(fields, message.FieldProducts)
+	}
+	if m.search_info != nil {
+		fields = append(fields, message.FieldSearchInfo)
+	}
+	if m.created_at != nil {
+		fields = append(fields, message.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldSessionID:
+		return m.SessionID()
+	case message.FieldRole:
+		return m.Role()
+	case message.FieldContent:
+		return m.Content()
+	case message.FieldResponseType:
+		return m.ResponseType()
+	case message.FieldQuickReplies:
+		return m.QuickReplies()
+	case message.FieldProducts:
+		return m.Products()
+	case message.FieldSearchInfo:
+		return m.SearchInfo()
+	case message.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case message.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case message.FieldRole:
+		return m.OldRole(ctx)
+	case message.FieldContent:
+		return m.OldContent(ctx)
+	case message.FieldResponseType:
+		return m.OldResponseType(ctx)
+	case message.FieldQuickReplies:
+		return m.OldQuickReplies(ctx)
+	case message.FieldProducts:
+		return m.OldProducts(ctx)
+	case message.FieldSearchInfo:
+		return m.OldSearchInfo(ctx)
+	case message.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Message field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldSessionID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionID(v)
+		return nil
+	case message.FieldRole:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRole(v)
+		return nil
+	case message.FieldContent:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetContent(v)
+		return nil
+	case message.FieldResponseType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResponseType(v)
+		return nil
+	case message.FieldQuickReplies:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuickReplies(v)
+		return nil
+	case message.FieldProducts:
+		v, ok := value.([]map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProducts(v)
+		return nil
+	case message.FieldSearchInfo:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSearchInfo(v)
+		return nil
+	case message.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Message numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(message.FieldResponseType) {
+		fields = append(fields, message.FieldResponseType)
+	}
+	if m.FieldCleared(message.FieldQuickReplies) {
+		fields = append(fields, message.FieldQuickReplies)
+	}
+	if m.FieldCleared(message.FieldProducts) {
+		fields = append(fields, message.FieldProducts)
+	}
+	if m.FieldCleared(message.FieldSearchInfo) {
+		fields = append(fields, message.FieldSearchInfo)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageMutation) ClearField(name string) error {
+	switch name {
+	case message.FieldResponseType:
+		m.ClearResponseType()
+		return nil
+	case message.FieldQuickReplies:
+		m.ClearQuickReplies()
+		return nil
+	case message.FieldProducts:
+		m.ClearProducts()
+		return nil
+	case message.FieldSearchInfo:
+		m.ClearSearchInfo()
+		return nil
+	}
+	return fmt.Errorf("unknown Message nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageMutation) ResetField(name string) error {
+	switch name {
+	case message.FieldSessionID:
+		m.ResetSessionID()
+		return nil
+	case message.FieldRole:
+		m.ResetRole()
+		return nil
+	case message.FieldContent:
+		m.ResetContent()
+		return nil
+	case message.FieldResponseType:
+		m.ResetResponseType()
+		return nil
+	case message.FieldQuickReplies:
+		m.ResetQuickReplies()
+		return nil
+	case message.FieldProducts:
+		m.ResetProducts()
+		return nil
+	case message.FieldSearchInfo:
+		m.ResetSearchInfo()
+		return nil
+	case message.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.session != nil {
+		edges = append(edges, message.EdgeSession)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeSession:
+		if id := m.session; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedsession {
+		edges = append(edges, message.EdgeSession)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case message.EdgeSession:
+		return m.clearedsession
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageMutation) ClearEdge(name string) error {
+	switch name {
+	case message.EdgeSession:
+		m.ClearSession()
+		return nil
+	}
+	return fmt.Errorf("unknown Message unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageMutation) ResetEdge(name string) error {
+	switch name {
+	case message.EdgeSession:
+		m.ResetSession()
+		return nil
+	}
+	return fmt.Errorf("unknown Message edge %s", name)
+}
+
+// PriceObservationMutation represents an operation that mutates the PriceObservation nodes in the graph.
+type PriceObservationMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	product_key   *string
+	provider      *string
+	source        *string
+	product_id    *string
+	page_token    *string
+	title         *string
+	merchant      *string
+	country       *string
+	amount        *int64
+	addamount     *int64
+	currency      *string
+	observed_at   *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*PriceObservation, error)
+	predicates    []predicate.PriceObservation
+}
+
+var _ ent.Mutation = (*PriceObservationMutation)(nil)
+
+// priceobservationOption allows management of the mutation configuration using functional options.
+type priceobservationOption func(*PriceObservationMutation)
+
+// newPriceObservationMutation creates new mutation for the PriceObservation entity.
+func newPriceObservationMutation(c config, op Op, opts ...priceobservationOption) *PriceObservationMutation {
+	m := &PriceObservationMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePriceObservation,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPriceObservationID sets the ID field of the mutation.
+func withPriceObservationID(id uuid.UUID) priceobservationOption {
+	return func(m *PriceObservationMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PriceObservation
+		)
+		m.oldValue = func(ctx context.Context) (*PriceObservation, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PriceObservation.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPriceObservation sets the old PriceObservation of the mutation.
+func withPriceObservation(node *PriceObservation) priceobservationOption {
+	return func(m *PriceObservationMutation) {
+		m.oldValue = func(context.Context) (*PriceObservation, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PriceObservationMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PriceObservationMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PriceObservation entities.
+func (m *PriceObservationMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PriceObservationMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PriceObservationMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PriceObservation.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetProductKey sets the "product_key" field.
+func (m *PriceObservationMutation) SetProductKey(s string) {
+	m.product_key = &s
+}
+
+// ProductKey returns the value of the "product_key" field in the mutation.
+func (m *PriceObservationMutation) ProductKey() (r string, exists bool) {
+	v := m.product_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProductKey returns the old "product_key" field's value of the PriceObservation entity.
+// If the PriceObservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PriceObservationMutation) OldProductKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProductKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProductKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProductKey: %w", err)
+	}
+	return oldValue.ProductKey, nil
+}
+
+// ResetProductKey resets all changes to the "product_key" field.
+func (m *PriceObservationMutation) ResetProductKey() {
+	m.product_key = nil
+}
+
+// SetProvider sets the "provider" field.
+func (m *PriceObservationMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *PriceObservationMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the PriceObservation entity.
+// If the PriceObservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PriceObservationMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *PriceObservationMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetSource sets the "source" field.
+func (m *PriceObservationMutation) SetSource(s string) {
+	m.source = &s
+}
+
+// Source returns the value of the "source" field in the mutation.
+func (m *PriceObservationMutation) Source() (r string, exists bool) {
+	v := m.source
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSource returns the old "source" field's value of the PriceObservation entity.
+// If the PriceObservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PriceObservationMutation) OldSource(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSource is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSource requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSource: %w", err)
+	}
+	return oldValue.Source, nil
+}
+
+// ResetSource resets all changes to the "source" field.
+func (m *PriceObservationMutation) ResetSource() {
+	m.source = nil
+}
+
+// SetProductID sets the "product_id" field.
+func (m *PriceObservationMutation) SetProductID(s string) {
+	m.product_id = &s
+}
+
+// ProductID returns the value of the "product_id" field in the mutation.
+func (m *PriceObservationMutation) ProductID() (r string, exists bool) {
+	v := m.product_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProductID returns the old "product_id" field's value of the PriceObservation entity.
+// If the PriceObservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PriceObservationMutation) OldProductID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProductID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProductID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProductID: %w", err)
+	}
+	return oldValue.ProductID, nil
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (m *PriceObservationMutation) ClearProductID() {
+	m.product_id = nil
+	m.clearedFields[priceobservation.FieldProductID] = struct{}{}
+}
+
+// ProductIDCleared returns if the "product_id" field was cleared in this mutation.
+func (m *PriceObservationMutation) ProductIDCleared() bool {
+	_, ok := m.clearedFields[priceobservation.FieldProductID]
+	return ok
+}
+
+// ResetProductID resets all changes to the "product_id" field.
+func (m *PriceObservationMutation) ResetProductID() {
+	m.product_id = nil
+	delete(m.clearedFields, priceobservation.FieldProductID)
+}
+
+// SetPageToken sets the "page_token" field.
+func (m *PriceObservationMutation) SetPageToken(s string) {
+	m.page_token = &s
+}
+
+// PageToken returns the value of the "page_token" field in the mutation.
+func (m *PriceObservationMutation) PageToken() (r string, exists bool) {
+	v := m.page_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPageToken returns the old "page_token" field's value of the PriceObservation entity.
+// If the PriceObservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PriceObservationMutation) OldPageToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPageToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPageToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPageToken: %w", err)
+	}
+	return oldValue.PageToken, nil
+}
+
+// ClearPageToken clears the value of the "page_token" field.
+func (m *PriceObservationMutation) ClearPageToken() {
+	m.page_token = nil
+	m.clearedFields[priceobservation.FieldPageToken] = struct{}{}
+}
+
+// PageTokenCleared returns if the "page_token" field was cleared in this mutation.
+func (m *PriceObservationMutation) PageTokenCleared() bool {
+	_, ok := m.clearedFields[priceobservation.FieldPageToken]
+	return ok
+}
+
+// ResetPageToken resets all changes to the "page_token" field.
+func (m *PriceObservationMutation) ResetPageToken() {
+	m.page_token = nil
+	delete(m.clearedFields, priceobservation.FieldPageToken)
+}
+
+// SetTitle sets the "title" field.
+func (m *PriceObservationMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *PriceObservationMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the PriceObservation entity.
+// If the PriceObservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PriceObservationMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *PriceObservationMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetMerchant sets the "merchant" field.
+func (m *PriceObservationMutation) SetMerchant(s string) {
+	m.merchant = &s
+}
+
+// Merchant returns the value of the "merchant" field in the mutation.
+func (m *PriceObservationMutation) Merchant() (r string, exists bool) {
+	v := m.merchant
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMerchant returns the old "merchant" field's value of the PriceObservation entity.
+// If the PriceObservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PriceObservationMutation) OldMerchant(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMerchant is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMerchant requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMerchant: %w", err)
+	}
+	return oldValue.Merchant, nil
+}
+
+// ClearMerchant clears the value of the "merchant" field.
+func (m *PriceObservationMutation) ClearMerchant() {
+	m.merchant = nil
+	m.clearedFields[priceobservation.FieldMerchant] = struct{}{}
+}
+
+// MerchantCleared returns if the "merchant" field was cleared in this mutation.
+func (m *PriceObservationMutation) MerchantCleared() bool {
+	_, ok := m.clearedFields[priceobservation.FieldMerchant]
+	return ok
+}
+
+// ResetMerchant resets all changes to the "merchant" field.
+func (m *PriceObservationMutation) ResetMerchant() {
+	m.merchant = nil
+	delete(m.clearedFields, priceobservation.FieldMerchant)
+}
+
+// SetCountry sets the "country" field.
+func (m *PriceObservationMutation) SetCountry(s string) {
+	m.country = &s
+}
+
+// Country returns the value of the "country" field in the mutation.
+func (m *PriceObservationMutation) Country() (r string, exists bool) {
+	v := m.country
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCountry returns the old "country" field's value of the PriceObservation entity.
+// If the PriceObservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PriceObservationMutation) OldCountry(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCountry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCountry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCountry: %w", err)
+	}
+	return oldValue.Country, nil
+}
+
+// ClearCountry clears the value of the "country" field.
+func (m *PriceObservationMutation) ClearCountry() {
+	m.country = nil
+	m.clearedFields[priceobservation.FieldCountry] = struct{}{}
+}
+
+// CountryCleared returns if the "country" field was cleared in this mutation.
+func (m *PriceObservationMutation) CountryCleared() bool {
+	_, ok := m.clearedFields[priceobservation.FieldCountry]
+	return ok
+}
+
+// ResetCountry resets all changes to the "country" field.
+func (m *PriceObservationMutation) ResetCountry() {
+	m.country = nil
+	delete(m.clearedFields, priceobservation.FieldCountry)
+}
+
+// SetAmount sets the "amount" field.
+func (m *PriceObservationMutation) SetAmount(i int64) {
+	m.amount = &i
+	m.addamount = nil
+}
+
+// Amount returns the value of the "amount" field in the mutation.
+func (m *PriceObservationMutation) Amount() (r int64, exists bool) {
+	v := m.amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAmount returns the old "amount" field's value of the PriceObservation entity.
+// If the PriceObservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PriceObservationMutation) OldAmount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAmount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAmount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAmount: %w", err)
+	}
+	return oldValue.Amount, nil
+}
+
+// AddAmount adds i to the "amount" field.
+func (m *PriceObservationMutation) AddAmount(i int64) {
+	if m.addamount != nil {
+		*m.addamount += i
+	} else {
+		m.addamount = &i
+	}
+}
+
+// AddedAmount returns the value that was added to the "amount" field in this mutation.
+func (m *PriceObservationMutation) AddedAmount() (r int64, exists bool) {
+	v := m.addamount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAmount resets all changes to the "amount" field.
+func (m *PriceObservationMutation) ResetAmount() {
+	m.amount = nil
+	m.addamount = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *PriceObservationMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *PriceObservationMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the PriceObservation entity.
+// If the PriceObservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PriceObservationMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *PriceObservationMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetObservedAt sets the "observed_at" field.
+func (m *PriceObservationMutation) SetObservedAt(t time.Time) {
+	m.observed_at = &t
+}
+
+// ObservedAt returns the value of the "observed_at" field in the mutation.
+func (m *PriceObservationMutation) ObservedAt() (r time.Time, exists bool) {
+	v := m.observed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldObservedAt returns the old "observed_at" field's value of the PriceObservation entity.
+// If the PriceObservation object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PriceObservationMutation) OldObservedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldObservedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldObservedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldObservedAt: %w", err)
+	}
+	return oldValue.ObservedAt, nil
+}
+
+// ResetObservedAt resets all changes to the "observed_at" field.
+func (m *PriceObservationMutation) ResetObservedAt() {
+	m.observed_at = nil
+}
+
+// Where appends a list predicates to the PriceObservationMutation builder.
+func (m *PriceObservationMutation) Where(ps ...predicate.PriceObservation) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PriceObservationMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PriceObservationMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PriceObservation, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PriceObservationMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PriceObservationMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PriceObservation).
+func (m *PriceObservationMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PriceObservationMutation) Fields() []string {
+	fields := make([]string, 0, 11)
+	if m.product_key != nil {
+		fields = append(fields, priceobservation.FieldProductKey)
+	}
+	if m.provider != nil {
+		fields = append(fields, priceobservation.FieldProvider)
+	}
+	if m.source != nil {
+		fields = append(fields, priceobservation.FieldSource)
+	}
+	if m.product_id != nil {
+		fields = append(fields, priceobservation.FieldProductID)
+	}
+	if m.page_token != nil {
+		fields = append(fields, priceobservation.FieldPageToken)
+	}
+	if m.title != nil {
+		fields = append(fields, priceobservation.FieldTitle)
+	}
+	if m.merchant != nil {
+		fields = append(fields, priceobservation.FieldMerchant)
+	}
+	if m.country != nil {
+		fields = append(fields, priceobservation.FieldCountry)
+	}
+	if m.amount != nil {
+		fields = append(fields, priceobservation.FieldAmount)
+	}
+	if m.currency != nil {
+		fields = append(fields, priceobservation.FieldCurrency)
+	}
+	if m.observed_at != nil {
+		fields = append(fields, priceobservation.FieldObservedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PriceObservationMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case priceobservation.FieldProductKey:
+		return m.ProductKey()
+	case priceobservation.FieldProvider:
+		return m.Provider()
+	case priceobservation.FieldSource:
+		return m.Source()
+	case priceobservation.FieldProductID:
+		return m.ProductID()
+	case priceobservation.FieldPageToken:
+		return m.PageToken()
+	case priceobservation.FieldTitle:
+		return m.Title()
+	case priceobservation.FieldMerchant:
+		return m.Merchant()
+	case priceobservation.FieldCountry:
+		return m.Country()
+	case priceobservation.FieldAmount:
+		return m.Amount()
+	case priceobservation.FieldCurrency:
+		return m.Currency()
+	case priceobservation.FieldObservedAt:
+		return m.ObservedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PriceObservationMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case priceobservation.FieldProductKey:
+		return m.OldProductKey(ctx)
+	case priceobservation.FieldProvider:
+		return m.OldProvider(ctx)
+	case priceobservation.FieldSource:
+		return m.OldSource(ctx)
+	case priceobservation.FieldProductID:
+		return m.OldProductID(ctx)
+	case priceobservation.FieldPageToken:
+		return m.OldPageToken(ctx)
+	case priceobservation.FieldTitle:
+		return m.OldTitle(ctx)
+	case priceobservation.FieldMerchant:
+		return m.OldMerchant(ctx)
+	case priceobservation.FieldCountry:
+		return m.OldCountry(ctx)
+	case priceobservation.FieldAmount:
+		return m.OldAmount(ctx)
+	case priceobservation.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case priceobservation.FieldObservedAt:
+		return m.OldObservedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PriceObservation field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PriceObservationMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case priceobservation.FieldProductKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProductKey(v)
+		return nil
+	case priceobservation.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case priceobservation.FieldSource:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSource(v)
+		return nil
+	case priceobservation.FieldProductID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProductID(v)
+		return nil
+	case priceobservation.FieldPageToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPageToken(v)
+		return nil
+	case priceobservation.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case priceobservation.FieldMerchant:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMerchant(v)
+		return nil
+	case priceobservation.FieldCountry:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCountry(v)
+		return nil
+	case priceobservation.FieldAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAmount(v)
+		return nil
+	case priceobservation.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case priceobservation.FieldObservedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetObservedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PriceObservation field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PriceObservationMutation) AddedFields() []string {
+	var fields []string
+	if m.addamount != nil {
+		fields = append(fields, priceobservation.FieldAmount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PriceObservationMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case priceobservation.FieldAmount:
+		return m.AddedAmount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PriceObservationMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case priceobservation.FieldAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PriceObservation numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PriceObservationMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(priceobservation.FieldProductID) {
+		fields = append(fields, priceobservation.FieldProductID)
+	}
+	if m.FieldCleared(priceobservation.FieldPageToken) {
+		fields = append(fields, priceobservation.FieldPageToken)
+	}
+	if m.FieldCleared(priceobservation.FieldMerchant) {
+		fields = append(fields, priceobservation.FieldMerchant)
+	}
+	if m.FieldCleared(priceobservation.FieldCountry) {
+		fields = append(fields, priceobservation.FieldCountry)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PriceObservationMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PriceObservationMutation) ClearField(name string) error {
+	switch name {
+	case priceobservation.FieldProductID:
+		m.ClearProductID()
+		return nil
+	case priceobservation.FieldPageToken:
+		m.ClearPageToken()
+		return nil
+	case priceobservation.FieldMerchant:
+		m.ClearMerchant()
+		return nil
+	case priceobservation.FieldCountry:
+		m.ClearCountry()
+		return nil
+	}
+	return fmt.Errorf("unknown PriceObservation nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PriceObservationMutation) ResetField(name string) error {
+	switch name {
+	case priceobservation.FieldProductKey:
+		m.ResetProductKey()
+		return nil
+	case priceobservation.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case priceobservation.FieldSource:
+		m.ResetSource()
+		return nil
+	case priceobservation.FieldProductID:
+		m.ResetProductID()
+		return nil
+	case priceobservation.FieldPageToken:
+		m.ResetPageToken()
+		return nil
+	case priceobservation.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case priceobservation.FieldMerchant:
+		m.ResetMerchant()
+		return nil
+	case priceobservation.FieldCountry:
+		m.ResetCountry()
+		return nil
+	case priceobservation.FieldAmount:
+		m.ResetAmount()
+		return nil
+	case priceobservation.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case priceobservation.FieldObservedAt:
+		m.ResetObservedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PriceObservation field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PriceObservationMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PriceObservationMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PriceObservationMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PriceObservationMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PriceObservationMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PriceObservationMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PriceObservationMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown PriceObservation unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PriceObservationMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown PriceObservation edge %s", name)
+}
+
+// SearchHistoryMutation represents an operation that mutates the SearchHistory nodes in the graph.
+type SearchHistoryMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	session_id           *string
+	search_query         *string
+	optimized_query      *string
+	search_type          *string
+	category             *string
+	country_code         *string
+	language_code        *string
+	currency             *string
+	result_count         *int
+	addresult_count      *int
+	products_found       *[]map[string]interface{}
+	appendproducts_found []map[string]interface{}
+	clicked_product_id   *string
+	created_at           *time.Time
+	expires_at           *time.Time
+	clearedFields        map[string]struct{}
+	user                 *uuid.UUID
+	cleareduser          bool
+	done                 bool
+	oldValue             func(context.Context) (*SearchHistory, error)
+	predicates           []predicate.SearchHistory
+}
+
+var _ ent.Mutation = (*SearchHistoryMutation)(nil)
+
+// searchhistoryOption allows management of the mutation configuration using functional options.
+type searchhistoryOption func(*SearchHistoryMutation)
+
+// newSearchHistoryMutation creates new mutation for the SearchHistory entity.
+func newSearchHistoryMutation(c config, op Op, opts ...searchhistoryOption) *SearchHistoryMutation {
+	m := &SearchHistoryMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeSearchHistory,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withSearchHistoryID sets the ID field of the mutation.
+func withSearchHistoryID(id uuid.UUID) searchhistoryOption {
+	return func(m *SearchHistoryMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *SearchHistory
+		)
+		m.oldValue = func(ctx context.Context) (*SearchHistory, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().SearchHistory.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withSearchHistory sets the old SearchHistory of the mutation.
+func withSearchHistory(node *SearchHistory) searchhistoryOption {
+	return func(m *SearchHistoryMutation) {
+		m.oldValue = func(context.Context) (*SearchHistory, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m SearchHistoryMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m SearchHistoryMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of SearchHistory entities.
+func (m *SearchHistoryMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *SearchHistoryMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *SearchHistoryMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().SearchHistory.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *SearchHistoryMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *SearchHistoryMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the SearchHistory entity.
+// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchHistoryMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *SearchHistoryMutation) ClearUserID() {
+	m.user = nil
+	m.clearedFields[searchhistory.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *SearchHistoryMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[searchhistory.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *SearchHistoryMutation) ResetUserID() {
+	m.user = nil
+	delete(m.clearedFields, searchhistory.FieldUserID)
+}
+
+// SetSessionID sets the "session_id" field.
+func (m *SearchHistoryMutation) SetSessionID(s string) {
+	m.session_id = &s
+}
+
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *SearchHistoryMutation) SessionID() (r string, exists bool) {
+	v := m.session_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionID returns the old "session_id" field's value of the SearchHistory entity.
+// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchHistoryMutation) OldSessionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+	}
+	return oldValue.SessionID, nil
+}
+
+// ClearSessionID clears the value of the "session_id" field.
+func (m *SearchHistoryMutation) ClearSessionID() {
+	m.session_id = nil
+	m.clearedFields[searchhistory.FieldSessionID] = struct{}{}
+}
+
+// SessionIDCleared returns if the "session_id" field was cleared in this mutation.
+func (m *SearchHistoryMutation) SessionIDCleared() bool {
+	_, ok := m.clearedFields[searchhistory.FieldSessionID]
+	return ok
+}
+
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *SearchHistoryMutation) ResetSessionID() {
+	m.session_id = nil
+	delete(m.clearedFields, searchhistory.FieldSessionID)
+}
+
+// SetSearchQuery sets the "search_query" field.
+func (m *SearchHistoryMutation) SetSearchQuery(s string) {
+	m.search_query = &s
+}
+
+// SearchQuery returns the value of the "search_query" field in the mutation.
+func (m *SearchHistoryMutation) SearchQuery() (r string, exists bool) {
+	v := m.search_query
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSearchQuery returns the old "search_query" field's value of the SearchHistory entity.
+// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchHistoryMutation) OldSearchQuery(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSearchQuery is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSearchQuery requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSearchQuery: %w", err)
+	}
+	return oldValue.SearchQuery, nil
+}
+
+// ResetSearchQuery resets all changes to the "search_query" field.
+func (m *SearchHistoryMutation) ResetSearchQuery() {
+	m.search_query = nil
+}
+
+// SetOptimizedQuery sets the "optimized_query" field.
+func (m *SearchHistoryMutation) SetOptimizedQuery(s string) {
+	m.optimized_query = &s
+}
+
+// OptimizedQuery returns the value of the "optimized_query" field in the mutation.
+func (m *SearchHistoryMutation) OptimizedQuery() (r string, exists bool) {
+	v := m.optimized_query
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOptimizedQuery returns the old "optimized_query" field's value of the SearchHistory entity.
+// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchHistoryMutation) OldOptimizedQuery(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOptimizedQuery is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOptimizedQuery requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOptimizedQuery: %w", err)
+	}
+	return oldValue.OptimizedQuery, nil
+}
+
+// ClearOptimizedQuery clears the value of the "optimized_query" field.
+func (m *SearchHistoryMutation) ClearOptimizedQuery() {
+	m.optimized_query = nil
+	m.clearedFields[searchhistory.FieldOptimizedQuery] = struct{}{}
+}
+
+// OptimizedQueryCleared returns if the "optimized_query" field was cleared in this mutation.
+func (m *SearchHistoryMutation) OptimizedQueryCleared() bool {
+	_, ok := m.clearedFields[searchhistory.FieldOptimizedQuery]
+	return ok
+}
+
+// ResetOptimizedQuery resets all changes to the "optimized_query" field.
+func (m *SearchHistoryMutation) ResetOptimizedQuery() {
+	m.optimized_query = nil
+	delete(m.clearedFields, searchhistory.FieldOptimizedQuery)
+}
+
+// SetSearchType sets the "search_type" field.
+func (m *SearchHistoryMutation) SetSearchType(s string) {
+	m.search_type = &s
+}
+
+// SearchType returns the value of the "search_type" field in the mutation.
+func (m *SearchHistoryMutation) SearchType() (r string, exists bool) {
+	v := m.search_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSearchType returns the old "search_type" field's value of the SearchHistory entity.
+// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchHistoryMutation) OldSearchType(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSearchType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSearchType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSearchType: %w", err)
+	}
+	return oldValue.SearchType, nil
+}
+
+// ResetSearchType resets all changes to the "search_type" field.
+func (m *SearchHistoryMutation) ResetSearchType() {
+	m.search_type = nil
+}
+
+// SetCategory sets the "category" field.
+func (m *SearchHistoryMutation) SetCategory(s string) {
+	m.category = &s
+}
+
+// Category returns the value of the "category" field in the mutation.
+func (m *SearchHistoryMutation) Category() (r string, exists bool) {
+	v := m.category
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCategory returns the old "category" field's value of the SearchHistory entity.
+// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchHistoryMutation) OldCategory(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCategory requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+	}
+	return oldValue.Category, nil
+}
+
+// ClearCategory clears the value of the "category" field.
+func (m *SearchHistoryMutation) ClearCategory() {
+	m.category = nil
+	m.clearedFields[searchhistory.FieldCategory] = struct{}{}
+}
+
+// CategoryCleared returns if the "category" field was cleared in this mutation.
+func (m *SearchHistoryMutation) CategoryCleared() bool {
+	_, ok := m.clearedFields[searchhistory.FieldCategory]
+	return ok
+}
+
+// ResetCategory resets all changes to the "category" field.
+func (m *SearchHistoryMutation) ResetCategory() {
+	m.category = nil
+	delete(m.clearedFields, searchhistory.FieldCategory)
+}
+
+// SetCountryCode sets the "country_code" field.
+func (m *SearchHistoryMutation) SetCountryCode(s string) {
+	m.country_code = &s
+}
+
+// CountryCode returns the value of the "country_code" field in the mutation.
+func (m *SearchHistoryMutation) CountryCode() (r string, exists bool) {
+	v := m.country_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCountryCode returns the old "country_code" field's value of the SearchHistory entity.
+// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchHistoryMutation) OldCountryCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCountryCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCountryCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCountryCode: %w", err)
+	}
+	return oldValue.CountryCode, nil
+}
+
+// ResetCountryCode resets all changes to the "country_code" field.
+func (m *SearchHistoryMutation) ResetCountryCode() {
+	m.country_code = nil
+}
+
+// SetLanguageCode sets the "language_code" field.
+func (m *SearchHistoryMutation) SetLanguageCode(s string) {
+	m.language_code = &s
+}
+
+// LanguageCode returns the value of the "language_code" field in the mutation.
+func (m *SearchHistoryMutation) LanguageCode() (r string, exists bool) {
+	v := m.language_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguageCode returns the old "language_code" field's value of the SearchHistory entity.
+// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchHistoryMutation) OldLanguageCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguageCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguageCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguageCode: %w", err)
+	}
+	return oldValue.LanguageCode, nil
+}
+
+// ResetLanguageCode resets all changes to the "language_code" field.
+func (m *SearchHistoryMutation) ResetLanguageCode() {
+	m.language_code = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *SearchHistoryMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *SearchHistoryMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the SearchHistory entity.
+// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchHistoryMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *SearchHistoryMutation) ResetCurrency() {
+	m.currency = nil
+}
+
+// SetResultCount sets the "result_count" field.
+func (m *SearchHistoryMutation) SetResultCount(i int) {
+	m.result_count = &i
+	m.addresult_count = nil
+}
+
+// ResultCount returns the value of the "result_count" field in the mutation.
+func (m *SearchHistoryMutation) ResultCount() (r int, exists bool) {
+	v := m.result_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldResultCount returns the old "result_count" field's value of the SearchHistory entity.
+// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchHistoryMutation) OldResultCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldResultCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldResultCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldResultCount: %w", err)
+	}
+	return oldValue.ResultCount, nil
+}
+
+// AddResultCount adds i to the "result_count" field.
+func (m *SearchHistoryMutation) AddResultCount(i int) {
+	if m.addresult_count != nil {
+		*m.addresult_count += i
+	} else {
+		m.addresult_count = &i
+	}
+}
+
+// AddedResultCount returns the value that was added to the "result_count" field in this mutation.
+func (m *SearchHistoryMutation) AddedResultCount() (r int, exists bool) {
+	v := m.addresult_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetResultCount resets all changes to the "result_count" field.
+func (m *SearchHistoryMutation) ResetResultCount() {
+	m.result_count = nil
+	m.addresult_count = nil
+}
+
+// SetProductsFound sets the "products_found" field.
+func (m *SearchHistoryMutation) SetProductsFound(value []map[string]interface{}) {
+	m.products_found = &value
+	m.appendproducts_found = nil
+}
+
+// ProductsFound returns the value of the "products_found" field in the mutation.
+func (m *SearchHistoryMutation) ProductsFound() (r []map[string]interface{}, exists bool) {
+	v := m.products_found
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProductsFound returns the old "products_found" field's value of the SearchHistory entity.
+// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchHistoryMutation) OldProductsFound(ctx context.Context) (v []map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProductsFound is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProductsFound requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProductsFound: %w", err)
+	}
+	return oldValue.ProductsFound, nil
+}
+
+// AppendProductsFound adds value to the "products_found" field.
+func (m *SearchHistoryMutation) AppendProductsFound(value []map[string]interface{}) {
+	m.appendproducts_found = append(m.appendproducts_found, value...)
+}
+
+// AppendedProductsFound returns the list of values that were appended to the "products_found" field in this mutation.
+func (m *SearchHistoryMutation) AppendedProductsFound() ([]map[string]interface{}, bool) {
+	if len(m.appendproducts_found) == 0 {
+		return nil, false
+	}
+	return m.appendproducts_found, true
+}
+
+// ClearProductsFound clears the value of the "products_found" field.
+func (m *SearchHistoryMutation) ClearProductsFound() {
+	m.products_found = nil
+	m.appendproducts_found = nil
+	m.clearedFields[searchhistory.FieldProductsFound] = struct{}{}
+}
+
+// ProductsFoundCleared returns if the "products_found" field was cleared in this mutation.
+func (m *SearchHistoryMutation) ProductsFoundCleared() bool {
+	_, ok := m.clearedFields[searchhistory.FieldProductsFound]
+	return ok
+}
+
+// ResetProductsFound resets all changes to the "products_found" field.
+func (m *SearchHistoryMutation) ResetProductsFound() {
+	m.products_found = nil
+	m.appendproducts_found = nil
+	delete(m.clearedFields, searchhistory.FieldProductsFound)
+}
+
+// SetClickedProductID sets the "clicked_product_id" field.
+func (m *SearchHistoryMutation) SetClickedProductID(s string) {
+	m.clicked_product_id = &s
+}
+
+// ClickedProductID returns the value of the "clicked_product_id" field in the mutation.
+func (m *SearchHistoryMutation) ClickedProductID() (r string, exists bool) {
+	v := m.clicked_product_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClickedProductID returns the old "clicked_product_id" field's value of the SearchHistory entity.
+// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchHistoryMutation) OldClickedProductID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClickedProductID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClickedProductID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClickedProductID: %w", err)
+	}
+	return oldValue.ClickedProductID, nil
+}
+
+// ClearClickedProductID clears the value of the "clicked_product_id" field.
+func (m *SearchHistoryMutation) ClearClickedProductID() {
+	m.clicked_product_id = nil
+	m.clearedFields[searchhistory.FieldClickedProductID] = struct{}{}
+}
+
+// ClickedProductIDCleared returns if the "clicked_product_id" field was cleared in this mutation.
+func (m *SearchHistoryMutation) ClickedProductIDCleared() bool {
+	_, ok := m.clearedFields[searchhistory.FieldClickedProductID]
+	return ok
+}
+
+// ResetClickedProductID resets all changes to the "clicked_product_id" field.
+func (m *SearchHistoryMutation) ResetClickedProductID() {
+	m.clicked_product_id = nil
+	delete(m.clearedFields, searchhistory.FieldClickedProductID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *SearchHistoryMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *SearchHistoryMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the SearchHistory entity.
+// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchHistoryMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *SearchHistoryMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetExpiresAt sets the "expires_at" field.
+func (m *SearchHistoryMutation) SetExpiresAt(t time.Time) {
+	m.expires_at = &t
+}
+
+// ExpiresAt returns the value of the "expires_at" field in the mutation.
+func (m *SearchHistoryMutation) ExpiresAt() (r time.Time, exists bool) {
+	v := m.expires_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExpiresAt returns the old "expires_at" field's value of the SearchHistory entity.
+// If the SearchHistory object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *SearchHistoryMutation) OldExpiresAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExpiresAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExpiresAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExpiresAt: %w", err)
+	}
+	return oldValue.ExpiresAt, nil
+}
+
+// ClearExpiresAt clears the value of the "expires_at" field.
+func (m *SearchHistoryMutation) ClearExpiresAt() {
+	m.expires_at = nil
+	m.clearedFields[searchhistory.FieldExpiresAt] = struct{}{}
+}
+
+// ExpiresAtCleared returns if the "expires_at" field was cleared in this mutation.
+func (m *SearchHistoryMutation) ExpiresAtCleared() bool {
+	_, ok := m.clearedFields[searchhistory.FieldExpiresAt]
+	return ok
+}
+
+// ResetExpiresAt resets all changes to the "expires_at" field.
+func (m *SearchHistoryMutation) ResetExpiresAt() {
+	m.expires_at = nil
+	delete(m.clearedFields, searchhistory.FieldExpiresAt)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *SearchHistoryMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[searchhistory.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *SearchHistoryMutation) UserCleared() bool {
+	return m.UserIDCleared() || m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *SearchHistoryMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *SearchHistoryMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the SearchHistoryMutation builder.
+func (m *SearchHistoryMutation) Where(ps ...predicate.SearchHistory) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the SearchHistoryMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *SearchHistoryMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.SearchHistory, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *SearchHistoryMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *SearchHistoryMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (SearchHistory).
+func (m *SearchHistoryMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *SearchHistoryMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.user != nil {
+		fields = append(fields, searchhistory.FieldUserID)
+	}
+	if m.session_id != nil {
+		fields = append(fields, searchhistory.FieldSessionID)
+	}
+	if m.search_query != nil {
+		fields = append(fields, searchhistory.FieldSearchQuery)
+	}
+	if m.optimized_query != nil {
+		fields = append(fields, searchhistory.FieldOptimizedQuery)
+	}
+	if m.search_type != nil {
+		fields = append(fields, searchhistory.FieldSearchType)
+	}
+	if m.category != nil {
+		fields = append(fields, searchhistory.FieldCategory)
+	}
+	if m.country_code != nil {
+		fields = append(fields, searchhistory.FieldCountryCode)
+	}
+	if m.language_code != nil {
+		fields = append(fields, searchhistory.FieldLanguageCode)
+	}
+	if m.currency != nil {
+		fields = append(fields, searchhistory.FieldCurrency)
+	}
+	if m.result_count != nil {
+		fields = append(fields, searchhistory.FieldResultCount)
+	}
+	if m.products_found != nil {
+		fields = append(fields, searchhistory.FieldProductsFound)
+	}
+	if m.clicked_product_id != nil {
+		fields = append(fields, searchhistory.FieldClickedProductID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, searchhistory.FieldCreatedAt)
+	}
+	if m.expires_at != nil {
+		fields = append(fields, searchhistory.FieldExpiresAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *SearchHistoryMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case searchhistory.FieldUserID:
+		return m.UserID()
+	case searchhistory.FieldSessionID:
+		return m.SessionID()
+	case searchhistory.FieldSearchQuery:
+		return m.SearchQuery()
+	case searchhistory.FieldOptimizedQuery:
+		return m.OptimizedQuery()
+	case searchhistory.FieldSearchType:
+		return m.SearchType()
+	case searchhistory.FieldCategory:
+		return m.Category()
+	case searchhistory.FieldCountryCode:
+		return m.CountryCode()
+	case searchhistory.FieldLanguageCode:
+		return m.LanguageCode()
+	case searchhistory.FieldCurrency:
+		return m.Currency()
+	case searchhistory.FieldResultCount:
+		return m.ResultCount()
+	case searchhistory.FieldProductsFound:
+		return m.ProductsFound()
+	case searchhistory.FieldClickedProductID:
+		return m.ClickedProductID()
+	case searchhistory.FieldCreatedAt:
+		return m.CreatedAt()
+	case searchhistory.FieldExpiresAt:
+		return m.ExpiresAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *SearchHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case searchhistory.FieldUserID:
+		return m.OldUserID(ctx)
+	case searchhistory.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case searchhistory.FieldSearchQuery:
+		return m.OldSearchQuery(ctx)
+	case searchhistory.FieldOptimizedQuery:
+		return m.OldOptimizedQuery(ctx)
+	case searchhistory.FieldSearchType:
+		return m.OldSearchType(ctx)
+	case searchhistory.FieldCategory:
+		return m.OldCategory(ctx)
+	case searchhistory.FieldCountryCode:
+		return m.OldCountryCode(ctx)
+	case searchhistory.FieldLanguageCode:
+		return m.OldLanguageCode(ctx)
+	case searchhistory.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case searchhistory.FieldResultCount:
+		return m.OldResultCount(ctx)
+	case searchhistory.FieldProductsFound:
+		return m.OldProductsFound(ctx)
+	case searchhistory.FieldClickedProductID:
+		return m.OldClickedProductID(ctx)
+	case searchhistory.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case searchhistory.FieldExpiresAt:
+		return m.OldExpiresAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown SearchHistory field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SearchHistoryMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case searchhistory.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case searchhistory.FieldSessionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionID(v)
+		return nil
+	case searchhistory.FieldSearchQuery:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSearchQuery(v)
+		return nil
+	case searchhistory.FieldOptimizedQuery:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOptimizedQuery(v)
+		return nil
+	case searchhistory.FieldSearchType:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSearchType(v)
+		return nil
+	case searchhistory.FieldCategory:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCategory(v)
+		return nil
+	case searchhistory.FieldCountryCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCountryCode(v)
+		return nil
+	case searchhistory.FieldLanguageCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLanguageCode(v)
+		return nil
+	case searchhistory.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case searchhistory.FieldResultCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetResultCount(v)
+		return nil
+	case searchhistory.FieldProductsFound:
+		v, ok := value.([]map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProductsFound(v)
+		return nil
+	case searchhistory.FieldClickedProductID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClickedProductID(v)
+		return nil
+	case searchhistory.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case searchhistory.FieldExpiresAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExpiresAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SearchHistory field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *SearchHistoryMutation) AddedFields() []string {
+	var fields []string
+	if m.addresult_count != nil {
+		fields = append(fields, searchhistory.FieldResultCount)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *SearchHistoryMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case searchhistory.FieldResultCount:
+		return m.AddedResultCount()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *SearchHistoryMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case searchhistory.FieldResultCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddResultCount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown SearchHistory numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *SearchHistoryMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(searchhistory.FieldUserID) {
+		fields = append(fields, searchhistory.FieldUserID)
+	}
+	if m.FieldCleared(searchhistory.FieldSessionID) {
+		fields = append(fields, searchhistory.FieldSessionID)
+	}
+	if m.FieldCleared(searchhistory.FieldOptimizedQuery) {
+		fields = append(fields, searchhistory.FieldOptimizedQuery)
+	}
+	if m.FieldCleared(searchhistory.FieldCategory) {
+		fields = append(fields, searchhistory.FieldCategory)
+	}
+	if m.FieldCleared(searchhistory.FieldProductsFound) {
+		fields = append(fields, searchhistory.FieldProductsFound)
+	}
+	if m.FieldCleared(searchhistory.FieldClickedProductID) {
+		fields = append(fields, searchhistory.FieldClickedProductID)
+	}
+	if m.FieldCleared(searchhistory.FieldExpiresAt) {
+		fields = append(fields, searchhistory.FieldExpiresAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *SearchHistoryMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *SearchHistoryMutation) ClearField(name string) error {
+	switch name {
+	case searchhistory.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case searchhistory.FieldSessionID:
+		m.ClearSessionID()
+		return nil
+	case searchhistory.FieldOptimizedQuery:
+		m.ClearOptimizedQuery()
+		return nil
+	case searchhistory.FieldCategory:
+		m.ClearCategory()
+		return nil
+	case searchhistory.FieldProductsFound:
+		m.ClearProductsFound()
+		return nil
+	case searchhistory.FieldClickedProductID:
+		m.ClearClickedProductID()
+		return nil
+	case searchhistory.FieldExpiresAt:
+		m.ClearExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SearchHistory nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *SearchHistoryMutation) ResetField(name string) error {
+	switch name {
+	case searchhistory.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case searchhistory.FieldSessionID:
+		m.ResetSessionID()
+		return nil
+	case searchhistory.FieldSearchQuery:
+		m.ResetSearchQuery()
+		return nil
+	case searchhistory.FieldOptimizedQuery:
+		m.ResetOptimizedQuery()
+		return nil
+	case searchhistory.FieldSearchType:
+		m.ResetSearchType()
+		return nil
+	case searchhistory.FieldCategory:
+		m.ResetCategory()
+		return nil
+	case searchhistory.FieldCountryCode:
+		m.ResetCountryCode()
+		return nil
+	case searchhistory.FieldLanguageCode:
+		m.ResetLanguageCode()
+		return nil
+	case searchhistory.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case searchhistory.FieldResultCount:
+		m.ResetResultCount()
+		return nil
+	case searchhistory.FieldProductsFound:
+		m.ResetProductsFound()
+		return nil
+	case searchhistory.FieldClickedProductID:
+		m.ResetClickedProductID()
+		return nil
+	case searchhistory.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case searchhistory.FieldExpiresAt:
+		m.ResetExpiresAt()
+		return nil
+	}
+	return fmt.Errorf("unknown SearchHistory field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *SearchHistoryMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, searchhistory.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *SearchHistoryMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case searchhistory.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *SearchHistoryMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *SearchHistoryMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *SearchHistoryMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, searchhistory.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *SearchHistoryMutation) EdgeCleared(name string) bool {
+	switch name {
+	case searchhistory.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *SearchHistoryMutation) ClearEdge(name string) error {
+	switch name {
+	case searchhistory.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown SearchHistory unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *SearchHistoryMutation) ResetEdge(name string) error {
+	switch name {
+	case searchhistory.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown SearchHistory edge %s", name)
+}
+
+// TokenUsageMutation represents an operation that mutates the TokenUsage nodes in the graph.
+type TokenUsageMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	user_id          *uuid.UUID
+	session_id       *string
+	provider         *string
+	model            *string
+	operation        *string
+	input_tokens     *int
+	addinput_tokens  *int
+	cached_tokens    *int
+	addcached_tokens *int
+	output_tokens    *int
+	addoutput_tokens *int
+	grounded         *bool
+	latency_ms       *int
+	addlatency_ms    *int
+	cost_usd         *float64
+	addcost_usd      *float64
+	day              *string
+	created_at       *time.Time
+	clearedFields    map[string]struct{}
+	done             bool
+	oldValue         func(context.Context) (*TokenUsage, error)
+	predicates       []predicate.TokenUsage
+}
+
+var _ ent.Mutation = (*TokenUsageMutation)(nil)
+
+// tokenusageOption allows management of the mutation configuration using functional options.
+type tokenusageOption func(*TokenUsageMutation)
+
+// newTokenUsageMutation creates new mutation for the TokenUsage entity.
+func newTokenUsageMutation(c config, op Op, opts ...tokenusageOption) *TokenUsageMutation {
+	m := &TokenUsageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTokenUsage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withTokenUsageID sets the ID field of the mutation.
+func withTokenUsageID(id uuid.UUID) tokenusageOption {
+	return func(m *TokenUsageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *TokenUsage
+		)
+		m.oldValue = func(ctx context.Context) (*TokenUsage, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().TokenUsage.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withTokenUsage sets the old TokenUsage of the mutation.
+func withTokenUsage(node *TokenUsage) tokenusageOption {
+	return func(m *TokenUsageMutation) {
+		m.oldValue = func(context.Context) (*TokenUsage, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TokenUsageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TokenUsageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TokenUsage entities.
+func (m *TokenUsageMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TokenUsageMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TokenUsageMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TokenUsage.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *TokenUsageMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *TokenUsageMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the TokenUsage entity.
+// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenUsageMutation) OldUserID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *TokenUsageMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[tokenusage.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *TokenUsageMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[tokenusage.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *TokenUsageMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, tokenusage.FieldUserID)
+}
+
+// SetSessionID sets the "session_id" field.
+func (m *TokenUsageMutation) SetSessionID(s string) {
+	m.session_id = &s
+}
+
+// SessionID returns the value of the "session_id" field in the mutation.
+func (m *TokenUsageMutation) SessionID() (r string, exists bool) {
+	v := m.session_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSessionID returns the old "session_id" field's value of the TokenUsage entity.
+// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenUsageMutation) OldSessionID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSessionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSessionID: %w", err)
+	}
+	return oldValue.SessionID, nil
+}
+
+// ClearSessionID clears the value of the "session_id" field.
+func (m *TokenUsageMutation) ClearSessionID() {
+	m.session_id = nil
+	m.clearedFields[tokenusage.FieldSessionID] = struct{}{}
+}
+
+// SessionIDCleared returns if the "session_id" field was cleared in this mutation.
+func (m *TokenUsageMutation) SessionIDCleared() bool {
+	_, ok := m.clearedFields[tokenusage.FieldSessionID]
+	return ok
+}
+
+// ResetSessionID resets all changes to the "session_id" field.
+func (m *TokenUsageMutation) ResetSessionID() {
+	m.session_id = nil
+	delete(m.clearedFields, tokenusage.FieldSessionID)
+}
+
+// SetProvider sets the "provider" field.
+func (m *TokenUsageMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *TokenUsageMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the TokenUsage entity.
+// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenUsageMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *TokenUsageMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetModel sets the "model" field.
+func (m *TokenUsageMutation) SetModel(s string) {
+	m.model = &s
+}
+
+// Model returns the value of the "model" field in the mutation.
+func (m *TokenUsageMutation) Model() (r string, exists bool) {
+	v := m.model
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldModel returns the old "model" field's value of the TokenUsage entity.
+// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenUsageMutation) OldModel(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldModel is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldModel requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldModel: %w", err)
+	}
+	return oldValue.Model, nil
+}
+
+// ResetModel resets all changes to the "model" field.
+func (m *TokenUsageMutation) ResetModel() {
+	m.model = nil
+}
+
+// SetOperation sets the "operation" field.
+func (m *TokenUsageMutation) SetOperation(s string) {
+	m.operation = &s
+}
+
+// Operation returns the value of the "operation" field in the mutation.
+func (m *TokenUsageMutation) Operation() (r string, exists bool) {
+	v := m.operation
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOperation returns the old "operation" field's value of the TokenUsage entity.
+// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenUsageMutation) OldOperation(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOperation is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOperation requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOperation: %w", err)
+	}
+	return oldValue.Operation, nil
+}
+
+// ResetOperation resets all changes to the "operation" field.
+func (m *TokenUsageMutation) ResetOperation() {
+	m.operation = nil
+}
+
+// SetInputTokens sets the "input_tokens" field.
+func (m *TokenUsageMutation) SetInputTokens(i int) {
+	m.input_tokens = &i
+	m.addinput_tokens = nil
+}
+
+// InputTokens returns the value of the "input_tokens" field in the mutation.
+func (m *TokenUsageMutation) InputTokens() (r int, exists bool) {
+	v := m.input_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInputTokens returns the old "input_tokens" field's value of the TokenUsage entity.
+// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenUsageMutation) OldInputTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInputTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInputTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInputTokens: %w", err)
+	}
+	return oldValue.InputTokens, nil
+}
+
+// AddInputTokens adds i to the "input_tokens" field.
+func (m *TokenUsageMutation) AddInputTokens(i int) {
+	if m.addinput_tokens != nil {
+		*m.addinput_tokens += i
+	} else {
+		m.addinput_tokens = &i
+	}
+}
+
+// AddedInputTokens returns the value that was added to the "input_tokens" field in this mutation.
+func (m *TokenUsageMutation) AddedInputTokens() (r int, exists bool) {
+	v := m.addinput_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetInputTokens resets all changes to the "input_tokens" field.
+func (m *TokenUsageMutation) ResetInputTokens() {
+	m.input_tokens = nil
+	m.addinput_tokens = nil
+}
+
+// SetCachedTokens sets the "cached_tokens" field.
+func (m *TokenUsageMutation) SetCachedTokens(i int) {
+	m.cached_tokens = &i
+	m.addcached_tokens = nil
+}
+
+// CachedTokens returns the value of the "cached_tokens" field in the mutation.
+func (m *TokenUsageMutation) CachedTokens() (r int, exists bool) {
+	v := m.cached_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCachedTokens returns the old "cached_tokens" field's value of the TokenUsage entity.
+// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenUsageMutation) OldCachedTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCachedTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCachedTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCachedTokens: %w", err)
+	}
+	return oldValue.CachedTokens, nil
+}
+
+// AddCachedTokens adds i to the "cached_tokens" field.
+func (m *TokenUsageMutation) AddCachedTokens(i int) {
+	if m.addcached_tokens != nil {
+		*m.addcached_tokens += i
+	} else {
+		m.addcached_tokens = &i
+	}
+}
+
+// AddedCachedTokens returns the value that was added to the "cached_tokens" field in this mutation.
+func (m *TokenUsageMutation) AddedCachedTokens() (r int, exists bool) {
+	v := m.addcached_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCachedTokens resets all changes to the "cached_tokens" field.
+func (m *TokenUsageMutation) ResetCachedTokens() {
+	m.cached_tokens = nil
+	m.addcached_tokens = nil
+}
+
+// SetOutputTokens sets the "output_tokens" field.
+func (m *TokenUsageMutation) SetOutputTokens(i int) {
+	m.output_tokens = &i
+	m.addoutput_tokens = nil
+}
+
+// OutputTokens returns the value of the "output_tokens" field in the mutation.
+func (m *TokenUsageMutation) OutputTokens() (r int, exists bool) {
+	v := m.output_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOutputTokens returns the old "output_tokens" field's value of the TokenUsage entity.
+// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenUsageMutation) OldOutputTokens(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOutputTokens is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOutputTokens requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOutputTokens: %w", err)
+	}
+	return oldValue.OutputTokens, nil
+}
+
+// AddOutputTokens adds i to the "output_tokens" field.
+func (m *TokenUsageMutation) AddOutputTokens(i int) {
+	if m.addoutput_tokens != nil {
+		*m.addoutput_tokens += i
+	} else {
+		m.addoutput_tokens = &i
+	}
+}
+
+// AddedOutputTokens returns the value that was added to the "output_tokens" field in this mutation.
+func (m *TokenUsageMutation) AddedOutputTokens() (r int, exists bool) {
+	v := m.addoutput_tokens
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetOutputTokens resets all changes to the "output_tokens" field.
+func (m *TokenUsageMutation) ResetOutputTokens() {
+	m.output_tokens = nil
+	m.addoutput_tokens = nil
+}
+
+// SetGrounded sets the "grounded" field.
+func (m *TokenUsageMutation) SetGrounded(b bool) {
+	m.grounded = &b
+}
+
+// Grounded returns the value of the "grounded" field in the mutation.
+func (m *TokenUsageMutation) Grounded() (r bool, exists bool) {
+	v := m.grounded
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGrounded returns the old "grounded" field's value of the TokenUsage entity.
+// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenUsageMutation) OldGrounded(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGrounded is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGrounded requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGrounded: %w", err)
+	}
+	return oldValue.Grounded, nil
+}
+
+// ResetGrounded resets all changes to the "grounded" field.
+func (m *TokenUsageMutation) ResetGrounded() {
+	m.grounded = nil
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (m *TokenUsageMutation) SetLatencyMs(i int) {
+	m.latency_ms = &i
+	m.addlatency_ms = nil
+}
+
+// LatencyMs returns the value of the "latency_ms" field in the mutation.
+func (m *TokenUsageMutation) LatencyMs() (r int, exists bool) {
+	v := m.latency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLatencyMs returns the old "latency_ms" field's value of the TokenUsage entity.
+// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenUsageMutation) OldLatencyMs(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLatencyMs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLatencyMs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLatencyMs: %w", err)
+	}
+	return oldValue.LatencyMs, nil
+}
+
+// AddLatencyMs adds i to the "latency_ms" field.
+func (m *TokenUsageMutation) AddLatencyMs(i int) {
+	if m.addlatency_ms != nil {
+		*m.addlatency_ms += i
+	} else {
+		m.addlatency_ms = &i
+	}
+}
+
+// AddedLatencyMs returns the value that was added to the "latency_ms" field in this mutation.
+func (m *TokenUsageMutation) AddedLatencyMs() (r int, exists bool) {
+	v := m.addlatency_ms
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetLatencyMs resets all changes to the "latency_ms" field.
+func (m *TokenUsageMutation) ResetLatencyMs() {
+	m.latency_ms = nil
+	m.addlatency_ms = nil
+}
+
+// SetCostUsd sets the "cost_usd" field.
+func (m *TokenUsageMutation) SetCostUsd(f float64) {
+	m.cost_usd = &f
+	m.addcost_usd = nil
+}
+
+// CostUsd returns the value of the "cost_usd" field in the mutation.
+func (m *TokenUsageMutation) CostUsd() (r float64, exists bool) {
+	v := m.cost_usd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCostUsd returns the old "cost_usd" field's value of the TokenUsage entity.
+// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenUsageMutation) OldCostUsd(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCostUsd is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCostUsd requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCostUsd: %w", err)
+	}
+	return oldValue.CostUsd, nil
+}
+
+// AddCostUsd adds f to the "cost_usd" field.
+func (m *TokenUsageMutation) AddCostUsd(f float64) {
+	if m.addcost_usd != nil {
+		*m.addcost_usd += f
+	} else {
+		m.addcost_usd = &f
+	}
+}
+
+// AddedCostUsd returns the value that was added to the "cost_usd" field in this mutation.
+func (m *TokenUsageMutation) AddedCostUsd() (r float64, exists bool) {
+	v := m.addcost_usd
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCostUsd resets all changes to the "cost_usd" field.
+func (m *TokenUsageMutation) ResetCostUsd() {
+	m.cost_usd = nil
+	m.addcost_usd = nil
+}
+
+// SetDay sets the "day" field.
+func (m *TokenUsageMutation) SetDay(s string) {
+	m.day = &s
+}
+
+// Day returns the value of the "day" field in the mutation.
+func (m *TokenUsageMutation) Day() (r string, exists bool) {
+	v := m.day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDay returns the old "day" field's value of the TokenUsage entity.
+// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenUsageMutation) OldDay(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDay: %w", err)
+	}
+	return oldValue.Day, nil
+}
+
+// ResetDay resets all changes to the "day" field.
+func (m *TokenUsageMutation) ResetDay() {
+	m.day = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *TokenUsageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TokenUsageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the TokenUsage entity.
+// If the TokenUsage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TokenUsageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TokenUsageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the TokenUsageMutation builder.
+func (m *TokenUsageMutation) Where(ps ...predicate.TokenUsage) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TokenUsageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TokenUsageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TokenUsage, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TokenUsageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TokenUsageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TokenUsage).
+func (m *TokenUsageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TokenUsageMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.user_id != nil {
+		fields = append(fields, tokenusage.FieldUserID)
+	}
+	if m.session_id != nil {
+		fields = append(fields, tokenusage.FieldSessionID)
+	}
+	if m.provider != nil {
+		fields = append(fields, tokenusage.FieldProvider)
+	}
+	if m.model != nil {
+		fields = append(fields, tokenusage.FieldModel)
+	}
+	if m.operation != nil {
+		fields = append(fields, tokenusage.FieldOperation)
+	}
+	if m.input_tokens != nil {
+		fields = append(fields, tokenusage.FieldInputTokens)
+	}
+	if m.cached_tokens != nil {
+		fields = append(fields, tokenusage.FieldCachedTokens)
+	}
+	if m.output_tokens != nil {
+		fields = append(fields, tokenusage.FieldOutputTokens)
+	}
+	if m.grounded != nil {
+		fields = append(fields, tokenusage.FieldGrounded)
+	}
+	if m.latency_ms != nil {
+		fields = append(fields, tokenusage.FieldLatencyMs)
+	}
+	if m.cost_usd != nil {
+		fields = append(fields, tokenusage.FieldCostUsd)
+	}
+	if m.day != nil {
+		fields = append(fields, tokenusage.FieldDay)
+	}
+	if m.created_at != nil {
+		fields = append(fields, tokenusage.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TokenUsageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case tokenusage.FieldUserID:
+		return m.UserID()
+	case tokenusage.FieldSessionID:
+		return m.SessionID()
+	case tokenusage.FieldProvider:
+		return m.Provider()
+	case tokenusage.FieldModel:
+		return m.Model()
+	case tokenusage.FieldOperation:
+		return m.Operation()
+	case tokenusage.FieldInputTokens:
+		return m.InputTokens()
+	case tokenusage.FieldCachedTokens:
+		return m.CachedTokens()
+	case tokenusage.FieldOutputTokens:
+		return m.OutputTokens()
+	case tokenusage.FieldGrounded:
+		return m.Grounded()
+	case tokenusage.FieldLatencyMs:
+		return m.LatencyMs()
+	case tokenusage.FieldCostUsd:
+		return m.CostUsd()
+	case tokenusage.FieldDay:
+		return m.Day()
+	case tokenusage.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TokenUsageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case tokenusage.FieldUserID:
+		return m.OldUserID(ctx)
+	case tokenusage.FieldSessionID:
+		return m.OldSessionID(ctx)
+	case tokenusage.FieldProvider:
+		return m.OldProvider(ctx)
+	case tokenusage.FieldModel:
+		return m.OldModel(ctx)
+	case tokenusage.FieldOperation:
+		return m.OldOperation(ctx)
+	case tokenusage.FieldInputTokens:
+		return m.OldInputTokens(ctx)
+	case tokenusage.FieldCachedTokens:
+		return m.OldCachedTokens(ctx)
+	case tokenusage.FieldOutputTokens:
+		return m.OldOutputTokens(ctx)
+	case tokenusage.FieldGrounded:
+		return m.OldGrounded(ctx)
+	case tokenusage.FieldLatencyMs:
+		return m.OldLatencyMs(ctx)
+	case tokenusage.FieldCostUsd:
+		return m.OldCostUsd(ctx)
+	case tokenusage.FieldDay:
+		return m.OldDay(ctx)
+	case tokenusage.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TokenUsage field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenUsageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case tokenusage.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case tokenusage.FieldSessionID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSessionID(v)
+		return nil
+	case tokenusage.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case tokenusage.FieldModel:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetModel(v)
+		return nil
+	case tokenusage.FieldOperation:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOperation(v)
+		return nil
+	case tokenusage.FieldInputTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInputTokens(v)
+		return nil
+	case tokenusage.FieldCachedTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCachedTokens(v)
+		return nil
+	case tokenusage.FieldOutputTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOutputTokens(v)
+		return nil
+	case tokenusage.FieldGrounded:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGrounded(v)
+		return nil
+	case tokenusage.FieldLatencyMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLatencyMs(v)
+		return nil
+	case tokenusage.FieldCostUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCostUsd(v)
+		return nil
+	case tokenusage.FieldDay:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDay(v)
+		return nil
+	case tokenusage.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TokenUsage field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TokenUsageMutation) AddedFields() []string {
+	var fields []string
+	if m.addinput_tokens != nil {
+		fields = append(fields, tokenusage.FieldInputTokens)
+	}
+	if m.addcached_tokens != nil {
+		fields = append(fields, tokenusage.FieldCachedTokens)
+	}
+	if m.addoutput_tokens != nil {
+		fields = append(fields, tokenusage.FieldOutputTokens)
+	}
+	if m.addlatency_ms != nil {
+		fields = append(fields, tokenusage.FieldLatencyMs)
+	}
+	if m.addcost_usd != nil {
+		fields = append(fields, tokenusage.FieldCostUsd)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TokenUsageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case tokenusage.FieldInputTokens:
+		return m.AddedInputTokens()
+	case tokenusage.FieldCachedTokens:
+		return m.AddedCachedTokens()
+	case tokenusage.FieldOutputTokens:
+		return m.AddedOutputTokens()
+	case tokenusage.FieldLatencyMs:
+		return m.AddedLatencyMs()
+	case tokenusage.FieldCostUsd:
+		return m.AddedCostUsd()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TokenUsageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case tokenusage.FieldInputTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddInputTokens(v)
+		return nil
+	case tokenusage.FieldCachedTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCachedTokens(v)
+		return nil
+	case tokenusage.FieldOutputTokens:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddOutputTokens(v)
+		return nil
+	case tokenusage.FieldLatencyMs:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLatencyMs(v)
+		return nil
+	case tokenusage.FieldCostUsd:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCostUsd(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TokenUsage numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TokenUsageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(tokenusage.FieldUserID) {
+		fields = append(fields, tokenusage.FieldUserID)
+	}
+	if m.FieldCleared(tokenusage.FieldSessionID) {
+		fields = append(fields, tokenusage.FieldSessionID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TokenUsageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TokenUsageMutation) ClearField(name string) error {
+	switch name {
+	case tokenusage.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case tokenusage.FieldSessionID:
+		m.ClearSessionID()
+		return nil
+	}
+	return fmt.Errorf("unknown TokenUsage nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TokenUsageMutation) ResetField(name string) error {
+	switch name {
+	case tokenusage.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case tokenusage.FieldSessionID:
+		m.ResetSessionID()
+		return nil
+	case tokenusage.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case tokenusage.FieldModel:
+		m.ResetModel()
+		return nil
+	case tokenusage.FieldOperation:
+		m.ResetOperation()
+		return nil
+	case tokenusage.FieldInputTokens:
+		m.ResetInputTokens()
+		return nil
+	case tokenusage.FieldCachedTokens:
+		m.ResetCachedTokens()
+		return nil
+	case tokenusage.FieldOutputTokens:
+		m.ResetOutputTokens()
+		return nil
+	case tokenusage.FieldGrounded:
+		m.ResetGrounded()
+		return nil
+	case tokenusage.FieldLatencyMs:
+		m.ResetLatencyMs()
+		return nil
+	case tokenusage.FieldCostUsd:
+		m.ResetCostUsd()
+		return nil
+	case tokenusage.FieldDay:
+		m.ResetDay()
+		return nil
+	case tokenusage.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown TokenUsage field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TokenUsageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TokenUsageMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TokenUsageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TokenUsageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TokenUsageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TokenUsageMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TokenUsageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TokenUsage unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TokenUsageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TokenUsage edge %s", name)
+}
+
+// UserMutation represents an operation that mutates the User nodes in the graph.
+type UserMutation struct {
+	config
+	op                         Op
+	typ                        string
+	id                         *uuid.UUID
+	email                      *string
+	password_hash              *string
+	google_id                  *string
+	name                       *string
+	avatar_url                 *string
+	provider                   *string
+	plan                       *string
+	created_at                 *time.Time
+	updated_at                 *time.Time
+	last_login                 *time.Time
+	clearedFields              map[string]struct{}
+	sessions                   map[uuid.UUID]struct{}
+	removedsessions            map[uuid.UUID]struct{}
+	clearedsessions            bool
+	search_history             map[uuid.UUID]struct{}
+	removedsearch_history      map[uuid.UUID]struct{}
+	clearedsearch_history      bool
+	preferences                *uuid.UUID
+	clearedpreferences         bool
+	watchlist                  map[uuid.UUID]struct{}
+	removedwatchlist           map[uuid.UUID]struct{}
+	clearedwatchlist           bool
+	wishlists                  map[uuid.UUID]struct{}
+	removedwishlists           map[uuid.UUID]struct{}
+	clearedwishlists           bool
+	conversation_shares        map[uuid.UUID]struct{}
+	removedconversation_shares map[uuid.UUID]struct{}
+	clearedconversation_shares bool
+	done                       bool
+	oldValue                   func(context.Context) (*User, error)
+	predicates                 []predicate.User
+}
+
+var _ ent.Mutation = (*UserMutation)(nil)
+
+// userOption allows management of the mutation configuration using functional options.
+type userOption func(*UserMutation)
+
+// newUserMutation creates new mutation for the User entity.
+func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
+	m := &UserMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUser,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserID sets the ID field of the mutation.
+func withUserID(id uuid.UUID) userOption {
+	return func(m *UserMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *User
+		)
+		m.oldValue = func(ctx context.Context) (*User, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().User.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUser sets the old User of the mutation.
+func withUser(node *User) userOption {
+	return func(m *UserMutation) {
+		m.oldValue = func(context.Context) (*User, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of User entities.
+func (m *UserMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetEmail sets the "email" field.
+func (m *UserMutation) SetEmail(s string) {
+	m.email = &s
+}
+
+// Email returns the value of the "email" field in the mutation.
+func (m *UserMutation) Email() (r string, exists bool) {
+	v := m.email
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEmail returns the old "email" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEmail requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+	}
+	return oldValue.Email, nil
+}
+
+// ResetEmail resets all changes to the "email" field.
+func (m *UserMutation) ResetEmail() {
+	m.email = nil
+}
+
+// SetPasswordHash sets the "password_hash" field.
+func (m *UserMutation) SetPasswordHash(s string) {
+	m.password_hash = &s
+}
+
+// PasswordHash returns the value of the "password_hash" field in the mutation.
+func (m *UserMutation) PasswordHash() (r string, exists bool) {
+	v := m.password_hash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPasswordHash returns the old "password_hash" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+	}
+	return oldValue.PasswordHash, nil
+}
+
+// ClearPasswordHash clears the value of the "password_hash" field.
+func (m *UserMutation) ClearPasswordHash() {
+	m.password_hash = nil
+	m.clearedFields[user.FieldPasswordHash] = struct{}{}
+}
+
+// PasswordHashCleared returns if the "password_hash" field was cleared in this mutation.
+func (m *UserMutation) PasswordHashCleared() bool {
+	_, ok := m.clearedFields[user.FieldPasswordHash]
+	return ok
+}
+
+// ResetPasswordHash resets all changes to the "password_hash" field.
+func (m *UserMutation) ResetPasswordHash() {
+	m.password_hash = nil
+	delete(m.clearedFields, user.FieldPasswordHash)
+}
+
+// SetGoogleID sets the "google_id" field.
+func (m *UserMutation) SetGoogleID(s string) {
+	m.google_id = &s
+}
+
+// GoogleID returns the value of the "google_id" field in the mutation.
+func (m *UserMutation) GoogleID() (r string, exists bool) {
+	v := m.google_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldGoogleID returns the old "google_id" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldGoogleID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldGoogleID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldGoogleID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldGoogleID: %w", err)
+	}
+	return oldValue.GoogleID, nil
+}
+
+// ClearGoogleID clears the value of the "google_id" field.
+func (m *UserMutation) ClearGoogleID() {
+	m.google_id = nil
+	m.clearedFields[user.FieldGoogleID] = struct{}{}
+}
+
+// GoogleIDCleared returns if the "google_id" field was cleared in this mutation.
+func (m *UserMutation) GoogleIDCleared() bool {
+	_, ok := m.clearedFields[user.FieldGoogleID]
+	return ok
+}
+
+// ResetGoogleID resets all changes to the "google_id" field.
+func (m *UserMutation) ResetGoogleID() {
+	m.google_id = nil
+	delete(m.clearedFields, user.FieldGoogleID)
+}
+
+// SetName sets the "name" field.
+func (m *UserMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *UserMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ClearName clears the value of the "name" field.
+func (m *UserMutation) ClearName() {
+	m.name = nil
+	m.clearedFields[user.FieldName] = struct{}{}
+}
+
+// NameCleared returns if the "name" field was cleared in this mutation.
+func (m *UserMutation) NameCleared() bool {
+	_, ok := m.clearedFields[user.FieldName]
+	return ok
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *UserMutation) ResetName() {
+	m.name = nil
+	delete(m.clearedFields, user.FieldName)
+}
+
+// SetAvatarURL sets the "avatar_url" field.
+func (m *UserMutation) SetAvatarURL(s string) {
+	m.avatar_url = &s
+}
+
+// AvatarURL returns the value of the "avatar_url" field in the mutation.
+func (m *UserMutation) AvatarURL() (r string, exists bool) {
+	v := m.avatar_url
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvatarURL returns the old "avatar_url" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldAvatarURL(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvatarURL is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvatarURL requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvatarURL: %w", err)
+	}
+	return oldValue.AvatarURL, nil
+}
+
+// ClearAvatarURL clears the value of the "avatar_url" field.
+func (m *UserMutation) ClearAvatarURL() {
+	m.avatar_url = nil
+	m.clearedFields[user.FieldAvatarURL] = struct{}{}
+}
+
+// AvatarURLCleared returns if the "avatar_url" field was cleared in this mutation.
+func (m *UserMutation) AvatarURLCleared() bool {
+	_, ok := m.clearedFields[user.FieldAvatarURL]
+	return ok
+}
+
+// ResetAvatarURL resets all changes to the "avatar_url" field.
+func (m *UserMutation) ResetAvatarURL() {
+	m.avatar_url = nil
+	delete(m.clearedFields, user.FieldAvatarURL)
+}
+
+// SetProvider sets the "provider" field.
+func (m *UserMutation) SetProvider(s string) {
+	m.provider = &s
+}
+
+// Provider returns the value of the "provider" field in the mutation.
+func (m *UserMutation) Provider() (r string, exists bool) {
+	v := m.provider
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProvider returns the old "provider" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldProvider(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProvider requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+	}
+	return oldValue.Provider, nil
+}
+
+// ResetProvider resets all changes to the "provider" field.
+func (m *UserMutation) ResetProvider() {
+	m.provider = nil
+}
+
+// SetPlan sets the "plan" field.
+func (m *UserMutation) SetPlan(s string) {
+	m.plan = &s
+}
+
+// Plan returns the value of the "plan" field in the mutation.
+func (m *UserMutation) Plan() (r string, exists bool) {
+	v := m.plan
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPlan returns the old "plan" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldPlan(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPlan is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPlan requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPlan: %w", err)
+	}
+	return oldValue.Plan, nil
+}
+
+// ResetPlan resets all changes to the "plan" field.
+func (m *UserMutation) ResetPlan() {
+	m.plan = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// SetLastLogin sets the "last_login" field.
+func (m *UserMutation) SetLastLogin(t time.Time) {
+	m.last_login = &t
+}
+
+// LastLogin returns the value of the "last_login" field in the mutation.
+func (m *UserMutation) LastLogin() (r time.Time, exists bool) {
+	v := m.last_login
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastLogin returns the old "last_login" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldLastLogin(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastLogin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastLogin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastLogin: %w", err)
+	}
+	return oldValue.LastLogin, nil
+}
+
+// ClearLastLogin clears the value of the "last_login" field.
+func (m *UserMutation) ClearLastLogin() {
+	m.last_login = nil
+	m.clearedFields[user.FieldLastLogin] = struct{}{}
+}
+
+// LastLoginCleared returns if the "last_login" field was cleared in this mutation.
+func (m *UserMutation) LastLoginCleared() bool {
+	_, ok := m.clearedFields[user.FieldLastLogin]
+	return ok
+}
+
+// ResetLastLogin resets all changes to the "last_login" field.
+func (m *UserMutation) ResetLastLogin() {
+	m.last_login = nil
+	delete(m.clearedFields, user.FieldLastLogin)
+}
+
+// AddSessionIDs adds the "sessions" edge to the ChatSession entity by ids.
+func (m *UserMutation) AddSessionIDs(ids ...uuid.UUID) {
+	if m.sessions == nil {
+		m.sessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.sessions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSessions clears the "sessions" edge to the ChatSession entity.
+func (m *UserMutation) ClearSessions() {
+	m.clearedsessions = true
+}
+
+// SessionsCleared reports if the "sessions" edge to the ChatSession entity was cleared.
+func (m *UserMutation) SessionsCleared() bool {
+	return m.clearedsessions
+}
+
+// RemoveSessionIDs removes the "sessions" edge to the ChatSession entity by IDs.
+func (m *UserMutation) RemoveSessionIDs(ids ...uuid.UUID) {
+	if m.removedsessions == nil {
+		m.removedsessions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.sessions, ids[i])
+		m.removedsessions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSessions returns the removed IDs of the "sessions" edge to the ChatSession entity.
+func (m *UserMutation) RemovedSessionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedsessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SessionsIDs returns the "sessions" edge IDs in the mutation.
+func (m *UserMutation) SessionsIDs() (ids []uuid.UUID) {
+	for id := range m.sessions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSessions resets all changes to the "sessions" edge.
+func (m *UserMutation) ResetSessions() {
+	m.sessions = nil
+	m.clearedsessions = false
+	m.removedsessions = nil
+}
+
+// AddSearchHistoryIDs adds the "search_history" edge to the SearchHistory entity by ids.
+func (m *UserMutation) AddSearchHistoryIDs(ids ...uuid.UUID) {
+	if m.search_history == nil {
+		m.search_history = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.search_history[ids[i]] = struct{}{}
+	}
+}
+
+// ClearSearchHistory clears the "search_history" edge to the SearchHistory entity.
+func (m *UserMutation) ClearSearchHistory() {
+	m.clearedsearch_history = true
+}
+
+// SearchHistoryCleared reports if the "search_history" edge to the SearchHistory entity was cleared.
+func (m *UserMutation) SearchHistoryCleared() bool {
+	return m.clearedsearch_history
+}
+
+// RemoveSearchHistoryIDs removes the "search_history" edge to the SearchHistory entity by IDs.
+func (m *UserMutation) RemoveSearchHistoryIDs(ids ...uuid.UUID) {
+	if m.removedsearch_history == nil {
+		m.removedsearch_history = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.search_history, ids[i])
+		m.removedsearch_history[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedSearchHistory returns the removed IDs of the "search_history" edge to the SearchHistory entity.
+func (m *UserMutation) RemovedSearchHistoryIDs() (ids []uuid.UUID) {
+	for id := range m.removedsearch_history {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// SearchHistoryIDs returns the "search_history" edge IDs in the mutation.
+func (m *UserMutation) SearchHistoryIDs() (ids []uuid.UUID) {
+	for id := range m.search_history {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetSearchHistory resets all changes to the "search_history" edge.
+func (m *UserMutation) ResetSearchHistory() {
+	m.search_history = nil
+	m.clearedsearch_history = false
+	m.removedsearch_history = nil
+}
+
+// SetPreferencesID sets the "preferences" edge to the UserPreference entity by id.
+func (m *UserMutation) SetPreferencesID(id uuid.UUID) {
+	m.preferences = &id
+}
+
+// ClearPreferences clears the "preferences" edge to the UserPreference entity.
+func (m *UserMutation) ClearPreferences() {
+	m.clearedpreferences = true
+}
+
+// PreferencesCleared reports if the "preferences" edge to the UserPreference entity was cleared.
+func (m *UserMutation) PreferencesCleared() bool {
+	return m.clearedpreferences
+}
+
+// PreferencesID returns the "preferences" edge ID in the mutation.
+func (m *UserMutation) PreferencesID() (id uuid.UUID, exists bool) {
+	if m.preferences != nil {
+		return *m.preferences, true
+	}
+	return
+}
+
+// PreferencesIDs returns the "preferences" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PreferencesID instead. It exists only for internal usage by the builders.
+func (m *UserMutation) PreferencesIDs() (ids []uuid.UUID) {
+	if id := m.preferences; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPreferences resets all changes to the "preferences" edge.
+func (m *UserMutation) ResetPreferences() {
+	m.preferences = nil
+	m.clearedpreferences = false
+}
+
+// AddWatchlistIDs adds the "watchlist" edge to the WatchlistItem entity by ids.
+func (m *UserMutation) AddWatchlistIDs(ids ...uuid.UUID) {
+	if m.watchlist == nil {
+		m.watchlist = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.watchlist[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWatchlist clears the "watchlist" edge to the WatchlistItem entity.
+func (m *UserMutation) ClearWatchlist() {
+	m.clearedwatchlist = true
+}
+
+// WatchlistCleared reports if the "watchlist" edge to the WatchlistItem entity was cleared.
+func (m *UserMutation) WatchlistCleared() bool {
+	return m.clearedwatchlist
+}
+
+// RemoveWatchlistIDs removes the "watchlist" edge to the WatchlistItem entity by IDs.
+func (m *UserMutation) RemoveWatchlistIDs(ids ...uuid.UUID) {
+	if m.removedwatchlist == nil {
+		m.removedwatchlist = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.watchlist, ids[i])
+		m.removedwatchlist[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWatchlist returns the removed IDs of the "watchlist" edge to the WatchlistItem entity.
+func (m *UserMutation) RemovedWatchlistIDs() (ids []uuid.UUID) {
+	for id := range m.removedwatchlist {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WatchlistIDs returns the "watchlist" edge IDs in the mutation.
+func (m *UserMutation) WatchlistIDs() (ids []uuid.UUID) {
+	for id := range m.watchlist {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWatchlist resets all changes to the "watchlist" edge.
+func (m *UserMutation) ResetWatchlist() {
+	m.watchlist = nil
+	m.clearedwatchlist = false
+	m.removedwatchlist = nil
+}
+
+// AddWishlistIDs adds the "wishlists" edge to the Wishlist entity by ids.
+func (m *UserMutation) AddWishlistIDs(ids ...uuid.UUID) {
+	if m.wishlists == nil {
+		m.wishlists = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.wishlists[ids[i]] = struct{}{}
+	}
+}
+
+// ClearWishlists clears the "wishlists" edge to the Wishlist entity.
+func (m *UserMutation) ClearWishlists() {
+	m.clearedwishlists = true
+}
+
+// WishlistsCleared reports if the "wishlists" edge to the Wishlist entity was cleared.
+func (m *UserMutation) WishlistsCleared() bool {
+	return m.clearedwishlists
+}
+
+// RemoveWishlistIDs removes the "wishlists" edge to the Wishlist entity by IDs.
+func (m *UserMutation) RemoveWishlistIDs(ids ...uuid.UUID) {
+	if m.removedwishlists == nil {
+		m.removedwishlists = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.wishlists, ids[i])
+		m.removedwishlists[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedWishlists returns the removed IDs of the "wishlists" edge to the Wishlist entity.
+func (m *UserMutation) RemovedWishlistsIDs() (ids []uuid.UUID) {
+	for id := range m.removedwishlists {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// WishlistsIDs returns the "wishlists" edge IDs in the mutation.
+func (m *UserMutation) WishlistsIDs() (ids []uuid.UUID) {
+	for id := range m.wishlists {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetWishlists resets all changes to the "wishlists" edge.
+func (m *UserMutation) ResetWishlists() {
+	m.wishlists = nil
+	m.clearedwishlists = false
+	m.removedwishlists = nil
+}
+
+// AddConversationShareIDs adds the "conversation_shares" edge to the ConversationShare entity by ids.
+func (m *UserMutation) AddConversationShareIDs(ids ...uuid.UUID) {
+	if m.conversation_shares == nil {
+		m.conversation_shares = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.conversation_shares[ids[i]] = struct{}{}
+	}
+}
+
+// ClearConversationShares clears the "conversation_shares" edge to the ConversationShare entity.
+func (m *UserMutation) ClearConversationShares() {
+	m.clearedconversation_shares = true
+}
+
+// ConversationSharesCleared reports if the "conversation_shares" edge to the ConversationShare entity was cleared.
+func (m *UserMutation) ConversationSharesCleared() bool {
+	return m.clearedconversation_shares
+}
+
+// RemoveConversationShareIDs removes the "conversation_shares" edge to the ConversationShare entity by IDs.
+func (m *UserMutation) RemoveConversationShareIDs(ids ...uuid.UUID) {
+	if m.removedconversation_shares == nil {
+		m.removedconversation_shares = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.conversation_shares, ids[i])
+		m.removedconversation_shares[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedConversationShares returns the removed IDs of the "conversation_shares" edge to the ConversationShare entity.
+func (m *UserMutation) RemovedConversationSharesIDs() (ids []uuid.UUID) {
+	for id := range m.removedconversation_shares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ConversationSharesIDs returns the "conversation_shares" edge IDs in the mutation.
+func (m *UserMutation) ConversationSharesIDs() (ids []uuid.UUID) {
+	for id := range m.conversation_shares {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetConversationShares resets all changes to the "conversation_shares" edge.
+func (m *UserMutation) ResetConversationShares() {
+	m.conversation_shares = nil
+	m.clearedconversation_shares = false
+	m.removedconversation_shares = nil
+}
+
+// Where appends a list predicates to the UserMutation builder.
+func (m *UserMutation) Where(ps ...predicate.User) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.User, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (User).
+func (m *UserMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.email != nil {
+		fields = append(fields, user.FieldEmail)
+	}
+	if m.password_hash != nil {
+		fields = append(fields, user.FieldPasswordHash)
+	}
+	if m.google_id != nil {
+		fields = append(fields, user.FieldGoogleID)
+	}
+	if m.name != nil {
+		fields = append(fields, user.FieldName)
+	}
+	if m.avatar_url != nil {
+		fields = append(fields, user.FieldAvatarURL)
+	}
+	if m.provider != nil {
+		fields = append(fields, user.FieldProvider)
+	}
+	if m.plan != nil {
+		fields = append(fields, user.FieldPlan)
+	}
+	if m.created_at != nil {
+		fields = append(fields, user.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, user.FieldUpdatedAt)
+	}
+	if m.last_login != nil {
+		fields = append(fields, user.FieldLastLogin)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *UserMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case user.FieldEmail:
+		return m.Email()
+	case user.FieldPasswordHash:
+		return m.PasswordHash()
+	case user.FieldGoogleID:
+		return m.GoogleID()
+	case user.FieldName:
+		return m.Name()
+	case user.FieldAvatarURL:
+		return m.AvatarURL()
+	case user.FieldProvider:
+		return m.Provider()
+	case user.FieldPlan:
+		return m.Plan()
+	case user.FieldCreatedAt:
+		return m.CreatedAt()
+	case user.FieldUpdatedAt:
+		return m.UpdatedAt()
+	case user.FieldLastLogin:
+		return m.LastLogin()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case user.FieldEmail:
+		return m.OldEmail(ctx)
+	case user.FieldPasswordHash:
+		return m.OldPasswordHash(ctx)
+	case user.FieldGoogleID:
+		return m.OldGoogleID(ctx)
+	case user.FieldName:
+		return m.OldName(ctx)
+	case user.FieldAvatarURL:
+		return m.OldAvatarURL(ctx)
+	case user.FieldProvider:
+		return m.OldProvider(ctx)
+	case user.FieldPlan:
+		return m.OldPlan(ctx)
+	case user.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case user.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	case user.FieldLastLogin:
+		return m.OldLastLogin(ctx)
+	}
+	return nil, fmt.Errorf("unknown User field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case user.FieldEmail:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEmail(v)
+		return nil
+	case user.FieldPasswordHash:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPasswordHash(v)
+		return nil
+	case user.FieldGoogleID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetGoogleID(v)
+		return nil
+	case user.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case user.FieldAvatarURL:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvatarURL(v)
+		return nil
+	case user.FieldProvider:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProvider(v)
+		return nil
+	case user.FieldPlan:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPlan(v)
+		return nil
+	case user.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case user.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	case user.FieldLastLogin:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastLogin(v)
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *UserMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *UserMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown User numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *UserMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(user.FieldPasswordHash) {
+		fields = append(fields, user.FieldPasswordHash)
+	}
+	if m.FieldCleared(user.FieldGoogleID) {
+		fields = append(fields, user.FieldGoogleID)
+	}
+	if m.FieldCleared(user.FieldName) {
+		fields = append(fields, user.FieldName)
+	}
+	if m.FieldCleared(user.FieldAvatarURL) {
+		fields = append(fields, user.FieldAvatarURL)
+	}
+	if m.FieldCleared(user.FieldLastLogin) {
+		fields = append(fields, user.FieldLastLogin)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *UserMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *UserMutation) ClearField(name string) error {
+	switch name {
+	case user.FieldPasswordHash:
+		m.ClearPasswordHash()
+		return nil
+	case user.FieldGoogleID:
+		m.ClearGoogleID()
+		return nil
+	case user.FieldName:
+		m.ClearName()
+		return nil
+	case user.FieldAvatarURL:
+		m.ClearAvatarURL()
+		return nil
+	case user.FieldLastLogin:
+		m.ClearLastLogin()
+		return nil
+	}
+	return fmt.Errorf("unknown User nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *UserMutation) ResetField(name string) error {
+	switch name {
+	case user.FieldEmail:
+		m.ResetEmail()
+		return nil
+	case user.FieldPasswordHash:
+		m.ResetPasswordHash()
+		return nil
+	case user.FieldGoogleID:
+		m.ResetGoogleID()
+		return nil
+	case user.FieldName:
+		m.ResetName()
+		return nil
+	case user.FieldAvatarURL:
+		m.ResetAvatarURL()
+		return nil
+	case user.FieldProvider:
+		m.ResetProvider()
+		return nil
+	case user.FieldPlan:
+		m.ResetPlan()
+		return nil
+	case user.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case user.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	case user.FieldLastLogin:
+		m.ResetLastLogin()
+		return nil
+	}
+	return fmt.Errorf("unknown User field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *UserMutation) AddedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.sessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.search_history != nil {
+		edges = append(edges, user.EdgeSearchHistory)
+	}
+	if m.preferences != nil {
+		edges = append(edges, user.EdgePreferences)
+	}
+	if m.watchlist != nil {
+		edges = append(edges, user.EdgeWatchlist)
+	}
+	if m.wishlists != nil {
+		edges = append(edges, user.EdgeWishlists)
+	}
+	if m.conversation_shares != nil {
+		edges = append(edges, user.EdgeConversationShares)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *UserMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.sessions))
+		for id := range m.sessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSearchHistory:
+		ids := make([]ent.Value, 0, len(m.search_history))
+		for id := range m.search_history {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgePreferences:
+		if id := m.preferences; id != nil {
+			return []ent.Value{*id}
+		}
+	case user.EdgeWatchlist:
+		ids := make([]ent.Value, 0, len(m.watchlist))
+		for id := range m.watchlist {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWishlists:
+		ids := make([]ent.Value, 0, len(m.wishlists))
+		for id := range m.wishlists {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeConversationShares:
+		ids := make([]ent.Value, 0, len(m.conversation_shares))
+		for id := range m.conversation_shares {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *UserMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.removedsessions != nil {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.removedsearch_history != nil {
+		edges = append(edges, user.EdgeSearchHistory)
+	}
+	if m.removedwatchlist != nil {
+		edges = append(edges, user.EdgeWatchlist)
+	}
+	if m.removedwishlists != nil {
+		edges = append(edges, user.EdgeWishlists)
+	}
+	if m.removedconversation_shares != nil {
+		edges = append(edges, user.EdgeConversationShares)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *UserMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case user.EdgeSessions:
+		ids := make([]ent.Value, 0, len(m.removedsessions))
+		for id := range m.removedsessions {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeSearchHistory:
+		ids := make([]ent.Value, 0, len(m.removedsearch_history))
+		for id := range m.removedsearch_history {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWatchlist:
+		ids := make([]ent.Value, 0, len(m.removedwatchlist))
+		for id := range m.removedwatchlist {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeWishlists:
+		ids := make([]ent.Value, 0, len(m.removedwishlists))
+		for id := range m.removedwishlists {
+			ids = append(ids, id)
+		}
+		return ids
+	case user.EdgeConversationShares:
+		ids := make([]ent.Value, 0, len(m.removedconversation_shares))
+		for id := range m.removedconversation_shares {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *UserMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 6)
+	if m.clearedsessions {
+		edges = append(edges, user.EdgeSessions)
+	}
+	if m.clearedsearch_history {
+		edges = append(edges, user.EdgeSearchHistory)
+	}
+	if m.clearedpreferences {
+		edges = append(edges, user.EdgePreferences)
+	}
+	if m.clearedwatchlist {
+		edges = append(edges, user.EdgeWatchlist)
+	}
+	if m.clearedwishlists {
+		edges = append(edges, user.EdgeWishlists)
+	}
+	if m.clearedconversation_shares {
+		edges = append(edges, user.EdgeConversationShares)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *UserMutation) EdgeCleared(name string) bool {
+	switch name {
+	case user.EdgeSessions:
+		return m.clearedsessions
+	case user.EdgeSearchHistory:
+		return m.clearedsearch_history
+	case user.EdgePreferences:
+		return m.clearedpreferences
+	case user.EdgeWatchlist:
+		return m.clearedwatchlist
+	case user.EdgeWishlists:
+		return m.clearedwishlists
+	case user.EdgeConversationShares:
+		return m.clearedconversation_shares
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *UserMutation) ClearEdge(name string) error {
+	switch name {
+	case user.EdgePreferences:
+		m.ClearPreferences()
+		return nil
+	}
+	return fmt.Errorf("unknown User unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *UserMutation) ResetEdge(name string) error {
+	switch name {
+	case user.EdgeSessions:
+		m.ResetSessions()
+		return nil
+	case user.EdgeSearchHistory:
+		m.ResetSearchHistory()
+		return nil
+	case user.EdgePreferences:
+		m.ResetPreferences()
+		return nil
+	case user.EdgeWatchlist:
+		m.ResetWatchlist()
+		return nil
+	case user.EdgeWishlists:
+		m.ResetWishlists()
+		return nil
+	case user.EdgeConversationShares:
+		m.ResetConversationShares()
+		return nil
+	}
+	return fmt.Errorf("unknown User edge %s", name)
+}
+
+// UserPreferenceMutation represents an operation that mutates the UserPreference nodes in the graph.
+type UserPreferenceMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *uuid.UUID
+	country                *string
+	currency               *string
+	language               *string
+	theme                  *string
+	sidebar_open           *bool
+	last_active_session_id *string
+	saved_search           *map[string]interface{}
+	created_at             *time.Time
+	updated_at             *time.Time
+	clearedFields          map[string]struct{}
+	user                   *uuid.UUID
+	cleareduser            bool
+	done                   bool
+	oldValue               func(context.Context) (*UserPreference, error)
+	predicates             []predicate.UserPreference
+}
+
+var _ ent.Mutation = (*UserPreferenceMutation)(nil)
+
+// userpreferenceOption allows management of the mutation configuration using functional options.
+type userpreferenceOption func(*UserPreferenceMutation)
+
+// newUserPreferenceMutation creates new mutation for the UserPreference entity.
+func newUserPreferenceMutation(c config, op Op, opts ...userpreferenceOption) *UserPreferenceMutation {
+	m := &UserPreferenceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeUserPreference,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withUserPreferenceID sets the ID field of the mutation.
+func withUserPreferenceID(id uuid.UUID) userpreferenceOption {
+	return func(m *UserPreferenceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *UserPreference
+		)
+		m.oldValue = func(ctx context.Context) (*UserPreference, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().UserPreference.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withUserPreference sets the old UserPreference of the mutation.
+func withUserPreference(node *UserPreference) userpreferenceOption {
+	return func(m *UserPreferenceMutation) {
+		m.oldValue = func(context.Context) (*UserPreference, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m UserPreferenceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m UserPreferenceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of UserPreference entities.
+func (m *UserPreferenceMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *UserPreferenceMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *UserPreferenceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().UserPreference.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *UserPreferenceMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *UserPreferenceMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the UserPreference entity.
+// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPreferenceMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *UserPreferenceMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetCountry sets the "country" field.
+func (m *UserPreferenceMutation) SetCountry(s string) {
+	m.country = &s
+}
+
+// Country returns the value of the "country" field in the mutation.
+func (m *UserPreferenceMutation) Country() (r string, exists bool) {
+	v := m.country
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCountry returns the old "country" field's value of the UserPreference entity.
+// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPreferenceMutation) OldCountry(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCountry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCountry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCountry: %w", err)
+	}
+	return oldValue.Country, nil
+}
+
+// ClearCountry clears the value of the "country" field.
+func (m *UserPreferenceMutation) ClearCountry() {
+	m.country = nil
+	m.clearedFields[userpreference.FieldCountry] = struct{}{}
+}
+
+// CountryCleared returns if the "country" field was cleared in this mutation.
+func (m *UserPreferenceMutation) CountryCleared() bool {
+	_, ok := m.clearedFields[userpreference.FieldCountry]
+	return ok
+}
+
+// ResetCountry resets all changes to the "country" field.
+func (m *UserPreferenceMutation) ResetCountry() {
+	m.country = nil
+	delete(m.clearedFields, userpreference.FieldCountry)
+}
+
+// SetCurrency sets the "currency" field.
+func (m *UserPreferenceMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *UserPreferenceMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrency returns the old "currency" field's value of the UserPreference entity.
+// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPreferenceMutation) OldCurrency(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
+}
+
+// ClearCurrency clears the value of the "currency" field.
+func (m *UserPreferenceMutation) ClearCurrency() {
+	m.currency = nil
+	m.clearedFields[userpreference.FieldCurrency] = struct{}{}
+}
+
+// CurrencyCleared returns if the "currency" field was cleared in this mutation.
+func (m *UserPreferenceMutation) CurrencyCleared() bool {
+	_, ok := m.clearedFields[userpreference.FieldCurrency]
+	return ok
+}
+
+// ResetCurrency resets all changes to the "currency" field.
+func (m *UserPreferenceMutation) ResetCurrency() {
+	m.currency = nil
+	delete(m.clearedFields, userpreference.FieldCurrency)
+}
+
+// SetLanguage sets the "language" field.
+func (m *UserPreferenceMutation) SetLanguage(s string) {
+	m.language = &s
+}
+
+// Language returns the value of the "language" field in the mutation.
+func (m *UserPreferenceMutation) Language() (r string, exists bool) {
+	v := m.language
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLanguage returns the old "language" field's value of the UserPreference entity.
+// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPreferenceMutation) OldLanguage(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLanguage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+	}
+	return oldValue.Language, nil
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (m *UserPreferenceMutation) ClearLanguage() {
+	m.language = nil
+	m.clearedFields[userpreference.FieldLanguage] = struct{}{}
+}
+
+// LanguageCleared returns if the "language" field was cleared in this mutation.
+func (m *UserPreferenceMutation) LanguageCleared() bool {
+	_, ok := m.clearedFields[userpreference.FieldLanguage]
+	return ok
+}
+
+// ResetLanguage resets all changes to the "language" field.
+func (m *UserPreferenceMutation) ResetLanguage() {
+	m.language = nil
+	delete(m.clearedFields, userpreference.FieldLanguage)
+}
+
+// SetTheme sets the "theme" field.
+func (m *UserPreferenceMutation) SetTheme(s string) {
+	m.theme = &s
+}
+
+// Theme returns the value of the "theme" field in the mutation.
+func (m *UserPreferenceMutation) Theme() (r string, exists bool) {
+	v := m.theme
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTheme returns the old "theme" field's value of the UserPreference entity.
+// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPreferenceMutation) OldTheme(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTheme is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTheme requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTheme: %w", err)
+	}
+	return oldValue.Theme, nil
+}
+
+// ClearTheme clears the value of the "theme" field.
+func (m *UserPreferenceMutation) ClearTheme() {
+	m.theme = nil
+	m.clearedFields[userpreference.FieldTheme] = struct{}{}
+}
+
+// ThemeCleared returns if the "theme" field was cleared in this mutation.
+func (m *UserPreferenceMutation) ThemeCleared() bool {
+	_, ok := m.clearedFields[userpreference.FieldTheme]
+	return ok
+}
+
+// ResetTheme resets all changes to the "theme" field.
+func (m *UserPreferenceMutation) ResetTheme() {
+	m.theme = nil
+	delete(m.clearedFields, userpreference.FieldTheme)
+}
+
+// SetSidebarOpen sets the "sidebar_open" field.
+func (m *UserPreferenceMutation) SetSidebarOpen(b bool) {
+	m.sidebar_open = &b
+}
+
+// SidebarOpen returns the value of the "sidebar_open" field in the mutation.
+func (m *UserPreferenceMutation) SidebarOpen() (r bool, exists bool) {
+	v := m.sidebar_open
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSidebarOpen returns the old "sidebar_open" field's value of the UserPreference entity.
+// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPreferenceMutation) OldSidebarOpen(ctx context.Context) (v *bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSidebarOpen is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSidebarOpen requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSidebarOpen: %w", err)
+	}
+	return oldValue.SidebarOpen, nil
+}
+
+// ClearSidebarOpen clears the value of the "sidebar_open" field.
+func (m *UserPreferenceMutation) ClearSidebarOpen() {
+	m.sidebar_open = nil
+	m.clearedFields[userpreference.FieldSidebarOpen] = struct{}{}
+}
+
+// SidebarOpenCleared returns if the "sidebar_open" field was cleared in this mutation.
+func (m *UserPreferenceMutation) SidebarOpenCleared() bool {
+	_, ok := m.clearedFields[userpreference.FieldSidebarOpen]
+	return ok
+}
+
+// ResetSidebarOpen resets all changes to the "sidebar_open" field.
+func (m *UserPreferenceMutation) ResetSidebarOpen() {
+	m.sidebar_open = nil
+	delete(m.clearedFields, userpreference.FieldSidebarOpen)
+}
+
+// SetLastActiveSessionID sets the "last_active_session_id" field.
+func (m *UserPreferenceMutation) SetLastActiveSessionID(s string) {
+	m.last_active_session_id = &s
+}
+
+// LastActiveSessionID returns the value of the "last_active_session_id" field in the mutation.
+func (m *UserPreferenceMutation) LastActiveSessionID() (r string, exists bool) {
+	v := m.last_active_session_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastActiveSessionID returns the old "last_active_session_id" field's value of the UserPreference entity.
+// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPreferenceMutation) OldLastActiveSessionID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastActiveSessionID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastActiveSessionID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastActiveSessionID: %w", err)
+	}
+	return oldValue.LastActiveSessionID, nil
+}
+
+// ClearLastActiveSessionID clears the value of the "last_active_session_id" field.
+func (m *UserPreferenceMutation) ClearLastActiveSessionID() {
+	m.last_active_session_id = nil
+	m.clearedFields[userpreference.FieldLastActiveSessionID] = struct{}{}
+}
+
+// LastActiveSessionIDCleared returns if the "last_active_session_id" field was cleared in this mutation.
+func (m *UserPreferenceMutation) LastActiveSessionIDCleared() bool {
+	_, ok := m.clearedFields[userpreference.FieldLastActiveSessionID]
+	return ok
+}
+
+// ResetLastActiveSessionID resets all changes to the "last_active_session_id" field.
+func (m *UserPreferenceMutation) ResetLastActiveSessionID() {
+	m.last_active_session_id = nil
+	delete(m.clearedFields, userpreference.FieldLastActiveSessionID)
+}
+
+// SetSavedSearch sets the "saved_search" field.
+func (m *UserPreferenceMutation) SetSavedSearch(value map[string]interface{}) {
+	m.saved_search = &value
+}
+
+// SavedSearch returns the value of the "saved_search" field in the mutation.
+func (m *UserPreferenceMutation) SavedSearch() (r map[string]interface{}, exists bool) {
+	v := m.saved_search
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSavedSearch returns the old "saved_search" field's value of the UserPreference entity.
+// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPreferenceMutation) OldSavedSearch(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSavedSearch is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSavedSearch requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSavedSearch: %w", err)
+	}
+	return oldValue.SavedSearch, nil
+}
+
+// ClearSavedSearch clears the value of the "saved_search" field.
+func (m *UserPreferenceMutation) ClearSavedSearch() {
+	m.saved_search = nil
+	m.clearedFields[userpreference.FieldSavedSearch] = struct{}{}
+}
+
+// SavedSearchCleared returns if the "saved_search" field was cleared in this mutation.
+func (m *UserPreferenceMutation) SavedSearchCleared() bool {
+	_, ok := m.clearedFields[userpreference.FieldSavedSearch]
+	return ok
+}
+
+// ResetSavedSearch resets all changes to the "saved_search" field.
+func (m *UserPreferenceMutation) ResetSavedSearch() {
+	m.saved_search = nil
+	delete(m.clearedFields, userpreference.FieldSavedSearch)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *UserPreferenceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *UserPreferenceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the UserPreference entity.
+// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPreferenceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *UserPreferenceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *UserPreferenceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *UserPreferenceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the UserPreference entity.
+// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserPreferenceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *UserPreferenceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *UserPreferenceMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[userpreference.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *UserPreferenceMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *UserPreferenceMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *UserPreferenceMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the UserPreferenceMutation builder.
+func (m *UserPreferenceMutation) Where(ps ...predicate.UserPreference) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the UserPreferenceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *UserPreferenceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.UserPreference, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *UserPreferenceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *UserPreferenceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (UserPreference).
+func (m *UserPreferenceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *UserPreferenceMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.user != nil {
+		fields = append(fields, userpreference.FieldUserID)
+	}
+	if m.country != nil {
+		fields = append(fields, userpreference.FieldCountry)
+	}
+	if m.currency != nil {
+		fields = append(fields, userpreference.FieldCurrency)
+	}
+	if m.language != nil {
+		fields = append(fields, userpreference.FieldLanguage)
+	}
+	if m.theme != nil {
+		fields = append(fields, userpreference.FieldTheme)
+	}
+	if m.sidebar_open != nil {
+		fields = append(fields, userpreference.FieldSidebarOpen)
+	}
+	if m.last_active_session_id != nil {
+		fields = append(fields, userpreference.FieldLastActiveSessionID)
+	}
+	if m.saved_search != nil {
+		fields = append(fields, userpreference.FieldSavedSearch)
+	}
+	if m.created_at != nil {
+		fields = append(fields, userpreference.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, userpreference.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *SearchHistoryMutation) Field(name string) (ent.Value, bool) {
+func (m *UserPreferenceMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case searchhistory.FieldUserID:
+	case userpreference.FieldUserID:
 		return m.UserID()
-	case searchhistory.FieldSessionID:
-		return m.SessionID()
-	case searchhistory.FieldSearchQuery:
-		return m.SearchQuery()
-	case searchhistory.FieldOptimizedQuery:
-		return m.OptimizedQuery()
-	case searchhistory.FieldSearchType:
-		return m.SearchType()
-	case searchhistory.FieldCategory:
-		return m.Category()
-	case searchhistory.FieldCountryCode:
-		return m.CountryCode()
-	case searchhistory.FieldLanguageCode:
-		return m.LanguageCode()
-	case searchhistory.FieldCurrency:
+	case userpreference.FieldCountry:
+		return m.Country()
+	case userpreference.FieldCurrency:
 		return m.Currency()
-	case searchhistory.FieldResultCount:
-		return m.ResultCount()
-	case searchhistory.FieldProductsFound:
-		return m.ProductsFound()
-	case searchhistory.FieldClickedProductID:
-		return m.ClickedProductID()
-	case searchhistory.FieldCreatedAt:
+	case userpreference.FieldLanguage:
+		return m.Language()
+	case userpreference.FieldTheme:
+		return m.Theme()
+	case userpreference.FieldSidebarOpen:
+		return m.SidebarOpen()
+	case userpreference.FieldLastActiveSessionID:
+		return m.LastActiveSessionID()
+	case userpreference.FieldSavedSearch:
+		return m.SavedSearch()
+	case userpreference.FieldCreatedAt:
 		return m.CreatedAt()
-	case searchhistory.FieldExpiresAt:
-		return m.ExpiresAt()
+	case userpreference.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -2966,312 +9193,249 @@ func (m *SearchHistoryMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *SearchHistoryMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *UserPreferenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case searchhistory.FieldUserID:
+	case userpreference.FieldUserID:
 		return m.OldUserID(ctx)
-	case searchhistory.FieldSessionID:
-		return m.OldSessionID(ctx)
-	case searchhistory.FieldSearchQuery:
-		return m.OldSearchQuery(ctx)
-	case searchhistory.FieldOptimizedQuery:
-		return m.OldOptimizedQuery(ctx)
-	case searchhistory.FieldSearchType:
-		return m.OldSearchType(ctx)
-	case searchhistory.FieldCategory:
-		return m.OldCategory(ctx)
-	case searchhistory.FieldCountryCode:
-		return m.OldCountryCode(ctx)
-	case searchhistory.FieldLanguageCode:
-		return m.OldLanguageCode(ctx)
-	case searchhistory.FieldCurrency:
+	case userpreference.FieldCountry:
+		return m.OldCountry(ctx)
+	case userpreference.FieldCurrency:
 		return m.OldCurrency(ctx)
-	case searchhistory.FieldResultCount:
-		return m.OldResultCount(ctx)
-	case searchhistory.FieldProductsFound:
-		return m.OldProductsFound(ctx)
-	case searchhistory.FieldClickedProductID:
-		return m.OldClickedProductID(ctx)
-	case searchhistory.FieldCreatedAt:
+	case userpreference.FieldLanguage:
+		return m.OldLanguage(ctx)
+	case userpreference.FieldTheme:
+		return m.OldTheme(ctx)
+	case userpreference.FieldSidebarOpen:
+		return m.OldSidebarOpen(ctx)
+	case userpreference.FieldLastActiveSessionID:
+		return m.OldLastActiveSessionID(ctx)
+	case userpreference.FieldSavedSearch:
+		return m.OldSavedSearch(ctx)
+	case userpreference.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case searchhistory.FieldExpiresAt:
-		return m.OldExpiresAt(ctx)
+	case userpreference.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown SearchHistory field %s", name)
+	return nil, fmt.Errorf("unknown UserPreference field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SearchHistoryMutation) SetField(name string, value ent.Value) error {
+func (m *UserPreferenceMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case searchhistory.FieldUserID:
+	case userpreference.FieldUserID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetUserID(v)
 		return nil
-	case searchhistory.FieldSessionID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSessionID(v)
-		return nil
-	case searchhistory.FieldSearchQuery:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSearchQuery(v)
-		return nil
-	case searchhistory.FieldOptimizedQuery:
+	case userpreference.FieldCountry:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetOptimizedQuery(v)
+		m.SetCountry(v)
 		return nil
-	case searchhistory.FieldSearchType:
+	case userpreference.FieldCurrency:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSearchType(v)
+		m.SetCurrency(v)
 		return nil
-	case searchhistory.FieldCategory:
+	case userpreference.FieldLanguage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCategory(v)
+		m.SetLanguage(v)
 		return nil
-	case searchhistory.FieldCountryCode:
+	case userpreference.FieldTheme:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCountryCode(v)
+		m.SetTheme(v)
 		return nil
-	case searchhistory.FieldLanguageCode:
-		v, ok := value.(string)
+	case userpreference.FieldSidebarOpen:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLanguageCode(v)
+		m.SetSidebarOpen(v)
 		return nil
-	case searchhistory.FieldCurrency:
+	case userpreference.FieldLastActiveSessionID:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCurrency(v)
-		return nil
-	case searchhistory.FieldResultCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetResultCount(v)
-		return nil
-	case searchhistory.FieldProductsFound:
-		v, ok := value.([]map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProductsFound(v)
+		m.SetLastActiveSessionID(v)
 		return nil
-	case searchhistory.FieldClickedProductID:
-		v, ok := value.(string)
+	case userpreference.FieldSavedSearch:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClickedProductID(v)
+		m.SetSavedSearch(v)
 		return nil
-	case searchhistory.FieldCreatedAt:
+	case userpreference.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case searchhistory.FieldExpiresAt:
+	case userpreference.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExpiresAt(v)
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown SearchHistory field %s", name)
+	return fmt.Errorf("unknown UserPreference field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *SearchHistoryMutation) AddedFields() []string {
-	var fields []string
-	if m.addresult_count != nil {
-		fields = append(fields, searchhistory.FieldResultCount)
-	}
-	return fields
+func (m *UserPreferenceMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *SearchHistoryMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case searchhistory.FieldResultCount:
-		return m.AddedResultCount()
-	}
+func (m *UserPreferenceMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *SearchHistoryMutation) AddField(name string, value ent.Value) error {
+func (m *UserPreferenceMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case searchhistory.FieldResultCount:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddResultCount(v)
-		return nil
 	}
-	return fmt.Errorf("unknown SearchHistory numeric field %s", name)
+	return fmt.Errorf("unknown UserPreference numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *SearchHistoryMutation) ClearedFields() []string {
+func (m *UserPreferenceMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(searchhistory.FieldUserID) {
-		fields = append(fields, searchhistory.FieldUserID)
+	if m.FieldCleared(userpreference.FieldCountry) {
+		fields = append(fields, userpreference.FieldCountry)
 	}
-	if m.FieldCleared(searchhistory.FieldSessionID) {
-		fields = append(fields, searchhistory.FieldSessionID)
+	if m.FieldCleared(userpreference.FieldCurrency) {
+		fields = append(fields, userpreference.FieldCurrency)
 	}
-	if m.FieldCleared(searchhistory.FieldOptimizedQuery) {
-		fields = append(fields, searchhistory.FieldOptimizedQuery)
+	if m.FieldCleared(userpreference.FieldLanguage) {
+		fields = append(fields, userpreference.FieldLanguage)
 	}
-	if m.FieldCleared(searchhistory.FieldCategory) {
-		fields = append(fields, searchhistory.FieldCategory)
+	if m.FieldCleared(userpreference.FieldTheme) {
+		fields = append(fields, userpreference.FieldTheme)
 	}
-	if m.FieldCleared(searchhistory.FieldProductsFound) {
-		fields = append(fields, searchhistory.FieldProductsFound)
+	if m.FieldCleared(userpreference.FieldSidebarOpen) {
+		fields = append(fields, userpreference.FieldSidebarOpen)
 	}
-	if m.FieldCleared(searchhistory.FieldClickedProductID) {
-		fields = append(fields, searchhistory.FieldClickedProductID)
+	if m.FieldCleared(userpreference.FieldLastActiveSessionID) {
+		fields = append(fields, userpreference.FieldLastActiveSessionID)
 	}
-	if m.FieldCleared(searchhistory.FieldExpiresAt) {
-		fields = append(fields, searchhistory.FieldExpiresAt)
+	if m.FieldCleared(userpreference.FieldSavedSearch) {
+		fields = append(fields, userpreference.FieldSavedSearch)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *SearchHistoryMutation) FieldCleared(name string) bool {
+func (m *UserPreferenceMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *SearchHistoryMutation) ClearField(name string) error {
+func (m *UserPreferenceMutation) ClearField(name string) error {
 	switch name {
-	case searchhistory.FieldUserID:
-		m.ClearUserID()
-		return nil
-	case searchhistory.FieldSessionID:
-		m.ClearSessionID()
+	case userpreference.FieldCountry:
+		m.ClearCountry()
 		return nil
-	case searchhistory.FieldOptimizedQuery:
-		m.ClearOptimizedQuery()
+	case userpreference.FieldCurrency:
+		m.ClearCurrency()
 		return nil
-	case searchhistory.FieldCategory:
-		m.ClearCategory()
+	case userpreference.FieldLanguage:
+		m.ClearLanguage()
 		return nil
-	case searchhistory.FieldProductsFound:
-		m.ClearProductsFound()
+	case userpreference.FieldTheme:
+		m.ClearTheme()
 		return nil
-	case searchhistory.FieldClickedProductID:
-		m.ClearClickedProductID()
+	case userpreference.FieldSidebarOpen:
+		m.ClearSidebarOpen()
 		return nil
-	case searchhistory.FieldExpiresAt:
-		m.ClearExpiresAt()
+	case userpreference.FieldLastActiveSessionID:
+		m.ClearLastActiveSessionID()
+		return nil
+	case userpreference.FieldSavedSearch:
+		m.ClearSavedSearch()
 		return nil
 	}
-	return fmt.Errorf("unknown SearchHistory nullable field %s", name)
+	return fmt.Errorf("unknown UserPreference nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *SearchHistoryMutation) ResetField(name string) error {
+func (m *UserPreferenceMutation) ResetField(name string) error {
 	switch name {
-	case searchhistory.FieldUserID:
+	case userpreference.FieldUserID:
 		m.ResetUserID()
 		return nil
-	case searchhistory.FieldSessionID:
-		m.ResetSessionID()
-		return nil
-	case searchhistory.FieldSearchQuery:
-		m.ResetSearchQuery()
-		return nil
-	case searchhistory.FieldOptimizedQuery:
-		m.ResetOptimizedQuery()
-		return nil
-	case searchhistory.FieldSearchType:
-		m.ResetSearchType()
-		return nil
-	case searchhistory.FieldCategory:
-		m.ResetCategory()
+	case userpreference.FieldCountry:
+		m.ResetCountry()
 		return nil
-	case searchhistory.FieldCountryCode:
-		m.ResetCountryCode()
+	case userpreference.FieldCurrency:
+		m.ResetCurrency()
 		return nil
-	case searchhistory.FieldLanguageCode:
-		m.ResetLanguageCode()
+	case userpreference.FieldLanguage:
+		m.ResetLanguage()
 		return nil
-	case searchhistory.FieldCurrency:
-		m.ResetCurrency()
+	case userpreference.FieldTheme:
+		m.ResetTheme()
 		return nil
-	case searchhistory.FieldResultCount:
-		m.ResetResultCount()
+	case userpreference.FieldSidebarOpen:
+		m.ResetSidebarOpen()
 		return nil
-	case searchhistory.FieldProductsFound:
-		m.ResetProductsFound()
+	case userpreference.FieldLastActiveSessionID:
+		m.ResetLastActiveSessionID()
 		return nil
-	case searchhistory.FieldClickedProductID:
-		m.ResetClickedProductID()
+	case userpreference.FieldSavedSearch:
+		m.ResetSavedSearch()
 		return nil
-	case searchhistory.FieldCreatedAt:
+	case userpreference.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case searchhistory.FieldExpiresAt:
-		m.ResetExpiresAt()
+	case userpreference.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown SearchHistory field %s", name)
+	return fmt.Errorf("unknown UserPreference field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *SearchHistoryMutation) AddedEdges() []string {
+func (m *UserPreferenceMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.user != nil {
-		edges = append(edges, searchhistory.EdgeUser)
+		edges = append(edges, userpreference.EdgeUser)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *SearchHistoryMutation) AddedIDs(name string) []ent.Value {
+func (m *UserPreferenceMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case searchhistory.EdgeUser:
+	case userpreference.EdgeUser:
 		if id := m.user; id != nil {
 			return []ent.Value{*id}
 		}
@@ -3280,31 +9444,31 @@ func (m *SearchHistoryMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *SearchHistoryMutation) RemovedEdges() []string {
+func (m *UserPreferenceMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *SearchHistoryMutation) RemovedIDs(name string) []ent.Value {
+func (m *UserPreferenceMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *SearchHistoryMutation) ClearedEdges() []string {
+func (m *UserPreferenceMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
 	if m.cleareduser {
-		edges = append(edges, searchhistory.EdgeUser)
+		edges = append(edges, userpreference.EdgeUser)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *SearchHistoryMutation) EdgeCleared(name string) bool {
+func (m *UserPreferenceMutation) EdgeCleared(name string) bool {
 	switch name {
-	case searchhistory.EdgeUser:
+	case userpreference.EdgeUser:
 		return m.cleareduser
 	}
 	return false
@@ -3312,66 +9476,66 @@ func (m *SearchHistoryMutation) EdgeCleared(name string) bool {
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *SearchHistoryMutation) ClearEdge(name string) error {
+func (m *UserPreferenceMutation) ClearEdge(name string) error {
 	switch name {
-	case searchhistory.EdgeUser:
+	case userpreference.EdgeUser:
 		m.ClearUser()
 		return nil
 	}
-	return fmt.Errorf("unknown SearchHistory unique edge %s", name)
+	return fmt.Errorf("unknown UserPreference unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *SearchHistoryMutation) ResetEdge(name string) error {
+func (m *UserPreferenceMutation) ResetEdge(name string) error {
 	switch name {
-	case searchhistory.EdgeUser:
+	case userpreference.EdgeUser:
 		m.ResetUser()
 		return nil
 	}
-	return fmt.Errorf("unknown SearchHistory edge %s", name)
+	return fmt.Errorf("unknown UserPreference edge %s", name)
 }
 
-// UserMutation represents an operation that mutates the User nodes in the graph.
-type UserMutation struct {
+// WatchlistItemMutation represents an operation that mutates the WatchlistItem nodes in the graph.
+type WatchlistItemMutation struct {
 	config
-	op                    Op
-	typ                   string
-	id                    *uuid.UUID
-	email                 *string
-	password_hash         *string
-	google_id             *string
-	name                  *string
-	avatar_url            *string
-	provider              *string
-	created_at            *time.Time
-	updated_at            *time.Time
-	last_login            *time.Time
-	clearedFields         map[string]struct{}
-	sessions              map[uuid.UUID]struct{}
-	removedsessions       map[uuid.UUID]struct{}
-	clearedsessions       bool
-	search_history        map[uuid.UUID]struct{}
-	removedsearch_history map[uuid.UUID]struct{}
-	clearedsearch_history bool
-	preferences           *uuid.UUID
-	clearedpreferences    bool
-	done                  bool
-	oldValue              func(context.Context) (*User, error)
-	predicates            []predicate.User
-}
-
-var _ ent.Mutation = (*UserMutation)(nil)
-
-// userOption allows management of the mutation configuration using functional options.
-type userOption func(*UserMutation)
-
-// newUserMutation creates new mutation for the User entity.
-func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
-	m := &UserMutation{
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	product_key      *string
+	page_token       *string
+	title            *string
+	link             *string
+	image            *string
+	target_amount    *int64
+	addtarget_amount *int64
+	currency         *string
+	country          *string
+	last_amount      *int64
+	addlast_amount   *int64
+	last_checked_at  *time.Time
+	notified_at      *time.Time
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	user             *uuid.UUID
+	cleareduser      bool
+	done             bool
+	oldValue         func(context.Context) (*WatchlistItem, error)
+	predicates       []predicate.WatchlistItem
+}
+
+var _ ent.Mutation = (*WatchlistItemMutation)(nil)
+
+// watchlistitemOption allows management of the mutation configuration using functional options.
+type watchlistitemOption func(*WatchlistItemMutation)
+
+// newWatchlistItemMutation creates new mutation for the WatchlistItem entity.
+func newWatchlistItemMutation(c config, op Op, opts ...watchlistitemOption) *WatchlistItemMutation {
+	m := &WatchlistItemMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeUser,
+		typ:           TypeWatchlistItem,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3380,20 +9544,20 @@ func newUserMutation(c config, op Op, opts ...userOption) *UserMutation {
 	return m
 }
 
-// withUserID sets the ID field of the mutation.
-func withUserID(id uuid.UUID) userOption {
-	return func(m *UserMutation) {
+// withWatchlistItemID sets the ID field of the mutation.
+func withWatchlistItemID(id uuid.UUID) watchlistitemOption {
+	return func(m *WatchlistItemMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *User
+			value *WatchlistItem
 		)
-		m.oldValue = func(ctx context.Context) (*User, error) {
+		m.oldValue = func(ctx context.Context) (*WatchlistItem, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().User.Get(ctx, id)
+					value, err = m.Client().WatchlistItem.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3402,10 +9566,10 @@ func withUserID(id uuid.UUID) userOption {
 	}
 }
 
-// withUser sets the old User of the mutation.
-func withUser(node *User) userOption {
-	return func(m *UserMutation) {
-		m.oldValue = func(context.Context) (*User, error) {
+// withWatchlistItem sets the old WatchlistItem of the mutation.
+func withWatchlistItem(node *WatchlistItem) watchlistitemOption {
+	return func(m *WatchlistItemMutation) {
+		m.oldValue = func(context.Context) (*WatchlistItem, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3414,7 +9578,7 @@ func withUser(node *User) userOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserMutation) Client() *Client {
+func (m WatchlistItemMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3422,7 +9586,7 @@ func (m UserMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m UserMutation) Tx() (*Tx, error) {
+func (m WatchlistItemMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3432,14 +9596,14 @@ func (m UserMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of User entities.
-func (m *UserMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of WatchlistItem entities.
+func (m *WatchlistItemMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
+func (m *WatchlistItemMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3450,7 +9614,7 @@ func (m *UserMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *WatchlistItemMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -3459,287 +9623,583 @@ func (m *UserMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().User.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().WatchlistItem.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetEmail sets the "email" field.
-func (m *UserMutation) SetEmail(s string) {
-	m.email = &s
+// SetUserID sets the "user_id" field.
+func (m *WatchlistItemMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *WatchlistItemMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the WatchlistItem entity.
+// If the WatchlistItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WatchlistItemMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *WatchlistItemMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetProductKey sets the "product_key" field.
+func (m *WatchlistItemMutation) SetProductKey(s string) {
+	m.product_key = &s
+}
+
+// ProductKey returns the value of the "product_key" field in the mutation.
+func (m *WatchlistItemMutation) ProductKey() (r string, exists bool) {
+	v := m.product_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProductKey returns the old "product_key" field's value of the WatchlistItem entity.
+// If the WatchlistItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WatchlistItemMutation) OldProductKey(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProductKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProductKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProductKey: %w", err)
+	}
+	return oldValue.ProductKey, nil
+}
+
+// ClearProductKey clears the value of the "product_key" field.
+func (m *WatchlistItemMutation) ClearProductKey() {
+	m.product_key = nil
+	m.clearedFields[watchlistitem.FieldProductKey] = struct{}{}
+}
+
+// ProductKeyCleared returns if the "product_key" field was cleared in this mutation.
+func (m *WatchlistItemMutation) ProductKeyCleared() bool {
+	_, ok := m.clearedFields[watchlistitem.FieldProductKey]
+	return ok
+}
+
+// ResetProductKey resets all changes to the "product_key" field.
+func (m *WatchlistItemMutation) ResetProductKey() {
+	m.product_key = nil
+	delete(m.clearedFields, watchlistitem.FieldProductKey)
+}
+
+// SetPageToken sets the "page_token" field.
+func (m *WatchlistItemMutation) SetPageToken(s string) {
+	m.page_token = &s
+}
+
+// PageToken returns the value of the "page_token" field in the mutation.
+func (m *WatchlistItemMutation) PageToken() (r string, exists bool) {
+	v := m.page_token
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPageToken returns the old "page_token" field's value of the WatchlistItem entity.
+// If the WatchlistItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WatchlistItemMutation) OldPageToken(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPageToken is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPageToken requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPageToken: %w", err)
+	}
+	return oldValue.PageToken, nil
+}
+
+// ResetPageToken resets all changes to the "page_token" field.
+func (m *WatchlistItemMutation) ResetPageToken() {
+	m.page_token = nil
+}
+
+// SetTitle sets the "title" field.
+func (m *WatchlistItemMutation) SetTitle(s string) {
+	m.title = &s
+}
+
+// Title returns the value of the "title" field in the mutation.
+func (m *WatchlistItemMutation) Title() (r string, exists bool) {
+	v := m.title
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTitle returns the old "title" field's value of the WatchlistItem entity.
+// If the WatchlistItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WatchlistItemMutation) OldTitle(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTitle is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTitle requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTitle: %w", err)
+	}
+	return oldValue.Title, nil
+}
+
+// ResetTitle resets all changes to the "title" field.
+func (m *WatchlistItemMutation) ResetTitle() {
+	m.title = nil
+}
+
+// SetLink sets the "link" field.
+func (m *WatchlistItemMutation) SetLink(s string) {
+	m.link = &s
+}
+
+// Link returns the value of the "link" field in the mutation.
+func (m *WatchlistItemMutation) Link() (r string, exists bool) {
+	v := m.link
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLink returns the old "link" field's value of the WatchlistItem entity.
+// If the WatchlistItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WatchlistItemMutation) OldLink(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLink is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLink requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLink: %w", err)
+	}
+	return oldValue.Link, nil
+}
+
+// ClearLink clears the value of the "link" field.
+func (m *WatchlistItemMutation) ClearLink() {
+	m.link = nil
+	m.clearedFields[watchlistitem.FieldLink] = struct{}{}
+}
+
+// LinkCleared returns if the "link" field was cleared in this mutation.
+func (m *WatchlistItemMutation) LinkCleared() bool {
+	_, ok := m.clearedFields[watchlistitem.FieldLink]
+	return ok
+}
+
+// ResetLink resets all changes to the "link" field.
+func (m *WatchlistItemMutation) ResetLink() {
+	m.link = nil
+	delete(m.clearedFields, watchlistitem.FieldLink)
 }
 
-// Email returns the value of the "email" field in the mutation.
-func (m *UserMutation) Email() (r string, exists bool) {
-	v := m.email
+// SetImage sets the "image" field.
+func (m *WatchlistItemMutation) SetImage(s string) {
+	m.image = &s
+}
+
+// Image returns the value of the "image" field in the mutation.
+func (m *WatchlistItemMutation) Image() (r string, exists bool) {
+	v := m.image
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldEmail returns the old "email" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldImage returns the old "image" field's value of the WatchlistItem entity.
+// If the WatchlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldEmail(ctx context.Context) (v string, err error) {
+func (m *WatchlistItemMutation) OldImage(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldEmail is only allowed on UpdateOne operations")
+		return v, errors.New("OldImage is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldEmail requires an ID field in the mutation")
+		return v, errors.New("OldImage requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldEmail: %w", err)
+		return v, fmt.Errorf("querying old value for OldImage: %w", err)
 	}
-	return oldValue.Email, nil
+	return oldValue.Image, nil
 }
 
-// ResetEmail resets all changes to the "email" field.
-func (m *UserMutation) ResetEmail() {
-	m.email = nil
+// ClearImage clears the value of the "image" field.
+func (m *WatchlistItemMutation) ClearImage() {
+	m.image = nil
+	m.clearedFields[watchlistitem.FieldImage] = struct{}{}
 }
 
-// SetPasswordHash sets the "password_hash" field.
-func (m *UserMutation) SetPasswordHash(s string) {
-	m.password_hash = &s
+// ImageCleared returns if the "image" field was cleared in this mutation.
+func (m *WatchlistItemMutation) ImageCleared() bool {
+	_, ok := m.clearedFields[watchlistitem.FieldImage]
+	return ok
 }
 
-// PasswordHash returns the value of the "password_hash" field in the mutation.
-func (m *UserMutation) PasswordHash() (r string, exists bool) {
-	v := m.password_hash
+// ResetImage resets all changes to the "image" field.
+func (m *WatchlistItemMutation) ResetImage() {
+	m.image = nil
+	delete(m.clearedFields, watchlistitem.FieldImage)
+}
+
+// SetTargetAmount sets the "target_amount" field.
+func (m *WatchlistItemMutation) SetTargetAmount(i int64) {
+	m.target_amount = &i
+	m.addtarget_amount = nil
+}
+
+// TargetAmount returns the value of the "target_amount" field in the mutation.
+func (m *WatchlistItemMutation) TargetAmount() (r int64, exists bool) {
+	v := m.target_amount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPasswordHash returns the old "password_hash" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldTargetAmount returns the old "target_amount" field's value of the WatchlistItem entity.
+// If the WatchlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldPasswordHash(ctx context.Context) (v string, err error) {
+func (m *WatchlistItemMutation) OldTargetAmount(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPasswordHash is only allowed on UpdateOne operations")
+		return v, errors.New("OldTargetAmount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPasswordHash requires an ID field in the mutation")
+		return v, errors.New("OldTargetAmount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPasswordHash: %w", err)
+		return v, fmt.Errorf("querying old value for OldTargetAmount: %w", err)
 	}
-	return oldValue.PasswordHash, nil
+	return oldValue.TargetAmount, nil
 }
 
-// ClearPasswordHash clears the value of the "password_hash" field.
-func (m *UserMutation) ClearPasswordHash() {
-	m.password_hash = nil
-	m.clearedFields[user.FieldPasswordHash] = struct{}{}
+// AddTargetAmount adds i to the "target_amount" field.
+func (m *WatchlistItemMutation) AddTargetAmount(i int64) {
+	if m.addtarget_amount != nil {
+		*m.addtarget_amount += i
+	} else {
+		m.addtarget_amount = &i
+	}
 }
 
-// PasswordHashCleared returns if the "password_hash" field was cleared in this mutation.
-func (m *UserMutation) PasswordHashCleared() bool {
-	_, ok := m.clearedFields[user.FieldPasswordHash]
-	return ok
+// AddedTargetAmount returns the value that was added to the "target_amount" field in this mutation.
+func (m *WatchlistItemMutation) AddedTargetAmount() (r int64, exists bool) {
+	v := m.addtarget_amount
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetPasswordHash resets all changes to the "password_hash" field.
-func (m *UserMutation) ResetPasswordHash() {
-	m.password_hash = nil
-	delete(m.clearedFields, user.FieldPasswordHash)
+// ResetTargetAmount resets all changes to the "target_amount" field.
+func (m *WatchlistItemMutation) ResetTargetAmount() {
+	m.target_amount = nil
+	m.addtarget_amount = nil
 }
 
-// SetGoogleID sets the "google_id" field.
-func (m *UserMutation) SetGoogleID(s string) {
-	m.google_id = &s
+// SetCurrency sets the "currency" field.
+func (m *WatchlistItemMutation) SetCurrency(s string) {
+	m.currency = &s
 }
 
-// GoogleID returns the value of the "google_id" field in the mutation.
-func (m *UserMutation) GoogleID() (r string, exists bool) {
-	v := m.google_id
+// Currency returns the value of the "currency" field in the mutation.
+func (m *WatchlistItemMutation) Currency() (r string, exists bool) {
+	v := m.currency
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldGoogleID returns the old "google_id" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCurrency returns the old "currency" field's value of the WatchlistItem entity.
+// If the WatchlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldGoogleID(ctx context.Context) (v string, err error) {
+func (m *WatchlistItemMutation) OldCurrency(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldGoogleID is only allowed on UpdateOne operations")
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldGoogleID requires an ID field in the mutation")
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldGoogleID: %w", err)
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
 	}
-	return oldValue.GoogleID, nil
+	return oldValue.Currency, nil
 }
 
-// ClearGoogleID clears the value of the "google_id" field.
-func (m *UserMutation) ClearGoogleID() {
-	m.google_id = nil
-	m.clearedFields[user.FieldGoogleID] = struct{}{}
+// ResetCurrency resets all changes to the "currency" field.
+func (m *WatchlistItemMutation) ResetCurrency() {
+	m.currency = nil
 }
 
-// GoogleIDCleared returns if the "google_id" field was cleared in this mutation.
-func (m *UserMutation) GoogleIDCleared() bool {
-	_, ok := m.clearedFields[user.FieldGoogleID]
+// SetCountry sets the "country" field.
+func (m *WatchlistItemMutation) SetCountry(s string) {
+	m.country = &s
+}
+
+// Country returns the value of the "country" field in the mutation.
+func (m *WatchlistItemMutation) Country() (r string, exists bool) {
+	v := m.country
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCountry returns the old "country" field's value of the WatchlistItem entity.
+// If the WatchlistItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WatchlistItemMutation) OldCountry(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCountry is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCountry requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCountry: %w", err)
+	}
+	return oldValue.Country, nil
+}
+
+// ClearCountry clears the value of the "country" field.
+func (m *WatchlistItemMutation) ClearCountry() {
+	m.country = nil
+	m.clearedFields[watchlistitem.FieldCountry] = struct{}{}
+}
+
+// CountryCleared returns if the "country" field was cleared in this mutation.
+func (m *WatchlistItemMutation) CountryCleared() bool {
+	_, ok := m.clearedFields[watchlistitem.FieldCountry]
 	return ok
 }
 
-// ResetGoogleID resets all changes to the "google_id" field.
-func (m *UserMutation) ResetGoogleID() {
-	m.google_id = nil
-	delete(m.clearedFields, user.FieldGoogleID)
+// ResetCountry resets all changes to the "country" field.
+func (m *WatchlistItemMutation) ResetCountry() {
+	m.country = nil
+	delete(m.clearedFields, watchlistitem.FieldCountry)
 }
 
-// SetName sets the "name" field.
-func (m *UserMutation) SetName(s string) {
-	m.name = &s
+// SetLastAmount sets the "last_amount" field.
+func (m *WatchlistItemMutation) SetLastAmount(i int64) {
+	m.last_amount = &i
+	m.addlast_amount = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *UserMutation) Name() (r string, exists bool) {
-	v := m.name
+// LastAmount returns the value of the "last_amount" field in the mutation.
+func (m *WatchlistItemMutation) LastAmount() (r int64, exists bool) {
+	v := m.last_amount
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldLastAmount returns the old "last_amount" field's value of the WatchlistItem entity.
+// If the WatchlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *WatchlistItemMutation) OldLastAmount(ctx context.Context) (v *int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastAmount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldLastAmount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastAmount: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.LastAmount, nil
 }
 
-// ClearName clears the value of the "name" field.
-func (m *UserMutation) ClearName() {
-	m.name = nil
-	m.clearedFields[user.FieldName] = struct{}{}
+// AddLastAmount adds i to the "last_amount" field.
+func (m *WatchlistItemMutation) AddLastAmount(i int64) {
+	if m.addlast_amount != nil {
+		*m.addlast_amount += i
+	} else {
+		m.addlast_amount = &i
+	}
 }
 
-// NameCleared returns if the "name" field was cleared in this mutation.
-func (m *UserMutation) NameCleared() bool {
-	_, ok := m.clearedFields[user.FieldName]
+// AddedLastAmount returns the value that was added to the "last_amount" field in this mutation.
+func (m *WatchlistItemMutation) AddedLastAmount() (r int64, exists bool) {
+	v := m.addlast_amount
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearLastAmount clears the value of the "last_amount" field.
+func (m *WatchlistItemMutation) ClearLastAmount() {
+	m.last_amount = nil
+	m.addlast_amount = nil
+	m.clearedFields[watchlistitem.FieldLastAmount] = struct{}{}
+}
+
+// LastAmountCleared returns if the "last_amount" field was cleared in this mutation.
+func (m *WatchlistItemMutation) LastAmountCleared() bool {
+	_, ok := m.clearedFields[watchlistitem.FieldLastAmount]
 	return ok
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *UserMutation) ResetName() {
-	m.name = nil
-	delete(m.clearedFields, user.FieldName)
+// ResetLastAmount resets all changes to the "last_amount" field.
+func (m *WatchlistItemMutation) ResetLastAmount() {
+	m.last_amount = nil
+	m.addlast_amount = nil
+	delete(m.clearedFields, watchlistitem.FieldLastAmount)
 }
 
-// SetAvatarURL sets the "avatar_url" field.
-func (m *UserMutation) SetAvatarURL(s string) {
-	m.avatar_url = &s
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (m *WatchlistItemMutation) SetLastCheckedAt(t time.Time) {
+	m.last_checked_at = &t
 }
 
-// AvatarURL returns the value of the "avatar_url" field in the mutation.
-func (m *UserMutation) AvatarURL() (r string, exists bool) {
-	v := m.avatar_url
+// LastCheckedAt returns the value of the "last_checked_at" field in the mutation.
+func (m *WatchlistItemMutation) LastCheckedAt() (r time.Time, exists bool) {
+	v := m.last_checked_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAvatarURL returns the old "avatar_url" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldLastCheckedAt returns the old "last_checked_at" field's value of the WatchlistItem entity.
+// If the WatchlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldAvatarURL(ctx context.Context) (v string, err error) {
+func (m *WatchlistItemMutation) OldLastCheckedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvatarURL is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastCheckedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvatarURL requires an ID field in the mutation")
+		return v, errors.New("OldLastCheckedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvatarURL: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastCheckedAt: %w", err)
 	}
-	return oldValue.AvatarURL, nil
+	return oldValue.LastCheckedAt, nil
 }
 
-// ClearAvatarURL clears the value of the "avatar_url" field.
-func (m *UserMutation) ClearAvatarURL() {
-	m.avatar_url = nil
-	m.clearedFields[user.FieldAvatarURL] = struct{}{}
+// ClearLastCheckedAt clears the value of the "last_checked_at" field.
+func (m *WatchlistItemMutation) ClearLastCheckedAt() {
+	m.last_checked_at = nil
+	m.clearedFields[watchlistitem.FieldLastCheckedAt] = struct{}{}
 }
 
-// AvatarURLCleared returns if the "avatar_url" field was cleared in this mutation.
-func (m *UserMutation) AvatarURLCleared() bool {
-	_, ok := m.clearedFields[user.FieldAvatarURL]
+// LastCheckedAtCleared returns if the "last_checked_at" field was cleared in this mutation.
+func (m *WatchlistItemMutation) LastCheckedAtCleared() bool {
+	_, ok := m.clearedFields[watchlistitem.FieldLastCheckedAt]
 	return ok
 }
 
-// ResetAvatarURL resets all changes to the "avatar_url" field.
-func (m *UserMutation) ResetAvatarURL() {
-	m.avatar_url = nil
-	delete(m.clearedFields, user.FieldAvatarURL)
+// ResetLastCheckedAt resets all changes to the "last_checked_at" field.
+func (m *WatchlistItemMutation) ResetLastCheckedAt() {
+	m.last_checked_at = nil
+	delete(m.clearedFields, watchlistitem.FieldLastCheckedAt)
 }
 
-// SetProvider sets the "provider" field.
-func (m *UserMutation) SetProvider(s string) {
-	m.provider = &s
+// SetNotifiedAt sets the "notified_at" field.
+func (m *WatchlistItemMutation) SetNotifiedAt(t time.Time) {
+	m.notified_at = &t
 }
 
-// Provider returns the value of the "provider" field in the mutation.
-func (m *UserMutation) Provider() (r string, exists bool) {
-	v := m.provider
+// NotifiedAt returns the value of the "notified_at" field in the mutation.
+func (m *WatchlistItemMutation) NotifiedAt() (r time.Time, exists bool) {
+	v := m.notified_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProvider returns the old "provider" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldNotifiedAt returns the old "notified_at" field's value of the WatchlistItem entity.
+// If the WatchlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldProvider(ctx context.Context) (v string, err error) {
+func (m *WatchlistItemMutation) OldNotifiedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProvider is only allowed on UpdateOne operations")
+		return v, errors.New("OldNotifiedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProvider requires an ID field in the mutation")
+		return v, errors.New("OldNotifiedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProvider: %w", err)
+		return v, fmt.Errorf("querying old value for OldNotifiedAt: %w", err)
 	}
-	return oldValue.Provider, nil
+	return oldValue.NotifiedAt, nil
 }
 
-// ResetProvider resets all changes to the "provider" field.
-func (m *UserMutation) ResetProvider() {
-	m.provider = nil
+// ClearNotifiedAt clears the value of the "notified_at" field.
+func (m *WatchlistItemMutation) ClearNotifiedAt() {
+	m.notified_at = nil
+	m.clearedFields[watchlistitem.FieldNotifiedAt] = struct{}{}
+}
+
+// NotifiedAtCleared returns if the "notified_at" field was cleared in this mutation.
+func (m *WatchlistItemMutation) NotifiedAtCleared() bool {
+	_, ok := m.clearedFields[watchlistitem.FieldNotifiedAt]
+	return ok
+}
+
+// ResetNotifiedAt resets all changes to the "notified_at" field.
+func (m *WatchlistItemMutation) ResetNotifiedAt() {
+	m.notified_at = nil
+	delete(m.clearedFields, watchlistitem.FieldNotifiedAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *UserMutation) SetCreatedAt(t time.Time) {
+func (m *WatchlistItemMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *WatchlistItemMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3747,10 +10207,10 @@ func (m *UserMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WatchlistItem entity.
+// If the WatchlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WatchlistItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3765,1284 +10225,1762 @@ func (m *UserMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserMutation) ResetCreatedAt() {
+func (m *WatchlistItemMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *UserMutation) SetUpdatedAt(t time.Time) {
+func (m *WatchlistItemMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
-	if v == nil {
-		return
-	}
-	return *v, true
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WatchlistItemMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the WatchlistItem entity.
+// If the WatchlistItem object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WatchlistItemMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WatchlistItemMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *WatchlistItemMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[watchlistitem.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *WatchlistItemMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *WatchlistItemMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *WatchlistItemMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the WatchlistItemMutation builder.
+func (m *WatchlistItemMutation) Where(ps ...predicate.WatchlistItem) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+// WhereP appends storage-level predicates to the WatchlistItemMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WatchlistItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WatchlistItem, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.UpdatedAt, nil
+	m.Where(p...)
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// Op returns the operation name.
+func (m *WatchlistItemMutation) Op() Op {
+	return m.op
 }
 
-// SetLastLogin sets the "last_login" field.
-func (m *UserMutation) SetLastLogin(t time.Time) {
-	m.last_login = &t
+// SetOp allows setting the mutation operation.
+func (m *WatchlistItemMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// LastLogin returns the value of the "last_login" field in the mutation.
-func (m *UserMutation) LastLogin() (r time.Time, exists bool) {
-	v := m.last_login
-	if v == nil {
-		return
-	}
-	return *v, true
+// Type returns the node type of this mutation (WatchlistItem).
+func (m *WatchlistItemMutation) Type() string {
+	return m.typ
 }
 
-// OldLastLogin returns the old "last_login" field's value of the User entity.
-// If the User object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserMutation) OldLastLogin(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastLogin is only allowed on UpdateOne operations")
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WatchlistItemMutation) Fields() []string {
+	fields := make([]string, 0, 14)
+	if m.user != nil {
+		fields = append(fields, watchlistitem.FieldUserID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastLogin requires an ID field in the mutation")
+	if m.product_key != nil {
+		fields = append(fields, watchlistitem.FieldProductKey)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastLogin: %w", err)
+	if m.page_token != nil {
+		fields = append(fields, watchlistitem.FieldPageToken)
 	}
-	return oldValue.LastLogin, nil
+	if m.title != nil {
+		fields = append(fields, watchlistitem.FieldTitle)
+	}
+	if m.link != nil {
+		fields = append(fields, watchlistitem.FieldLink)
+	}
+	if m.image != nil {
+		fields = append(fields, watchlistitem.FieldImage)
+	}
+	if m.target_amount != nil {
+		fields = append(fields, watchlistitem.FieldTargetAmount)
+	}
+	if m.currency != nil {
+		fields = append(fields, watchlistitem.FieldCurrency)
+	}
+	if m.country != nil {
+		fields = append(fields, watchlistitem.FieldCountry)
+	}
+	if m.last_amount != nil {
+		fields = append(fields, watchlistitem.FieldLastAmount)
+	}
+	if m.last_checked_at != nil {
+		fields = append(fields, watchlistitem.FieldLastCheckedAt)
+	}
+	if m.notified_at != nil {
+		fields = append(fields, watchlistitem.FieldNotifiedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, watchlistitem.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, watchlistitem.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// ClearLastLogin clears the value of the "last_login" field.
-func (m *UserMutation) ClearLastLogin() {
-	m.last_login = nil
-	m.clearedFields[user.FieldLastLogin] = struct{}{}
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WatchlistItemMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case watchlistitem.FieldUserID:
+		return m.UserID()
+	case watchlistitem.FieldProductKey:
+		return m.ProductKey()
+	case watchlistitem.FieldPageToken:
+		return m.PageToken()
+	case watchlistitem.FieldTitle:
+		return m.Title()
+	case watchlistitem.FieldLink:
+		return m.Link()
+	case watchlistitem.FieldImage:
+		return m.Image()
+	case watchlistitem.FieldTargetAmount:
+		return m.TargetAmount()
+	case watchlistitem.FieldCurrency:
+		return m.Currency()
+	case watchlistitem.FieldCountry:
+		return m.Country()
+	case watchlistitem.FieldLastAmount:
+		return m.LastAmount()
+	case watchlistitem.FieldLastCheckedAt:
+		return m.LastCheckedAt()
+	case watchlistitem.FieldNotifiedAt:
+		return m.NotifiedAt()
+	case watchlistitem.FieldCreatedAt:
+		return m.CreatedAt()
+	case watchlistitem.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// LastLoginCleared returns if the "last_login" field was cleared in this mutation.
-func (m *UserMutation) LastLoginCleared() bool {
-	_, ok := m.clearedFields[user.FieldLastLogin]
-	return ok
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WatchlistItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case watchlistitem.FieldUserID:
+		return m.OldUserID(ctx)
+	case watchlistitem.FieldProductKey:
+		return m.OldProductKey(ctx)
+	case watchlistitem.FieldPageToken:
+		return m.OldPageToken(ctx)
+	case watchlistitem.FieldTitle:
+		return m.OldTitle(ctx)
+	case watchlistitem.FieldLink:
+		return m.OldLink(ctx)
+	case watchlistitem.FieldImage:
+		return m.OldImage(ctx)
+	case watchlistitem.FieldTargetAmount:
+		return m.OldTargetAmount(ctx)
+	case watchlistitem.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case watchlistitem.FieldCountry:
+		return m.OldCountry(ctx)
+	case watchlistitem.FieldLastAmount:
+		return m.OldLastAmount(ctx)
+	case watchlistitem.FieldLastCheckedAt:
+		return m.OldLastCheckedAt(ctx)
+	case watchlistitem.FieldNotifiedAt:
+		return m.OldNotifiedAt(ctx)
+	case watchlistitem.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case watchlistitem.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown WatchlistItem field %s", name)
 }
 
-// ResetLastLogin resets all changes to the "last_login" field.
-func (m *UserMutation) ResetLastLogin() {
-	m.last_login = nil
-	delete(m.clearedFields, user.FieldLastLogin)
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WatchlistItemMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case watchlistitem.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case watchlistitem.FieldProductKey:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProductKey(v)
+		return nil
+	case watchlistitem.FieldPageToken:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPageToken(v)
+		return nil
+	case watchlistitem.FieldTitle:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTitle(v)
+		return nil
+	case watchlistitem.FieldLink:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLink(v)
+		return nil
+	case watchlistitem.FieldImage:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetImage(v)
+		return nil
+	case watchlistitem.FieldTargetAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTargetAmount(v)
+		return nil
+	case watchlistitem.FieldCurrency:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrency(v)
+		return nil
+	case watchlistitem.FieldCountry:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCountry(v)
+		return nil
+	case watchlistitem.FieldLastAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastAmount(v)
+		return nil
+	case watchlistitem.FieldLastCheckedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastCheckedAt(v)
+		return nil
+	case watchlistitem.FieldNotifiedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetNotifiedAt(v)
+		return nil
+	case watchlistitem.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case watchlistitem.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WatchlistItem field %s", name)
 }
 
-// AddSessionIDs adds the "sessions" edge to the ChatSession entity by ids.
-func (m *UserMutation) AddSessionIDs(ids ...uuid.UUID) {
-	if m.sessions == nil {
-		m.sessions = make(map[uuid.UUID]struct{})
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WatchlistItemMutation) AddedFields() []string {
+	var fields []string
+	if m.addtarget_amount != nil {
+		fields = append(fields, watchlistitem.FieldTargetAmount)
 	}
-	for i := range ids {
-		m.sessions[ids[i]] = struct{}{}
+	if m.addlast_amount != nil {
+		fields = append(fields, watchlistitem.FieldLastAmount)
 	}
+	return fields
 }
 
-// ClearSessions clears the "sessions" edge to the ChatSession entity.
-func (m *UserMutation) ClearSessions() {
-	m.clearedsessions = true
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WatchlistItemMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case watchlistitem.FieldTargetAmount:
+		return m.AddedTargetAmount()
+	case watchlistitem.FieldLastAmount:
+		return m.AddedLastAmount()
+	}
+	return nil, false
 }
 
-// SessionsCleared reports if the "sessions" edge to the ChatSession entity was cleared.
-func (m *UserMutation) SessionsCleared() bool {
-	return m.clearedsessions
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WatchlistItemMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case watchlistitem.FieldTargetAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTargetAmount(v)
+		return nil
+	case watchlistitem.FieldLastAmount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddLastAmount(v)
+		return nil
+	}
+	return fmt.Errorf("unknown WatchlistItem numeric field %s", name)
 }
 
-// RemoveSessionIDs removes the "sessions" edge to the ChatSession entity by IDs.
-func (m *UserMutation) RemoveSessionIDs(ids ...uuid.UUID) {
-	if m.removedsessions == nil {
-		m.removedsessions = make(map[uuid.UUID]struct{})
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WatchlistItemMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(watchlistitem.FieldProductKey) {
+		fields = append(fields, watchlistitem.FieldProductKey)
 	}
-	for i := range ids {
-		delete(m.sessions, ids[i])
-		m.removedsessions[ids[i]] = struct{}{}
+	if m.FieldCleared(watchlistitem.FieldLink) {
+		fields = append(fields, watchlistitem.FieldLink)
+	}
+	if m.FieldCleared(watchlistitem.FieldImage) {
+		fields = append(fields, watchlistitem.FieldImage)
+	}
+	if m.FieldCleared(watchlistitem.FieldCountry) {
+		fields = append(fields, watchlistitem.FieldCountry)
+	}
+	if m.FieldCleared(watchlistitem.FieldLastAmount) {
+		fields = append(fields, watchlistitem.FieldLastAmount)
 	}
+	if m.FieldCleared(watchlistitem.FieldLastCheckedAt) {
+		fields = append(fields, watchlistitem.FieldLastCheckedAt)
+	}
+	if m.FieldCleared(watchlistitem.FieldNotifiedAt) {
+		fields = append(fields, watchlistitem.FieldNotifiedAt)
+	}
+	return fields
 }
 
-// RemovedSessions returns the removed IDs of the "sessions" edge to the ChatSession entity.
-func (m *UserMutation) RemovedSessionsIDs() (ids []uuid.UUID) {
-	for id := range m.removedsessions {
-		ids = append(ids, id)
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WatchlistItemMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WatchlistItemMutation) ClearField(name string) error {
+	switch name {
+	case watchlistitem.FieldProductKey:
+		m.ClearProductKey()
+		return nil
+	case watchlistitem.FieldLink:
+		m.ClearLink()
+		return nil
+	case watchlistitem.FieldImage:
+		m.ClearImage()
+		return nil
+	case watchlistitem.FieldCountry:
+		m.ClearCountry()
+		return nil
+	case watchlistitem.FieldLastAmount:
+		m.ClearLastAmount()
+		return nil
+	case watchlistitem.FieldLastCheckedAt:
+		m.ClearLastCheckedAt()
+		return nil
+	case watchlistitem.FieldNotifiedAt:
+		m.ClearNotifiedAt()
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown WatchlistItem nullable field %s", name)
 }
 
-// SessionsIDs returns the "sessions" edge IDs in the mutation.
-func (m *UserMutation) SessionsIDs() (ids []uuid.UUID) {
-	for id := range m.sessions {
-		ids = append(ids, id)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WatchlistItemMutation) ResetField(name string) error {
+	switch name {
+	case watchlistitem.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case watchlistitem.FieldProductKey:
+		m.ResetProductKey()
+		return nil
+	case watchlistitem.FieldPageToken:
+		m.ResetPageToken()
+		return nil
+	case watchlistitem.FieldTitle:
+		m.ResetTitle()
+		return nil
+	case watchlistitem.FieldLink:
+		m.ResetLink()
+		return nil
+	case watchlistitem.FieldImage:
+		m.ResetImage()
+		return nil
+	case watchlistitem.FieldTargetAmount:
+		m.ResetTargetAmount()
+		return nil
+	case watchlistitem.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case watchlistitem.FieldCountry:
+		m.ResetCountry()
+		return nil
+	case watchlistitem.FieldLastAmount:
+		m.ResetLastAmount()
+		return nil
+	case watchlistitem.FieldLastCheckedAt:
+		m.ResetLastCheckedAt()
+		return nil
+	case watchlistitem.FieldNotifiedAt:
+		m.ResetNotifiedAt()
+		return nil
+	case watchlistitem.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case watchlistitem.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return
+	return fmt.Errorf("unknown WatchlistItem field %s", name)
 }
 
-// ResetSessions resets all changes to the "sessions" edge.
-func (m *UserMutation) ResetSessions() {
-	m.sessions = nil
-	m.clearedsessions = false
-	m.removedsessions = nil
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WatchlistItemMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, watchlistitem.EdgeUser)
+	}
+	return edges
 }
 
-// AddSearchHistoryIDs adds the "search_history" edge to the SearchHistory entity by ids.
-func (m *UserMutation) AddSearchHistoryIDs(ids ...uuid.UUID) {
-	if m.search_history == nil {
-		m.search_history = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.search_history[ids[i]] = struct{}{}
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WatchlistItemMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case watchlistitem.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
 	}
+	return nil
 }
 
-// ClearSearchHistory clears the "search_history" edge to the SearchHistory entity.
-func (m *UserMutation) ClearSearchHistory() {
-	m.clearedsearch_history = true
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WatchlistItemMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SearchHistoryCleared reports if the "search_history" edge to the SearchHistory entity was cleared.
-func (m *UserMutation) SearchHistoryCleared() bool {
-	return m.clearedsearch_history
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WatchlistItemMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// RemoveSearchHistoryIDs removes the "search_history" edge to the SearchHistory entity by IDs.
-func (m *UserMutation) RemoveSearchHistoryIDs(ids ...uuid.UUID) {
-	if m.removedsearch_history == nil {
-		m.removedsearch_history = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.search_history, ids[i])
-		m.removedsearch_history[ids[i]] = struct{}{}
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WatchlistItemMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, watchlistitem.EdgeUser)
 	}
+	return edges
 }
 
-// RemovedSearchHistory returns the removed IDs of the "search_history" edge to the SearchHistory entity.
-func (m *UserMutation) RemovedSearchHistoryIDs() (ids []uuid.UUID) {
-	for id := range m.removedsearch_history {
-		ids = append(ids, id)
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WatchlistItemMutation) EdgeCleared(name string) bool {
+	switch name {
+	case watchlistitem.EdgeUser:
+		return m.cleareduser
 	}
-	return
+	return false
 }
 
-// SearchHistoryIDs returns the "search_history" edge IDs in the mutation.
-func (m *UserMutation) SearchHistoryIDs() (ids []uuid.UUID) {
-	for id := range m.search_history {
-		ids = append(ids, id)
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WatchlistItemMutation) ClearEdge(name string) error {
+	switch name {
+	case watchlistitem.EdgeUser:
+		m.ClearUser()
+		return nil
 	}
-	return
-}
-
-// ResetSearchHistory resets all changes to the "search_history" edge.
-func (m *UserMutation) ResetSearchHistory() {
-	m.search_history = nil
-	m.clearedsearch_history = false
-	m.removedsearch_history = nil
+	return fmt.Errorf("unknown WatchlistItem unique edge %s", name)
 }
 
-// SetPreferencesID sets the "preferences" edge to the UserPreference entity by id.
-func (m *UserMutation) SetPreferencesID(id uuid.UUID) {
-	m.preferences = &id
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WatchlistItemMutation) ResetEdge(name string) error {
+	switch name {
+	case watchlistitem.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown WatchlistItem edge %s", name)
 }
 
-// ClearPreferences clears the "preferences" edge to the UserPreference entity.
-func (m *UserMutation) ClearPreferences() {
-	m.clearedpreferences = true
+// WishlistMutation represents an operation that mutates the Wishlist nodes in the graph.
+type WishlistMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	name             *string
+	shared           *bool
+	share_version    *int
+	addshare_version *int
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	user             *uuid.UUID
+	cleareduser      bool
+	items            map[uuid.UUID]struct{}
+	removeditems     map[uuid.UUID]struct{}
+	cleareditems     bool
+	done             bool
+	oldValue         func(context.Context) (*Wishlist, error)
+	predicates       []predicate.Wishlist
+}
+
+var _ ent.Mutation = (*WishlistMutation)(nil)
+
+// wishlistOption allows management of the mutation configuration using functional options.
+type wishlistOption func(*WishlistMutation)
+
+// newWishlistMutation creates new mutation for the Wishlist entity.
+func newWishlistMutation(c config, op Op, opts ...wishlistOption) *WishlistMutation {
+	m := &WishlistMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWishlist,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// PreferencesCleared reports if the "preferences" edge to the UserPreference entity was cleared.
-func (m *UserMutation) PreferencesCleared() bool {
-	return m.clearedpreferences
+// withWishlistID sets the ID field of the mutation.
+func withWishlistID(id uuid.UUID) wishlistOption {
+	return func(m *WishlistMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Wishlist
+		)
+		m.oldValue = func(ctx context.Context) (*Wishlist, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Wishlist.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// PreferencesID returns the "preferences" edge ID in the mutation.
-func (m *UserMutation) PreferencesID() (id uuid.UUID, exists bool) {
-	if m.preferences != nil {
-		return *m.preferences, true
+// withWishlist sets the old Wishlist of the mutation.
+func withWishlist(node *Wishlist) wishlistOption {
+	return func(m *WishlistMutation) {
+		m.oldValue = func(context.Context) (*Wishlist, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return
 }
 
-// PreferencesIDs returns the "preferences" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PreferencesID instead. It exists only for internal usage by the builders.
-func (m *UserMutation) PreferencesIDs() (ids []uuid.UUID) {
-	if id := m.preferences; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WishlistMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// ResetPreferences resets all changes to the "preferences" edge.
-func (m *UserMutation) ResetPreferences() {
-	m.preferences = nil
-	m.clearedpreferences = false
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WishlistMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// Where appends a list predicates to the UserMutation builder.
-func (m *UserMutation) Where(ps ...predicate.User) {
-	m.predicates = append(m.predicates, ps...)
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Wishlist entities.
+func (m *WishlistMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// WhereP appends storage-level predicates to the UserMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.User, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WishlistMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
 	}
-	m.Where(p...)
+	return *m.id, true
 }
 
-// Op returns the operation name.
-func (m *UserMutation) Op() Op {
-	return m.op
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WishlistMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Wishlist.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetOp allows setting the mutation operation.
-func (m *UserMutation) SetOp(op Op) {
-	m.op = op
+// SetUserID sets the "user_id" field.
+func (m *WishlistMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
 }
 
-// Type returns the node type of this mutation (User).
-func (m *UserMutation) Type() string {
-	return m.typ
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *WishlistMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 9)
-	if m.email != nil {
-		fields = append(fields, user.FieldEmail)
-	}
-	if m.password_hash != nil {
-		fields = append(fields, user.FieldPasswordHash)
-	}
-	if m.google_id != nil {
-		fields = append(fields, user.FieldGoogleID)
-	}
-	if m.name != nil {
-		fields = append(fields, user.FieldName)
-	}
-	if m.avatar_url != nil {
-		fields = append(fields, user.FieldAvatarURL)
-	}
-	if m.provider != nil {
-		fields = append(fields, user.FieldProvider)
-	}
-	if m.created_at != nil {
-		fields = append(fields, user.FieldCreatedAt)
+// OldUserID returns the old "user_id" field's value of the Wishlist entity.
+// If the Wishlist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WishlistMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
-	if m.updated_at != nil {
-		fields = append(fields, user.FieldUpdatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
-	if m.last_login != nil {
-		fields = append(fields, user.FieldLastLogin)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return fields
+	return oldValue.UserID, nil
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *UserMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case user.FieldEmail:
-		return m.Email()
-	case user.FieldPasswordHash:
-		return m.PasswordHash()
-	case user.FieldGoogleID:
-		return m.GoogleID()
-	case user.FieldName:
-		return m.Name()
-	case user.FieldAvatarURL:
-		return m.AvatarURL()
-	case user.FieldProvider:
-		return m.Provider()
-	case user.FieldCreatedAt:
-		return m.CreatedAt()
-	case user.FieldUpdatedAt:
-		return m.UpdatedAt()
-	case user.FieldLastLogin:
-		return m.LastLogin()
-	}
-	return nil, false
+// ResetUserID resets all changes to the "user_id" field.
+func (m *WishlistMutation) ResetUserID() {
+	m.user = nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case user.FieldEmail:
-		return m.OldEmail(ctx)
-	case user.FieldPasswordHash:
-		return m.OldPasswordHash(ctx)
-	case user.FieldGoogleID:
-		return m.OldGoogleID(ctx)
-	case user.FieldName:
-		return m.OldName(ctx)
-	case user.FieldAvatarURL:
-		return m.OldAvatarURL(ctx)
-	case user.FieldProvider:
-		return m.OldProvider(ctx)
-	case user.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case user.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
-	case user.FieldLastLogin:
-		return m.OldLastLogin(ctx)
+// SetName sets the "name" field.
+func (m *WishlistMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *WishlistMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
 	}
-	return nil, fmt.Errorf("unknown User field %s", name)
+	return *v, true
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *UserMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case user.FieldEmail:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetEmail(v)
-		return nil
-	case user.FieldPasswordHash:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPasswordHash(v)
-		return nil
-	case user.FieldGoogleID:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetGoogleID(v)
-		return nil
-	case user.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case user.FieldAvatarURL:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAvatarURL(v)
-		return nil
-	case user.FieldProvider:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProvider(v)
-		return nil
-	case user.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case user.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
-	case user.FieldLastLogin:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastLogin(v)
-		return nil
+// OldName returns the old "name" field's value of the Wishlist entity.
+// If the Wishlist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WishlistMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *UserMutation) AddedFields() []string {
-	return nil
+// ResetName resets all changes to the "name" field.
+func (m *WishlistMutation) ResetName() {
+	m.name = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *UserMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetShared sets the "shared" field.
+func (m *WishlistMutation) SetShared(b bool) {
+	m.shared = &b
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *UserMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// Shared returns the value of the "shared" field in the mutation.
+func (m *WishlistMutation) Shared() (r bool, exists bool) {
+	v := m.shared
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown User numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *UserMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(user.FieldPasswordHash) {
-		fields = append(fields, user.FieldPasswordHash)
-	}
-	if m.FieldCleared(user.FieldGoogleID) {
-		fields = append(fields, user.FieldGoogleID)
-	}
-	if m.FieldCleared(user.FieldName) {
-		fields = append(fields, user.FieldName)
+// OldShared returns the old "shared" field's value of the Wishlist entity.
+// If the Wishlist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WishlistMutation) OldShared(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShared is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(user.FieldAvatarURL) {
-		fields = append(fields, user.FieldAvatarURL)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShared requires an ID field in the mutation")
 	}
-	if m.FieldCleared(user.FieldLastLogin) {
-		fields = append(fields, user.FieldLastLogin)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShared: %w", err)
 	}
-	return fields
+	return oldValue.Shared, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *UserMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// ResetShared resets all changes to the "shared" field.
+func (m *WishlistMutation) ResetShared() {
+	m.shared = nil
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *UserMutation) ClearField(name string) error {
-	switch name {
-	case user.FieldPasswordHash:
-		m.ClearPasswordHash()
-		return nil
-	case user.FieldGoogleID:
-		m.ClearGoogleID()
-		return nil
-	case user.FieldName:
-		m.ClearName()
-		return nil
-	case user.FieldAvatarURL:
-		m.ClearAvatarURL()
-		return nil
-	case user.FieldLastLogin:
-		m.ClearLastLogin()
-		return nil
-	}
-	return fmt.Errorf("unknown User nullable field %s", name)
+// SetShareVersion sets the "share_version" field.
+func (m *WishlistMutation) SetShareVersion(i int) {
+	m.share_version = &i
+	m.addshare_version = nil
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *UserMutation) ResetField(name string) error {
-	switch name {
-	case user.FieldEmail:
-		m.ResetEmail()
-		return nil
-	case user.FieldPasswordHash:
-		m.ResetPasswordHash()
-		return nil
-	case user.FieldGoogleID:
-		m.ResetGoogleID()
-		return nil
-	case user.FieldName:
-		m.ResetName()
-		return nil
-	case user.FieldAvatarURL:
-		m.ResetAvatarURL()
-		return nil
-	case user.FieldProvider:
-		m.ResetProvider()
-		return nil
-	case user.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case user.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	case user.FieldLastLogin:
-		m.ResetLastLogin()
-		return nil
+// ShareVersion returns the value of the "share_version" field in the mutation.
+func (m *WishlistMutation) ShareVersion() (r int, exists bool) {
+	v := m.share_version
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown User field %s", name)
+	return *v, true
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.sessions != nil {
-		edges = append(edges, user.EdgeSessions)
+// OldShareVersion returns the old "share_version" field's value of the Wishlist entity.
+// If the Wishlist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WishlistMutation) OldShareVersion(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldShareVersion is only allowed on UpdateOne operations")
 	}
-	if m.search_history != nil {
-		edges = append(edges, user.EdgeSearchHistory)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldShareVersion requires an ID field in the mutation")
 	}
-	if m.preferences != nil {
-		edges = append(edges, user.EdgePreferences)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldShareVersion: %w", err)
 	}
-	return edges
+	return oldValue.ShareVersion, nil
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *UserMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeSessions:
-		ids := make([]ent.Value, 0, len(m.sessions))
-		for id := range m.sessions {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeSearchHistory:
-		ids := make([]ent.Value, 0, len(m.search_history))
-		for id := range m.search_history {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgePreferences:
-		if id := m.preferences; id != nil {
-			return []ent.Value{*id}
-		}
+// AddShareVersion adds i to the "share_version" field.
+func (m *WishlistMutation) AddShareVersion(i int) {
+	if m.addshare_version != nil {
+		*m.addshare_version += i
+	} else {
+		m.addshare_version = &i
 	}
-	return nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.removedsessions != nil {
-		edges = append(edges, user.EdgeSessions)
-	}
-	if m.removedsearch_history != nil {
-		edges = append(edges, user.EdgeSearchHistory)
+// AddedShareVersion returns the value that was added to the "share_version" field in this mutation.
+func (m *WishlistMutation) AddedShareVersion() (r int, exists bool) {
+	v := m.addshare_version
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *UserMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case user.EdgeSessions:
-		ids := make([]ent.Value, 0, len(m.removedsessions))
-		for id := range m.removedsessions {
-			ids = append(ids, id)
-		}
-		return ids
-	case user.EdgeSearchHistory:
-		ids := make([]ent.Value, 0, len(m.removedsearch_history))
-		for id := range m.removedsearch_history {
-			ids = append(ids, id)
-		}
-		return ids
+// ResetShareVersion resets all changes to the "share_version" field.
+func (m *WishlistMutation) ResetShareVersion() {
+	m.share_version = nil
+	m.addshare_version = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *WishlistMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WishlistMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return nil
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 3)
-	if m.clearedsessions {
-		edges = append(edges, user.EdgeSessions)
+// OldCreatedAt returns the old "created_at" field's value of the Wishlist entity.
+// If the Wishlist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WishlistMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	if m.clearedsearch_history {
-		edges = append(edges, user.EdgeSearchHistory)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	if m.clearedpreferences {
-		edges = append(edges, user.EdgePreferences)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return edges
+	return oldValue.CreatedAt, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *UserMutation) EdgeCleared(name string) bool {
-	switch name {
-	case user.EdgeSessions:
-		return m.clearedsessions
-	case user.EdgeSearchHistory:
-		return m.clearedsearch_history
-	case user.EdgePreferences:
-		return m.clearedpreferences
-	}
-	return false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WishlistMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *UserMutation) ClearEdge(name string) error {
-	switch name {
-	case user.EdgePreferences:
-		m.ClearPreferences()
-		return nil
+// SetUpdatedAt sets the "updated_at" field.
+func (m *WishlistMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *WishlistMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown User unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *UserMutation) ResetEdge(name string) error {
-	switch name {
-	case user.EdgeSessions:
-		m.ResetSessions()
-		return nil
-	case user.EdgeSearchHistory:
-		m.ResetSearchHistory()
-		return nil
-	case user.EdgePreferences:
-		m.ResetPreferences()
-		return nil
+// OldUpdatedAt returns the old "updated_at" field's value of the Wishlist entity.
+// If the Wishlist object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *WishlistMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown User edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// UserPreferenceMutation represents an operation that mutates the UserPreference nodes in the graph.
-type UserPreferenceMutation struct {
-	config
-	op                     Op
-	typ                    string
-	id                     *uuid.UUID
-	country                *string
-	currency               *string
-	language               *string
-	theme                  *string
-	sidebar_open           *bool
-	last_active_session_id *string
-	saved_search           *map[string]interface{}
-	created_at             *time.Time
-	updated_at             *time.Time
-	clearedFields          map[string]struct{}
-	user                   *uuid.UUID
-	cleareduser            bool
-	done                   bool
-	oldValue               func(context.Context) (*UserPreference, error)
-	predicates             []predicate.UserPreference
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *WishlistMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-var _ ent.Mutation = (*UserPreferenceMutation)(nil)
+// ClearUser clears the "user" edge to the User entity.
+func (m *WishlistMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[wishlist.FieldUserID] = struct{}{}
+}
 
-// userpreferenceOption allows management of the mutation configuration using functional options.
-type userpreferenceOption func(*UserPreferenceMutation)
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *WishlistMutation) UserCleared() bool {
+	return m.cleareduser
+}
 
-// newUserPreferenceMutation creates new mutation for the UserPreference entity.
-func newUserPreferenceMutation(c config, op Op, opts ...userpreferenceOption) *UserPreferenceMutation {
-	m := &UserPreferenceMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeUserPreference,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *WishlistMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
 	}
-	return m
+	return
 }
 
-// withUserPreferenceID sets the ID field of the mutation.
-func withUserPreferenceID(id uuid.UUID) userpreferenceOption {
-	return func(m *UserPreferenceMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *UserPreference
-		)
-		m.oldValue = func(ctx context.Context) (*UserPreference, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().UserPreference.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// ResetUser resets all changes to the "user" edge.
+func (m *WishlistMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// AddItemIDs adds the "items" edge to the WishlistItem entity by ids.
+func (m *WishlistMutation) AddItemIDs(ids ...uuid.UUID) {
+	if m.items == nil {
+		m.items = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.items[ids[i]] = struct{}{}
 	}
 }
 
-// withUserPreference sets the old UserPreference of the mutation.
-func withUserPreference(node *UserPreference) userpreferenceOption {
-	return func(m *UserPreferenceMutation) {
-		m.oldValue = func(context.Context) (*UserPreference, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// ClearItems clears the "items" edge to the WishlistItem entity.
+func (m *WishlistMutation) ClearItems() {
+	m.cleareditems = true
+}
+
+// ItemsCleared reports if the "items" edge to the WishlistItem entity was cleared.
+func (m *WishlistMutation) ItemsCleared() bool {
+	return m.cleareditems
+}
+
+// RemoveItemIDs removes the "items" edge to the WishlistItem entity by IDs.
+func (m *WishlistMutation) RemoveItemIDs(ids ...uuid.UUID) {
+	if m.removeditems == nil {
+		m.removeditems = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.items, ids[i])
+		m.removeditems[ids[i]] = struct{}{}
 	}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m UserPreferenceMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// RemovedItems returns the removed IDs of the "items" edge to the WishlistItem entity.
+func (m *WishlistMutation) RemovedItemsIDs() (ids []uuid.UUID) {
+	for id := range m.removeditems {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m UserPreferenceMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// ItemsIDs returns the "items" edge IDs in the mutation.
+func (m *WishlistMutation) ItemsIDs() (ids []uuid.UUID) {
+	for id := range m.items {
+		ids = append(ids, id)
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	return
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of UserPreference entities.
-func (m *UserPreferenceMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ResetItems resets all changes to the "items" edge.
+func (m *WishlistMutation) ResetItems() {
+	m.items = nil
+	m.cleareditems = false
+	m.removeditems = nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *UserPreferenceMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// Where appends a list predicates to the WishlistMutation builder.
+func (m *WishlistMutation) Where(ps ...predicate.Wishlist) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *UserPreferenceMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().UserPreference.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// WhereP appends storage-level predicates to the WishlistMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *WishlistMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Wishlist, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
+	m.Where(p...)
 }
 
-// SetUserID sets the "user_id" field.
-func (m *UserPreferenceMutation) SetUserID(u uuid.UUID) {
-	m.user = &u
+// Op returns the operation name.
+func (m *WishlistMutation) Op() Op {
+	return m.op
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *UserPreferenceMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.user
-	if v == nil {
-		return
-	}
-	return *v, true
+// SetOp allows setting the mutation operation.
+func (m *WishlistMutation) SetOp(op Op) {
+	m.op = op
 }
 
-// OldUserID returns the old "user_id" field's value of the UserPreference entity.
-// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserPreferenceMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+// Type returns the node type of this mutation (Wishlist).
+func (m *WishlistMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *WishlistMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.user != nil {
+		fields = append(fields, wishlist.FieldUserID)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+	if m.name != nil {
+		fields = append(fields, wishlist.FieldName)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	if m.shared != nil {
+		fields = append(fields, wishlist.FieldShared)
 	}
-	return oldValue.UserID, nil
+	if m.share_version != nil {
+		fields = append(fields, wishlist.FieldShareVersion)
+	}
+	if m.created_at != nil {
+		fields = append(fields, wishlist.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, wishlist.FieldUpdatedAt)
+	}
+	return fields
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *UserPreferenceMutation) ResetUserID() {
-	m.user = nil
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *WishlistMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case wishlist.FieldUserID:
+		return m.UserID()
+	case wishlist.FieldName:
+		return m.Name()
+	case wishlist.FieldShared:
+		return m.Shared()
+	case wishlist.FieldShareVersion:
+		return m.ShareVersion()
+	case wishlist.FieldCreatedAt:
+		return m.CreatedAt()
+	case wishlist.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
 }
 
-// SetCountry sets the "country" field.
-func (m *UserPreferenceMutation) SetCountry(s string) {
-	m.country = &s
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *WishlistMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case wishlist.FieldUserID:
+		return m.OldUserID(ctx)
+	case wishlist.FieldName:
+		return m.OldName(ctx)
+	case wishlist.FieldShared:
+		return m.OldShared(ctx)
+	case wishlist.FieldShareVersion:
+		return m.OldShareVersion(ctx)
+	case wishlist.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case wishlist.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Wishlist field %s", name)
 }
 
-// Country returns the value of the "country" field in the mutation.
-func (m *UserPreferenceMutation) Country() (r string, exists bool) {
-	v := m.country
-	if v == nil {
-		return
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WishlistMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case wishlist.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case wishlist.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case wishlist.FieldShared:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShared(v)
+		return nil
+	case wishlist.FieldShareVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetShareVersion(v)
+		return nil
+	case wishlist.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case wishlist.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown Wishlist field %s", name)
 }
 
-// OldCountry returns the old "country" field's value of the UserPreference entity.
-// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserPreferenceMutation) OldCountry(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCountry is only allowed on UpdateOne operations")
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *WishlistMutation) AddedFields() []string {
+	var fields []string
+	if m.addshare_version != nil {
+		fields = append(fields, wishlist.FieldShareVersion)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCountry requires an ID field in the mutation")
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *WishlistMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case wishlist.FieldShareVersion:
+		return m.AddedShareVersion()
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCountry: %w", err)
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *WishlistMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case wishlist.FieldShareVersion:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddShareVersion(v)
+		return nil
 	}
-	return oldValue.Country, nil
+	return fmt.Errorf("unknown Wishlist numeric field %s", name)
 }
 
-// ClearCountry clears the value of the "country" field.
-func (m *UserPreferenceMutation) ClearCountry() {
-	m.country = nil
-	m.clearedFields[userpreference.FieldCountry] = struct{}{}
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *WishlistMutation) ClearedFields() []string {
+	return nil
 }
 
-// CountryCleared returns if the "country" field was cleared in this mutation.
-func (m *UserPreferenceMutation) CountryCleared() bool {
-	_, ok := m.clearedFields[userpreference.FieldCountry]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *WishlistMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetCountry resets all changes to the "country" field.
-func (m *UserPreferenceMutation) ResetCountry() {
-	m.country = nil
-	delete(m.clearedFields, userpreference.FieldCountry)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *WishlistMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Wishlist nullable field %s", name)
 }
 
-// SetCurrency sets the "currency" field.
-func (m *UserPreferenceMutation) SetCurrency(s string) {
-	m.currency = &s
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *WishlistMutation) ResetField(name string) error {
+	switch name {
+	case wishlist.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case wishlist.FieldName:
+		m.ResetName()
+		return nil
+	case wishlist.FieldShared:
+		m.ResetShared()
+		return nil
+	case wishlist.FieldShareVersion:
+		m.ResetShareVersion()
+		return nil
+	case wishlist.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case wishlist.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Wishlist field %s", name)
 }
 
-// Currency returns the value of the "currency" field in the mutation.
-func (m *UserPreferenceMutation) Currency() (r string, exists bool) {
-	v := m.currency
-	if v == nil {
-		return
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *WishlistMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, wishlist.EdgeUser)
 	}
-	return *v, true
+	if m.items != nil {
+		edges = append(edges, wishlist.EdgeItems)
+	}
+	return edges
 }
 
-// OldCurrency returns the old "currency" field's value of the UserPreference entity.
-// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserPreferenceMutation) OldCurrency(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *WishlistMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case wishlist.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case wishlist.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.items))
+		for id := range m.items {
+			ids = append(ids, id)
+		}
+		return ids
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *WishlistMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removeditems != nil {
+		edges = append(edges, wishlist.EdgeItems)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *WishlistMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case wishlist.EdgeItems:
+		ids := make([]ent.Value, 0, len(m.removeditems))
+		for id := range m.removeditems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *WishlistMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, wishlist.EdgeUser)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	if m.cleareditems {
+		edges = append(edges, wishlist.EdgeItems)
 	}
-	return oldValue.Currency, nil
+	return edges
 }
 
-// ClearCurrency clears the value of the "currency" field.
-func (m *UserPreferenceMutation) ClearCurrency() {
-	m.currency = nil
-	m.clearedFields[userpreference.FieldCurrency] = struct{}{}
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *WishlistMutation) EdgeCleared(name string) bool {
+	switch name {
+	case wishlist.EdgeUser:
+		return m.cleareduser
+	case wishlist.EdgeItems:
+		return m.cleareditems
+	}
+	return false
 }
 
-// CurrencyCleared returns if the "currency" field was cleared in this mutation.
-func (m *UserPreferenceMutation) CurrencyCleared() bool {
-	_, ok := m.clearedFields[userpreference.FieldCurrency]
-	return ok
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *WishlistMutation) ClearEdge(name string) error {
+	switch name {
+	case wishlist.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Wishlist unique edge %s", name)
 }
 
-// ResetCurrency resets all changes to the "currency" field.
-func (m *UserPreferenceMutation) ResetCurrency() {
-	m.currency = nil
-	delete(m.clearedFields, userpreference.FieldCurrency)
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *WishlistMutation) ResetEdge(name string) error {
+	switch name {
+	case wishlist.EdgeUser:
+		m.ResetUser()
+		return nil
+	case wishlist.EdgeItems:
+		m.ResetItems()
+		return nil
+	}
+	return fmt.Errorf("unknown Wishlist edge %s", name)
 }
 
-// SetLanguage sets the "language" field.
-func (m *UserPreferenceMutation) SetLanguage(s string) {
-	m.language = &s
+// WishlistItemMutation represents an operation that mutates the WishlistItem nodes in the graph.
+type WishlistItemMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	page_token      *string
+	product_key     *string
+	product         *map[string]interface{}
+	note            *string
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	wishlist        *uuid.UUID
+	clearedwishlist bool
+	done            bool
+	oldValue        func(context.Context) (*WishlistItem, error)
+	predicates      []predicate.WishlistItem
+}
+
+var _ ent.Mutation = (*WishlistItemMutation)(nil)
+
+// wishlistitemOption allows management of the mutation configuration using functional options.
+type wishlistitemOption func(*WishlistItemMutation)
+
+// newWishlistItemMutation creates new mutation for the WishlistItem entity.
+func newWishlistItemMutation(c config, op Op, opts ...wishlistitemOption) *WishlistItemMutation {
+	m := &WishlistItemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeWishlistItem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// Language returns the value of the "language" field in the mutation.
-func (m *UserPreferenceMutation) Language() (r string, exists bool) {
-	v := m.language
-	if v == nil {
-		return
+// withWishlistItemID sets the ID field of the mutation.
+func withWishlistItemID(id uuid.UUID) wishlistitemOption {
+	return func(m *WishlistItemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *WishlistItem
+		)
+		m.oldValue = func(ctx context.Context) (*WishlistItem, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().WishlistItem.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return *v, true
 }
 
-// OldLanguage returns the old "language" field's value of the UserPreference entity.
-// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserPreferenceMutation) OldLanguage(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLanguage is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLanguage requires an ID field in the mutation")
+// withWishlistItem sets the old WishlistItem of the mutation.
+func withWishlistItem(node *WishlistItem) wishlistitemOption {
+	return func(m *WishlistItemMutation) {
+		m.oldValue = func(context.Context) (*WishlistItem, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLanguage: %w", err)
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m WishlistItemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m WishlistItemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return oldValue.Language, nil
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// ClearLanguage clears the value of the "language" field.
-func (m *UserPreferenceMutation) ClearLanguage() {
-	m.language = nil
-	m.clearedFields[userpreference.FieldLanguage] = struct{}{}
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of WishlistItem entities.
+func (m *WishlistItemMutation) SetID(id uuid.UUID) {
+	m.id = &id
 }
 
-// LanguageCleared returns if the "language" field was cleared in this mutation.
-func (m *UserPreferenceMutation) LanguageCleared() bool {
-	_, ok := m.clearedFields[userpreference.FieldLanguage]
-	return ok
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *WishlistItemMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
 }
 
-// ResetLanguage resets all changes to the "language" field.
-func (m *UserPreferenceMutation) ResetLanguage() {
-	m.language = nil
-	delete(m.clearedFields, userpreference.FieldLanguage)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *WishlistItemMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().WishlistItem.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetTheme sets the "theme" field.
-func (m *UserPreferenceMutation) SetTheme(s string) {
-	m.theme = &s
+// SetWishlistID sets the "wishlist_id" field.
+func (m *WishlistItemMutation) SetWishlistID(u uuid.UUID) {
+	m.wishlist = &u
 }
 
-// Theme returns the value of the "theme" field in the mutation.
-func (m *UserPreferenceMutation) Theme() (r string, exists bool) {
-	v := m.theme
+// WishlistID returns the value of the "wishlist_id" field in the mutation.
+func (m *WishlistItemMutation) WishlistID() (r uuid.UUID, exists bool) {
+	v := m.wishlist
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTheme returns the old "theme" field's value of the UserPreference entity.
-// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// OldWishlistID returns the old "wishlist_id" field's value of the WishlistItem entity.
+// If the WishlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserPreferenceMutation) OldTheme(ctx context.Context) (v *string, err error) {
+func (m *WishlistItemMutation) OldWishlistID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTheme is only allowed on UpdateOne operations")
+		return v, errors.New("OldWishlistID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTheme requires an ID field in the mutation")
+		return v, errors.New("OldWishlistID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTheme: %w", err)
+		return v, fmt.Errorf("querying old value for OldWishlistID: %w", err)
 	}
-	return oldValue.Theme, nil
-}
-
-// ClearTheme clears the value of the "theme" field.
-func (m *UserPreferenceMutation) ClearTheme() {
-	m.theme = nil
-	m.clearedFields[userpreference.FieldTheme] = struct{}{}
-}
-
-// ThemeCleared returns if the "theme" field was cleared in this mutation.
-func (m *UserPreferenceMutation) ThemeCleared() bool {
-	_, ok := m.clearedFields[userpreference.FieldTheme]
-	return ok
+	return oldValue.WishlistID, nil
 }
 
-// ResetTheme resets all changes to the "theme" field.
-func (m *UserPreferenceMutation) ResetTheme() {
-	m.theme = nil
-	delete(m.clearedFields, userpreference.FieldTheme)
+// ResetWishlistID resets all changes to the "wishlist_id" field.
+func (m *WishlistItemMutation) ResetWishlistID() {
+	m.wishlist = nil
 }
 
-// SetSidebarOpen sets the "sidebar_open" field.
-func (m *UserPreferenceMutation) SetSidebarOpen(b bool) {
-	m.sidebar_open = &b
+// SetPageToken sets the "page_token" field.
+func (m *WishlistItemMutation) SetPageToken(s string) {
+	m.page_token = &s
 }
 
-// SidebarOpen returns the value of the "sidebar_open" field in the mutation.
-func (m *UserPreferenceMutation) SidebarOpen() (r bool, exists bool) {
-	v := m.sidebar_open
+// PageToken returns the value of the "page_token" field in the mutation.
+func (m *WishlistItemMutation) PageToken() (r string, exists bool) {
+	v := m.page_token
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSidebarOpen returns the old "sidebar_open" field's value of the UserPreference entity.
-// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// OldPageToken returns the old "page_token" field's value of the WishlistItem entity.
+// If the WishlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserPreferenceMutation) OldSidebarOpen(ctx context.Context) (v *bool, err error) {
+func (m *WishlistItemMutation) OldPageToken(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSidebarOpen is only allowed on UpdateOne operations")
+		return v, errors.New("OldPageToken is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSidebarOpen requires an ID field in the mutation")
+		return v, errors.New("OldPageToken requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSidebarOpen: %w", err)
+		return v, fmt.Errorf("querying old value for OldPageToken: %w", err)
 	}
-	return oldValue.SidebarOpen, nil
+	return oldValue.PageToken, nil
 }
 
-// ClearSidebarOpen clears the value of the "sidebar_open" field.
-func (m *UserPreferenceMutation) ClearSidebarOpen() {
-	m.sidebar_open = nil
-	m.clearedFields[userpreference.FieldSidebarOpen] = struct{}{}
+// ClearPageToken clears the value of the "page_token" field.
+func (m *WishlistItemMutation) ClearPageToken() {
+	m.page_token = nil
+	m.clearedFields[wishlistitem.FieldPageToken] = struct{}{}
 }
 
-// SidebarOpenCleared returns if the "sidebar_open" field was cleared in this mutation.
-func (m *UserPreferenceMutation) SidebarOpenCleared() bool {
-	_, ok := m.clearedFields[userpreference.FieldSidebarOpen]
+// PageTokenCleared returns if the "page_token" field was cleared in this mutation.
+func (m *WishlistItemMutation) PageTokenCleared() bool {
+	_, ok := m.clearedFields[wishlistitem.FieldPageToken]
 	return ok
 }
 
-// ResetSidebarOpen resets all changes to the "sidebar_open" field.
-func (m *UserPreferenceMutation) ResetSidebarOpen() {
-	m.sidebar_open = nil
-	delete(m.clearedFields, userpreference.FieldSidebarOpen)
+// ResetPageToken resets all changes to the "page_token" field.
+func (m *WishlistItemMutation) ResetPageToken() {
+	m.page_token = nil
+	delete(m.clearedFields, wishlistitem.FieldPageToken)
 }
 
-// SetLastActiveSessionID sets the "last_active_session_id" field.
-func (m *UserPreferenceMutation) SetLastActiveSessionID(s string) {
-	m.last_active_session_id = &s
+// SetProductKey sets the "product_key" field.
+func (m *WishlistItemMutation) SetProductKey(s string) {
+	m.product_key = &s
 }
 
-// LastActiveSessionID returns the value of the "last_active_session_id" field in the mutation.
-func (m *UserPreferenceMutation) LastActiveSessionID() (r string, exists bool) {
-	v := m.last_active_session_id
+// ProductKey returns the value of the "product_key" field in the mutation.
+func (m *WishlistItemMutation) ProductKey() (r string, exists bool) {
+	v := m.product_key
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastActiveSessionID returns the old "last_active_session_id" field's value of the UserPreference entity.
-// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// OldProductKey returns the old "product_key" field's value of the WishlistItem entity.
+// If the WishlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserPreferenceMutation) OldLastActiveSessionID(ctx context.Context) (v *string, err error) {
+func (m *WishlistItemMutation) OldProductKey(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastActiveSessionID is only allowed on UpdateOne operations")
+		return v, errors.New("OldProductKey is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastActiveSessionID requires an ID field in the mutation")
+		return v, errors.New("OldProductKey requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastActiveSessionID: %w", err)
+		return v, fmt.Errorf("querying old value for OldProductKey: %w", err)
 	}
-	return oldValue.LastActiveSessionID, nil
+	return oldValue.ProductKey, nil
 }
 
-// ClearLastActiveSessionID clears the value of the "last_active_session_id" field.
-func (m *UserPreferenceMutation) ClearLastActiveSessionID() {
-	m.last_active_session_id = nil
-	m.clearedFields[userpreference.FieldLastActiveSessionID] = struct{}{}
+// ClearProductKey clears the value of the "product_key" field.
+func (m *WishlistItemMutation) ClearProductKey() {
+	m.product_key = nil
+	m.clearedFields[wishlistitem.FieldProductKey] = struct{}{}
 }
 
-// LastActiveSessionIDCleared returns if the "last_active_session_id" field was cleared in this mutation.
-func (m *UserPreferenceMutation) LastActiveSessionIDCleared() bool {
-	_, ok := m.clearedFields[userpreference.FieldLastActiveSessionID]
+// ProductKeyCleared returns if the "product_key" field was cleared in this mutation.
+func (m *WishlistItemMutation) ProductKeyCleared() bool {
+	_, ok := m.clearedFields[wishlistitem.FieldProductKey]
 	return ok
 }
 
-// ResetLastActiveSessionID resets all changes to the "last_active_session_id" field.
-func (m *UserPreferenceMutation) ResetLastActiveSessionID() {
-	m.last_active_session_id = nil
-	delete(m.clearedFields, userpreference.FieldLastActiveSessionID)
+// ResetProductKey resets all changes to the "product_key" field.
+func (m *WishlistItemMutation) ResetProductKey() {
+	m.product_key = nil
+	delete(m.clearedFields, wishlistitem.FieldProductKey)
 }
 
-// SetSavedSearch sets the "saved_search" field.
-func (m *UserPreferenceMutation) SetSavedSearch(value map[string]interface{}) {
-	m.saved_search = &value
+// SetProduct sets the "product" field.
+func (m *WishlistItemMutation) SetProduct(value map[string]interface{}) {
+	m.product = &value
 }
 
-// SavedSearch returns the value of the "saved_search" field in the mutation.
-func (m *UserPreferenceMutation) SavedSearch() (r map[string]interface{}, exists bool) {
-	v := m.saved_search
+// Product returns the value of the "product" field in the mutation.
+func (m *WishlistItemMutation) Product() (r map[string]interface{}, exists bool) {
+	v := m.product
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSavedSearch returns the old "saved_search" field's value of the UserPreference entity.
-// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// OldProduct returns the old "product" field's value of the WishlistItem entity.
+// If the WishlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserPreferenceMutation) OldSavedSearch(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *WishlistItemMutation) OldProduct(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSavedSearch is only allowed on UpdateOne operations")
+		return v, errors.New("OldProduct is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSavedSearch requires an ID field in the mutation")
+		return v, errors.New("OldProduct requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSavedSearch: %w", err)
+		return v, fmt.Errorf("querying old value for OldProduct: %w", err)
 	}
-	return oldValue.SavedSearch, nil
-}
-
-// ClearSavedSearch clears the value of the "saved_search" field.
-func (m *UserPreferenceMutation) ClearSavedSearch() {
-	m.saved_search = nil
-	m.clearedFields[userpreference.FieldSavedSearch] = struct{}{}
-}
-
-// SavedSearchCleared returns if the "saved_search" field was cleared in this mutation.
-func (m *UserPreferenceMutation) SavedSearchCleared() bool {
-	_, ok := m.clearedFields[userpreference.FieldSavedSearch]
-	return ok
+	return oldValue.Product, nil
 }
 
-// ResetSavedSearch resets all changes to the "saved_search" field.
-func (m *UserPreferenceMutation) ResetSavedSearch() {
-	m.saved_search = nil
-	delete(m.clearedFields, userpreference.FieldSavedSearch)
+// ResetProduct resets all changes to the "product" field.
+func (m *WishlistItemMutation) ResetProduct() {
+	m.product = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *UserPreferenceMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetNote sets the "note" field.
+func (m *WishlistItemMutation) SetNote(s string) {
+	m.note = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *UserPreferenceMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Note returns the value of the "note" field in the mutation.
+func (m *WishlistItemMutation) Note() (r string, exists bool) {
+	v := m.note
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the UserPreference entity.
-// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// OldNote returns the old "note" field's value of the WishlistItem entity.
+// If the WishlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserPreferenceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WishlistItemMutation) OldNote(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldNote is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldNote requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldNote: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Note, nil
+}
+
+// ClearNote clears the value of the "note" field.
+func (m *WishlistItemMutation) ClearNote() {
+	m.note = nil
+	m.clearedFields[wishlistitem.FieldNote] = struct{}{}
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *UserPreferenceMutation) ResetCreatedAt() {
-	m.created_at = nil
+// NoteCleared returns if the "note" field was cleared in this mutation.
+func (m *WishlistItemMutation) NoteCleared() bool {
+	_, ok := m.clearedFields[wishlistitem.FieldNote]
+	return ok
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *UserPreferenceMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// ResetNote resets all changes to the "note" field.
+func (m *WishlistItemMutation) ResetNote() {
+	m.note = nil
+	delete(m.clearedFields, wishlistitem.FieldNote)
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *UserPreferenceMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// SetCreatedAt sets the "created_at" field.
+func (m *WishlistItemMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *WishlistItemMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the UserPreference entity.
-// If the UserPreference object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the WishlistItem entity.
+// If the WishlistItem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *UserPreferenceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *WishlistItemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *UserPreferenceMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *WishlistItemMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *UserPreferenceMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[userpreference.FieldUserID] = struct{}{}
+// ClearWishlist clears the "wishlist" edge to the Wishlist entity.
+func (m *WishlistItemMutation) ClearWishlist() {
+	m.clearedwishlist = true
+	m.clearedFields[wishlistitem.FieldWishlistID] = struct{}{}
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *UserPreferenceMutation) UserCleared() bool {
-	return m.cleareduser
+// WishlistCleared reports if the "wishlist" edge to the Wishlist entity was cleared.
+func (m *WishlistItemMutation) WishlistCleared() bool {
+	return m.clearedwishlist
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
+// WishlistIDs returns the "wishlist" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *UserPreferenceMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
+// WishlistID instead. It exists only for internal usage by the builders.
+func (m *WishlistItemMutation) WishlistIDs() (ids []uuid.UUID) {
+	if id := m.wishlist; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *UserPreferenceMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// ResetWishlist resets all changes to the "wishlist" edge.
+func (m *WishlistItemMutation) ResetWishlist() {
+	m.wishlist = nil
+	m.clearedwishlist = false
 }
 
-// Where appends a list predicates to the UserPreferenceMutation builder.
-func (m *UserPreferenceMutation) Where(ps ...predicate.UserPreference) {
+// Where appends a list predicates to the WishlistItemMutation builder.
+func (m *WishlistItemMutation) Where(ps ...predicate.WishlistItem) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the UserPreferenceMutation builder. Using this method,
+// WhereP appends storage-level predicates to the WishlistItemMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *UserPreferenceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.UserPreference, len(ps))
+func (m *WishlistItemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.WishlistItem, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5050,54 +11988,42 @@ func (m *UserPreferenceMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *UserPreferenceMutation) Op() Op {
+func (m *WishlistItemMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *UserPreferenceMutation) SetOp(op Op) {
+func (m *WishlistItemMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (UserPreference).
-func (m *UserPreferenceMutation) Type() string {
+// Type returns the node type of this mutation (WishlistItem).
+func (m *WishlistItemMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *UserPreferenceMutation) Fields() []string {
-	fields := make([]string, 0, 10)
-	if m.user != nil {
-		fields = append(fields, userpreference.FieldUserID)
-	}
-	if m.country != nil {
-		fields = append(fields, userpreference.FieldCountry)
-	}
-	if m.currency != nil {
-		fields = append(fields, userpreference.FieldCurrency)
-	}
-	if m.language != nil {
-		fields = append(fields, userpreference.FieldLanguage)
+func (m *WishlistItemMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.wishlist != nil {
+		fields = append(fields, wishlistitem.FieldWishlistID)
 	}
-	if m.theme != nil {
-		fields = append(fields, userpreference.FieldTheme)
+	if m.page_token != nil {
+		fields = append(fields, wishlistitem.FieldPageToken)
 	}
-	if m.sidebar_open != nil {
-		fields = append(fields, userpreference.FieldSidebarOpen)
+	if m.product_key != nil {
+		fields = append(fields, wishlistitem.FieldProductKey)
 	}
-	if m.last_active_session_id != nil {
-		fields = append(fields, userpreference.FieldLastActiveSessionID)
+	if m.product != nil {
+		fields = append(fields, wishlistitem.FieldProduct)
 	}
-	if m.saved_search != nil {
-		fields = append(fields, userpreference.FieldSavedSearch)
+	if m.note != nil {
+		fields = append(fields, wishlistitem.FieldNote)
 	}
 	if m.created_at != nil {
-		fields = append(fields, userpreference.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, userpreference.FieldUpdatedAt)
+		fields = append(fields, wishlistitem.FieldCreatedAt)
 	}
 	return fields
 }
@@ -5105,28 +12031,20 @@ func (m *UserPreferenceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *UserPreferenceMutation) Field(name string) (ent.Value, bool) {
+func (m *WishlistItemMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case userpreference.FieldUserID:
-		return m.UserID()
-	case userpreference.FieldCountry:
-		return m.Country()
-	case userpreference.FieldCurrency:
-		return m.Currency()
-	case userpreference.FieldLanguage:
-		return m.Language()
-	case userpreference.FieldTheme:
-		return m.Theme()
-	case userpreference.FieldSidebarOpen:
-		return m.SidebarOpen()
-	case userpreference.FieldLastActiveSessionID:
-		return m.LastActiveSessionID()
-	case userpreference.FieldSavedSearch:
-		return m.SavedSearch()
-	case userpreference.FieldCreatedAt:
+	case wishlistitem.FieldWishlistID:
+		return m.WishlistID()
+	case wishlistitem.FieldPageToken:
+		return m.PageToken()
+	case wishlistitem.FieldProductKey:
+		return m.ProductKey()
+	case wishlistitem.FieldProduct:
+		return m.Product()
+	case wishlistitem.FieldNote:
+		return m.Note()
+	case wishlistitem.FieldCreatedAt:
 		return m.CreatedAt()
-	case userpreference.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -5134,250 +12052,178 @@ func (m *UserPreferenceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *UserPreferenceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *WishlistItemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case userpreference.FieldUserID:
-		return m.OldUserID(ctx)
-	case userpreference.FieldCountry:
-		return m.OldCountry(ctx)
-	case userpreference.FieldCurrency:
-		return m.OldCurrency(ctx)
-	case userpreference.FieldLanguage:
-		return m.OldLanguage(ctx)
-	case userpreference.FieldTheme:
-		return m.OldTheme(ctx)
-	case userpreference.FieldSidebarOpen:
-		return m.OldSidebarOpen(ctx)
-	case userpreference.FieldLastActiveSessionID:
-		return m.OldLastActiveSessionID(ctx)
-	case userpreference.FieldSavedSearch:
-		return m.OldSavedSearch(ctx)
-	case userpreference.FieldCreatedAt:
+	case wishlistitem.FieldWishlistID:
+		return m.OldWishlistID(ctx)
+	case wishlistitem.FieldPageToken:
+		return m.OldPageToken(ctx)
+	case wishlistitem.FieldProductKey:
+		return m.OldProductKey(ctx)
+	case wishlistitem.FieldProduct:
+		return m.OldProduct(ctx)
+	case wishlistitem.FieldNote:
+		return m.OldNote(ctx)
+	case wishlistitem.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case userpreference.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown UserPreference field %s", name)
+	return nil, fmt.Errorf("unknown WishlistItem field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserPreferenceMutation) SetField(name string, value ent.Value) error {
+func (m *WishlistItemMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case userpreference.FieldUserID:
+	case wishlistitem.FieldWishlistID:
 		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUserID(v)
-		return nil
-	case userpreference.FieldCountry:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCountry(v)
-		return nil
-	case userpreference.FieldCurrency:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCurrency(v)
+		m.SetWishlistID(v)
 		return nil
-	case userpreference.FieldLanguage:
+	case wishlistitem.FieldPageToken:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLanguage(v)
+		m.SetPageToken(v)
 		return nil
-	case userpreference.FieldTheme:
+	case wishlistitem.FieldProductKey:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTheme(v)
+		m.SetProductKey(v)
 		return nil
-	case userpreference.FieldSidebarOpen:
-		v, ok := value.(bool)
+	case wishlistitem.FieldProduct:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSidebarOpen(v)
+		m.SetProduct(v)
 		return nil
-	case userpreference.FieldLastActiveSessionID:
+	case wishlistitem.FieldNote:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastActiveSessionID(v)
-		return nil
-	case userpreference.FieldSavedSearch:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSavedSearch(v)
+		m.SetNote(v)
 		return nil
-	case userpreference.FieldCreatedAt:
+	case wishlistitem.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case userpreference.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
 	}
-	return fmt.Errorf("unknown UserPreference field %s", name)
+	return fmt.Errorf("unknown WishlistItem field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *UserPreferenceMutation) AddedFields() []string {
+func (m *WishlistItemMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *UserPreferenceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *WishlistItemMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *UserPreferenceMutation) AddField(name string, value ent.Value) error {
+func (m *WishlistItemMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown UserPreference numeric field %s", name)
+	return fmt.Errorf("unknown WishlistItem numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *UserPreferenceMutation) ClearedFields() []string {
+func (m *WishlistItemMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(userpreference.FieldCountry) {
-		fields = append(fields, userpreference.FieldCountry)
-	}
-	if m.FieldCleared(userpreference.FieldCurrency) {
-		fields = append(fields, userpreference.FieldCurrency)
-	}
-	if m.FieldCleared(userpreference.FieldLanguage) {
-		fields = append(fields, userpreference.FieldLanguage)
-	}
-	if m.FieldCleared(userpreference.FieldTheme) {
-		fields = append(fields, userpreference.FieldTheme)
-	}
-	if m.FieldCleared(userpreference.FieldSidebarOpen) {
-		fields = append(fields, userpreference.FieldSidebarOpen)
+	if m.FieldCleared(wishlistitem.FieldPageToken) {
+		fields = append(fields, wishlistitem.FieldPageToken)
 	}
-	if m.FieldCleared(userpreference.FieldLastActiveSessionID) {
-		fields = append(fields, userpreference.FieldLastActiveSessionID)
+	if m.FieldCleared(wishlistitem.FieldProductKey) {
+		fields = append(fields, wishlistitem.FieldProductKey)
 	}
-	if m.FieldCleared(userpreference.FieldSavedSearch) {
-		fields = append(fields, userpreference.FieldSavedSearch)
+	if m.FieldCleared(wishlistitem.FieldNote) {
+		fields = append(fields, wishlistitem.FieldNote)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *UserPreferenceMutation) FieldCleared(name string) bool {
+func (m *WishlistItemMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *UserPreferenceMutation) ClearField(name string) error {
+func (m *WishlistItemMutation) ClearField(name string) error {
 	switch name {
-	case userpreference.FieldCountry:
-		m.ClearCountry()
+	case wishlistitem.FieldPageToken:
+		m.ClearPageToken()
 		return nil
-	case userpreference.FieldCurrency:
-		m.ClearCurrency()
-		return nil
-	case userpreference.FieldLanguage:
-		m.ClearLanguage()
-		return nil
-	case userpreference.FieldTheme:
-		m.ClearTheme()
+	case wishlistitem.FieldProductKey:
+		m.ClearProductKey()
 		return nil
-	case userpreference.FieldSidebarOpen:
-		m.ClearSidebarOpen()
-		return nil
-	case userpreference.FieldLastActiveSessionID:
-		m.ClearLastActiveSessionID()
-		return nil
-	case userpreference.FieldSavedSearch:
-		m.ClearSavedSearch()
+	case wishlistitem.FieldNote:
+		m.ClearNote()
 		return nil
 	}
-	return fmt.Errorf("unknown UserPreference nullable field %s", name)
+	return fmt.Errorf("unknown WishlistItem nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *UserPreferenceMutation) ResetField(name string) error {
+func (m *WishlistItemMutation) ResetField(name string) error {
 	switch name {
-	case userpreference.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case userpreference.FieldCountry:
-		m.ResetCountry()
-		return nil
-	case userpreference.FieldCurrency:
-		m.ResetCurrency()
-		return nil
-	case userpreference.FieldLanguage:
-		m.ResetLanguage()
+	case wishlistitem.FieldWishlistID:
+		m.ResetWishlistID()
 		return nil
-	case userpreference.FieldTheme:
-		m.ResetTheme()
+	case wishlistitem.FieldPageToken:
+		m.ResetPageToken()
 		return nil
-	case userpreference.FieldSidebarOpen:
-		m.ResetSidebarOpen()
+	case wishlistitem.FieldProductKey:
+		m.ResetProductKey()
 		return nil
-	case userpreference.FieldLastActiveSessionID:
-		m.ResetLastActiveSessionID()
+	case wishlistitem.FieldProduct:
+		m.ResetProduct()
 		return nil
-	case userpreference.FieldSavedSearch:
-		m.ResetSavedSearch()
+	case wishlistitem.FieldNote:
+		m.ResetNote()
 		return nil
-	case userpreference.FieldCreatedAt:
+	case wishlistitem.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case userpreference.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
 	}
-	return fmt.Errorf("unknown UserPreference field %s", name)
+	return fmt.Errorf("unknown WishlistItem field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *UserPreferenceMutation) AddedEdges() []string {
+func (m *WishlistItemMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, userpreference.EdgeUser)
+	if m.wishlist != nil {
+		edges = append(edges, wishlistitem.EdgeWishlist)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *UserPreferenceMutation) AddedIDs(name string) []ent.Value {
+func (m *WishlistItemMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case userpreference.EdgeUser:
-		if id := m.user; id != nil {
+	case wishlistitem.EdgeWishlist:
+		if id := m.wishlist; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -5385,54 +12231,54 @@ func (m *UserPreferenceMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *UserPreferenceMutation) RemovedEdges() []string {
+func (m *WishlistItemMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *UserPreferenceMutation) RemovedIDs(name string) []ent.Value {
+func (m *WishlistItemMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *UserPreferenceMutation) ClearedEdges() []string {
+func (m *WishlistItemMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, userpreference.EdgeUser)
+	if m.clearedwishlist {
+		edges = append(edges, wishlistitem.EdgeWishlist)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *UserPreferenceMutation) EdgeCleared(name string) bool {
+func (m *WishlistItemMutation) EdgeCleared(name string) bool {
 	switch name {
-	case userpreference.EdgeUser:
-		return m.cleareduser
+	case wishlistitem.EdgeWishlist:
+		return m.clearedwishlist
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *UserPreferenceMutation) ClearEdge(name string) error {
+func (m *WishlistItemMutation) ClearEdge(name string) error {
 	switch name {
-	case userpreference.EdgeUser:
-		m.ClearUser()
+	case wishlistitem.EdgeWishlist:
+		m.ClearWishlist()
 		return nil
 	}
-	return fmt.Errorf("unknown UserPreference unique edge %s", name)
+	return fmt.Errorf("unknown WishlistItem unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *UserPreferenceMutation) ResetEdge(name string) error {
+func (m *WishlistItemMutation) ResetEdge(name string) error {
 	switch name {
-	case userpreference.EdgeUser:
-		m.ResetUser()
+	case wishlistitem.EdgeWishlist:
+		m.ResetWishlist()
 		return nil
 	}
-	return fmt.Errorf("unknown UserPreference edge %s", name)
+	return fmt.Errorf("unknown WishlistItem edge %s", name)
 }
@@ -9,14 +9,35 @@ import (
 // ChatSession is the predicate function for chatsession builders.
 type ChatSession func(*sql.Selector)
 
+// ConversationShare is the predicate function for conversationshare builders.
+type ConversationShare func(*sql.Selector)
+
+// ExchangeRate is the predicate function for exchangerate builders.
+type ExchangeRate func(*sql.Selector)
+
 // Message is the predicate function for message builders.
 type Message func(*sql.Selector)
 
+// PriceObservation is the predicate function for priceobservation builders.
+type PriceObservation func(*sql.Selector)
+
 // SearchHistory is the predicate function for searchhistory builders.
 type SearchHistory func(*sql.Selector)
 
+// TokenUsage is the predicate function for tokenusage builders.
+type TokenUsage func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
 
 // UserPreference is the predicate function for userpreference builders.
 type UserPreference func(*sql.Selector)
+
+// WatchlistItem is the predicate function for watchlistitem builders.
+type WatchlistItem func(*sql.Selector)
+
+// Wishlist is the predicate function for wishlist builders.
+type Wishlist func(*sql.Selector)
+
+// WishlistItem is the predicate function for wishlistitem builders.
+type WishlistItem func(*sql.Selector)
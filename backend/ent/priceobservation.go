@@ -0,0 +1,219 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mylittleprice/ent/priceobservation"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// PriceObservation is the model entity for the PriceObservation schema.
+type PriceObservation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// ProductKey holds the value of the "product_key" field.
+	ProductKey string `json:"product_key,omitempty"`
+	// Provider holds the value of the "provider" field.
+	Provider string `json:"provider,omitempty"`
+	// Source holds the value of the "source" field.
+	Source string `json:"source,omitempty"`
+	// ProductID holds the value of the "product_id" field.
+	ProductID string `json:"product_id,omitempty"`
+	// PageToken holds the value of the "page_token" field.
+	PageToken string `json:"page_token,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Merchant holds the value of the "merchant" field.
+	Merchant string `json:"merchant,omitempty"`
+	// Country holds the value of the "country" field.
+	Country string `json:"country,omitempty"`
+	// Amount holds the value of the "amount" field.
+	Amount int64 `json:"amount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// ObservedAt holds the value of the "observed_at" field.
+	ObservedAt   time.Time `json:"observed_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PriceObservation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case priceobservation.FieldAmount:
+			values[i] = new(sql.NullInt64)
+		case priceobservation.FieldProductKey, priceobservation.FieldProvider, priceobservation.FieldSource, priceobservation.FieldProductID, priceobservation.FieldPageToken, priceobservation.FieldTitle, priceobservation.FieldMerchant, priceobservation.FieldCountry, priceobservation.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case priceobservation.FieldObservedAt:
+			values[i] = new(sql.NullTime)
+		case priceobservation.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PriceObservation fields.
+func (_m *PriceObservation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case priceobservation.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case priceobservation.FieldProductKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field product_key", values[i])
+			} else if value.Valid {
+				_m.ProductKey = value.String
+			}
+		case priceobservation.FieldProvider:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field provider", values[i])
+			} else if value.Valid {
+				_m.Provider = value.String
+			}
+		case priceobservation.FieldSource:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source", values[i])
+			} else if value.Valid {
+				_m.Source = value.String
+			}
+		case priceobservation.FieldProductID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field product_id", values[i])
+			} else if value.Valid {
+				_m.ProductID = value.String
+			}
+		case priceobservation.FieldPageToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field page_token", values[i])
+			} else if value.Valid {
+				_m.PageToken = value.String
+			}
+		case priceobservation.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case priceobservation.FieldMerchant:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field merchant", values[i])
+			} else if value.Valid {
+				_m.Merchant = value.String
+			}
+		case priceobservation.FieldCountry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country", values[i])
+			} else if value.Valid {
+				_m.Country = value.String
+			}
+		case priceobservation.FieldAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field amount", values[i])
+			} else if value.Valid {
+				_m.Amount = value.Int64
+			}
+		case priceobservation.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case priceobservation.FieldObservedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field observed_at", values[i])
+			} else if value.Valid {
+				_m.ObservedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PriceObservation.
+// This includes values selected through modifiers, order, etc.
+func (_m *PriceObservation) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this PriceObservation.
+// Note that you need to call PriceObservation.Unwrap() before calling this method if this PriceObservation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PriceObservation) Update() *PriceObservationUpdateOne {
+	return NewPriceObservationClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PriceObservation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PriceObservation) Unwrap() *PriceObservation {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PriceObservation is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PriceObservation) String() string {
+	var builder strings.Builder
+	builder.WriteString("PriceObservation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("product_key=")
+	builder.WriteString(_m.ProductKey)
+	builder.WriteString(", ")
+	builder.WriteString("provider=")
+	builder.WriteString(_m.Provider)
+	builder.WriteString(", ")
+	builder.WriteString("source=")
+	builder.WriteString(_m.Source)
+	builder.WriteString(", ")
+	builder.WriteString("product_id=")
+	builder.WriteString(_m.ProductID)
+	builder.WriteString(", ")
+	builder.WriteString("page_token=")
+	builder.WriteString(_m.PageToken)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("merchant=")
+	builder.WriteString(_m.Merchant)
+	builder.WriteString(", ")
+	builder.WriteString("country=")
+	builder.WriteString(_m.Country)
+	builder.WriteString(", ")
+	builder.WriteString("amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Amount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("observed_at=")
+	builder.WriteString(_m.ObservedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PriceObservations is a parsable slice of PriceObservation.
+type PriceObservations []*PriceObservation
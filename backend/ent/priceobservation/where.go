@@ -0,0 +1,831 @@
+// Code generated by ent, DO NOT EDIT.
+
+package priceobservation
+
+import (
+	"mylittleprice/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLTE(FieldID, id))
+}
+
+// ProductKey applies equality check predicate on the "product_key" field. It's identical to ProductKeyEQ.
+func ProductKey(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldProductKey, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldProvider, v))
+}
+
+// Source applies equality check predicate on the "source" field. It's identical to SourceEQ.
+func Source(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldSource, v))
+}
+
+// ProductID applies equality check predicate on the "product_id" field. It's identical to ProductIDEQ.
+func ProductID(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldProductID, v))
+}
+
+// PageToken applies equality check predicate on the "page_token" field. It's identical to PageTokenEQ.
+func PageToken(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldPageToken, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldTitle, v))
+}
+
+// Merchant applies equality check predicate on the "merchant" field. It's identical to MerchantEQ.
+func Merchant(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldMerchant, v))
+}
+
+// Country applies equality check predicate on the "country" field. It's identical to CountryEQ.
+func Country(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldCountry, v))
+}
+
+// Amount applies equality check predicate on the "amount" field. It's identical to AmountEQ.
+func Amount(v int64) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldAmount, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldCurrency, v))
+}
+
+// ObservedAt applies equality check predicate on the "observed_at" field. It's identical to ObservedAtEQ.
+func ObservedAt(v time.Time) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldObservedAt, v))
+}
+
+// ProductKeyEQ applies the EQ predicate on the "product_key" field.
+func ProductKeyEQ(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldProductKey, v))
+}
+
+// ProductKeyNEQ applies the NEQ predicate on the "product_key" field.
+func ProductKeyNEQ(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNEQ(FieldProductKey, v))
+}
+
+// ProductKeyIn applies the In predicate on the "product_key" field.
+func ProductKeyIn(vs ...string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldIn(FieldProductKey, vs...))
+}
+
+// ProductKeyNotIn applies the NotIn predicate on the "product_key" field.
+func ProductKeyNotIn(vs ...string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNotIn(FieldProductKey, vs...))
+}
+
+// ProductKeyGT applies the GT predicate on the "product_key" field.
+func ProductKeyGT(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGT(FieldProductKey, v))
+}
+
+// ProductKeyGTE applies the GTE predicate on the "product_key" field.
+func ProductKeyGTE(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGTE(FieldProductKey, v))
+}
+
+// ProductKeyLT applies the LT predicate on the "product_key" field.
+func ProductKeyLT(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLT(FieldProductKey, v))
+}
+
+// ProductKeyLTE applies the LTE predicate on the "product_key" field.
+func ProductKeyLTE(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLTE(FieldProductKey, v))
+}
+
+// ProductKeyContains applies the Contains predicate on the "product_key" field.
+func ProductKeyContains(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldContains(FieldProductKey, v))
+}
+
+// ProductKeyHasPrefix applies the HasPrefix predicate on the "product_key" field.
+func ProductKeyHasPrefix(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldHasPrefix(FieldProductKey, v))
+}
+
+// ProductKeyHasSuffix applies the HasSuffix predicate on the "product_key" field.
+func ProductKeyHasSuffix(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldHasSuffix(FieldProductKey, v))
+}
+
+// ProductKeyEqualFold applies the EqualFold predicate on the "product_key" field.
+func ProductKeyEqualFold(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEqualFold(FieldProductKey, v))
+}
+
+// ProductKeyContainsFold applies the ContainsFold predicate on the "product_key" field.
+func ProductKeyContainsFold(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldContainsFold(FieldProductKey, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// SourceEQ applies the EQ predicate on the "source" field.
+func SourceEQ(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldSource, v))
+}
+
+// SourceNEQ applies the NEQ predicate on the "source" field.
+func SourceNEQ(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNEQ(FieldSource, v))
+}
+
+// SourceIn applies the In predicate on the "source" field.
+func SourceIn(vs ...string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldIn(FieldSource, vs...))
+}
+
+// SourceNotIn applies the NotIn predicate on the "source" field.
+func SourceNotIn(vs ...string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNotIn(FieldSource, vs...))
+}
+
+// SourceGT applies the GT predicate on the "source" field.
+func SourceGT(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGT(FieldSource, v))
+}
+
+// SourceGTE applies the GTE predicate on the "source" field.
+func SourceGTE(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGTE(FieldSource, v))
+}
+
+// SourceLT applies the LT predicate on the "source" field.
+func SourceLT(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLT(FieldSource, v))
+}
+
+// SourceLTE applies the LTE predicate on the "source" field.
+func SourceLTE(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLTE(FieldSource, v))
+}
+
+// SourceContains applies the Contains predicate on the "source" field.
+func SourceContains(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldContains(FieldSource, v))
+}
+
+// SourceHasPrefix applies the HasPrefix predicate on the "source" field.
+func SourceHasPrefix(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldHasPrefix(FieldSource, v))
+}
+
+// SourceHasSuffix applies the HasSuffix predicate on the "source" field.
+func SourceHasSuffix(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldHasSuffix(FieldSource, v))
+}
+
+// SourceEqualFold applies the EqualFold predicate on the "source" field.
+func SourceEqualFold(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEqualFold(FieldSource, v))
+}
+
+// SourceContainsFold applies the ContainsFold predicate on the "source" field.
+func SourceContainsFold(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldContainsFold(FieldSource, v))
+}
+
+// ProductIDEQ applies the EQ predicate on the "product_id" field.
+func ProductIDEQ(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldProductID, v))
+}
+
+// ProductIDNEQ applies the NEQ predicate on the "product_id" field.
+func ProductIDNEQ(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNEQ(FieldProductID, v))
+}
+
+// ProductIDIn applies the In predicate on the "product_id" field.
+func ProductIDIn(vs ...string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldIn(FieldProductID, vs...))
+}
+
+// ProductIDNotIn applies the NotIn predicate on the "product_id" field.
+func ProductIDNotIn(vs ...string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNotIn(FieldProductID, vs...))
+}
+
+// ProductIDGT applies the GT predicate on the "product_id" field.
+func ProductIDGT(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGT(FieldProductID, v))
+}
+
+// ProductIDGTE applies the GTE predicate on the "product_id" field.
+func ProductIDGTE(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGTE(FieldProductID, v))
+}
+
+// ProductIDLT applies the LT predicate on the "product_id" field.
+func ProductIDLT(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLT(FieldProductID, v))
+}
+
+// ProductIDLTE applies the LTE predicate on the "product_id" field.
+func ProductIDLTE(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLTE(FieldProductID, v))
+}
+
+// ProductIDContains applies the Contains predicate on the "product_id" field.
+func ProductIDContains(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldContains(FieldProductID, v))
+}
+
+// ProductIDHasPrefix applies the HasPrefix predicate on the "product_id" field.
+func ProductIDHasPrefix(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldHasPrefix(FieldProductID, v))
+}
+
+// ProductIDHasSuffix applies the HasSuffix predicate on the "product_id" field.
+func ProductIDHasSuffix(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldHasSuffix(FieldProductID, v))
+}
+
+// ProductIDIsNil applies the IsNil predicate on the "product_id" field.
+func ProductIDIsNil() predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldIsNull(FieldProductID))
+}
+
+// ProductIDNotNil applies the NotNil predicate on the "product_id" field.
+func ProductIDNotNil() predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNotNull(FieldProductID))
+}
+
+// ProductIDEqualFold applies the EqualFold predicate on the "product_id" field.
+func ProductIDEqualFold(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEqualFold(FieldProductID, v))
+}
+
+// ProductIDContainsFold applies the ContainsFold predicate on the "product_id" field.
+func ProductIDContainsFold(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldContainsFold(FieldProductID, v))
+}
+
+// PageTokenEQ applies the EQ predicate on the "page_token" field.
+func PageTokenEQ(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldPageToken, v))
+}
+
+// PageTokenNEQ applies the NEQ predicate on the "page_token" field.
+func PageTokenNEQ(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNEQ(FieldPageToken, v))
+}
+
+// PageTokenIn applies the In predicate on the "page_token" field.
+func PageTokenIn(vs ...string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldIn(FieldPageToken, vs...))
+}
+
+// PageTokenNotIn applies the NotIn predicate on the "page_token" field.
+func PageTokenNotIn(vs ...string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNotIn(FieldPageToken, vs...))
+}
+
+// PageTokenGT applies the GT predicate on the "page_token" field.
+func PageTokenGT(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGT(FieldPageToken, v))
+}
+
+// PageTokenGTE applies the GTE predicate on the "page_token" field.
+func PageTokenGTE(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGTE(FieldPageToken, v))
+}
+
+// PageTokenLT applies the LT predicate on the "page_token" field.
+func PageTokenLT(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLT(FieldPageToken, v))
+}
+
+// PageTokenLTE applies the LTE predicate on the "page_token" field.
+func PageTokenLTE(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLTE(FieldPageToken, v))
+}
+
+// PageTokenContains applies the Contains predicate on the "page_token" field.
+func PageTokenContains(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldContains(FieldPageToken, v))
+}
+
+// PageTokenHasPrefix applies the HasPrefix predicate on the "page_token" field.
+func PageTokenHasPrefix(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldHasPrefix(FieldPageToken, v))
+}
+
+// PageTokenHasSuffix applies the HasSuffix predicate on the "page_token" field.
+func PageTokenHasSuffix(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldHasSuffix(FieldPageToken, v))
+}
+
+// PageTokenIsNil applies the IsNil predicate on the "page_token" field.
+func PageTokenIsNil() predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldIsNull(FieldPageToken))
+}
+
+// PageTokenNotNil applies the NotNil predicate on the "page_token" field.
+func PageTokenNotNil() predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNotNull(FieldPageToken))
+}
+
+// PageTokenEqualFold applies the EqualFold predicate on the "page_token" field.
+func PageTokenEqualFold(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEqualFold(FieldPageToken, v))
+}
+
+// PageTokenContainsFold applies the ContainsFold predicate on the "page_token" field.
+func PageTokenContainsFold(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldContainsFold(FieldPageToken, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// MerchantEQ applies the EQ predicate on the "merchant" field.
+func MerchantEQ(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldMerchant, v))
+}
+
+// MerchantNEQ applies the NEQ predicate on the "merchant" field.
+func MerchantNEQ(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNEQ(FieldMerchant, v))
+}
+
+// MerchantIn applies the In predicate on the "merchant" field.
+func MerchantIn(vs ...string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldIn(FieldMerchant, vs...))
+}
+
+// MerchantNotIn applies the NotIn predicate on the "merchant" field.
+func MerchantNotIn(vs ...string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNotIn(FieldMerchant, vs...))
+}
+
+// MerchantGT applies the GT predicate on the "merchant" field.
+func MerchantGT(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGT(FieldMerchant, v))
+}
+
+// MerchantGTE applies the GTE predicate on the "merchant" field.
+func MerchantGTE(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGTE(FieldMerchant, v))
+}
+
+// MerchantLT applies the LT predicate on the "merchant" field.
+func MerchantLT(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLT(FieldMerchant, v))
+}
+
+// MerchantLTE applies the LTE predicate on the "merchant" field.
+func MerchantLTE(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLTE(FieldMerchant, v))
+}
+
+// MerchantContains applies the Contains predicate on the "merchant" field.
+func MerchantContains(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldContains(FieldMerchant, v))
+}
+
+// MerchantHasPrefix applies the HasPrefix predicate on the "merchant" field.
+func MerchantHasPrefix(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldHasPrefix(FieldMerchant, v))
+}
+
+// MerchantHasSuffix applies the HasSuffix predicate on the "merchant" field.
+func MerchantHasSuffix(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldHasSuffix(FieldMerchant, v))
+}
+
+// MerchantIsNil applies the IsNil predicate on the "merchant" field.
+func MerchantIsNil() predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldIsNull(FieldMerchant))
+}
+
+// MerchantNotNil applies the NotNil predicate on the "merchant" field.
+func MerchantNotNil() predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNotNull(FieldMerchant))
+}
+
+// MerchantEqualFold applies the EqualFold predicate on the "merchant" field.
+func MerchantEqualFold(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEqualFold(FieldMerchant, v))
+}
+
+// MerchantContainsFold applies the ContainsFold predicate on the "merchant" field.
+func MerchantContainsFold(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldContainsFold(FieldMerchant, v))
+}
+
+// CountryEQ applies the EQ predicate on the "country" field.
+func CountryEQ(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldCountry, v))
+}
+
+// CountryNEQ applies the NEQ predicate on the "country" field.
+func CountryNEQ(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNEQ(FieldCountry, v))
+}
+
+// CountryIn applies the In predicate on the "country" field.
+func CountryIn(vs ...string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldIn(FieldCountry, vs...))
+}
+
+// CountryNotIn applies the NotIn predicate on the "country" field.
+func CountryNotIn(vs ...string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNotIn(FieldCountry, vs...))
+}
+
+// CountryGT applies the GT predicate on the "country" field.
+func CountryGT(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGT(FieldCountry, v))
+}
+
+// CountryGTE applies the GTE predicate on the "country" field.
+func CountryGTE(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGTE(FieldCountry, v))
+}
+
+// CountryLT applies the LT predicate on the "country" field.
+func CountryLT(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLT(FieldCountry, v))
+}
+
+// CountryLTE applies the LTE predicate on the "country" field.
+func CountryLTE(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLTE(FieldCountry, v))
+}
+
+// CountryContains applies the Contains predicate on the "country" field.
+func CountryContains(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldContains(FieldCountry, v))
+}
+
+// CountryHasPrefix applies the HasPrefix predicate on the "country" field.
+func CountryHasPrefix(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldHasPrefix(FieldCountry, v))
+}
+
+// CountryHasSuffix applies the HasSuffix predicate on the "country" field.
+func CountryHasSuffix(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldHasSuffix(FieldCountry, v))
+}
+
+// CountryIsNil applies the IsNil predicate on the "country" field.
+func CountryIsNil() predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldIsNull(FieldCountry))
+}
+
+// CountryNotNil applies the NotNil predicate on the "country" field.
+func CountryNotNil() predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNotNull(FieldCountry))
+}
+
+// CountryEqualFold applies the EqualFold predicate on the "country" field.
+func CountryEqualFold(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEqualFold(FieldCountry, v))
+}
+
+// CountryContainsFold applies the ContainsFold predicate on the "country" field.
+func CountryContainsFold(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldContainsFold(FieldCountry, v))
+}
+
+// AmountEQ applies the EQ predicate on the "amount" field.
+func AmountEQ(v int64) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldAmount, v))
+}
+
+// AmountNEQ applies the NEQ predicate on the "amount" field.
+func AmountNEQ(v int64) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNEQ(FieldAmount, v))
+}
+
+// AmountIn applies the In predicate on the "amount" field.
+func AmountIn(vs ...int64) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldIn(FieldAmount, vs...))
+}
+
+// AmountNotIn applies the NotIn predicate on the "amount" field.
+func AmountNotIn(vs ...int64) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNotIn(FieldAmount, vs...))
+}
+
+// AmountGT applies the GT predicate on the "amount" field.
+func AmountGT(v int64) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGT(FieldAmount, v))
+}
+
+// AmountGTE applies the GTE predicate on the "amount" field.
+func AmountGTE(v int64) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGTE(FieldAmount, v))
+}
+
+// AmountLT applies the LT predicate on the "amount" field.
+func AmountLT(v int64) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLT(FieldAmount, v))
+}
+
+// AmountLTE applies the LTE predicate on the "amount" field.
+func AmountLTE(v int64) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLTE(FieldAmount, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// ObservedAtEQ applies the EQ predicate on the "observed_at" field.
+func ObservedAtEQ(v time.Time) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldEQ(FieldObservedAt, v))
+}
+
+// ObservedAtNEQ applies the NEQ predicate on the "observed_at" field.
+func ObservedAtNEQ(v time.Time) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNEQ(FieldObservedAt, v))
+}
+
+// ObservedAtIn applies the In predicate on the "observed_at" field.
+func ObservedAtIn(vs ...time.Time) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldIn(FieldObservedAt, vs...))
+}
+
+// ObservedAtNotIn applies the NotIn predicate on the "observed_at" field.
+func ObservedAtNotIn(vs ...time.Time) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldNotIn(FieldObservedAt, vs...))
+}
+
+// ObservedAtGT applies the GT predicate on the "observed_at" field.
+func ObservedAtGT(v time.Time) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGT(FieldObservedAt, v))
+}
+
+// ObservedAtGTE applies the GTE predicate on the "observed_at" field.
+func ObservedAtGTE(v time.Time) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldGTE(FieldObservedAt, v))
+}
+
+// ObservedAtLT applies the LT predicate on the "observed_at" field.
+func ObservedAtLT(v time.Time) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLT(FieldObservedAt, v))
+}
+
+// ObservedAtLTE applies the LTE predicate on the "observed_at" field.
+func ObservedAtLTE(v time.Time) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.FieldLTE(FieldObservedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PriceObservation) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PriceObservation) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PriceObservation) predicate.PriceObservation {
+	return predicate.PriceObservation(sql.NotPredicates(p))
+}
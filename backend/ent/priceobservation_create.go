@@ -0,0 +1,406 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mylittleprice/ent/priceobservation"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// PriceObservationCreate is the builder for creating a PriceObservation entity.
+type PriceObservationCreate struct {
+	config
+	mutation *PriceObservationMutation
+	hooks    []Hook
+}
+
+// SetProductKey sets the "product_key" field.
+func (_c *PriceObservationCreate) SetProductKey(v string) *PriceObservationCreate {
+	_c.mutation.SetProductKey(v)
+	return _c
+}
+
+// SetProvider sets the "provider" field.
+func (_c *PriceObservationCreate) SetProvider(v string) *PriceObservationCreate {
+	_c.mutation.SetProvider(v)
+	return _c
+}
+
+// SetSource sets the "source" field.
+func (_c *PriceObservationCreate) SetSource(v string) *PriceObservationCreate {
+	_c.mutation.SetSource(v)
+	return _c
+}
+
+// SetProductID sets the "product_id" field.
+func (_c *PriceObservationCreate) SetProductID(v string) *PriceObservationCreate {
+	_c.mutation.SetProductID(v)
+	return _c
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (_c *PriceObservationCreate) SetNillableProductID(v *string) *PriceObservationCreate {
+	if v != nil {
+		_c.SetProductID(*v)
+	}
+	return _c
+}
+
+// SetPageToken sets the "page_token" field.
+func (_c *PriceObservationCreate) SetPageToken(v string) *PriceObservationCreate {
+	_c.mutation.SetPageToken(v)
+	return _c
+}
+
+// SetNillablePageToken sets the "page_token" field if the given value is not nil.
+func (_c *PriceObservationCreate) SetNillablePageToken(v *string) *PriceObservationCreate {
+	if v != nil {
+		_c.SetPageToken(*v)
+	}
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *PriceObservationCreate) SetTitle(v string) *PriceObservationCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetMerchant sets the "merchant" field.
+func (_c *PriceObservationCreate) SetMerchant(v string) *PriceObservationCreate {
+	_c.mutation.SetMerchant(v)
+	return _c
+}
+
+// SetNillableMerchant sets the "merchant" field if the given value is not nil.
+func (_c *PriceObservationCreate) SetNillableMerchant(v *string) *PriceObservationCreate {
+	if v != nil {
+		_c.SetMerchant(*v)
+	}
+	return _c
+}
+
+// SetCountry sets the "country" field.
+func (_c *PriceObservationCreate) SetCountry(v string) *PriceObservationCreate {
+	_c.mutation.SetCountry(v)
+	return _c
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_c *PriceObservationCreate) SetNillableCountry(v *string) *PriceObservationCreate {
+	if v != nil {
+		_c.SetCountry(*v)
+	}
+	return _c
+}
+
+// SetAmount sets the "amount" field.
+func (_c *PriceObservationCreate) SetAmount(v int64) *PriceObservationCreate {
+	_c.mutation.SetAmount(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *PriceObservationCreate) SetCurrency(v string) *PriceObservationCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetObservedAt sets the "observed_at" field.
+func (_c *PriceObservationCreate) SetObservedAt(v time.Time) *PriceObservationCreate {
+	_c.mutation.SetObservedAt(v)
+	return _c
+}
+
+// SetNillableObservedAt sets the "observed_at" field if the given value is not nil.
+func (_c *PriceObservationCreate) SetNillableObservedAt(v *time.Time) *PriceObservationCreate {
+	if v != nil {
+		_c.SetObservedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PriceObservationCreate) SetID(v uuid.UUID) *PriceObservationCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PriceObservationCreate) SetNillableID(v *uuid.UUID) *PriceObservationCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the PriceObservationMutation object of the builder.
+func (_c *PriceObservationCreate) Mutation() *PriceObservationMutation {
+	return _c.mutation
+}
+
+// Save creates the PriceObservation in the database.
+func (_c *PriceObservationCreate) Save(ctx context.Context) (*PriceObservation, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PriceObservationCreate) SaveX(ctx context.Context) *PriceObservation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PriceObservationCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PriceObservationCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PriceObservationCreate) defaults() {
+	if _, ok := _c.mutation.ObservedAt(); !ok {
+		v := priceobservation.DefaultObservedAt()
+		_c.mutation.SetObservedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := priceobservation.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PriceObservationCreate) check() error {
+	if _, ok := _c.mutation.ProductKey(); !ok {
+		return &ValidationError{Name: "product_key", err: errors.New(`ent: missing required field "PriceObservation.product_key"`)}
+	}
+	if v, ok := _c.mutation.ProductKey(); ok {
+		if err := priceobservation.ProductKeyValidator(v); err != nil {
+			return &ValidationError{Name: "product_key", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.product_key": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Provider(); !ok {
+		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "PriceObservation.provider"`)}
+	}
+	if v, ok := _c.mutation.Provider(); ok {
+		if err := priceobservation.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.provider": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Source(); !ok {
+		return &ValidationError{Name: "source", err: errors.New(`ent: missing required field "PriceObservation.source"`)}
+	}
+	if v, ok := _c.mutation.Source(); ok {
+		if err := priceobservation.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.source": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "PriceObservation.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := priceobservation.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Amount(); !ok {
+		return &ValidationError{Name: "amount", err: errors.New(`ent: missing required field "PriceObservation.amount"`)}
+	}
+	if v, ok := _c.mutation.Amount(); ok {
+		if err := priceobservation.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "PriceObservation.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := priceobservation.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.currency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ObservedAt(); !ok {
+		return &ValidationError{Name: "observed_at", err: errors.New(`ent: missing required field "PriceObservation.observed_at"`)}
+	}
+	return nil
+}
+
+func (_c *PriceObservationCreate) sqlSave(ctx context.Context) (*PriceObservation, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PriceObservationCreate) createSpec() (*PriceObservation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PriceObservation{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(priceobservation.Table, sqlgraph.NewFieldSpec(priceobservation.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.ProductKey(); ok {
+		_spec.SetField(priceobservation.FieldProductKey, field.TypeString, value)
+		_node.ProductKey = value
+	}
+	if value, ok := _c.mutation.Provider(); ok {
+		_spec.SetField(priceobservation.FieldProvider, field.TypeString, value)
+		_node.Provider = value
+	}
+	if value, ok := _c.mutation.Source(); ok {
+		_spec.SetField(priceobservation.FieldSource, field.TypeString, value)
+		_node.Source = value
+	}
+	if value, ok := _c.mutation.ProductID(); ok {
+		_spec.SetField(priceobservation.FieldProductID, field.TypeString, value)
+		_node.ProductID = value
+	}
+	if value, ok := _c.mutation.PageToken(); ok {
+		_spec.SetField(priceobservation.FieldPageToken, field.TypeString, value)
+		_node.PageToken = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(priceobservation.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Merchant(); ok {
+		_spec.SetField(priceobservation.FieldMerchant, field.TypeString, value)
+		_node.Merchant = value
+	}
+	if value, ok := _c.mutation.Country(); ok {
+		_spec.SetField(priceobservation.FieldCountry, field.TypeString, value)
+		_node.Country = value
+	}
+	if value, ok := _c.mutation.Amount(); ok {
+		_spec.SetField(priceobservation.FieldAmount, field.TypeInt64, value)
+		_node.Amount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(priceobservation.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.ObservedAt(); ok {
+		_spec.SetField(priceobservation.FieldObservedAt, field.TypeTime, value)
+		_node.ObservedAt = value
+	}
+	return _node, _spec
+}
+
+// PriceObservationCreateBulk is the builder for creating many PriceObservation entities in bulk.
+type PriceObservationCreateBulk struct {
+	config
+	err      error
+	builders []*PriceObservationCreate
+}
+
+// Save creates the PriceObservation entities in the database.
+func (_c *PriceObservationCreateBulk) Save(ctx context.Context) ([]*PriceObservation, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PriceObservation, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PriceObservationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PriceObservationCreateBulk) SaveX(ctx context.Context) []*PriceObservation {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PriceObservationCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PriceObservationCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
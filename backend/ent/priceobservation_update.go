@@ -0,0 +1,683 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mylittleprice/ent/predicate"
+	"mylittleprice/ent/priceobservation"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+)
+
+// PriceObservationUpdate is the builder for updating PriceObservation entities.
+type PriceObservationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PriceObservationMutation
+}
+
+// Where appends a list predicates to the PriceObservationUpdate builder.
+func (_u *PriceObservationUpdate) Where(ps ...predicate.PriceObservation) *PriceObservationUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetProductKey sets the "product_key" field.
+func (_u *PriceObservationUpdate) SetProductKey(v string) *PriceObservationUpdate {
+	_u.mutation.SetProductKey(v)
+	return _u
+}
+
+// SetNillableProductKey sets the "product_key" field if the given value is not nil.
+func (_u *PriceObservationUpdate) SetNillableProductKey(v *string) *PriceObservationUpdate {
+	if v != nil {
+		_u.SetProductKey(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *PriceObservationUpdate) SetProvider(v string) *PriceObservationUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *PriceObservationUpdate) SetNillableProvider(v *string) *PriceObservationUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *PriceObservationUpdate) SetSource(v string) *PriceObservationUpdate {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *PriceObservationUpdate) SetNillableSource(v *string) *PriceObservationUpdate {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetProductID sets the "product_id" field.
+func (_u *PriceObservationUpdate) SetProductID(v string) *PriceObservationUpdate {
+	_u.mutation.SetProductID(v)
+	return _u
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (_u *PriceObservationUpdate) SetNillableProductID(v *string) *PriceObservationUpdate {
+	if v != nil {
+		_u.SetProductID(*v)
+	}
+	return _u
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (_u *PriceObservationUpdate) ClearProductID() *PriceObservationUpdate {
+	_u.mutation.ClearProductID()
+	return _u
+}
+
+// SetPageToken sets the "page_token" field.
+func (_u *PriceObservationUpdate) SetPageToken(v string) *PriceObservationUpdate {
+	_u.mutation.SetPageToken(v)
+	return _u
+}
+
+// SetNillablePageToken sets the "page_token" field if the given value is not nil.
+func (_u *PriceObservationUpdate) SetNillablePageToken(v *string) *PriceObservationUpdate {
+	if v != nil {
+		_u.SetPageToken(*v)
+	}
+	return _u
+}
+
+// ClearPageToken clears the value of the "page_token" field.
+func (_u *PriceObservationUpdate) ClearPageToken() *PriceObservationUpdate {
+	_u.mutation.ClearPageToken()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *PriceObservationUpdate) SetTitle(v string) *PriceObservationUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *PriceObservationUpdate) SetNillableTitle(v *string) *PriceObservationUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetMerchant sets the "merchant" field.
+func (_u *PriceObservationUpdate) SetMerchant(v string) *PriceObservationUpdate {
+	_u.mutation.SetMerchant(v)
+	return _u
+}
+
+// SetNillableMerchant sets the "merchant" field if the given value is not nil.
+func (_u *PriceObservationUpdate) SetNillableMerchant(v *string) *PriceObservationUpdate {
+	if v != nil {
+		_u.SetMerchant(*v)
+	}
+	return _u
+}
+
+// ClearMerchant clears the value of the "merchant" field.
+func (_u *PriceObservationUpdate) ClearMerchant() *PriceObservationUpdate {
+	_u.mutation.ClearMerchant()
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *PriceObservationUpdate) SetCountry(v string) *PriceObservationUpdate {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *PriceObservationUpdate) SetNillableCountry(v *string) *PriceObservationUpdate {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// ClearCountry clears the value of the "country" field.
+func (_u *PriceObservationUpdate) ClearCountry() *PriceObservationUpdate {
+	_u.mutation.ClearCountry()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *PriceObservationUpdate) SetAmount(v int64) *PriceObservationUpdate {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *PriceObservationUpdate) SetNillableAmount(v *int64) *PriceObservationUpdate {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *PriceObservationUpdate) AddAmount(v int64) *PriceObservationUpdate {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PriceObservationUpdate) SetCurrency(v string) *PriceObservationUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PriceObservationUpdate) SetNillableCurrency(v *string) *PriceObservationUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PriceObservationMutation object of the builder.
+func (_u *PriceObservationUpdate) Mutation() *PriceObservationMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PriceObservationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PriceObservationUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PriceObservationUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PriceObservationUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PriceObservationUpdate) check() error {
+	if v, ok := _u.mutation.ProductKey(); ok {
+		if err := priceobservation.ProductKeyValidator(v); err != nil {
+			return &ValidationError{Name: "product_key", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.product_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := priceobservation.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := priceobservation.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := priceobservation.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Amount(); ok {
+		if err := priceobservation.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := priceobservation.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.currency": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PriceObservationUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(priceobservation.Table, priceobservation.Columns, sqlgraph.NewFieldSpec(priceobservation.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ProductKey(); ok {
+		_spec.SetField(priceobservation.FieldProductKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(priceobservation.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(priceobservation.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ProductID(); ok {
+		_spec.SetField(priceobservation.FieldProductID, field.TypeString, value)
+	}
+	if _u.mutation.ProductIDCleared() {
+		_spec.ClearField(priceobservation.FieldProductID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PageToken(); ok {
+		_spec.SetField(priceobservation.FieldPageToken, field.TypeString, value)
+	}
+	if _u.mutation.PageTokenCleared() {
+		_spec.ClearField(priceobservation.FieldPageToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(priceobservation.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Merchant(); ok {
+		_spec.SetField(priceobservation.FieldMerchant, field.TypeString, value)
+	}
+	if _u.mutation.MerchantCleared() {
+		_spec.ClearField(priceobservation.FieldMerchant, field.TypeString)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(priceobservation.FieldCountry, field.TypeString, value)
+	}
+	if _u.mutation.CountryCleared() {
+		_spec.ClearField(priceobservation.FieldCountry, field.TypeString)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(priceobservation.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(priceobservation.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(priceobservation.FieldCurrency, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{priceobservation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PriceObservationUpdateOne is the builder for updating a single PriceObservation entity.
+type PriceObservationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PriceObservationMutation
+}
+
+// SetProductKey sets the "product_key" field.
+func (_u *PriceObservationUpdateOne) SetProductKey(v string) *PriceObservationUpdateOne {
+	_u.mutation.SetProductKey(v)
+	return _u
+}
+
+// SetNillableProductKey sets the "product_key" field if the given value is not nil.
+func (_u *PriceObservationUpdateOne) SetNillableProductKey(v *string) *PriceObservationUpdateOne {
+	if v != nil {
+		_u.SetProductKey(*v)
+	}
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *PriceObservationUpdateOne) SetProvider(v string) *PriceObservationUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *PriceObservationUpdateOne) SetNillableProvider(v *string) *PriceObservationUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetSource sets the "source" field.
+func (_u *PriceObservationUpdateOne) SetSource(v string) *PriceObservationUpdateOne {
+	_u.mutation.SetSource(v)
+	return _u
+}
+
+// SetNillableSource sets the "source" field if the given value is not nil.
+func (_u *PriceObservationUpdateOne) SetNillableSource(v *string) *PriceObservationUpdateOne {
+	if v != nil {
+		_u.SetSource(*v)
+	}
+	return _u
+}
+
+// SetProductID sets the "product_id" field.
+func (_u *PriceObservationUpdateOne) SetProductID(v string) *PriceObservationUpdateOne {
+	_u.mutation.SetProductID(v)
+	return _u
+}
+
+// SetNillableProductID sets the "product_id" field if the given value is not nil.
+func (_u *PriceObservationUpdateOne) SetNillableProductID(v *string) *PriceObservationUpdateOne {
+	if v != nil {
+		_u.SetProductID(*v)
+	}
+	return _u
+}
+
+// ClearProductID clears the value of the "product_id" field.
+func (_u *PriceObservationUpdateOne) ClearProductID() *PriceObservationUpdateOne {
+	_u.mutation.ClearProductID()
+	return _u
+}
+
+// SetPageToken sets the "page_token" field.
+func (_u *PriceObservationUpdateOne) SetPageToken(v string) *PriceObservationUpdateOne {
+	_u.mutation.SetPageToken(v)
+	return _u
+}
+
+// SetNillablePageToken sets the "page_token" field if the given value is not nil.
+func (_u *PriceObservationUpdateOne) SetNillablePageToken(v *string) *PriceObservationUpdateOne {
+	if v != nil {
+		_u.SetPageToken(*v)
+	}
+	return _u
+}
+
+// ClearPageToken clears the value of the "page_token" field.
+func (_u *PriceObservationUpdateOne) ClearPageToken() *PriceObservationUpdateOne {
+	_u.mutation.ClearPageToken()
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *PriceObservationUpdateOne) SetTitle(v string) *PriceObservationUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *PriceObservationUpdateOne) SetNillableTitle(v *string) *PriceObservationUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetMerchant sets the "merchant" field.
+func (_u *PriceObservationUpdateOne) SetMerchant(v string) *PriceObservationUpdateOne {
+	_u.mutation.SetMerchant(v)
+	return _u
+}
+
+// SetNillableMerchant sets the "merchant" field if the given value is not nil.
+func (_u *PriceObservationUpdateOne) SetNillableMerchant(v *string) *PriceObservationUpdateOne {
+	if v != nil {
+		_u.SetMerchant(*v)
+	}
+	return _u
+}
+
+// ClearMerchant clears the value of the "merchant" field.
+func (_u *PriceObservationUpdateOne) ClearMerchant() *PriceObservationUpdateOne {
+	_u.mutation.ClearMerchant()
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *PriceObservationUpdateOne) SetCountry(v string) *PriceObservationUpdateOne {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *PriceObservationUpdateOne) SetNillableCountry(v *string) *PriceObservationUpdateOne {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// ClearCountry clears the value of the "country" field.
+func (_u *PriceObservationUpdateOne) ClearCountry() *PriceObservationUpdateOne {
+	_u.mutation.ClearCountry()
+	return _u
+}
+
+// SetAmount sets the "amount" field.
+func (_u *PriceObservationUpdateOne) SetAmount(v int64) *PriceObservationUpdateOne {
+	_u.mutation.ResetAmount()
+	_u.mutation.SetAmount(v)
+	return _u
+}
+
+// SetNillableAmount sets the "amount" field if the given value is not nil.
+func (_u *PriceObservationUpdateOne) SetNillableAmount(v *int64) *PriceObservationUpdateOne {
+	if v != nil {
+		_u.SetAmount(*v)
+	}
+	return _u
+}
+
+// AddAmount adds value to the "amount" field.
+func (_u *PriceObservationUpdateOne) AddAmount(v int64) *PriceObservationUpdateOne {
+	_u.mutation.AddAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *PriceObservationUpdateOne) SetCurrency(v string) *PriceObservationUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *PriceObservationUpdateOne) SetNillableCurrency(v *string) *PriceObservationUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// Mutation returns the PriceObservationMutation object of the builder.
+func (_u *PriceObservationUpdateOne) Mutation() *PriceObservationMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the PriceObservationUpdate builder.
+func (_u *PriceObservationUpdateOne) Where(ps ...predicate.PriceObservation) *PriceObservationUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PriceObservationUpdateOne) Select(field string, fields ...string) *PriceObservationUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PriceObservation entity.
+func (_u *PriceObservationUpdateOne) Save(ctx context.Context) (*PriceObservation, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PriceObservationUpdateOne) SaveX(ctx context.Context) *PriceObservation {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PriceObservationUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PriceObservationUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PriceObservationUpdateOne) check() error {
+	if v, ok := _u.mutation.ProductKey(); ok {
+		if err := priceobservation.ProductKeyValidator(v); err != nil {
+			return &ValidationError{Name: "product_key", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.product_key": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := priceobservation.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Source(); ok {
+		if err := priceobservation.SourceValidator(v); err != nil {
+			return &ValidationError{Name: "source", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.source": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := priceobservation.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Amount(); ok {
+		if err := priceobservation.AmountValidator(v); err != nil {
+			return &ValidationError{Name: "amount", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := priceobservation.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "PriceObservation.currency": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *PriceObservationUpdateOne) sqlSave(ctx context.Context) (_node *PriceObservation, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(priceobservation.Table, priceobservation.Columns, sqlgraph.NewFieldSpec(priceobservation.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PriceObservation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, priceobservation.FieldID)
+		for _, f := range fields {
+			if !priceobservation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != priceobservation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ProductKey(); ok {
+		_spec.SetField(priceobservation.FieldProductKey, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(priceobservation.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Source(); ok {
+		_spec.SetField(priceobservation.FieldSource, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ProductID(); ok {
+		_spec.SetField(priceobservation.FieldProductID, field.TypeString, value)
+	}
+	if _u.mutation.ProductIDCleared() {
+		_spec.ClearField(priceobservation.FieldProductID, field.TypeString)
+	}
+	if value, ok := _u.mutation.PageToken(); ok {
+		_spec.SetField(priceobservation.FieldPageToken, field.TypeString, value)
+	}
+	if _u.mutation.PageTokenCleared() {
+		_spec.ClearField(priceobservation.FieldPageToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(priceobservation.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Merchant(); ok {
+		_spec.SetField(priceobservation.FieldMerchant, field.TypeString, value)
+	}
+	if _u.mutation.MerchantCleared() {
+		_spec.ClearField(priceobservation.FieldMerchant, field.TypeString)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(priceobservation.FieldCountry, field.TypeString, value)
+	}
+	if _u.mutation.CountryCleared() {
+		_spec.ClearField(priceobservation.FieldCountry, field.TypeString)
+	}
+	if value, ok := _u.mutation.Amount(); ok {
+		_spec.SetField(priceobservation.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedAmount(); ok {
+		_spec.AddField(priceobservation.FieldAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(priceobservation.FieldCurrency, field.TypeString, value)
+	}
+	_node = &PriceObservation{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{priceobservation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -4,11 +4,18 @@ package ent
 
 import (
 	"mylittleprice/ent/chatsession"
+	"mylittleprice/ent/conversationshare"
+	"mylittleprice/ent/exchangerate"
 	"mylittleprice/ent/message"
+	"mylittleprice/ent/priceobservation"
 	"mylittleprice/ent/schema"
 	"mylittleprice/ent/searchhistory"
+	"mylittleprice/ent/tokenusage"
 	"mylittleprice/ent/user"
 	"mylittleprice/ent/userpreference"
+	"mylittleprice/ent/watchlistitem"
+	"mylittleprice/ent/wishlist"
+	"mylittleprice/ent/wishlistitem"
 	"time"
 
 	"github.com/google/uuid"
@@ -66,6 +73,44 @@ func init() {
 	chatsessionDescID := chatsessionFields[0].Descriptor()
 	// chatsession.DefaultID holds the default value on creation for the id field.
 	chatsession.DefaultID = chatsessionDescID.Default.(func() uuid.UUID)
+	conversationshareFields := schema.ConversationShare{}.Fields()
+	_ = conversationshareFields
+	// conversationshareDescViewCount is the schema descriptor for view_count field.
+	conversationshareDescViewCount := conversationshareFields[7].Descriptor()
+	// conversationshare.DefaultViewCount holds the default value on creation for the view_count field.
+	conversationshare.DefaultViewCount = conversationshareDescViewCount.Default.(int)
+	// conversationshareDescCreatedAt is the schema descriptor for created_at field.
+	conversationshareDescCreatedAt := conversationshareFields[10].Descriptor()
+	// conversationshare.DefaultCreatedAt holds the default value on creation for the created_at field.
+	conversationshare.DefaultCreatedAt = conversationshareDescCreatedAt.Default.(func() time.Time)
+	// conversationshareDescID is the schema descriptor for id field.
+	conversationshareDescID := conversationshareFields[0].Descriptor()
+	// conversationshare.DefaultID holds the default value on creation for the id field.
+	conversationshare.DefaultID = conversationshareDescID.Default.(func() uuid.UUID)
+	exchangerateFields := schema.ExchangeRate{}.Fields()
+	_ = exchangerateFields
+	// exchangerateDescCurrency is the schema descriptor for currency field.
+	exchangerateDescCurrency := exchangerateFields[1].Descriptor()
+	// exchangerate.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	exchangerate.CurrencyValidator = exchangerateDescCurrency.Validators[0].(func(string) error)
+	// exchangerateDescRate is the schema descriptor for rate field.
+	exchangerateDescRate := exchangerateFields[2].Descriptor()
+	// exchangerate.RateValidator is a validator for the "rate" field. It is called by the builders before save.
+	exchangerate.RateValidator = exchangerateDescRate.Validators[0].(func(float64) error)
+	// exchangerateDescSource is the schema descriptor for source field.
+	exchangerateDescSource := exchangerateFields[3].Descriptor()
+	// exchangerate.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	exchangerate.SourceValidator = exchangerateDescSource.Validators[0].(func(string) error)
+	// exchangerateDescUpdatedAt is the schema descriptor for updated_at field.
+	exchangerateDescUpdatedAt := exchangerateFields[4].Descriptor()
+	// exchangerate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	exchangerate.DefaultUpdatedAt = exchangerateDescUpdatedAt.Default.(func() time.Time)
+	// exchangerate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	exchangerate.UpdateDefaultUpdatedAt = exchangerateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// exchangerateDescID is the schema descriptor for id field.
+	exchangerateDescID := exchangerateFields[0].Descriptor()
+	// exchangerate.DefaultID holds the default value on creation for the id field.
+	exchangerate.DefaultID = exchangerateDescID.Default.(func() uuid.UUID)
 	messageFields := schema.Message{}.Fields()
 	_ = messageFields
 	// messageDescRole is the schema descriptor for role field.
@@ -84,6 +129,40 @@ func init() {
 	messageDescID := messageFields[0].Descriptor()
 	// message.DefaultID holds the default value on creation for the id field.
 	message.DefaultID = messageDescID.Default.(func() uuid.UUID)
+	priceobservationFields := schema.PriceObservation{}.Fields()
+	_ = priceobservationFields
+	// priceobservationDescProductKey is the schema descriptor for product_key field.
+	priceobservationDescProductKey := priceobservationFields[1].Descriptor()
+	// priceobservation.ProductKeyValidator is a validator for the "product_key" field. It is called by the builders before save.
+	priceobservation.ProductKeyValidator = priceobservationDescProductKey.Validators[0].(func(string) error)
+	// priceobservationDescProvider is the schema descriptor for provider field.
+	priceobservationDescProvider := priceobservationFields[2].Descriptor()
+	// priceobservation.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	priceobservation.ProviderValidator = priceobservationDescProvider.Validators[0].(func(string) error)
+	// priceobservationDescSource is the schema descriptor for source field.
+	priceobservationDescSource := priceobservationFields[3].Descriptor()
+	// priceobservation.SourceValidator is a validator for the "source" field. It is called by the builders before save.
+	priceobservation.SourceValidator = priceobservationDescSource.Validators[0].(func(string) error)
+	// priceobservationDescTitle is the schema descriptor for title field.
+	priceobservationDescTitle := priceobservationFields[6].Descriptor()
+	// priceobservation.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	priceobservation.TitleValidator = priceobservationDescTitle.Validators[0].(func(string) error)
+	// priceobservationDescAmount is the schema descriptor for amount field.
+	priceobservationDescAmount := priceobservationFields[9].Descriptor()
+	// priceobservation.AmountValidator is a validator for the "amount" field. It is called by the builders before save.
+	priceobservation.AmountValidator = priceobservationDescAmount.Validators[0].(func(int64) error)
+	// priceobservationDescCurrency is the schema descriptor for currency field.
+	priceobservationDescCurrency := priceobservationFields[10].Descriptor()
+	// priceobservation.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	priceobservation.CurrencyValidator = priceobservationDescCurrency.Validators[0].(func(string) error)
+	// priceobservationDescObservedAt is the schema descriptor for observed_at field.
+	priceobservationDescObservedAt := priceobservationFields[11].Descriptor()
+	// priceobservation.DefaultObservedAt holds the default value on creation for the observed_at field.
+	priceobservation.DefaultObservedAt = priceobservationDescObservedAt.Default.(func() time.Time)
+	// priceobservationDescID is the schema descriptor for id field.
+	priceobservationDescID := priceobservationFields[0].Descriptor()
+	// priceobservation.DefaultID holds the default value on creation for the id field.
+	priceobservation.DefaultID = priceobservationDescID.Default.(func() uuid.UUID)
 	searchhistoryFields := schema.SearchHistory{}.Fields()
 	_ = searchhistoryFields
 	// searchhistoryDescSearchQuery is the schema descriptor for search_query field.
@@ -118,6 +197,56 @@ func init() {
 	searchhistoryDescID := searchhistoryFields[0].Descriptor()
 	// searchhistory.DefaultID holds the default value on creation for the id field.
 	searchhistory.DefaultID = searchhistoryDescID.Default.(func() uuid.UUID)
+	tokenusageFields := schema.TokenUsage{}.Fields()
+	_ = tokenusageFields
+	// tokenusageDescProvider is the schema descriptor for provider field.
+	tokenusageDescProvider := tokenusageFields[3].Descriptor()
+	// tokenusage.ProviderValidator is a validator for the "provider" field. It is called by the builders before save.
+	tokenusage.ProviderValidator = tokenusageDescProvider.Validators[0].(func(string) error)
+	// tokenusageDescModel is the schema descriptor for model field.
+	tokenusageDescModel := tokenusageFields[4].Descriptor()
+	// tokenusage.ModelValidator is a validator for the "model" field. It is called by the builders before save.
+	tokenusage.ModelValidator = tokenusageDescModel.Validators[0].(func(string) error)
+	// tokenusageDescOperation is the schema descriptor for operation field.
+	tokenusageDescOperation := tokenusageFields[5].Descriptor()
+	// tokenusage.OperationValidator is a validator for the "operation" field. It is called by the builders before save.
+	tokenusage.OperationValidator = tokenusageDescOperation.Validators[0].(func(string) error)
+	// tokenusageDescInputTokens is the schema descriptor for input_tokens field.
+	tokenusageDescInputTokens := tokenusageFields[6].Descriptor()
+	// tokenusage.DefaultInputTokens holds the default value on creation for the input_tokens field.
+	tokenusage.DefaultInputTokens = tokenusageDescInputTokens.Default.(int)
+	// tokenusageDescCachedTokens is the schema descriptor for cached_tokens field.
+	tokenusageDescCachedTokens := tokenusageFields[7].Descriptor()
+	// tokenusage.DefaultCachedTokens holds the default value on creation for the cached_tokens field.
+	tokenusage.DefaultCachedTokens = tokenusageDescCachedTokens.Default.(int)
+	// tokenusageDescOutputTokens is the schema descriptor for output_tokens field.
+	tokenusageDescOutputTokens := tokenusageFields[8].Descriptor()
+	// tokenusage.DefaultOutputTokens holds the default value on creation for the output_tokens field.
+	tokenusage.DefaultOutputTokens = tokenusageDescOutputTokens.Default.(int)
+	// tokenusageDescGrounded is the schema descriptor for grounded field.
+	tokenusageDescGrounded := tokenusageFields[9].Descriptor()
+	// tokenusage.DefaultGrounded holds the default value on creation for the grounded field.
+	tokenusage.DefaultGrounded = tokenusageDescGrounded.Default.(bool)
+	// tokenusageDescLatencyMs is the schema descriptor for latency_ms field.
+	tokenusageDescLatencyMs := tokenusageFields[10].Descriptor()
+	// tokenusage.DefaultLatencyMs holds the default value on creation for the latency_ms field.
+	tokenusage.DefaultLatencyMs = tokenusageDescLatencyMs.Default.(int)
+	// tokenusageDescCostUsd is the schema descriptor for cost_usd field.
+	tokenusageDescCostUsd := tokenusageFields[11].Descriptor()
+	// tokenusage.DefaultCostUsd holds the default value on creation for the cost_usd field.
+	tokenusage.DefaultCostUsd = tokenusageDescCostUsd.Default.(float64)
+	// tokenusageDescDay is the schema descriptor for day field.
+	tokenusageDescDay := tokenusageFields[12].Descriptor()
+	// tokenusage.DayValidator is a validator for the "day" field. It is called by the builders before save.
+	tokenusage.DayValidator = tokenusageDescDay.Validators[0].(func(string) error)
+	// tokenusageDescCreatedAt is the schema descriptor for created_at field.
+	tokenusageDescCreatedAt := tokenusageFields[13].Descriptor()
+	// tokenusage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	tokenusage.DefaultCreatedAt = tokenusageDescCreatedAt.Default.(func() time.Time)
+	// tokenusageDescID is the schema descriptor for id field.
+	tokenusageDescID := tokenusageFields[0].Descriptor()
+	// tokenusage.DefaultID holds the default value on creation for the id field.
+	tokenusage.DefaultID = tokenusageDescID.Default.(func() uuid.UUID)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescEmail is the schema descriptor for email field.
@@ -128,12 +257,16 @@ func init() {
 	userDescProvider := userFields[6].Descriptor()
 	// user.DefaultProvider holds the default value on creation for the provider field.
 	user.DefaultProvider = userDescProvider.Default.(string)
+	// userDescPlan is the schema descriptor for plan field.
+	userDescPlan := userFields[7].Descriptor()
+	// user.DefaultPlan holds the default value on creation for the plan field.
+	user.DefaultPlan = userDescPlan.Default.(string)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[7].Descriptor()
+	userDescCreatedAt := userFields[8].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[8].Descriptor()
+	userDescUpdatedAt := userFields[9].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -158,4 +291,74 @@ func init() {
 	userpreferenceDescID := userpreferenceFields[0].Descriptor()
 	// userpreference.DefaultID holds the default value on creation for the id field.
 	userpreference.DefaultID = userpreferenceDescID.Default.(func() uuid.UUID)
+	watchlistitemFields := schema.WatchlistItem{}.Fields()
+	_ = watchlistitemFields
+	// watchlistitemDescPageToken is the schema descriptor for page_token field.
+	watchlistitemDescPageToken := watchlistitemFields[3].Descriptor()
+	// watchlistitem.PageTokenValidator is a validator for the "page_token" field. It is called by the builders before save.
+	watchlistitem.PageTokenValidator = watchlistitemDescPageToken.Validators[0].(func(string) error)
+	// watchlistitemDescTitle is the schema descriptor for title field.
+	watchlistitemDescTitle := watchlistitemFields[4].Descriptor()
+	// watchlistitem.TitleValidator is a validator for the "title" field. It is called by the builders before save.
+	watchlistitem.TitleValidator = watchlistitemDescTitle.Validators[0].(func(string) error)
+	// watchlistitemDescTargetAmount is the schema descriptor for target_amount field.
+	watchlistitemDescTargetAmount := watchlistitemFields[7].Descriptor()
+	// watchlistitem.TargetAmountValidator is a validator for the "target_amount" field. It is called by the builders before save.
+	watchlistitem.TargetAmountValidator = watchlistitemDescTargetAmount.Validators[0].(func(int64) error)
+	// watchlistitemDescCurrency is the schema descriptor for currency field.
+	watchlistitemDescCurrency := watchlistitemFields[8].Descriptor()
+	// watchlistitem.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	watchlistitem.CurrencyValidator = watchlistitemDescCurrency.Validators[0].(func(string) error)
+	// watchlistitemDescCreatedAt is the schema descriptor for created_at field.
+	watchlistitemDescCreatedAt := watchlistitemFields[13].Descriptor()
+	// watchlistitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	watchlistitem.DefaultCreatedAt = watchlistitemDescCreatedAt.Default.(func() time.Time)
+	// watchlistitemDescUpdatedAt is the schema descriptor for updated_at field.
+	watchlistitemDescUpdatedAt := watchlistitemFields[14].Descriptor()
+	// watchlistitem.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	watchlistitem.DefaultUpdatedAt = watchlistitemDescUpdatedAt.Default.(func() time.Time)
+	// watchlistitem.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	watchlistitem.UpdateDefaultUpdatedAt = watchlistitemDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// watchlistitemDescID is the schema descriptor for id field.
+	watchlistitemDescID := watchlistitemFields[0].Descriptor()
+	// watchlistitem.DefaultID holds the default value on creation for the id field.
+	watchlistitem.DefaultID = watchlistitemDescID.Default.(func() uuid.UUID)
+	wishlistFields := schema.Wishlist{}.Fields()
+	_ = wishlistFields
+	// wishlistDescName is the schema descriptor for name field.
+	wishlistDescName := wishlistFields[2].Descriptor()
+	// wishlist.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	wishlist.NameValidator = wishlistDescName.Validators[0].(func(string) error)
+	// wishlistDescShared is the schema descriptor for shared field.
+	wishlistDescShared := wishlistFields[3].Descriptor()
+	// wishlist.DefaultShared holds the default value on creation for the shared field.
+	wishlist.DefaultShared = wishlistDescShared.Default.(bool)
+	// wishlistDescShareVersion is the schema descriptor for share_version field.
+	wishlistDescShareVersion := wishlistFields[4].Descriptor()
+	// wishlist.DefaultShareVersion holds the default value on creation for the share_version field.
+	wishlist.DefaultShareVersion = wishlistDescShareVersion.Default.(int)
+	// wishlistDescCreatedAt is the schema descriptor for created_at field.
+	wishlistDescCreatedAt := wishlistFields[5].Descriptor()
+	// wishlist.DefaultCreatedAt holds the default value on creation for the created_at field.
+	wishlist.DefaultCreatedAt = wishlistDescCreatedAt.Default.(func() time.Time)
+	// wishlistDescUpdatedAt is the schema descriptor for updated_at field.
+	wishlistDescUpdatedAt := wishlistFields[6].Descriptor()
+	// wishlist.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	wishlist.DefaultUpdatedAt = wishlistDescUpdatedAt.Default.(func() time.Time)
+	// wishlist.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	wishlist.UpdateDefaultUpdatedAt = wishlistDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// wishlistDescID is the schema descriptor for id field.
+	wishlistDescID := wishlistFields[0].Descriptor()
+	// wishlist.DefaultID holds the default value on creation for the id field.
+	wishlist.DefaultID = wishlistDescID.Default.(func() uuid.UUID)
+	wishlistitemFields := schema.WishlistItem{}.Fields()
+	_ = wishlistitemFields
+	// wishlistitemDescCreatedAt is the schema descriptor for created_at field.
+	wishlistitemDescCreatedAt := wishlistitemFields[6].Descriptor()
+	// wishlistitem.DefaultCreatedAt holds the default value on creation for the created_at field.
+	wishlistitem.DefaultCreatedAt = wishlistitemDescCreatedAt.Default.(func() time.Time)
+	// wishlistitemDescID is the schema descriptor for id field.
+	wishlistitemDescID := wishlistitemFields[0].Descriptor()
+	// wishlistitem.DefaultID holds the default value on creation for the id field.
+	wishlistitem.DefaultID = wishlistitemDescID.Default.(func() uuid.UUID)
 }
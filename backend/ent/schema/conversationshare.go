@@ -0,0 +1,76 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// ConversationShare holds the schema definition for the ConversationShare entity.
+// A read-only snapshot of a chat session, served through a public link.
+type ConversationShare struct {
+	ent.Schema
+}
+
+// Fields of the ConversationShare.
+func (ConversationShare) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("user_id", uuid.UUID{}).
+			Immutable(),
+		field.UUID("session_id", uuid.UUID{}).
+			Immutable(), // Shared ChatSession; not a foreign key, shares outlive expired sessions
+		field.String("title").
+			Optional().
+			Immutable(),
+		field.JSON("messages", []map[string]interface{}{}).
+			Immutable().
+			SchemaType(map[string]string{
+				dialect.Postgres: "jsonb",
+			}), // Snapshot of the messages and their products, without personal data
+		field.Int("message_count").
+			Immutable(),
+		field.String("currency").
+			Optional().
+			Immutable(),
+		field.Int("view_count").
+			Default(0),
+		field.Time("expires_at").
+			Optional().
+			Nillable().
+			Immutable(), // Nil for links that don't expire
+		field.Time("revoked_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the ConversationShare.
+func (ConversationShare) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("conversation_shares").
+			Field("user_id").
+			Immutable().
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the ConversationShare.
+func (ConversationShare) Indexes() []ent.Index {
+	return []ent.Index{
+		// A user's shares, newest first
+		index.Fields("user_id", "created_at"),
+	}
+}
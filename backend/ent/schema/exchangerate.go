@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// ExchangeRate holds the schema definition for the ExchangeRate entity.
+// One row per currency, quoted against EUR like the ECB reference rates.
+type ExchangeRate struct {
+	ent.Schema
+}
+
+// Fields of the ExchangeRate.
+func (ExchangeRate) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("currency").
+			Unique().
+			NotEmpty(), // ISO 4217 code
+		field.Float("rate").
+			Positive(), // Units of currency per 1 EUR
+		field.String("source").
+			NotEmpty(), // Rate source that last set the rate ("file", "ecb")
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
@@ -0,0 +1,58 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// PriceObservation holds the schema definition for the PriceObservation entity.
+// One row per price a product search or product details call returned.
+type PriceObservation struct {
+	ent.Schema
+}
+
+// Fields of the PriceObservation.
+func (PriceObservation) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.String("product_key").
+			NotEmpty(), // Stable product identity, see services.PriceProductKey
+		field.String("provider").
+			NotEmpty(), // Product search provider ("serpapi", "fixture", "feed")
+		field.String("source").
+			NotEmpty(), // "search" or "details"
+		field.String("product_id").
+			Optional(), // Provider's product ID, if any
+		field.String("page_token").
+			Optional(),
+		field.String("title").
+			NotEmpty(),
+		field.String("merchant").
+			Optional(),
+		field.String("country").
+			Optional(), // Empty for product details, which aren't fetched per country
+		field.Int64("amount").
+			Positive(), // Minor units of currency
+		field.String("currency").
+			NotEmpty(), // ISO 4217 code
+		field.Time("observed_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Indexes of the PriceObservation.
+func (PriceObservation) Indexes() []ent.Index {
+	return []ent.Index{
+		// Price history of a product
+		index.Fields("product_key", "observed_at"),
+		// Product of a details page token
+		index.Fields("page_token"),
+	}
+}
@@ -0,0 +1,67 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// TokenUsage holds the schema definition for the TokenUsage entity.
+// One row per LLM generation or embedding call.
+type TokenUsage struct {
+	ent.Schema
+}
+
+// Fields of the TokenUsage.
+func (TokenUsage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		// No edge to User: usage records are kept for accounting even if
+		// the user or session is deleted
+		field.UUID("user_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.String("session_id").
+			Optional(),
+		field.String("provider").
+			NotEmpty(),
+		field.String("model").
+			NotEmpty(),
+		field.String("operation").
+			NotEmpty(), // "generate" or "embed"
+		field.Int("input_tokens").
+			Default(0),
+		field.Int("cached_tokens").
+			Default(0), // Part of input_tokens served from the prompt cache
+		field.Int("output_tokens").
+			Default(0),
+		field.Bool("grounded").
+			Default(false),
+		field.Int("latency_ms").
+			Default(0),
+		field.Float("cost_usd").
+			Default(0), // Computed from the price table at the time of the call
+		field.String("day").
+			NotEmpty(), // UTC date (YYYY-MM-DD) for daily aggregation
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Indexes of the TokenUsage.
+func (TokenUsage) Indexes() []ent.Index {
+	return []ent.Index{
+		// Per-session cost
+		index.Fields("session_id"),
+		// Per-user usage by day
+		index.Fields("user_id", "day"),
+		// Aggregation by day and model
+		index.Fields("day", "model"),
+	}
+}
@@ -36,6 +36,8 @@ func (User) Fields() []ent.Field {
 			Optional(),
 		field.String("provider").
 			Default("email"), // "email" or "google"
+		field.String("plan").
+			Default("free"), // Quota plan: "free" or "pro" (see QuotaService)
 		field.Time("created_at").
 			Immutable().
 			Default(func() time.Time { return time.Now() }),
@@ -54,6 +56,9 @@ func (User) Edges() []ent.Edge {
 		edge.To("search_history", SearchHistory.Type),
 		edge.To("preferences", UserPreference.Type).
 			Unique(), // One-to-one relationship
+		edge.To("watchlist", WatchlistItem.Type),
+		edge.To("wishlists", Wishlist.Type),
+		edge.To("conversation_shares", ConversationShare.Type),
 	}
 }
 
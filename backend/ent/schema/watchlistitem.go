@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WatchlistItem holds the schema definition for the WatchlistItem entity.
+// A product a user wants to be notified about when its price drops to a target.
+type WatchlistItem struct {
+	ent.Schema
+}
+
+// Fields of the WatchlistItem.
+func (WatchlistItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.String("product_key").
+			Optional(), // Key of the product's price history, if known
+		field.Text("page_token").
+			NotEmpty(), // Re-checked with the product search's GetDetails
+		field.String("title").
+			NotEmpty(),
+		field.String("link").
+			Optional(),
+		field.String("image").
+			Optional(),
+		field.Int64("target_amount").
+			Positive(), // Minor units of currency
+		field.String("currency").
+			NotEmpty(), // ISO 4217 code of target_amount
+		field.String("country").
+			Optional(),
+		field.Int64("last_amount").
+			Optional().
+			Nillable(), // Lowest price at the last check, in currency
+		field.Time("last_checked_at").
+			Optional().
+			Nillable(),
+		field.Time("notified_at").
+			Optional().
+			Nillable(), // Last time the price crossed the target
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the WatchlistItem.
+func (WatchlistItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("watchlist").
+			Field("user_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the WatchlistItem.
+func (WatchlistItem) Indexes() []ent.Index {
+	return []ent.Index{
+		// A user's watchlist, newest first
+		index.Fields("user_id", "created_at"),
+		// Re-check job - items checked longest ago first
+		index.Fields("last_checked_at"),
+	}
+}
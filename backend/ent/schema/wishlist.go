@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// Wishlist holds the schema definition for the Wishlist entity.
+// A named list of products a user saved.
+type Wishlist struct {
+	ent.Schema
+}
+
+// Fields of the Wishlist.
+func (Wishlist) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.String("name").
+			NotEmpty(), // At most 100 characters
+		field.Bool("shared").
+			Default(false), // Share links are valid
+		field.Int("share_version").
+			Default(0), // Signed into share links; bumped on every share so older links stop working
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Wishlist.
+func (Wishlist) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("wishlists").
+			Field("user_id").
+			Required().
+			Unique(),
+		edge.To("items", WishlistItem.Type),
+	}
+}
+
+// Indexes of the Wishlist.
+func (Wishlist) Indexes() []ent.Index {
+	return []ent.Index{
+		// A user's wishlists, oldest first
+		index.Fields("user_id", "created_at"),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// WishlistItem holds the schema definition for the WishlistItem entity.
+// A product saved to a wishlist, as its card looked when it was saved.
+type WishlistItem struct {
+	ent.Schema
+}
+
+// Fields of the WishlistItem.
+func (WishlistItem) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Immutable(),
+		field.UUID("wishlist_id", uuid.UUID{}),
+		field.Text("page_token").
+			Optional(), // Opens the product details
+		field.String("product_key").
+			Optional(), // Key of the product's price history, if known
+		field.JSON("product", map[string]interface{}{}).
+			SchemaType(map[string]string{
+				dialect.Postgres: "jsonb",
+			}), // ProductCard snapshot
+		field.String("note").
+			Optional(), // At most 500 characters
+		field.Time("created_at").
+			Immutable().
+			Default(time.Now),
+	}
+}
+
+// Edges of the WishlistItem.
+func (WishlistItem) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("wishlist", Wishlist.Type).
+			Ref("items").
+			Field("wishlist_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the WishlistItem.
+func (WishlistItem) Indexes() []ent.Index {
+	return []ent.Index{
+		// A wishlist's items in the order they were saved
+		index.Fields("wishlist_id", "created_at"),
+	}
+}
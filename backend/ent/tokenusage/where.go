@@ -0,0 +1,771 @@
+// Code generated by ent, DO NOT EDIT.
+
+package tokenusage
+
+import (
+	"mylittleprice/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldUserID, v))
+}
+
+// SessionID applies equality check predicate on the "session_id" field. It's identical to SessionIDEQ.
+func SessionID(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldSessionID, v))
+}
+
+// Provider applies equality check predicate on the "provider" field. It's identical to ProviderEQ.
+func Provider(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldProvider, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldModel, v))
+}
+
+// Operation applies equality check predicate on the "operation" field. It's identical to OperationEQ.
+func Operation(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldOperation, v))
+}
+
+// InputTokens applies equality check predicate on the "input_tokens" field. It's identical to InputTokensEQ.
+func InputTokens(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldInputTokens, v))
+}
+
+// CachedTokens applies equality check predicate on the "cached_tokens" field. It's identical to CachedTokensEQ.
+func CachedTokens(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldCachedTokens, v))
+}
+
+// OutputTokens applies equality check predicate on the "output_tokens" field. It's identical to OutputTokensEQ.
+func OutputTokens(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldOutputTokens, v))
+}
+
+// Grounded applies equality check predicate on the "grounded" field. It's identical to GroundedEQ.
+func Grounded(v bool) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldGrounded, v))
+}
+
+// LatencyMs applies equality check predicate on the "latency_ms" field. It's identical to LatencyMsEQ.
+func LatencyMs(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldLatencyMs, v))
+}
+
+// CostUsd applies equality check predicate on the "cost_usd" field. It's identical to CostUsdEQ.
+func CostUsd(v float64) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldCostUsd, v))
+}
+
+// Day applies equality check predicate on the "day" field. It's identical to DayEQ.
+func Day(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldDay, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNotNull(FieldUserID))
+}
+
+// SessionIDEQ applies the EQ predicate on the "session_id" field.
+func SessionIDEQ(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldSessionID, v))
+}
+
+// SessionIDNEQ applies the NEQ predicate on the "session_id" field.
+func SessionIDNEQ(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNEQ(FieldSessionID, v))
+}
+
+// SessionIDIn applies the In predicate on the "session_id" field.
+func SessionIDIn(vs ...string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldIn(FieldSessionID, vs...))
+}
+
+// SessionIDNotIn applies the NotIn predicate on the "session_id" field.
+func SessionIDNotIn(vs ...string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNotIn(FieldSessionID, vs...))
+}
+
+// SessionIDGT applies the GT predicate on the "session_id" field.
+func SessionIDGT(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGT(FieldSessionID, v))
+}
+
+// SessionIDGTE applies the GTE predicate on the "session_id" field.
+func SessionIDGTE(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGTE(FieldSessionID, v))
+}
+
+// SessionIDLT applies the LT predicate on the "session_id" field.
+func SessionIDLT(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLT(FieldSessionID, v))
+}
+
+// SessionIDLTE applies the LTE predicate on the "session_id" field.
+func SessionIDLTE(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLTE(FieldSessionID, v))
+}
+
+// SessionIDContains applies the Contains predicate on the "session_id" field.
+func SessionIDContains(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldContains(FieldSessionID, v))
+}
+
+// SessionIDHasPrefix applies the HasPrefix predicate on the "session_id" field.
+func SessionIDHasPrefix(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldHasPrefix(FieldSessionID, v))
+}
+
+// SessionIDHasSuffix applies the HasSuffix predicate on the "session_id" field.
+func SessionIDHasSuffix(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldHasSuffix(FieldSessionID, v))
+}
+
+// SessionIDIsNil applies the IsNil predicate on the "session_id" field.
+func SessionIDIsNil() predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldIsNull(FieldSessionID))
+}
+
+// SessionIDNotNil applies the NotNil predicate on the "session_id" field.
+func SessionIDNotNil() predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNotNull(FieldSessionID))
+}
+
+// SessionIDEqualFold applies the EqualFold predicate on the "session_id" field.
+func SessionIDEqualFold(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEqualFold(FieldSessionID, v))
+}
+
+// SessionIDContainsFold applies the ContainsFold predicate on the "session_id" field.
+func SessionIDContainsFold(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldContainsFold(FieldSessionID, v))
+}
+
+// ProviderEQ applies the EQ predicate on the "provider" field.
+func ProviderEQ(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldProvider, v))
+}
+
+// ProviderNEQ applies the NEQ predicate on the "provider" field.
+func ProviderNEQ(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNEQ(FieldProvider, v))
+}
+
+// ProviderIn applies the In predicate on the "provider" field.
+func ProviderIn(vs ...string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldIn(FieldProvider, vs...))
+}
+
+// ProviderNotIn applies the NotIn predicate on the "provider" field.
+func ProviderNotIn(vs ...string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNotIn(FieldProvider, vs...))
+}
+
+// ProviderGT applies the GT predicate on the "provider" field.
+func ProviderGT(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGT(FieldProvider, v))
+}
+
+// ProviderGTE applies the GTE predicate on the "provider" field.
+func ProviderGTE(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGTE(FieldProvider, v))
+}
+
+// ProviderLT applies the LT predicate on the "provider" field.
+func ProviderLT(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLT(FieldProvider, v))
+}
+
+// ProviderLTE applies the LTE predicate on the "provider" field.
+func ProviderLTE(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLTE(FieldProvider, v))
+}
+
+// ProviderContains applies the Contains predicate on the "provider" field.
+func ProviderContains(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldContains(FieldProvider, v))
+}
+
+// ProviderHasPrefix applies the HasPrefix predicate on the "provider" field.
+func ProviderHasPrefix(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldHasPrefix(FieldProvider, v))
+}
+
+// ProviderHasSuffix applies the HasSuffix predicate on the "provider" field.
+func ProviderHasSuffix(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldHasSuffix(FieldProvider, v))
+}
+
+// ProviderEqualFold applies the EqualFold predicate on the "provider" field.
+func ProviderEqualFold(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEqualFold(FieldProvider, v))
+}
+
+// ProviderContainsFold applies the ContainsFold predicate on the "provider" field.
+func ProviderContainsFold(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldContainsFold(FieldProvider, v))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldContainsFold(FieldModel, v))
+}
+
+// OperationEQ applies the EQ predicate on the "operation" field.
+func OperationEQ(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldOperation, v))
+}
+
+// OperationNEQ applies the NEQ predicate on the "operation" field.
+func OperationNEQ(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNEQ(FieldOperation, v))
+}
+
+// OperationIn applies the In predicate on the "operation" field.
+func OperationIn(vs ...string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldIn(FieldOperation, vs...))
+}
+
+// OperationNotIn applies the NotIn predicate on the "operation" field.
+func OperationNotIn(vs ...string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNotIn(FieldOperation, vs...))
+}
+
+// OperationGT applies the GT predicate on the "operation" field.
+func OperationGT(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGT(FieldOperation, v))
+}
+
+// OperationGTE applies the GTE predicate on the "operation" field.
+func OperationGTE(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGTE(FieldOperation, v))
+}
+
+// OperationLT applies the LT predicate on the "operation" field.
+func OperationLT(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLT(FieldOperation, v))
+}
+
+// OperationLTE applies the LTE predicate on the "operation" field.
+func OperationLTE(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLTE(FieldOperation, v))
+}
+
+// OperationContains applies the Contains predicate on the "operation" field.
+func OperationContains(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldContains(FieldOperation, v))
+}
+
+// OperationHasPrefix applies the HasPrefix predicate on the "operation" field.
+func OperationHasPrefix(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldHasPrefix(FieldOperation, v))
+}
+
+// OperationHasSuffix applies the HasSuffix predicate on the "operation" field.
+func OperationHasSuffix(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldHasSuffix(FieldOperation, v))
+}
+
+// OperationEqualFold applies the EqualFold predicate on the "operation" field.
+func OperationEqualFold(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEqualFold(FieldOperation, v))
+}
+
+// OperationContainsFold applies the ContainsFold predicate on the "operation" field.
+func OperationContainsFold(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldContainsFold(FieldOperation, v))
+}
+
+// InputTokensEQ applies the EQ predicate on the "input_tokens" field.
+func InputTokensEQ(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldInputTokens, v))
+}
+
+// InputTokensNEQ applies the NEQ predicate on the "input_tokens" field.
+func InputTokensNEQ(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNEQ(FieldInputTokens, v))
+}
+
+// InputTokensIn applies the In predicate on the "input_tokens" field.
+func InputTokensIn(vs ...int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldIn(FieldInputTokens, vs...))
+}
+
+// InputTokensNotIn applies the NotIn predicate on the "input_tokens" field.
+func InputTokensNotIn(vs ...int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNotIn(FieldInputTokens, vs...))
+}
+
+// InputTokensGT applies the GT predicate on the "input_tokens" field.
+func InputTokensGT(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGT(FieldInputTokens, v))
+}
+
+// InputTokensGTE applies the GTE predicate on the "input_tokens" field.
+func InputTokensGTE(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGTE(FieldInputTokens, v))
+}
+
+// InputTokensLT applies the LT predicate on the "input_tokens" field.
+func InputTokensLT(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLT(FieldInputTokens, v))
+}
+
+// InputTokensLTE applies the LTE predicate on the "input_tokens" field.
+func InputTokensLTE(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLTE(FieldInputTokens, v))
+}
+
+// CachedTokensEQ applies the EQ predicate on the "cached_tokens" field.
+func CachedTokensEQ(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldCachedTokens, v))
+}
+
+// CachedTokensNEQ applies the NEQ predicate on the "cached_tokens" field.
+func CachedTokensNEQ(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNEQ(FieldCachedTokens, v))
+}
+
+// CachedTokensIn applies the In predicate on the "cached_tokens" field.
+func CachedTokensIn(vs ...int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldIn(FieldCachedTokens, vs...))
+}
+
+// CachedTokensNotIn applies the NotIn predicate on the "cached_tokens" field.
+func CachedTokensNotIn(vs ...int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNotIn(FieldCachedTokens, vs...))
+}
+
+// CachedTokensGT applies the GT predicate on the "cached_tokens" field.
+func CachedTokensGT(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGT(FieldCachedTokens, v))
+}
+
+// CachedTokensGTE applies the GTE predicate on the "cached_tokens" field.
+func CachedTokensGTE(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGTE(FieldCachedTokens, v))
+}
+
+// CachedTokensLT applies the LT predicate on the "cached_tokens" field.
+func CachedTokensLT(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLT(FieldCachedTokens, v))
+}
+
+// CachedTokensLTE applies the LTE predicate on the "cached_tokens" field.
+func CachedTokensLTE(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLTE(FieldCachedTokens, v))
+}
+
+// OutputTokensEQ applies the EQ predicate on the "output_tokens" field.
+func OutputTokensEQ(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldOutputTokens, v))
+}
+
+// OutputTokensNEQ applies the NEQ predicate on the "output_tokens" field.
+func OutputTokensNEQ(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNEQ(FieldOutputTokens, v))
+}
+
+// OutputTokensIn applies the In predicate on the "output_tokens" field.
+func OutputTokensIn(vs ...int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldIn(FieldOutputTokens, vs...))
+}
+
+// OutputTokensNotIn applies the NotIn predicate on the "output_tokens" field.
+func OutputTokensNotIn(vs ...int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNotIn(FieldOutputTokens, vs...))
+}
+
+// OutputTokensGT applies the GT predicate on the "output_tokens" field.
+func OutputTokensGT(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGT(FieldOutputTokens, v))
+}
+
+// OutputTokensGTE applies the GTE predicate on the "output_tokens" field.
+func OutputTokensGTE(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGTE(FieldOutputTokens, v))
+}
+
+// OutputTokensLT applies the LT predicate on the "output_tokens" field.
+func OutputTokensLT(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLT(FieldOutputTokens, v))
+}
+
+// OutputTokensLTE applies the LTE predicate on the "output_tokens" field.
+func OutputTokensLTE(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLTE(FieldOutputTokens, v))
+}
+
+// GroundedEQ applies the EQ predicate on the "grounded" field.
+func GroundedEQ(v bool) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldGrounded, v))
+}
+
+// GroundedNEQ applies the NEQ predicate on the "grounded" field.
+func GroundedNEQ(v bool) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNEQ(FieldGrounded, v))
+}
+
+// LatencyMsEQ applies the EQ predicate on the "latency_ms" field.
+func LatencyMsEQ(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldLatencyMs, v))
+}
+
+// LatencyMsNEQ applies the NEQ predicate on the "latency_ms" field.
+func LatencyMsNEQ(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNEQ(FieldLatencyMs, v))
+}
+
+// LatencyMsIn applies the In predicate on the "latency_ms" field.
+func LatencyMsIn(vs ...int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldIn(FieldLatencyMs, vs...))
+}
+
+// LatencyMsNotIn applies the NotIn predicate on the "latency_ms" field.
+func LatencyMsNotIn(vs ...int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNotIn(FieldLatencyMs, vs...))
+}
+
+// LatencyMsGT applies the GT predicate on the "latency_ms" field.
+func LatencyMsGT(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGT(FieldLatencyMs, v))
+}
+
+// LatencyMsGTE applies the GTE predicate on the "latency_ms" field.
+func LatencyMsGTE(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGTE(FieldLatencyMs, v))
+}
+
+// LatencyMsLT applies the LT predicate on the "latency_ms" field.
+func LatencyMsLT(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLT(FieldLatencyMs, v))
+}
+
+// LatencyMsLTE applies the LTE predicate on the "latency_ms" field.
+func LatencyMsLTE(v int) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLTE(FieldLatencyMs, v))
+}
+
+// CostUsdEQ applies the EQ predicate on the "cost_usd" field.
+func CostUsdEQ(v float64) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldCostUsd, v))
+}
+
+// CostUsdNEQ applies the NEQ predicate on the "cost_usd" field.
+func CostUsdNEQ(v float64) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNEQ(FieldCostUsd, v))
+}
+
+// CostUsdIn applies the In predicate on the "cost_usd" field.
+func CostUsdIn(vs ...float64) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldIn(FieldCostUsd, vs...))
+}
+
+// CostUsdNotIn applies the NotIn predicate on the "cost_usd" field.
+func CostUsdNotIn(vs ...float64) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNotIn(FieldCostUsd, vs...))
+}
+
+// CostUsdGT applies the GT predicate on the "cost_usd" field.
+func CostUsdGT(v float64) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGT(FieldCostUsd, v))
+}
+
+// CostUsdGTE applies the GTE predicate on the "cost_usd" field.
+func CostUsdGTE(v float64) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGTE(FieldCostUsd, v))
+}
+
+// CostUsdLT applies the LT predicate on the "cost_usd" field.
+func CostUsdLT(v float64) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLT(FieldCostUsd, v))
+}
+
+// CostUsdLTE applies the LTE predicate on the "cost_usd" field.
+func CostUsdLTE(v float64) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLTE(FieldCostUsd, v))
+}
+
+// DayEQ applies the EQ predicate on the "day" field.
+func DayEQ(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldDay, v))
+}
+
+// DayNEQ applies the NEQ predicate on the "day" field.
+func DayNEQ(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNEQ(FieldDay, v))
+}
+
+// DayIn applies the In predicate on the "day" field.
+func DayIn(vs ...string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldIn(FieldDay, vs...))
+}
+
+// DayNotIn applies the NotIn predicate on the "day" field.
+func DayNotIn(vs ...string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNotIn(FieldDay, vs...))
+}
+
+// DayGT applies the GT predicate on the "day" field.
+func DayGT(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGT(FieldDay, v))
+}
+
+// DayGTE applies the GTE predicate on the "day" field.
+func DayGTE(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGTE(FieldDay, v))
+}
+
+// DayLT applies the LT predicate on the "day" field.
+func DayLT(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLT(FieldDay, v))
+}
+
+// DayLTE applies the LTE predicate on the "day" field.
+func DayLTE(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLTE(FieldDay, v))
+}
+
+// DayContains applies the Contains predicate on the "day" field.
+func DayContains(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldContains(FieldDay, v))
+}
+
+// DayHasPrefix applies the HasPrefix predicate on the "day" field.
+func DayHasPrefix(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldHasPrefix(FieldDay, v))
+}
+
+// DayHasSuffix applies the HasSuffix predicate on the "day" field.
+func DayHasSuffix(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldHasSuffix(FieldDay, v))
+}
+
+// DayEqualFold applies the EqualFold predicate on the "day" field.
+func DayEqualFold(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEqualFold(FieldDay, v))
+}
+
+// DayContainsFold applies the ContainsFold predicate on the "day" field.
+func DayContainsFold(v string) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldContainsFold(FieldDay, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TokenUsage) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TokenUsage) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TokenUsage) predicate.TokenUsage {
+	return predicate.TokenUsage(sql.NotPredicates(p))
+}
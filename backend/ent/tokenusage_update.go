@@ -0,0 +1,776 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mylittleprice/ent/predicate"
+	"mylittleprice/ent/tokenusage"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// TokenUsageUpdate is the builder for updating TokenUsage entities.
+type TokenUsageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TokenUsageMutation
+}
+
+// Where appends a list predicates to the TokenUsageUpdate builder.
+func (_u *TokenUsageUpdate) Where(ps ...predicate.TokenUsage) *TokenUsageUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TokenUsageUpdate) SetUserID(v uuid.UUID) *TokenUsageUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TokenUsageUpdate) SetNillableUserID(v *uuid.UUID) *TokenUsageUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *TokenUsageUpdate) ClearUserID() *TokenUsageUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetSessionID sets the "session_id" field.
+func (_u *TokenUsageUpdate) SetSessionID(v string) *TokenUsageUpdate {
+	_u.mutation.SetSessionID(v)
+	return _u
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (_u *TokenUsageUpdate) SetNillableSessionID(v *string) *TokenUsageUpdate {
+	if v != nil {
+		_u.SetSessionID(*v)
+	}
+	return _u
+}
+
+// ClearSessionID clears the value of the "session_id" field.
+func (_u *TokenUsageUpdate) ClearSessionID() *TokenUsageUpdate {
+	_u.mutation.ClearSessionID()
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *TokenUsageUpdate) SetProvider(v string) *TokenUsageUpdate {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *TokenUsageUpdate) SetNillableProvider(v *string) *TokenUsageUpdate {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *TokenUsageUpdate) SetModel(v string) *TokenUsageUpdate {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *TokenUsageUpdate) SetNillableModel(v *string) *TokenUsageUpdate {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetOperation sets the "operation" field.
+func (_u *TokenUsageUpdate) SetOperation(v string) *TokenUsageUpdate {
+	_u.mutation.SetOperation(v)
+	return _u
+}
+
+// SetNillableOperation sets the "operation" field if the given value is not nil.
+func (_u *TokenUsageUpdate) SetNillableOperation(v *string) *TokenUsageUpdate {
+	if v != nil {
+		_u.SetOperation(*v)
+	}
+	return _u
+}
+
+// SetInputTokens sets the "input_tokens" field.
+func (_u *TokenUsageUpdate) SetInputTokens(v int) *TokenUsageUpdate {
+	_u.mutation.ResetInputTokens()
+	_u.mutation.SetInputTokens(v)
+	return _u
+}
+
+// SetNillableInputTokens sets the "input_tokens" field if the given value is not nil.
+func (_u *TokenUsageUpdate) SetNillableInputTokens(v *int) *TokenUsageUpdate {
+	if v != nil {
+		_u.SetInputTokens(*v)
+	}
+	return _u
+}
+
+// AddInputTokens adds value to the "input_tokens" field.
+func (_u *TokenUsageUpdate) AddInputTokens(v int) *TokenUsageUpdate {
+	_u.mutation.AddInputTokens(v)
+	return _u
+}
+
+// SetCachedTokens sets the "cached_tokens" field.
+func (_u *TokenUsageUpdate) SetCachedTokens(v int) *TokenUsageUpdate {
+	_u.mutation.ResetCachedTokens()
+	_u.mutation.SetCachedTokens(v)
+	return _u
+}
+
+// SetNillableCachedTokens sets the "cached_tokens" field if the given value is not nil.
+func (_u *TokenUsageUpdate) SetNillableCachedTokens(v *int) *TokenUsageUpdate {
+	if v != nil {
+		_u.SetCachedTokens(*v)
+	}
+	return _u
+}
+
+// AddCachedTokens adds value to the "cached_tokens" field.
+func (_u *TokenUsageUpdate) AddCachedTokens(v int) *TokenUsageUpdate {
+	_u.mutation.AddCachedTokens(v)
+	return _u
+}
+
+// SetOutputTokens sets the "output_tokens" field.
+func (_u *TokenUsageUpdate) SetOutputTokens(v int) *TokenUsageUpdate {
+	_u.mutation.ResetOutputTokens()
+	_u.mutation.SetOutputTokens(v)
+	return _u
+}
+
+// SetNillableOutputTokens sets the "output_tokens" field if the given value is not nil.
+func (_u *TokenUsageUpdate) SetNillableOutputTokens(v *int) *TokenUsageUpdate {
+	if v != nil {
+		_u.SetOutputTokens(*v)
+	}
+	return _u
+}
+
+// AddOutputTokens adds value to the "output_tokens" field.
+func (_u *TokenUsageUpdate) AddOutputTokens(v int) *TokenUsageUpdate {
+	_u.mutation.AddOutputTokens(v)
+	return _u
+}
+
+// SetGrounded sets the "grounded" field.
+func (_u *TokenUsageUpdate) SetGrounded(v bool) *TokenUsageUpdate {
+	_u.mutation.SetGrounded(v)
+	return _u
+}
+
+// SetNillableGrounded sets the "grounded" field if the given value is not nil.
+func (_u *TokenUsageUpdate) SetNillableGrounded(v *bool) *TokenUsageUpdate {
+	if v != nil {
+		_u.SetGrounded(*v)
+	}
+	return _u
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (_u *TokenUsageUpdate) SetLatencyMs(v int) *TokenUsageUpdate {
+	_u.mutation.ResetLatencyMs()
+	_u.mutation.SetLatencyMs(v)
+	return _u
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (_u *TokenUsageUpdate) SetNillableLatencyMs(v *int) *TokenUsageUpdate {
+	if v != nil {
+		_u.SetLatencyMs(*v)
+	}
+	return _u
+}
+
+// AddLatencyMs adds value to the "latency_ms" field.
+func (_u *TokenUsageUpdate) AddLatencyMs(v int) *TokenUsageUpdate {
+	_u.mutation.AddLatencyMs(v)
+	return _u
+}
+
+// SetCostUsd sets the "cost_usd" field.
+func (_u *TokenUsageUpdate) SetCostUsd(v float64) *TokenUsageUpdate {
+	_u.mutation.ResetCostUsd()
+	_u.mutation.SetCostUsd(v)
+	return _u
+}
+
+// SetNillableCostUsd sets the "cost_usd" field if the given value is not nil.
+func (_u *TokenUsageUpdate) SetNillableCostUsd(v *float64) *TokenUsageUpdate {
+	if v != nil {
+		_u.SetCostUsd(*v)
+	}
+	return _u
+}
+
+// AddCostUsd adds value to the "cost_usd" field.
+func (_u *TokenUsageUpdate) AddCostUsd(v float64) *TokenUsageUpdate {
+	_u.mutation.AddCostUsd(v)
+	return _u
+}
+
+// SetDay sets the "day" field.
+func (_u *TokenUsageUpdate) SetDay(v string) *TokenUsageUpdate {
+	_u.mutation.SetDay(v)
+	return _u
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (_u *TokenUsageUpdate) SetNillableDay(v *string) *TokenUsageUpdate {
+	if v != nil {
+		_u.SetDay(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TokenUsageMutation object of the builder.
+func (_u *TokenUsageUpdate) Mutation() *TokenUsageMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TokenUsageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TokenUsageUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TokenUsageUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TokenUsageUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TokenUsageUpdate) check() error {
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := tokenusage.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "TokenUsage.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Model(); ok {
+		if err := tokenusage.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "TokenUsage.model": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Operation(); ok {
+		if err := tokenusage.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "TokenUsage.operation": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Day(); ok {
+		if err := tokenusage.DayValidator(v); err != nil {
+			return &ValidationError{Name: "day", err: fmt.Errorf(`ent: validator failed for field "TokenUsage.day": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TokenUsageUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tokenusage.Table, tokenusage.Columns, sqlgraph.NewFieldSpec(tokenusage.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(tokenusage.FieldUserID, field.TypeUUID, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(tokenusage.FieldUserID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.SessionID(); ok {
+		_spec.SetField(tokenusage.FieldSessionID, field.TypeString, value)
+	}
+	if _u.mutation.SessionIDCleared() {
+		_spec.ClearField(tokenusage.FieldSessionID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(tokenusage.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(tokenusage.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Operation(); ok {
+		_spec.SetField(tokenusage.FieldOperation, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InputTokens(); ok {
+		_spec.SetField(tokenusage.FieldInputTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInputTokens(); ok {
+		_spec.AddField(tokenusage.FieldInputTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CachedTokens(); ok {
+		_spec.SetField(tokenusage.FieldCachedTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCachedTokens(); ok {
+		_spec.AddField(tokenusage.FieldCachedTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OutputTokens(); ok {
+		_spec.SetField(tokenusage.FieldOutputTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOutputTokens(); ok {
+		_spec.AddField(tokenusage.FieldOutputTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Grounded(); ok {
+		_spec.SetField(tokenusage.FieldGrounded, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LatencyMs(); ok {
+		_spec.SetField(tokenusage.FieldLatencyMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLatencyMs(); ok {
+		_spec.AddField(tokenusage.FieldLatencyMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CostUsd(); ok {
+		_spec.SetField(tokenusage.FieldCostUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCostUsd(); ok {
+		_spec.AddField(tokenusage.FieldCostUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Day(); ok {
+		_spec.SetField(tokenusage.FieldDay, field.TypeString, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tokenusage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TokenUsageUpdateOne is the builder for updating a single TokenUsage entity.
+type TokenUsageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TokenUsageMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TokenUsageUpdateOne) SetUserID(v uuid.UUID) *TokenUsageUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TokenUsageUpdateOne) SetNillableUserID(v *uuid.UUID) *TokenUsageUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *TokenUsageUpdateOne) ClearUserID() *TokenUsageUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetSessionID sets the "session_id" field.
+func (_u *TokenUsageUpdateOne) SetSessionID(v string) *TokenUsageUpdateOne {
+	_u.mutation.SetSessionID(v)
+	return _u
+}
+
+// SetNillableSessionID sets the "session_id" field if the given value is not nil.
+func (_u *TokenUsageUpdateOne) SetNillableSessionID(v *string) *TokenUsageUpdateOne {
+	if v != nil {
+		_u.SetSessionID(*v)
+	}
+	return _u
+}
+
+// ClearSessionID clears the value of the "session_id" field.
+func (_u *TokenUsageUpdateOne) ClearSessionID() *TokenUsageUpdateOne {
+	_u.mutation.ClearSessionID()
+	return _u
+}
+
+// SetProvider sets the "provider" field.
+func (_u *TokenUsageUpdateOne) SetProvider(v string) *TokenUsageUpdateOne {
+	_u.mutation.SetProvider(v)
+	return _u
+}
+
+// SetNillableProvider sets the "provider" field if the given value is not nil.
+func (_u *TokenUsageUpdateOne) SetNillableProvider(v *string) *TokenUsageUpdateOne {
+	if v != nil {
+		_u.SetProvider(*v)
+	}
+	return _u
+}
+
+// SetModel sets the "model" field.
+func (_u *TokenUsageUpdateOne) SetModel(v string) *TokenUsageUpdateOne {
+	_u.mutation.SetModel(v)
+	return _u
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (_u *TokenUsageUpdateOne) SetNillableModel(v *string) *TokenUsageUpdateOne {
+	if v != nil {
+		_u.SetModel(*v)
+	}
+	return _u
+}
+
+// SetOperation sets the "operation" field.
+func (_u *TokenUsageUpdateOne) SetOperation(v string) *TokenUsageUpdateOne {
+	_u.mutation.SetOperation(v)
+	return _u
+}
+
+// SetNillableOperation sets the "operation" field if the given value is not nil.
+func (_u *TokenUsageUpdateOne) SetNillableOperation(v *string) *TokenUsageUpdateOne {
+	if v != nil {
+		_u.SetOperation(*v)
+	}
+	return _u
+}
+
+// SetInputTokens sets the "input_tokens" field.
+func (_u *TokenUsageUpdateOne) SetInputTokens(v int) *TokenUsageUpdateOne {
+	_u.mutation.ResetInputTokens()
+	_u.mutation.SetInputTokens(v)
+	return _u
+}
+
+// SetNillableInputTokens sets the "input_tokens" field if the given value is not nil.
+func (_u *TokenUsageUpdateOne) SetNillableInputTokens(v *int) *TokenUsageUpdateOne {
+	if v != nil {
+		_u.SetInputTokens(*v)
+	}
+	return _u
+}
+
+// AddInputTokens adds value to the "input_tokens" field.
+func (_u *TokenUsageUpdateOne) AddInputTokens(v int) *TokenUsageUpdateOne {
+	_u.mutation.AddInputTokens(v)
+	return _u
+}
+
+// SetCachedTokens sets the "cached_tokens" field.
+func (_u *TokenUsageUpdateOne) SetCachedTokens(v int) *TokenUsageUpdateOne {
+	_u.mutation.ResetCachedTokens()
+	_u.mutation.SetCachedTokens(v)
+	return _u
+}
+
+// SetNillableCachedTokens sets the "cached_tokens" field if the given value is not nil.
+func (_u *TokenUsageUpdateOne) SetNillableCachedTokens(v *int) *TokenUsageUpdateOne {
+	if v != nil {
+		_u.SetCachedTokens(*v)
+	}
+	return _u
+}
+
+// AddCachedTokens adds value to the "cached_tokens" field.
+func (_u *TokenUsageUpdateOne) AddCachedTokens(v int) *TokenUsageUpdateOne {
+	_u.mutation.AddCachedTokens(v)
+	return _u
+}
+
+// SetOutputTokens sets the "output_tokens" field.
+func (_u *TokenUsageUpdateOne) SetOutputTokens(v int) *TokenUsageUpdateOne {
+	_u.mutation.ResetOutputTokens()
+	_u.mutation.SetOutputTokens(v)
+	return _u
+}
+
+// SetNillableOutputTokens sets the "output_tokens" field if the given value is not nil.
+func (_u *TokenUsageUpdateOne) SetNillableOutputTokens(v *int) *TokenUsageUpdateOne {
+	if v != nil {
+		_u.SetOutputTokens(*v)
+	}
+	return _u
+}
+
+// AddOutputTokens adds value to the "output_tokens" field.
+func (_u *TokenUsageUpdateOne) AddOutputTokens(v int) *TokenUsageUpdateOne {
+	_u.mutation.AddOutputTokens(v)
+	return _u
+}
+
+// SetGrounded sets the "grounded" field.
+func (_u *TokenUsageUpdateOne) SetGrounded(v bool) *TokenUsageUpdateOne {
+	_u.mutation.SetGrounded(v)
+	return _u
+}
+
+// SetNillableGrounded sets the "grounded" field if the given value is not nil.
+func (_u *TokenUsageUpdateOne) SetNillableGrounded(v *bool) *TokenUsageUpdateOne {
+	if v != nil {
+		_u.SetGrounded(*v)
+	}
+	return _u
+}
+
+// SetLatencyMs sets the "latency_ms" field.
+func (_u *TokenUsageUpdateOne) SetLatencyMs(v int) *TokenUsageUpdateOne {
+	_u.mutation.ResetLatencyMs()
+	_u.mutation.SetLatencyMs(v)
+	return _u
+}
+
+// SetNillableLatencyMs sets the "latency_ms" field if the given value is not nil.
+func (_u *TokenUsageUpdateOne) SetNillableLatencyMs(v *int) *TokenUsageUpdateOne {
+	if v != nil {
+		_u.SetLatencyMs(*v)
+	}
+	return _u
+}
+
+// AddLatencyMs adds value to the "latency_ms" field.
+func (_u *TokenUsageUpdateOne) AddLatencyMs(v int) *TokenUsageUpdateOne {
+	_u.mutation.AddLatencyMs(v)
+	return _u
+}
+
+// SetCostUsd sets the "cost_usd" field.
+func (_u *TokenUsageUpdateOne) SetCostUsd(v float64) *TokenUsageUpdateOne {
+	_u.mutation.ResetCostUsd()
+	_u.mutation.SetCostUsd(v)
+	return _u
+}
+
+// SetNillableCostUsd sets the "cost_usd" field if the given value is not nil.
+func (_u *TokenUsageUpdateOne) SetNillableCostUsd(v *float64) *TokenUsageUpdateOne {
+	if v != nil {
+		_u.SetCostUsd(*v)
+	}
+	return _u
+}
+
+// AddCostUsd adds value to the "cost_usd" field.
+func (_u *TokenUsageUpdateOne) AddCostUsd(v float64) *TokenUsageUpdateOne {
+	_u.mutation.AddCostUsd(v)
+	return _u
+}
+
+// SetDay sets the "day" field.
+func (_u *TokenUsageUpdateOne) SetDay(v string) *TokenUsageUpdateOne {
+	_u.mutation.SetDay(v)
+	return _u
+}
+
+// SetNillableDay sets the "day" field if the given value is not nil.
+func (_u *TokenUsageUpdateOne) SetNillableDay(v *string) *TokenUsageUpdateOne {
+	if v != nil {
+		_u.SetDay(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TokenUsageMutation object of the builder.
+func (_u *TokenUsageUpdateOne) Mutation() *TokenUsageMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TokenUsageUpdate builder.
+func (_u *TokenUsageUpdateOne) Where(ps ...predicate.TokenUsage) *TokenUsageUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TokenUsageUpdateOne) Select(field string, fields ...string) *TokenUsageUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TokenUsage entity.
+func (_u *TokenUsageUpdateOne) Save(ctx context.Context) (*TokenUsage, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TokenUsageUpdateOne) SaveX(ctx context.Context) *TokenUsage {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TokenUsageUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TokenUsageUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TokenUsageUpdateOne) check() error {
+	if v, ok := _u.mutation.Provider(); ok {
+		if err := tokenusage.ProviderValidator(v); err != nil {
+			return &ValidationError{Name: "provider", err: fmt.Errorf(`ent: validator failed for field "TokenUsage.provider": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Model(); ok {
+		if err := tokenusage.ModelValidator(v); err != nil {
+			return &ValidationError{Name: "model", err: fmt.Errorf(`ent: validator failed for field "TokenUsage.model": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Operation(); ok {
+		if err := tokenusage.OperationValidator(v); err != nil {
+			return &ValidationError{Name: "operation", err: fmt.Errorf(`ent: validator failed for field "TokenUsage.operation": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Day(); ok {
+		if err := tokenusage.DayValidator(v); err != nil {
+			return &ValidationError{Name: "day", err: fmt.Errorf(`ent: validator failed for field "TokenUsage.day": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TokenUsageUpdateOne) sqlSave(ctx context.Context) (_node *TokenUsage, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(tokenusage.Table, tokenusage.Columns, sqlgraph.NewFieldSpec(tokenusage.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TokenUsage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, tokenusage.FieldID)
+		for _, f := range fields {
+			if !tokenusage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != tokenusage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(tokenusage.FieldUserID, field.TypeUUID, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(tokenusage.FieldUserID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.SessionID(); ok {
+		_spec.SetField(tokenusage.FieldSessionID, field.TypeString, value)
+	}
+	if _u.mutation.SessionIDCleared() {
+		_spec.ClearField(tokenusage.FieldSessionID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Provider(); ok {
+		_spec.SetField(tokenusage.FieldProvider, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Model(); ok {
+		_spec.SetField(tokenusage.FieldModel, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Operation(); ok {
+		_spec.SetField(tokenusage.FieldOperation, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.InputTokens(); ok {
+		_spec.SetField(tokenusage.FieldInputTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedInputTokens(); ok {
+		_spec.AddField(tokenusage.FieldInputTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CachedTokens(); ok {
+		_spec.SetField(tokenusage.FieldCachedTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCachedTokens(); ok {
+		_spec.AddField(tokenusage.FieldCachedTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.OutputTokens(); ok {
+		_spec.SetField(tokenusage.FieldOutputTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedOutputTokens(); ok {
+		_spec.AddField(tokenusage.FieldOutputTokens, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Grounded(); ok {
+		_spec.SetField(tokenusage.FieldGrounded, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.LatencyMs(); ok {
+		_spec.SetField(tokenusage.FieldLatencyMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLatencyMs(); ok {
+		_spec.AddField(tokenusage.FieldLatencyMs, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CostUsd(); ok {
+		_spec.SetField(tokenusage.FieldCostUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedCostUsd(); ok {
+		_spec.AddField(tokenusage.FieldCostUsd, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.Day(); ok {
+		_spec.SetField(tokenusage.FieldDay, field.TypeString, value)
+	}
+	_node = &TokenUsage{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{tokenusage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -14,14 +14,28 @@ type Tx struct {
 	config
 	// ChatSession is the client for interacting with the ChatSession builders.
 	ChatSession *ChatSessionClient
+	// ConversationShare is the client for interacting with the ConversationShare builders.
+	ConversationShare *ConversationShareClient
+	// ExchangeRate is the client for interacting with the ExchangeRate builders.
+	ExchangeRate *ExchangeRateClient
 	// Message is the client for interacting with the Message builders.
 	Message *MessageClient
+	// PriceObservation is the client for interacting with the PriceObservation builders.
+	PriceObservation *PriceObservationClient
 	// SearchHistory is the client for interacting with the SearchHistory builders.
 	SearchHistory *SearchHistoryClient
+	// TokenUsage is the client for interacting with the TokenUsage builders.
+	TokenUsage *TokenUsageClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 	// UserPreference is the client for interacting with the UserPreference builders.
 	UserPreference *UserPreferenceClient
+	// WatchlistItem is the client for interacting with the WatchlistItem builders.
+	WatchlistItem *WatchlistItemClient
+	// Wishlist is the client for interacting with the Wishlist builders.
+	Wishlist *WishlistClient
+	// WishlistItem is the client for interacting with the WishlistItem builders.
+	WishlistItem *WishlistItemClient
 
 	// lazily loaded.
 	client     *Client
@@ -154,10 +168,17 @@ func (tx *Tx) Client() *Client {
 
 func (tx *Tx) init() {
 	tx.ChatSession = NewChatSessionClient(tx.config)
+	tx.ConversationShare = NewConversationShareClient(tx.config)
+	tx.ExchangeRate = NewExchangeRateClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
+	tx.PriceObservation = NewPriceObservationClient(tx.config)
 	tx.SearchHistory = NewSearchHistoryClient(tx.config)
+	tx.TokenUsage = NewTokenUsageClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 	tx.UserPreference = NewUserPreferenceClient(tx.config)
+	tx.WatchlistItem = NewWatchlistItemClient(tx.config)
+	tx.Wishlist = NewWishlistClient(tx.config)
+	tx.WishlistItem = NewWishlistItemClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -31,6 +31,8 @@ type User struct {
 	AvatarURL string `json:"avatar_url,omitempty"`
 	// Provider holds the value of the "provider" field.
 	Provider string `json:"provider,omitempty"`
+	// Plan holds the value of the "plan" field.
+	Plan string `json:"plan,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -51,9 +53,15 @@ type UserEdges struct {
 	SearchHistory []*SearchHistory `json:"search_history,omitempty"`
 	// Preferences holds the value of the preferences edge.
 	Preferences *UserPreference `json:"preferences,omitempty"`
+	// Watchlist holds the value of the watchlist edge.
+	Watchlist []*WatchlistItem `json:"watchlist,omitempty"`
+	// Wishlists holds the value of the wishlists edge.
+	Wishlists []*Wishlist `json:"wishlists,omitempty"`
+	// ConversationShares holds the value of the conversation_shares edge.
+	ConversationShares []*ConversationShare `json:"conversation_shares,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [3]bool
+	loadedTypes [6]bool
 }
 
 // SessionsOrErr returns the Sessions value or an error if the edge
@@ -85,12 +93,39 @@ func (e UserEdges) PreferencesOrErr() (*UserPreference, error) {
 	return nil, &NotLoadedError{edge: "preferences"}
 }
 
+// WatchlistOrErr returns the Watchlist value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WatchlistOrErr() ([]*WatchlistItem, error) {
+	if e.loadedTypes[3] {
+		return e.Watchlist, nil
+	}
+	return nil, &NotLoadedError{edge: "watchlist"}
+}
+
+// WishlistsOrErr returns the Wishlists value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) WishlistsOrErr() ([]*Wishlist, error) {
+	if e.loadedTypes[4] {
+		return e.Wishlists, nil
+	}
+	return nil, &NotLoadedError{edge: "wishlists"}
+}
+
+// ConversationSharesOrErr returns the ConversationShares value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ConversationSharesOrErr() ([]*ConversationShare, error) {
+	if e.loadedTypes[5] {
+		return e.ConversationShares, nil
+	}
+	return nil, &NotLoadedError{edge: "conversation_shares"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldEmail, user.FieldPasswordHash, user.FieldGoogleID, user.FieldName, user.FieldAvatarURL, user.FieldProvider:
+		case user.FieldEmail, user.FieldPasswordHash, user.FieldGoogleID, user.FieldName, user.FieldAvatarURL, user.FieldProvider, user.FieldPlan:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt, user.FieldUpdatedAt, user.FieldLastLogin:
 			values[i] = new(sql.NullTime)
@@ -153,6 +188,12 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Provider = value.String
 			}
+		case user.FieldPlan:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field plan", values[i])
+			} else if value.Valid {
+				_m.Plan = value.String
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -199,6 +240,21 @@ func (_m *User) QueryPreferences() *UserPreferenceQuery {
 	return NewUserClient(_m.config).QueryPreferences(_m)
 }
 
+// QueryWatchlist queries the "watchlist" edge of the User entity.
+func (_m *User) QueryWatchlist() *WatchlistItemQuery {
+	return NewUserClient(_m.config).QueryWatchlist(_m)
+}
+
+// QueryWishlists queries the "wishlists" edge of the User entity.
+func (_m *User) QueryWishlists() *WishlistQuery {
+	return NewUserClient(_m.config).QueryWishlists(_m)
+}
+
+// QueryConversationShares queries the "conversation_shares" edge of the User entity.
+func (_m *User) QueryConversationShares() *ConversationShareQuery {
+	return NewUserClient(_m.config).QueryConversationShares(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -239,6 +295,9 @@ func (_m *User) String() string {
 	builder.WriteString("provider=")
 	builder.WriteString(_m.Provider)
 	builder.WriteString(", ")
+	builder.WriteString("plan=")
+	builder.WriteString(_m.Plan)
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -27,6 +27,8 @@ const (
 	FieldAvatarURL = "avatar_url"
 	// FieldProvider holds the string denoting the provider field in the database.
 	FieldProvider = "provider"
+	// FieldPlan holds the string denoting the plan field in the database.
+	FieldPlan = "plan"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -39,6 +41,12 @@ const (
 	EdgeSearchHistory = "search_history"
 	// EdgePreferences holds the string denoting the preferences edge name in mutations.
 	EdgePreferences = "preferences"
+	// EdgeWatchlist holds the string denoting the watchlist edge name in mutations.
+	EdgeWatchlist = "watchlist"
+	// EdgeWishlists holds the string denoting the wishlists edge name in mutations.
+	EdgeWishlists = "wishlists"
+	// EdgeConversationShares holds the string denoting the conversation_shares edge name in mutations.
+	EdgeConversationShares = "conversation_shares"
 	// Table holds the table name of the user in the database.
 	Table = "users"
 	// SessionsTable is the table that holds the sessions relation/edge.
@@ -62,6 +70,27 @@ const (
 	PreferencesInverseTable = "user_preferences"
 	// PreferencesColumn is the table column denoting the preferences relation/edge.
 	PreferencesColumn = "user_id"
+	// WatchlistTable is the table that holds the watchlist relation/edge.
+	WatchlistTable = "watchlist_items"
+	// WatchlistInverseTable is the table name for the WatchlistItem entity.
+	// It exists in this package in order to avoid circular dependency with the "watchlistitem" package.
+	WatchlistInverseTable = "watchlist_items"
+	// WatchlistColumn is the table column denoting the watchlist relation/edge.
+	WatchlistColumn = "user_id"
+	// WishlistsTable is the table that holds the wishlists relation/edge.
+	WishlistsTable = "wishlists"
+	// WishlistsInverseTable is the table name for the Wishlist entity.
+	// It exists in this package in order to avoid circular dependency with the "wishlist" package.
+	WishlistsInverseTable = "wishlists"
+	// WishlistsColumn is the table column denoting the wishlists relation/edge.
+	WishlistsColumn = "user_id"
+	// ConversationSharesTable is the table that holds the conversation_shares relation/edge.
+	ConversationSharesTable = "conversation_shares"
+	// ConversationSharesInverseTable is the table name for the ConversationShare entity.
+	// It exists in this package in order to avoid circular dependency with the "conversationshare" package.
+	ConversationSharesInverseTable = "conversation_shares"
+	// ConversationSharesColumn is the table column denoting the conversation_shares relation/edge.
+	ConversationSharesColumn = "user_id"
 )
 
 // Columns holds all SQL columns for user fields.
@@ -73,6 +102,7 @@ var Columns = []string{
 	FieldName,
 	FieldAvatarURL,
 	FieldProvider,
+	FieldPlan,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 	FieldLastLogin,
@@ -93,6 +123,8 @@ var (
 	EmailValidator func(string) error
 	// DefaultProvider holds the default value on creation for the "provider" field.
 	DefaultProvider string
+	// DefaultPlan holds the default value on creation for the "plan" field.
+	DefaultPlan string
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -141,6 +173,11 @@ func ByProvider(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldProvider, opts...).ToFunc()
 }
 
+// ByPlan orders the results by the plan field.
+func ByPlan(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPlan, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -190,6 +227,48 @@ func ByPreferencesField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newPreferencesStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByWatchlistCount orders the results by watchlist count.
+func ByWatchlistCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWatchlistStep(), opts...)
+	}
+}
+
+// ByWatchlist orders the results by watchlist terms.
+func ByWatchlist(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWatchlistStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByWishlistsCount orders the results by wishlists count.
+func ByWishlistsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newWishlistsStep(), opts...)
+	}
+}
+
+// ByWishlists orders the results by wishlists terms.
+func ByWishlists(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWishlistsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByConversationSharesCount orders the results by conversation_shares count.
+func ByConversationSharesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newConversationSharesStep(), opts...)
+	}
+}
+
+// ByConversationShares orders the results by conversation_shares terms.
+func ByConversationShares(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newConversationSharesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newSessionsStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -211,3 +290,24 @@ func newPreferencesStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2O, false, PreferencesTable, PreferencesColumn),
 	)
 }
+func newWatchlistStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WatchlistInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WatchlistTable, WatchlistColumn),
+	)
+}
+func newWishlistsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WishlistsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, WishlistsTable, WishlistsColumn),
+	)
+}
+func newConversationSharesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ConversationSharesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ConversationSharesTable, ConversationSharesColumn),
+	)
+}
@@ -86,6 +86,11 @@ func Provider(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldProvider, v))
 }
 
+// Plan applies equality check predicate on the "plan" field. It's identical to PlanEQ.
+func Plan(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPlan, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -531,6 +536,71 @@ func ProviderContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldProvider, v))
 }
 
+// PlanEQ applies the EQ predicate on the "plan" field.
+func PlanEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldPlan, v))
+}
+
+// PlanNEQ applies the NEQ predicate on the "plan" field.
+func PlanNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldPlan, v))
+}
+
+// PlanIn applies the In predicate on the "plan" field.
+func PlanIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldPlan, vs...))
+}
+
+// PlanNotIn applies the NotIn predicate on the "plan" field.
+func PlanNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldPlan, vs...))
+}
+
+// PlanGT applies the GT predicate on the "plan" field.
+func PlanGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldPlan, v))
+}
+
+// PlanGTE applies the GTE predicate on the "plan" field.
+func PlanGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldPlan, v))
+}
+
+// PlanLT applies the LT predicate on the "plan" field.
+func PlanLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldPlan, v))
+}
+
+// PlanLTE applies the LTE predicate on the "plan" field.
+func PlanLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldPlan, v))
+}
+
+// PlanContains applies the Contains predicate on the "plan" field.
+func PlanContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldPlan, v))
+}
+
+// PlanHasPrefix applies the HasPrefix predicate on the "plan" field.
+func PlanHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldPlan, v))
+}
+
+// PlanHasSuffix applies the HasSuffix predicate on the "plan" field.
+func PlanHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldPlan, v))
+}
+
+// PlanEqualFold applies the EqualFold predicate on the "plan" field.
+func PlanEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldPlan, v))
+}
+
+// PlanContainsFold applies the ContainsFold predicate on the "plan" field.
+func PlanContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldPlan, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -730,6 +800,75 @@ func HasPreferencesWith(preds ...predicate.UserPreference) predicate.User {
 	})
 }
 
+// HasWatchlist applies the HasEdge predicate on the "watchlist" edge.
+func HasWatchlist() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WatchlistTable, WatchlistColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWatchlistWith applies the HasEdge predicate on the "watchlist" edge with a given conditions (other predicates).
+func HasWatchlistWith(preds ...predicate.WatchlistItem) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newWatchlistStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasWishlists applies the HasEdge predicate on the "wishlists" edge.
+func HasWishlists() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, WishlistsTable, WishlistsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWishlistsWith applies the HasEdge predicate on the "wishlists" edge with a given conditions (other predicates).
+func HasWishlistsWith(preds ...predicate.Wishlist) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newWishlistsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasConversationShares applies the HasEdge predicate on the "conversation_shares" edge.
+func HasConversationShares() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ConversationSharesTable, ConversationSharesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasConversationSharesWith applies the HasEdge predicate on the "conversation_shares" edge with a given conditions (other predicates).
+func HasConversationSharesWith(preds ...predicate.ConversationShare) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newConversationSharesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
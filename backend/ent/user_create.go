@@ -7,9 +7,12 @@ import (
 	"errors"
 	"fmt"
 	"mylittleprice/ent/chatsession"
+	"mylittleprice/ent/conversationshare"
 	"mylittleprice/ent/searchhistory"
 	"mylittleprice/ent/user"
 	"mylittleprice/ent/userpreference"
+	"mylittleprice/ent/watchlistitem"
+	"mylittleprice/ent/wishlist"
 	"time"
 
 	"entgo.io/ent/dialect/sql/sqlgraph"
@@ -100,6 +103,20 @@ func (_c *UserCreate) SetNillableProvider(v *string) *UserCreate {
 	return _c
 }
 
+// SetPlan sets the "plan" field.
+func (_c *UserCreate) SetPlan(v string) *UserCreate {
+	_c.mutation.SetPlan(v)
+	return _c
+}
+
+// SetNillablePlan sets the "plan" field if the given value is not nil.
+func (_c *UserCreate) SetNillablePlan(v *string) *UserCreate {
+	if v != nil {
+		_c.SetPlan(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *UserCreate) SetCreatedAt(v time.Time) *UserCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -205,6 +222,51 @@ func (_c *UserCreate) SetPreferences(v *UserPreference) *UserCreate {
 	return _c.SetPreferencesID(v.ID)
 }
 
+// AddWatchlistIDs adds the "watchlist" edge to the WatchlistItem entity by IDs.
+func (_c *UserCreate) AddWatchlistIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddWatchlistIDs(ids...)
+	return _c
+}
+
+// AddWatchlist adds the "watchlist" edges to the WatchlistItem entity.
+func (_c *UserCreate) AddWatchlist(v ...*WatchlistItem) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWatchlistIDs(ids...)
+}
+
+// AddWishlistIDs adds the "wishlists" edge to the Wishlist entity by IDs.
+func (_c *UserCreate) AddWishlistIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddWishlistIDs(ids...)
+	return _c
+}
+
+// AddWishlists adds the "wishlists" edges to the Wishlist entity.
+func (_c *UserCreate) AddWishlists(v ...*Wishlist) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddWishlistIDs(ids...)
+}
+
+// AddConversationShareIDs adds the "conversation_shares" edge to the ConversationShare entity by IDs.
+func (_c *UserCreate) AddConversationShareIDs(ids ...uuid.UUID) *UserCreate {
+	_c.mutation.AddConversationShareIDs(ids...)
+	return _c
+}
+
+// AddConversationShares adds the "conversation_shares" edges to the ConversationShare entity.
+func (_c *UserCreate) AddConversationShares(v ...*ConversationShare) *UserCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddConversationShareIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_c *UserCreate) Mutation() *UserMutation {
 	return _c.mutation
@@ -244,6 +306,10 @@ func (_c *UserCreate) defaults() {
 		v := user.DefaultProvider
 		_c.mutation.SetProvider(v)
 	}
+	if _, ok := _c.mutation.Plan(); !ok {
+		v := user.DefaultPlan
+		_c.mutation.SetPlan(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := user.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -271,6 +337,9 @@ func (_c *UserCreate) check() error {
 	if _, ok := _c.mutation.Provider(); !ok {
 		return &ValidationError{Name: "provider", err: errors.New(`ent: missing required field "User.provider"`)}
 	}
+	if _, ok := _c.mutation.Plan(); !ok {
+		return &ValidationError{Name: "plan", err: errors.New(`ent: missing required field "User.plan"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "User.created_at"`)}
 	}
@@ -336,6 +405,10 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		_spec.SetField(user.FieldProvider, field.TypeString, value)
 		_node.Provider = value
 	}
+	if value, ok := _c.mutation.Plan(); ok {
+		_spec.SetField(user.FieldPlan, field.TypeString, value)
+		_node.Plan = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(user.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -396,6 +469,54 @@ func (_c *UserCreate) createSpec() (*User, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.WatchlistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WatchlistTable,
+			Columns: []string{user.WatchlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.WishlistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WishlistsTable,
+			Columns: []string{user.WishlistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ConversationSharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ConversationSharesTable,
+			Columns: []string{user.ConversationSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	return _node, _spec
 }
 
@@ -8,10 +8,13 @@ import (
 	"fmt"
 	"math"
 	"mylittleprice/ent/chatsession"
+	"mylittleprice/ent/conversationshare"
 	"mylittleprice/ent/predicate"
 	"mylittleprice/ent/searchhistory"
 	"mylittleprice/ent/user"
 	"mylittleprice/ent/userpreference"
+	"mylittleprice/ent/watchlistitem"
+	"mylittleprice/ent/wishlist"
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
@@ -23,13 +26,16 @@ import (
 // UserQuery is the builder for querying User entities.
 type UserQuery struct {
 	config
-	ctx               *QueryContext
-	order             []user.OrderOption
-	inters            []Interceptor
-	predicates        []predicate.User
-	withSessions      *ChatSessionQuery
-	withSearchHistory *SearchHistoryQuery
-	withPreferences   *UserPreferenceQuery
+	ctx                    *QueryContext
+	order                  []user.OrderOption
+	inters                 []Interceptor
+	predicates             []predicate.User
+	withSessions           *ChatSessionQuery
+	withSearchHistory      *SearchHistoryQuery
+	withPreferences        *UserPreferenceQuery
+	withWatchlist          *WatchlistItemQuery
+	withWishlists          *WishlistQuery
+	withConversationShares *ConversationShareQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -132,6 +138,72 @@ func (_q *UserQuery) QueryPreferences() *UserPreferenceQuery {
 	return query
 }
 
+// QueryWatchlist chains the current query on the "watchlist" edge.
+func (_q *UserQuery) QueryWatchlist() *WatchlistItemQuery {
+	query := (&WatchlistItemClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(watchlistitem.Table, watchlistitem.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WatchlistTable, user.WatchlistColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryWishlists chains the current query on the "wishlists" edge.
+func (_q *UserQuery) QueryWishlists() *WishlistQuery {
+	query := (&WishlistClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(wishlist.Table, wishlist.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.WishlistsTable, user.WishlistsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryConversationShares chains the current query on the "conversation_shares" edge.
+func (_q *UserQuery) QueryConversationShares() *ConversationShareQuery {
+	query := (&ConversationShareClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, selector),
+			sqlgraph.To(conversationshare.Table, conversationshare.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.ConversationSharesTable, user.ConversationSharesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first User entity from the query.
 // Returns a *NotFoundError when no User was found.
 func (_q *UserQuery) First(ctx context.Context) (*User, error) {
@@ -319,14 +391,17 @@ func (_q *UserQuery) Clone() *UserQuery {
 		return nil
 	}
 	return &UserQuery{
-		config:            _q.config,
-		ctx:               _q.ctx.Clone(),
-		order:             append([]user.OrderOption{}, _q.order...),
-		inters:            append([]Interceptor{}, _q.inters...),
-		predicates:        append([]predicate.User{}, _q.predicates...),
-		withSessions:      _q.withSessions.Clone(),
-		withSearchHistory: _q.withSearchHistory.Clone(),
-		withPreferences:   _q.withPreferences.Clone(),
+		config:                 _q.config,
+		ctx:                    _q.ctx.Clone(),
+		order:                  append([]user.OrderOption{}, _q.order...),
+		inters:                 append([]Interceptor{}, _q.inters...),
+		predicates:             append([]predicate.User{}, _q.predicates...),
+		withSessions:           _q.withSessions.Clone(),
+		withSearchHistory:      _q.withSearchHistory.Clone(),
+		withPreferences:        _q.withPreferences.Clone(),
+		withWatchlist:          _q.withWatchlist.Clone(),
+		withWishlists:          _q.withWishlists.Clone(),
+		withConversationShares: _q.withConversationShares.Clone(),
 		// clone intermediate query.
 		sql:  _q.sql.Clone(),
 		path: _q.path,
@@ -366,6 +441,39 @@ func (_q *UserQuery) WithPreferences(opts ...func(*UserPreferenceQuery)) *UserQu
 	return _q
 }
 
+// WithWatchlist tells the query-builder to eager-load the nodes that are connected to
+// the "watchlist" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithWatchlist(opts ...func(*WatchlistItemQuery)) *UserQuery {
+	query := (&WatchlistItemClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWatchlist = query
+	return _q
+}
+
+// WithWishlists tells the query-builder to eager-load the nodes that are connected to
+// the "wishlists" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithWishlists(opts ...func(*WishlistQuery)) *UserQuery {
+	query := (&WishlistClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withWishlists = query
+	return _q
+}
+
+// WithConversationShares tells the query-builder to eager-load the nodes that are connected to
+// the "conversation_shares" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *UserQuery) WithConversationShares(opts ...func(*ConversationShareQuery)) *UserQuery {
+	query := (&ConversationShareClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withConversationShares = query
+	return _q
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -444,10 +552,13 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 	var (
 		nodes       = []*User{}
 		_spec       = _q.querySpec()
-		loadedTypes = [3]bool{
+		loadedTypes = [6]bool{
 			_q.withSessions != nil,
 			_q.withSearchHistory != nil,
 			_q.withPreferences != nil,
+			_q.withWatchlist != nil,
+			_q.withWishlists != nil,
+			_q.withConversationShares != nil,
 		}
 	)
 	_spec.ScanValues = func(columns []string) ([]any, error) {
@@ -488,6 +599,29 @@ func (_q *UserQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*User, e
 			return nil, err
 		}
 	}
+	if query := _q.withWatchlist; query != nil {
+		if err := _q.loadWatchlist(ctx, query, nodes,
+			func(n *User) { n.Edges.Watchlist = []*WatchlistItem{} },
+			func(n *User, e *WatchlistItem) { n.Edges.Watchlist = append(n.Edges.Watchlist, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withWishlists; query != nil {
+		if err := _q.loadWishlists(ctx, query, nodes,
+			func(n *User) { n.Edges.Wishlists = []*Wishlist{} },
+			func(n *User, e *Wishlist) { n.Edges.Wishlists = append(n.Edges.Wishlists, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withConversationShares; query != nil {
+		if err := _q.loadConversationShares(ctx, query, nodes,
+			func(n *User) { n.Edges.ConversationShares = []*ConversationShare{} },
+			func(n *User, e *ConversationShare) {
+				n.Edges.ConversationShares = append(n.Edges.ConversationShares, e)
+			}); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -578,6 +712,96 @@ func (_q *UserQuery) loadPreferences(ctx context.Context, query *UserPreferenceQ
 	}
 	return nil
 }
+func (_q *UserQuery) loadWatchlist(ctx context.Context, query *WatchlistItemQuery, nodes []*User, init func(*User), assign func(*User, *WatchlistItem)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(watchlistitem.FieldUserID)
+	}
+	query.Where(predicate.WatchlistItem(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.WatchlistColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadWishlists(ctx context.Context, query *WishlistQuery, nodes []*User, init func(*User), assign func(*User, *Wishlist)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(wishlist.FieldUserID)
+	}
+	query.Where(predicate.Wishlist(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.WishlistsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *UserQuery) loadConversationShares(ctx context.Context, query *ConversationShareQuery, nodes []*User, init func(*User), assign func(*User, *ConversationShare)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*User)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(conversationshare.FieldUserID)
+	}
+	query.Where(predicate.ConversationShare(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(user.ConversationSharesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.UserID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "user_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (_q *UserQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := _q.querySpec()
@@ -7,10 +7,13 @@ import (
 	"errors"
 	"fmt"
 	"mylittleprice/ent/chatsession"
+	"mylittleprice/ent/conversationshare"
 	"mylittleprice/ent/predicate"
 	"mylittleprice/ent/searchhistory"
 	"mylittleprice/ent/user"
 	"mylittleprice/ent/userpreference"
+	"mylittleprice/ent/watchlistitem"
+	"mylittleprice/ent/wishlist"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -140,6 +143,20 @@ func (_u *UserUpdate) SetNillableProvider(v *string) *UserUpdate {
 	return _u
 }
 
+// SetPlan sets the "plan" field.
+func (_u *UserUpdate) SetPlan(v string) *UserUpdate {
+	_u.mutation.SetPlan(v)
+	return _u
+}
+
+// SetNillablePlan sets the "plan" field if the given value is not nil.
+func (_u *UserUpdate) SetNillablePlan(v *string) *UserUpdate {
+	if v != nil {
+		_u.SetPlan(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserUpdate) SetUpdatedAt(v time.Time) *UserUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -215,6 +232,51 @@ func (_u *UserUpdate) SetPreferences(v *UserPreference) *UserUpdate {
 	return _u.SetPreferencesID(v.ID)
 }
 
+// AddWatchlistIDs adds the "watchlist" edge to the WatchlistItem entity by IDs.
+func (_u *UserUpdate) AddWatchlistIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddWatchlistIDs(ids...)
+	return _u
+}
+
+// AddWatchlist adds the "watchlist" edges to the WatchlistItem entity.
+func (_u *UserUpdate) AddWatchlist(v ...*WatchlistItem) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWatchlistIDs(ids...)
+}
+
+// AddWishlistIDs adds the "wishlists" edge to the Wishlist entity by IDs.
+func (_u *UserUpdate) AddWishlistIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddWishlistIDs(ids...)
+	return _u
+}
+
+// AddWishlists adds the "wishlists" edges to the Wishlist entity.
+func (_u *UserUpdate) AddWishlists(v ...*Wishlist) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWishlistIDs(ids...)
+}
+
+// AddConversationShareIDs adds the "conversation_shares" edge to the ConversationShare entity by IDs.
+func (_u *UserUpdate) AddConversationShareIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.AddConversationShareIDs(ids...)
+	return _u
+}
+
+// AddConversationShares adds the "conversation_shares" edges to the ConversationShare entity.
+func (_u *UserUpdate) AddConversationShares(v ...*ConversationShare) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddConversationShareIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdate) Mutation() *UserMutation {
 	return _u.mutation
@@ -268,6 +330,69 @@ func (_u *UserUpdate) ClearPreferences() *UserUpdate {
 	return _u
 }
 
+// ClearWatchlist clears all "watchlist" edges to the WatchlistItem entity.
+func (_u *UserUpdate) ClearWatchlist() *UserUpdate {
+	_u.mutation.ClearWatchlist()
+	return _u
+}
+
+// RemoveWatchlistIDs removes the "watchlist" edge to WatchlistItem entities by IDs.
+func (_u *UserUpdate) RemoveWatchlistIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveWatchlistIDs(ids...)
+	return _u
+}
+
+// RemoveWatchlist removes "watchlist" edges to WatchlistItem entities.
+func (_u *UserUpdate) RemoveWatchlist(v ...*WatchlistItem) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWatchlistIDs(ids...)
+}
+
+// ClearWishlists clears all "wishlists" edges to the Wishlist entity.
+func (_u *UserUpdate) ClearWishlists() *UserUpdate {
+	_u.mutation.ClearWishlists()
+	return _u
+}
+
+// RemoveWishlistIDs removes the "wishlists" edge to Wishlist entities by IDs.
+func (_u *UserUpdate) RemoveWishlistIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveWishlistIDs(ids...)
+	return _u
+}
+
+// RemoveWishlists removes "wishlists" edges to Wishlist entities.
+func (_u *UserUpdate) RemoveWishlists(v ...*Wishlist) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWishlistIDs(ids...)
+}
+
+// ClearConversationShares clears all "conversation_shares" edges to the ConversationShare entity.
+func (_u *UserUpdate) ClearConversationShares() *UserUpdate {
+	_u.mutation.ClearConversationShares()
+	return _u
+}
+
+// RemoveConversationShareIDs removes the "conversation_shares" edge to ConversationShare entities by IDs.
+func (_u *UserUpdate) RemoveConversationShareIDs(ids ...uuid.UUID) *UserUpdate {
+	_u.mutation.RemoveConversationShareIDs(ids...)
+	return _u
+}
+
+// RemoveConversationShares removes "conversation_shares" edges to ConversationShare entities.
+func (_u *UserUpdate) RemoveConversationShares(v ...*ConversationShare) *UserUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveConversationShareIDs(ids...)
+}
+
 // Save executes the query and returns the number of nodes affected by the update operation.
 func (_u *UserUpdate) Save(ctx context.Context) (int, error) {
 	_u.defaults()
@@ -356,6 +481,9 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Provider(); ok {
 		_spec.SetField(user.FieldProvider, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Plan(); ok {
+		_spec.SetField(user.FieldPlan, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -484,6 +612,141 @@ func (_u *UserUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.WatchlistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WatchlistTable,
+			Columns: []string{user.WatchlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWatchlistIDs(); len(nodes) > 0 && !_u.mutation.WatchlistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WatchlistTable,
+			Columns: []string{user.WatchlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WatchlistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WatchlistTable,
+			Columns: []string{user.WatchlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WishlistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WishlistsTable,
+			Columns: []string{user.WishlistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWishlistsIDs(); len(nodes) > 0 && !_u.mutation.WishlistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WishlistsTable,
+			Columns: []string{user.WishlistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WishlistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WishlistsTable,
+			Columns: []string{user.WishlistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ConversationSharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ConversationSharesTable,
+			Columns: []string{user.ConversationSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationshare.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedConversationSharesIDs(); len(nodes) > 0 && !_u.mutation.ConversationSharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ConversationSharesTable,
+			Columns: []string{user.ConversationSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConversationSharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ConversationSharesTable,
+			Columns: []string{user.ConversationSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{user.Label}
@@ -612,6 +875,20 @@ func (_u *UserUpdateOne) SetNillableProvider(v *string) *UserUpdateOne {
 	return _u
 }
 
+// SetPlan sets the "plan" field.
+func (_u *UserUpdateOne) SetPlan(v string) *UserUpdateOne {
+	_u.mutation.SetPlan(v)
+	return _u
+}
+
+// SetNillablePlan sets the "plan" field if the given value is not nil.
+func (_u *UserUpdateOne) SetNillablePlan(v *string) *UserUpdateOne {
+	if v != nil {
+		_u.SetPlan(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *UserUpdateOne) SetUpdatedAt(v time.Time) *UserUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -687,6 +964,51 @@ func (_u *UserUpdateOne) SetPreferences(v *UserPreference) *UserUpdateOne {
 	return _u.SetPreferencesID(v.ID)
 }
 
+// AddWatchlistIDs adds the "watchlist" edge to the WatchlistItem entity by IDs.
+func (_u *UserUpdateOne) AddWatchlistIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddWatchlistIDs(ids...)
+	return _u
+}
+
+// AddWatchlist adds the "watchlist" edges to the WatchlistItem entity.
+func (_u *UserUpdateOne) AddWatchlist(v ...*WatchlistItem) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWatchlistIDs(ids...)
+}
+
+// AddWishlistIDs adds the "wishlists" edge to the Wishlist entity by IDs.
+func (_u *UserUpdateOne) AddWishlistIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddWishlistIDs(ids...)
+	return _u
+}
+
+// AddWishlists adds the "wishlists" edges to the Wishlist entity.
+func (_u *UserUpdateOne) AddWishlists(v ...*Wishlist) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddWishlistIDs(ids...)
+}
+
+// AddConversationShareIDs adds the "conversation_shares" edge to the ConversationShare entity by IDs.
+func (_u *UserUpdateOne) AddConversationShareIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.AddConversationShareIDs(ids...)
+	return _u
+}
+
+// AddConversationShares adds the "conversation_shares" edges to the ConversationShare entity.
+func (_u *UserUpdateOne) AddConversationShares(v ...*ConversationShare) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddConversationShareIDs(ids...)
+}
+
 // Mutation returns the UserMutation object of the builder.
 func (_u *UserUpdateOne) Mutation() *UserMutation {
 	return _u.mutation
@@ -740,6 +1062,69 @@ func (_u *UserUpdateOne) ClearPreferences() *UserUpdateOne {
 	return _u
 }
 
+// ClearWatchlist clears all "watchlist" edges to the WatchlistItem entity.
+func (_u *UserUpdateOne) ClearWatchlist() *UserUpdateOne {
+	_u.mutation.ClearWatchlist()
+	return _u
+}
+
+// RemoveWatchlistIDs removes the "watchlist" edge to WatchlistItem entities by IDs.
+func (_u *UserUpdateOne) RemoveWatchlistIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveWatchlistIDs(ids...)
+	return _u
+}
+
+// RemoveWatchlist removes "watchlist" edges to WatchlistItem entities.
+func (_u *UserUpdateOne) RemoveWatchlist(v ...*WatchlistItem) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWatchlistIDs(ids...)
+}
+
+// ClearWishlists clears all "wishlists" edges to the Wishlist entity.
+func (_u *UserUpdateOne) ClearWishlists() *UserUpdateOne {
+	_u.mutation.ClearWishlists()
+	return _u
+}
+
+// RemoveWishlistIDs removes the "wishlists" edge to Wishlist entities by IDs.
+func (_u *UserUpdateOne) RemoveWishlistIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveWishlistIDs(ids...)
+	return _u
+}
+
+// RemoveWishlists removes "wishlists" edges to Wishlist entities.
+func (_u *UserUpdateOne) RemoveWishlists(v ...*Wishlist) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveWishlistIDs(ids...)
+}
+
+// ClearConversationShares clears all "conversation_shares" edges to the ConversationShare entity.
+func (_u *UserUpdateOne) ClearConversationShares() *UserUpdateOne {
+	_u.mutation.ClearConversationShares()
+	return _u
+}
+
+// RemoveConversationShareIDs removes the "conversation_shares" edge to ConversationShare entities by IDs.
+func (_u *UserUpdateOne) RemoveConversationShareIDs(ids ...uuid.UUID) *UserUpdateOne {
+	_u.mutation.RemoveConversationShareIDs(ids...)
+	return _u
+}
+
+// RemoveConversationShares removes "conversation_shares" edges to ConversationShare entities.
+func (_u *UserUpdateOne) RemoveConversationShares(v ...*ConversationShare) *UserUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveConversationShareIDs(ids...)
+}
+
 // Where appends a list predicates to the UserUpdate builder.
 func (_u *UserUpdateOne) Where(ps ...predicate.User) *UserUpdateOne {
 	_u.mutation.Where(ps...)
@@ -858,6 +1243,9 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 	if value, ok := _u.mutation.Provider(); ok {
 		_spec.SetField(user.FieldProvider, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Plan(); ok {
+		_spec.SetField(user.FieldPlan, field.TypeString, value)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(user.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -986,6 +1374,141 @@ func (_u *UserUpdateOne) sqlSave(ctx context.Context) (_node *User, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.WatchlistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WatchlistTable,
+			Columns: []string{user.WatchlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWatchlistIDs(); len(nodes) > 0 && !_u.mutation.WatchlistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WatchlistTable,
+			Columns: []string{user.WatchlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WatchlistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WatchlistTable,
+			Columns: []string{user.WatchlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.WishlistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WishlistsTable,
+			Columns: []string{user.WishlistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedWishlistsIDs(); len(nodes) > 0 && !_u.mutation.WishlistsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WishlistsTable,
+			Columns: []string{user.WishlistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WishlistsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.WishlistsTable,
+			Columns: []string{user.WishlistsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ConversationSharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ConversationSharesTable,
+			Columns: []string{user.ConversationSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationshare.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedConversationSharesIDs(); len(nodes) > 0 && !_u.mutation.ConversationSharesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ConversationSharesTable,
+			Columns: []string{user.ConversationSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ConversationSharesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   user.ConversationSharesTable,
+			Columns: []string{user.ConversationSharesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(conversationshare.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &User{config: _u.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
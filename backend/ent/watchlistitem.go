@@ -0,0 +1,290 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mylittleprice/ent/user"
+	"mylittleprice/ent/watchlistitem"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// WatchlistItem is the model entity for the WatchlistItem schema.
+type WatchlistItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// ProductKey holds the value of the "product_key" field.
+	ProductKey string `json:"product_key,omitempty"`
+	// PageToken holds the value of the "page_token" field.
+	PageToken string `json:"page_token,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Link holds the value of the "link" field.
+	Link string `json:"link,omitempty"`
+	// Image holds the value of the "image" field.
+	Image string `json:"image,omitempty"`
+	// TargetAmount holds the value of the "target_amount" field.
+	TargetAmount int64 `json:"target_amount,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Country holds the value of the "country" field.
+	Country string `json:"country,omitempty"`
+	// LastAmount holds the value of the "last_amount" field.
+	LastAmount *int64 `json:"last_amount,omitempty"`
+	// LastCheckedAt holds the value of the "last_checked_at" field.
+	LastCheckedAt *time.Time `json:"last_checked_at,omitempty"`
+	// NotifiedAt holds the value of the "notified_at" field.
+	NotifiedAt *time.Time `json:"notified_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WatchlistItemQuery when eager-loading is set.
+	Edges        WatchlistItemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WatchlistItemEdges holds the relations/edges for other nodes in the graph.
+type WatchlistItemEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WatchlistItemEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WatchlistItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case watchlistitem.FieldTargetAmount, watchlistitem.FieldLastAmount:
+			values[i] = new(sql.NullInt64)
+		case watchlistitem.FieldProductKey, watchlistitem.FieldPageToken, watchlistitem.FieldTitle, watchlistitem.FieldLink, watchlistitem.FieldImage, watchlistitem.FieldCurrency, watchlistitem.FieldCountry:
+			values[i] = new(sql.NullString)
+		case watchlistitem.FieldLastCheckedAt, watchlistitem.FieldNotifiedAt, watchlistitem.FieldCreatedAt, watchlistitem.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case watchlistitem.FieldID, watchlistitem.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WatchlistItem fields.
+func (_m *WatchlistItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case watchlistitem.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case watchlistitem.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case watchlistitem.FieldProductKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field product_key", values[i])
+			} else if value.Valid {
+				_m.ProductKey = value.String
+			}
+		case watchlistitem.FieldPageToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field page_token", values[i])
+			} else if value.Valid {
+				_m.PageToken = value.String
+			}
+		case watchlistitem.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				_m.Title = value.String
+			}
+		case watchlistitem.FieldLink:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field link", values[i])
+			} else if value.Valid {
+				_m.Link = value.String
+			}
+		case watchlistitem.FieldImage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field image", values[i])
+			} else if value.Valid {
+				_m.Image = value.String
+			}
+		case watchlistitem.FieldTargetAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field target_amount", values[i])
+			} else if value.Valid {
+				_m.TargetAmount = value.Int64
+			}
+		case watchlistitem.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case watchlistitem.FieldCountry:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field country", values[i])
+			} else if value.Valid {
+				_m.Country = value.String
+			}
+		case watchlistitem.FieldLastAmount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field last_amount", values[i])
+			} else if value.Valid {
+				_m.LastAmount = new(int64)
+				*_m.LastAmount = value.Int64
+			}
+		case watchlistitem.FieldLastCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_checked_at", values[i])
+			} else if value.Valid {
+				_m.LastCheckedAt = new(time.Time)
+				*_m.LastCheckedAt = value.Time
+			}
+		case watchlistitem.FieldNotifiedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field notified_at", values[i])
+			} else if value.Valid {
+				_m.NotifiedAt = new(time.Time)
+				*_m.NotifiedAt = value.Time
+			}
+		case watchlistitem.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case watchlistitem.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WatchlistItem.
+// This includes values selected through modifiers, order, etc.
+func (_m *WatchlistItem) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the WatchlistItem entity.
+func (_m *WatchlistItem) QueryUser() *UserQuery {
+	return NewWatchlistItemClient(_m.config).QueryUser(_m)
+}
+
+// Update returns a builder for updating this WatchlistItem.
+// Note that you need to call WatchlistItem.Unwrap() before calling this method if this WatchlistItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WatchlistItem) Update() *WatchlistItemUpdateOne {
+	return NewWatchlistItemClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WatchlistItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WatchlistItem) Unwrap() *WatchlistItem {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WatchlistItem is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WatchlistItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("WatchlistItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("product_key=")
+	builder.WriteString(_m.ProductKey)
+	builder.WriteString(", ")
+	builder.WriteString("page_token=")
+	builder.WriteString(_m.PageToken)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(_m.Title)
+	builder.WriteString(", ")
+	builder.WriteString("link=")
+	builder.WriteString(_m.Link)
+	builder.WriteString(", ")
+	builder.WriteString("image=")
+	builder.WriteString(_m.Image)
+	builder.WriteString(", ")
+	builder.WriteString("target_amount=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TargetAmount))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("country=")
+	builder.WriteString(_m.Country)
+	builder.WriteString(", ")
+	if v := _m.LastAmount; v != nil {
+		builder.WriteString("last_amount=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := _m.LastCheckedAt; v != nil {
+		builder.WriteString("last_checked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.NotifiedAt; v != nil {
+		builder.WriteString("notified_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WatchlistItems is a parsable slice of WatchlistItem.
+type WatchlistItems []*WatchlistItem
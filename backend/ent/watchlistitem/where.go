@@ -0,0 +1,950 @@
+// Code generated by ent, DO NOT EDIT.
+
+package watchlistitem
+
+import (
+	"mylittleprice/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldUserID, v))
+}
+
+// ProductKey applies equality check predicate on the "product_key" field. It's identical to ProductKeyEQ.
+func ProductKey(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldProductKey, v))
+}
+
+// PageToken applies equality check predicate on the "page_token" field. It's identical to PageTokenEQ.
+func PageToken(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldPageToken, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldTitle, v))
+}
+
+// Link applies equality check predicate on the "link" field. It's identical to LinkEQ.
+func Link(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldLink, v))
+}
+
+// Image applies equality check predicate on the "image" field. It's identical to ImageEQ.
+func Image(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldImage, v))
+}
+
+// TargetAmount applies equality check predicate on the "target_amount" field. It's identical to TargetAmountEQ.
+func TargetAmount(v int64) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldTargetAmount, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Country applies equality check predicate on the "country" field. It's identical to CountryEQ.
+func Country(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldCountry, v))
+}
+
+// LastAmount applies equality check predicate on the "last_amount" field. It's identical to LastAmountEQ.
+func LastAmount(v int64) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldLastAmount, v))
+}
+
+// LastCheckedAt applies equality check predicate on the "last_checked_at" field. It's identical to LastCheckedAtEQ.
+func LastCheckedAt(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldLastCheckedAt, v))
+}
+
+// NotifiedAt applies equality check predicate on the "notified_at" field. It's identical to NotifiedAtEQ.
+func NotifiedAt(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldNotifiedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// ProductKeyEQ applies the EQ predicate on the "product_key" field.
+func ProductKeyEQ(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldProductKey, v))
+}
+
+// ProductKeyNEQ applies the NEQ predicate on the "product_key" field.
+func ProductKeyNEQ(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNEQ(FieldProductKey, v))
+}
+
+// ProductKeyIn applies the In predicate on the "product_key" field.
+func ProductKeyIn(vs ...string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIn(FieldProductKey, vs...))
+}
+
+// ProductKeyNotIn applies the NotIn predicate on the "product_key" field.
+func ProductKeyNotIn(vs ...string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotIn(FieldProductKey, vs...))
+}
+
+// ProductKeyGT applies the GT predicate on the "product_key" field.
+func ProductKeyGT(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGT(FieldProductKey, v))
+}
+
+// ProductKeyGTE applies the GTE predicate on the "product_key" field.
+func ProductKeyGTE(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGTE(FieldProductKey, v))
+}
+
+// ProductKeyLT applies the LT predicate on the "product_key" field.
+func ProductKeyLT(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLT(FieldProductKey, v))
+}
+
+// ProductKeyLTE applies the LTE predicate on the "product_key" field.
+func ProductKeyLTE(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLTE(FieldProductKey, v))
+}
+
+// ProductKeyContains applies the Contains predicate on the "product_key" field.
+func ProductKeyContains(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldContains(FieldProductKey, v))
+}
+
+// ProductKeyHasPrefix applies the HasPrefix predicate on the "product_key" field.
+func ProductKeyHasPrefix(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldHasPrefix(FieldProductKey, v))
+}
+
+// ProductKeyHasSuffix applies the HasSuffix predicate on the "product_key" field.
+func ProductKeyHasSuffix(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldHasSuffix(FieldProductKey, v))
+}
+
+// ProductKeyIsNil applies the IsNil predicate on the "product_key" field.
+func ProductKeyIsNil() predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIsNull(FieldProductKey))
+}
+
+// ProductKeyNotNil applies the NotNil predicate on the "product_key" field.
+func ProductKeyNotNil() predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotNull(FieldProductKey))
+}
+
+// ProductKeyEqualFold applies the EqualFold predicate on the "product_key" field.
+func ProductKeyEqualFold(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEqualFold(FieldProductKey, v))
+}
+
+// ProductKeyContainsFold applies the ContainsFold predicate on the "product_key" field.
+func ProductKeyContainsFold(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldContainsFold(FieldProductKey, v))
+}
+
+// PageTokenEQ applies the EQ predicate on the "page_token" field.
+func PageTokenEQ(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldPageToken, v))
+}
+
+// PageTokenNEQ applies the NEQ predicate on the "page_token" field.
+func PageTokenNEQ(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNEQ(FieldPageToken, v))
+}
+
+// PageTokenIn applies the In predicate on the "page_token" field.
+func PageTokenIn(vs ...string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIn(FieldPageToken, vs...))
+}
+
+// PageTokenNotIn applies the NotIn predicate on the "page_token" field.
+func PageTokenNotIn(vs ...string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotIn(FieldPageToken, vs...))
+}
+
+// PageTokenGT applies the GT predicate on the "page_token" field.
+func PageTokenGT(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGT(FieldPageToken, v))
+}
+
+// PageTokenGTE applies the GTE predicate on the "page_token" field.
+func PageTokenGTE(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGTE(FieldPageToken, v))
+}
+
+// PageTokenLT applies the LT predicate on the "page_token" field.
+func PageTokenLT(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLT(FieldPageToken, v))
+}
+
+// PageTokenLTE applies the LTE predicate on the "page_token" field.
+func PageTokenLTE(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLTE(FieldPageToken, v))
+}
+
+// PageTokenContains applies the Contains predicate on the "page_token" field.
+func PageTokenContains(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldContains(FieldPageToken, v))
+}
+
+// PageTokenHasPrefix applies the HasPrefix predicate on the "page_token" field.
+func PageTokenHasPrefix(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldHasPrefix(FieldPageToken, v))
+}
+
+// PageTokenHasSuffix applies the HasSuffix predicate on the "page_token" field.
+func PageTokenHasSuffix(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldHasSuffix(FieldPageToken, v))
+}
+
+// PageTokenEqualFold applies the EqualFold predicate on the "page_token" field.
+func PageTokenEqualFold(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEqualFold(FieldPageToken, v))
+}
+
+// PageTokenContainsFold applies the ContainsFold predicate on the "page_token" field.
+func PageTokenContainsFold(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldContainsFold(FieldPageToken, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// LinkEQ applies the EQ predicate on the "link" field.
+func LinkEQ(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldLink, v))
+}
+
+// LinkNEQ applies the NEQ predicate on the "link" field.
+func LinkNEQ(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNEQ(FieldLink, v))
+}
+
+// LinkIn applies the In predicate on the "link" field.
+func LinkIn(vs ...string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIn(FieldLink, vs...))
+}
+
+// LinkNotIn applies the NotIn predicate on the "link" field.
+func LinkNotIn(vs ...string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotIn(FieldLink, vs...))
+}
+
+// LinkGT applies the GT predicate on the "link" field.
+func LinkGT(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGT(FieldLink, v))
+}
+
+// LinkGTE applies the GTE predicate on the "link" field.
+func LinkGTE(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGTE(FieldLink, v))
+}
+
+// LinkLT applies the LT predicate on the "link" field.
+func LinkLT(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLT(FieldLink, v))
+}
+
+// LinkLTE applies the LTE predicate on the "link" field.
+func LinkLTE(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLTE(FieldLink, v))
+}
+
+// LinkContains applies the Contains predicate on the "link" field.
+func LinkContains(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldContains(FieldLink, v))
+}
+
+// LinkHasPrefix applies the HasPrefix predicate on the "link" field.
+func LinkHasPrefix(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldHasPrefix(FieldLink, v))
+}
+
+// LinkHasSuffix applies the HasSuffix predicate on the "link" field.
+func LinkHasSuffix(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldHasSuffix(FieldLink, v))
+}
+
+// LinkIsNil applies the IsNil predicate on the "link" field.
+func LinkIsNil() predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIsNull(FieldLink))
+}
+
+// LinkNotNil applies the NotNil predicate on the "link" field.
+func LinkNotNil() predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotNull(FieldLink))
+}
+
+// LinkEqualFold applies the EqualFold predicate on the "link" field.
+func LinkEqualFold(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEqualFold(FieldLink, v))
+}
+
+// LinkContainsFold applies the ContainsFold predicate on the "link" field.
+func LinkContainsFold(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldContainsFold(FieldLink, v))
+}
+
+// ImageEQ applies the EQ predicate on the "image" field.
+func ImageEQ(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldImage, v))
+}
+
+// ImageNEQ applies the NEQ predicate on the "image" field.
+func ImageNEQ(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNEQ(FieldImage, v))
+}
+
+// ImageIn applies the In predicate on the "image" field.
+func ImageIn(vs ...string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIn(FieldImage, vs...))
+}
+
+// ImageNotIn applies the NotIn predicate on the "image" field.
+func ImageNotIn(vs ...string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotIn(FieldImage, vs...))
+}
+
+// ImageGT applies the GT predicate on the "image" field.
+func ImageGT(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGT(FieldImage, v))
+}
+
+// ImageGTE applies the GTE predicate on the "image" field.
+func ImageGTE(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGTE(FieldImage, v))
+}
+
+// ImageLT applies the LT predicate on the "image" field.
+func ImageLT(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLT(FieldImage, v))
+}
+
+// ImageLTE applies the LTE predicate on the "image" field.
+func ImageLTE(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLTE(FieldImage, v))
+}
+
+// ImageContains applies the Contains predicate on the "image" field.
+func ImageContains(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldContains(FieldImage, v))
+}
+
+// ImageHasPrefix applies the HasPrefix predicate on the "image" field.
+func ImageHasPrefix(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldHasPrefix(FieldImage, v))
+}
+
+// ImageHasSuffix applies the HasSuffix predicate on the "image" field.
+func ImageHasSuffix(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldHasSuffix(FieldImage, v))
+}
+
+// ImageIsNil applies the IsNil predicate on the "image" field.
+func ImageIsNil() predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIsNull(FieldImage))
+}
+
+// ImageNotNil applies the NotNil predicate on the "image" field.
+func ImageNotNil() predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotNull(FieldImage))
+}
+
+// ImageEqualFold applies the EqualFold predicate on the "image" field.
+func ImageEqualFold(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEqualFold(FieldImage, v))
+}
+
+// ImageContainsFold applies the ContainsFold predicate on the "image" field.
+func ImageContainsFold(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldContainsFold(FieldImage, v))
+}
+
+// TargetAmountEQ applies the EQ predicate on the "target_amount" field.
+func TargetAmountEQ(v int64) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldTargetAmount, v))
+}
+
+// TargetAmountNEQ applies the NEQ predicate on the "target_amount" field.
+func TargetAmountNEQ(v int64) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNEQ(FieldTargetAmount, v))
+}
+
+// TargetAmountIn applies the In predicate on the "target_amount" field.
+func TargetAmountIn(vs ...int64) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIn(FieldTargetAmount, vs...))
+}
+
+// TargetAmountNotIn applies the NotIn predicate on the "target_amount" field.
+func TargetAmountNotIn(vs ...int64) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotIn(FieldTargetAmount, vs...))
+}
+
+// TargetAmountGT applies the GT predicate on the "target_amount" field.
+func TargetAmountGT(v int64) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGT(FieldTargetAmount, v))
+}
+
+// TargetAmountGTE applies the GTE predicate on the "target_amount" field.
+func TargetAmountGTE(v int64) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGTE(FieldTargetAmount, v))
+}
+
+// TargetAmountLT applies the LT predicate on the "target_amount" field.
+func TargetAmountLT(v int64) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLT(FieldTargetAmount, v))
+}
+
+// TargetAmountLTE applies the LTE predicate on the "target_amount" field.
+func TargetAmountLTE(v int64) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLTE(FieldTargetAmount, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// CountryEQ applies the EQ predicate on the "country" field.
+func CountryEQ(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldCountry, v))
+}
+
+// CountryNEQ applies the NEQ predicate on the "country" field.
+func CountryNEQ(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNEQ(FieldCountry, v))
+}
+
+// CountryIn applies the In predicate on the "country" field.
+func CountryIn(vs ...string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIn(FieldCountry, vs...))
+}
+
+// CountryNotIn applies the NotIn predicate on the "country" field.
+func CountryNotIn(vs ...string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotIn(FieldCountry, vs...))
+}
+
+// CountryGT applies the GT predicate on the "country" field.
+func CountryGT(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGT(FieldCountry, v))
+}
+
+// CountryGTE applies the GTE predicate on the "country" field.
+func CountryGTE(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGTE(FieldCountry, v))
+}
+
+// CountryLT applies the LT predicate on the "country" field.
+func CountryLT(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLT(FieldCountry, v))
+}
+
+// CountryLTE applies the LTE predicate on the "country" field.
+func CountryLTE(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLTE(FieldCountry, v))
+}
+
+// CountryContains applies the Contains predicate on the "country" field.
+func CountryContains(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldContains(FieldCountry, v))
+}
+
+// CountryHasPrefix applies the HasPrefix predicate on the "country" field.
+func CountryHasPrefix(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldHasPrefix(FieldCountry, v))
+}
+
+// CountryHasSuffix applies the HasSuffix predicate on the "country" field.
+func CountryHasSuffix(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldHasSuffix(FieldCountry, v))
+}
+
+// CountryIsNil applies the IsNil predicate on the "country" field.
+func CountryIsNil() predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIsNull(FieldCountry))
+}
+
+// CountryNotNil applies the NotNil predicate on the "country" field.
+func CountryNotNil() predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotNull(FieldCountry))
+}
+
+// CountryEqualFold applies the EqualFold predicate on the "country" field.
+func CountryEqualFold(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEqualFold(FieldCountry, v))
+}
+
+// CountryContainsFold applies the ContainsFold predicate on the "country" field.
+func CountryContainsFold(v string) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldContainsFold(FieldCountry, v))
+}
+
+// LastAmountEQ applies the EQ predicate on the "last_amount" field.
+func LastAmountEQ(v int64) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldLastAmount, v))
+}
+
+// LastAmountNEQ applies the NEQ predicate on the "last_amount" field.
+func LastAmountNEQ(v int64) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNEQ(FieldLastAmount, v))
+}
+
+// LastAmountIn applies the In predicate on the "last_amount" field.
+func LastAmountIn(vs ...int64) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIn(FieldLastAmount, vs...))
+}
+
+// LastAmountNotIn applies the NotIn predicate on the "last_amount" field.
+func LastAmountNotIn(vs ...int64) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotIn(FieldLastAmount, vs...))
+}
+
+// LastAmountGT applies the GT predicate on the "last_amount" field.
+func LastAmountGT(v int64) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGT(FieldLastAmount, v))
+}
+
+// LastAmountGTE applies the GTE predicate on the "last_amount" field.
+func LastAmountGTE(v int64) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGTE(FieldLastAmount, v))
+}
+
+// LastAmountLT applies the LT predicate on the "last_amount" field.
+func LastAmountLT(v int64) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLT(FieldLastAmount, v))
+}
+
+// LastAmountLTE applies the LTE predicate on the "last_amount" field.
+func LastAmountLTE(v int64) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLTE(FieldLastAmount, v))
+}
+
+// LastAmountIsNil applies the IsNil predicate on the "last_amount" field.
+func LastAmountIsNil() predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIsNull(FieldLastAmount))
+}
+
+// LastAmountNotNil applies the NotNil predicate on the "last_amount" field.
+func LastAmountNotNil() predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotNull(FieldLastAmount))
+}
+
+// LastCheckedAtEQ applies the EQ predicate on the "last_checked_at" field.
+func LastCheckedAtEQ(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtNEQ applies the NEQ predicate on the "last_checked_at" field.
+func LastCheckedAtNEQ(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNEQ(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtIn applies the In predicate on the "last_checked_at" field.
+func LastCheckedAtIn(vs ...time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIn(FieldLastCheckedAt, vs...))
+}
+
+// LastCheckedAtNotIn applies the NotIn predicate on the "last_checked_at" field.
+func LastCheckedAtNotIn(vs ...time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotIn(FieldLastCheckedAt, vs...))
+}
+
+// LastCheckedAtGT applies the GT predicate on the "last_checked_at" field.
+func LastCheckedAtGT(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGT(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtGTE applies the GTE predicate on the "last_checked_at" field.
+func LastCheckedAtGTE(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGTE(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtLT applies the LT predicate on the "last_checked_at" field.
+func LastCheckedAtLT(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLT(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtLTE applies the LTE predicate on the "last_checked_at" field.
+func LastCheckedAtLTE(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLTE(FieldLastCheckedAt, v))
+}
+
+// LastCheckedAtIsNil applies the IsNil predicate on the "last_checked_at" field.
+func LastCheckedAtIsNil() predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIsNull(FieldLastCheckedAt))
+}
+
+// LastCheckedAtNotNil applies the NotNil predicate on the "last_checked_at" field.
+func LastCheckedAtNotNil() predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotNull(FieldLastCheckedAt))
+}
+
+// NotifiedAtEQ applies the EQ predicate on the "notified_at" field.
+func NotifiedAtEQ(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldNotifiedAt, v))
+}
+
+// NotifiedAtNEQ applies the NEQ predicate on the "notified_at" field.
+func NotifiedAtNEQ(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNEQ(FieldNotifiedAt, v))
+}
+
+// NotifiedAtIn applies the In predicate on the "notified_at" field.
+func NotifiedAtIn(vs ...time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIn(FieldNotifiedAt, vs...))
+}
+
+// NotifiedAtNotIn applies the NotIn predicate on the "notified_at" field.
+func NotifiedAtNotIn(vs ...time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotIn(FieldNotifiedAt, vs...))
+}
+
+// NotifiedAtGT applies the GT predicate on the "notified_at" field.
+func NotifiedAtGT(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGT(FieldNotifiedAt, v))
+}
+
+// NotifiedAtGTE applies the GTE predicate on the "notified_at" field.
+func NotifiedAtGTE(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGTE(FieldNotifiedAt, v))
+}
+
+// NotifiedAtLT applies the LT predicate on the "notified_at" field.
+func NotifiedAtLT(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLT(FieldNotifiedAt, v))
+}
+
+// NotifiedAtLTE applies the LTE predicate on the "notified_at" field.
+func NotifiedAtLTE(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLTE(FieldNotifiedAt, v))
+}
+
+// NotifiedAtIsNil applies the IsNil predicate on the "notified_at" field.
+func NotifiedAtIsNil() predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIsNull(FieldNotifiedAt))
+}
+
+// NotifiedAtNotNil applies the NotNil predicate on the "notified_at" field.
+func NotifiedAtNotNil() predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotNull(FieldNotifiedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.WatchlistItem {
+	return predicate.WatchlistItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.WatchlistItem {
+	return predicate.WatchlistItem(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WatchlistItem) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WatchlistItem) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WatchlistItem) predicate.WatchlistItem {
+	return predicate.WatchlistItem(sql.NotPredicates(p))
+}
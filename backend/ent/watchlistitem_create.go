@@ -0,0 +1,484 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mylittleprice/ent/user"
+	"mylittleprice/ent/watchlistitem"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WatchlistItemCreate is the builder for creating a WatchlistItem entity.
+type WatchlistItemCreate struct {
+	config
+	mutation *WatchlistItemMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *WatchlistItemCreate) SetUserID(v uuid.UUID) *WatchlistItemCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetProductKey sets the "product_key" field.
+func (_c *WatchlistItemCreate) SetProductKey(v string) *WatchlistItemCreate {
+	_c.mutation.SetProductKey(v)
+	return _c
+}
+
+// SetNillableProductKey sets the "product_key" field if the given value is not nil.
+func (_c *WatchlistItemCreate) SetNillableProductKey(v *string) *WatchlistItemCreate {
+	if v != nil {
+		_c.SetProductKey(*v)
+	}
+	return _c
+}
+
+// SetPageToken sets the "page_token" field.
+func (_c *WatchlistItemCreate) SetPageToken(v string) *WatchlistItemCreate {
+	_c.mutation.SetPageToken(v)
+	return _c
+}
+
+// SetTitle sets the "title" field.
+func (_c *WatchlistItemCreate) SetTitle(v string) *WatchlistItemCreate {
+	_c.mutation.SetTitle(v)
+	return _c
+}
+
+// SetLink sets the "link" field.
+func (_c *WatchlistItemCreate) SetLink(v string) *WatchlistItemCreate {
+	_c.mutation.SetLink(v)
+	return _c
+}
+
+// SetNillableLink sets the "link" field if the given value is not nil.
+func (_c *WatchlistItemCreate) SetNillableLink(v *string) *WatchlistItemCreate {
+	if v != nil {
+		_c.SetLink(*v)
+	}
+	return _c
+}
+
+// SetImage sets the "image" field.
+func (_c *WatchlistItemCreate) SetImage(v string) *WatchlistItemCreate {
+	_c.mutation.SetImage(v)
+	return _c
+}
+
+// SetNillableImage sets the "image" field if the given value is not nil.
+func (_c *WatchlistItemCreate) SetNillableImage(v *string) *WatchlistItemCreate {
+	if v != nil {
+		_c.SetImage(*v)
+	}
+	return _c
+}
+
+// SetTargetAmount sets the "target_amount" field.
+func (_c *WatchlistItemCreate) SetTargetAmount(v int64) *WatchlistItemCreate {
+	_c.mutation.SetTargetAmount(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *WatchlistItemCreate) SetCurrency(v string) *WatchlistItemCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetCountry sets the "country" field.
+func (_c *WatchlistItemCreate) SetCountry(v string) *WatchlistItemCreate {
+	_c.mutation.SetCountry(v)
+	return _c
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_c *WatchlistItemCreate) SetNillableCountry(v *string) *WatchlistItemCreate {
+	if v != nil {
+		_c.SetCountry(*v)
+	}
+	return _c
+}
+
+// SetLastAmount sets the "last_amount" field.
+func (_c *WatchlistItemCreate) SetLastAmount(v int64) *WatchlistItemCreate {
+	_c.mutation.SetLastAmount(v)
+	return _c
+}
+
+// SetNillableLastAmount sets the "last_amount" field if the given value is not nil.
+func (_c *WatchlistItemCreate) SetNillableLastAmount(v *int64) *WatchlistItemCreate {
+	if v != nil {
+		_c.SetLastAmount(*v)
+	}
+	return _c
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (_c *WatchlistItemCreate) SetLastCheckedAt(v time.Time) *WatchlistItemCreate {
+	_c.mutation.SetLastCheckedAt(v)
+	return _c
+}
+
+// SetNillableLastCheckedAt sets the "last_checked_at" field if the given value is not nil.
+func (_c *WatchlistItemCreate) SetNillableLastCheckedAt(v *time.Time) *WatchlistItemCreate {
+	if v != nil {
+		_c.SetLastCheckedAt(*v)
+	}
+	return _c
+}
+
+// SetNotifiedAt sets the "notified_at" field.
+func (_c *WatchlistItemCreate) SetNotifiedAt(v time.Time) *WatchlistItemCreate {
+	_c.mutation.SetNotifiedAt(v)
+	return _c
+}
+
+// SetNillableNotifiedAt sets the "notified_at" field if the given value is not nil.
+func (_c *WatchlistItemCreate) SetNillableNotifiedAt(v *time.Time) *WatchlistItemCreate {
+	if v != nil {
+		_c.SetNotifiedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WatchlistItemCreate) SetCreatedAt(v time.Time) *WatchlistItemCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WatchlistItemCreate) SetNillableCreatedAt(v *time.Time) *WatchlistItemCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *WatchlistItemCreate) SetUpdatedAt(v time.Time) *WatchlistItemCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *WatchlistItemCreate) SetNillableUpdatedAt(v *time.Time) *WatchlistItemCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WatchlistItemCreate) SetID(v uuid.UUID) *WatchlistItemCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *WatchlistItemCreate) SetNillableID(v *uuid.UUID) *WatchlistItemCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *WatchlistItemCreate) SetUser(v *User) *WatchlistItemCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// Mutation returns the WatchlistItemMutation object of the builder.
+func (_c *WatchlistItemCreate) Mutation() *WatchlistItemMutation {
+	return _c.mutation
+}
+
+// Save creates the WatchlistItem in the database.
+func (_c *WatchlistItemCreate) Save(ctx context.Context) (*WatchlistItem, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WatchlistItemCreate) SaveX(ctx context.Context) *WatchlistItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WatchlistItemCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WatchlistItemCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WatchlistItemCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := watchlistitem.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := watchlistitem.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := watchlistitem.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WatchlistItemCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "WatchlistItem.user_id"`)}
+	}
+	if _, ok := _c.mutation.PageToken(); !ok {
+		return &ValidationError{Name: "page_token", err: errors.New(`ent: missing required field "WatchlistItem.page_token"`)}
+	}
+	if v, ok := _c.mutation.PageToken(); ok {
+		if err := watchlistitem.PageTokenValidator(v); err != nil {
+			return &ValidationError{Name: "page_token", err: fmt.Errorf(`ent: validator failed for field "WatchlistItem.page_token": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "WatchlistItem.title"`)}
+	}
+	if v, ok := _c.mutation.Title(); ok {
+		if err := watchlistitem.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "WatchlistItem.title": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TargetAmount(); !ok {
+		return &ValidationError{Name: "target_amount", err: errors.New(`ent: missing required field "WatchlistItem.target_amount"`)}
+	}
+	if v, ok := _c.mutation.TargetAmount(); ok {
+		if err := watchlistitem.TargetAmountValidator(v); err != nil {
+			return &ValidationError{Name: "target_amount", err: fmt.Errorf(`ent: validator failed for field "WatchlistItem.target_amount": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "WatchlistItem.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := watchlistitem.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "WatchlistItem.currency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WatchlistItem.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "WatchlistItem.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "WatchlistItem.user"`)}
+	}
+	return nil
+}
+
+func (_c *WatchlistItemCreate) sqlSave(ctx context.Context) (*WatchlistItem, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WatchlistItemCreate) createSpec() (*WatchlistItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WatchlistItem{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(watchlistitem.Table, sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.ProductKey(); ok {
+		_spec.SetField(watchlistitem.FieldProductKey, field.TypeString, value)
+		_node.ProductKey = value
+	}
+	if value, ok := _c.mutation.PageToken(); ok {
+		_spec.SetField(watchlistitem.FieldPageToken, field.TypeString, value)
+		_node.PageToken = value
+	}
+	if value, ok := _c.mutation.Title(); ok {
+		_spec.SetField(watchlistitem.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := _c.mutation.Link(); ok {
+		_spec.SetField(watchlistitem.FieldLink, field.TypeString, value)
+		_node.Link = value
+	}
+	if value, ok := _c.mutation.Image(); ok {
+		_spec.SetField(watchlistitem.FieldImage, field.TypeString, value)
+		_node.Image = value
+	}
+	if value, ok := _c.mutation.TargetAmount(); ok {
+		_spec.SetField(watchlistitem.FieldTargetAmount, field.TypeInt64, value)
+		_node.TargetAmount = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(watchlistitem.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Country(); ok {
+		_spec.SetField(watchlistitem.FieldCountry, field.TypeString, value)
+		_node.Country = value
+	}
+	if value, ok := _c.mutation.LastAmount(); ok {
+		_spec.SetField(watchlistitem.FieldLastAmount, field.TypeInt64, value)
+		_node.LastAmount = &value
+	}
+	if value, ok := _c.mutation.LastCheckedAt(); ok {
+		_spec.SetField(watchlistitem.FieldLastCheckedAt, field.TypeTime, value)
+		_node.LastCheckedAt = &value
+	}
+	if value, ok := _c.mutation.NotifiedAt(); ok {
+		_spec.SetField(watchlistitem.FieldNotifiedAt, field.TypeTime, value)
+		_node.NotifiedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(watchlistitem.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(watchlistitem.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlistitem.UserTable,
+			Columns: []string{watchlistitem.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WatchlistItemCreateBulk is the builder for creating many WatchlistItem entities in bulk.
+type WatchlistItemCreateBulk struct {
+	config
+	err      error
+	builders []*WatchlistItemCreate
+}
+
+// Save creates the WatchlistItem entities in the database.
+func (_c *WatchlistItemCreateBulk) Save(ctx context.Context) ([]*WatchlistItem, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WatchlistItem, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WatchlistItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WatchlistItemCreateBulk) SaveX(ctx context.Context) []*WatchlistItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WatchlistItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WatchlistItemCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
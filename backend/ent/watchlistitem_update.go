@@ -0,0 +1,924 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mylittleprice/ent/predicate"
+	"mylittleprice/ent/user"
+	"mylittleprice/ent/watchlistitem"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WatchlistItemUpdate is the builder for updating WatchlistItem entities.
+type WatchlistItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WatchlistItemMutation
+}
+
+// Where appends a list predicates to the WatchlistItemUpdate builder.
+func (_u *WatchlistItemUpdate) Where(ps ...predicate.WatchlistItem) *WatchlistItemUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *WatchlistItemUpdate) SetUserID(v uuid.UUID) *WatchlistItemUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *WatchlistItemUpdate) SetNillableUserID(v *uuid.UUID) *WatchlistItemUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetProductKey sets the "product_key" field.
+func (_u *WatchlistItemUpdate) SetProductKey(v string) *WatchlistItemUpdate {
+	_u.mutation.SetProductKey(v)
+	return _u
+}
+
+// SetNillableProductKey sets the "product_key" field if the given value is not nil.
+func (_u *WatchlistItemUpdate) SetNillableProductKey(v *string) *WatchlistItemUpdate {
+	if v != nil {
+		_u.SetProductKey(*v)
+	}
+	return _u
+}
+
+// ClearProductKey clears the value of the "product_key" field.
+func (_u *WatchlistItemUpdate) ClearProductKey() *WatchlistItemUpdate {
+	_u.mutation.ClearProductKey()
+	return _u
+}
+
+// SetPageToken sets the "page_token" field.
+func (_u *WatchlistItemUpdate) SetPageToken(v string) *WatchlistItemUpdate {
+	_u.mutation.SetPageToken(v)
+	return _u
+}
+
+// SetNillablePageToken sets the "page_token" field if the given value is not nil.
+func (_u *WatchlistItemUpdate) SetNillablePageToken(v *string) *WatchlistItemUpdate {
+	if v != nil {
+		_u.SetPageToken(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *WatchlistItemUpdate) SetTitle(v string) *WatchlistItemUpdate {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *WatchlistItemUpdate) SetNillableTitle(v *string) *WatchlistItemUpdate {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetLink sets the "link" field.
+func (_u *WatchlistItemUpdate) SetLink(v string) *WatchlistItemUpdate {
+	_u.mutation.SetLink(v)
+	return _u
+}
+
+// SetNillableLink sets the "link" field if the given value is not nil.
+func (_u *WatchlistItemUpdate) SetNillableLink(v *string) *WatchlistItemUpdate {
+	if v != nil {
+		_u.SetLink(*v)
+	}
+	return _u
+}
+
+// ClearLink clears the value of the "link" field.
+func (_u *WatchlistItemUpdate) ClearLink() *WatchlistItemUpdate {
+	_u.mutation.ClearLink()
+	return _u
+}
+
+// SetImage sets the "image" field.
+func (_u *WatchlistItemUpdate) SetImage(v string) *WatchlistItemUpdate {
+	_u.mutation.SetImage(v)
+	return _u
+}
+
+// SetNillableImage sets the "image" field if the given value is not nil.
+func (_u *WatchlistItemUpdate) SetNillableImage(v *string) *WatchlistItemUpdate {
+	if v != nil {
+		_u.SetImage(*v)
+	}
+	return _u
+}
+
+// ClearImage clears the value of the "image" field.
+func (_u *WatchlistItemUpdate) ClearImage() *WatchlistItemUpdate {
+	_u.mutation.ClearImage()
+	return _u
+}
+
+// SetTargetAmount sets the "target_amount" field.
+func (_u *WatchlistItemUpdate) SetTargetAmount(v int64) *WatchlistItemUpdate {
+	_u.mutation.ResetTargetAmount()
+	_u.mutation.SetTargetAmount(v)
+	return _u
+}
+
+// SetNillableTargetAmount sets the "target_amount" field if the given value is not nil.
+func (_u *WatchlistItemUpdate) SetNillableTargetAmount(v *int64) *WatchlistItemUpdate {
+	if v != nil {
+		_u.SetTargetAmount(*v)
+	}
+	return _u
+}
+
+// AddTargetAmount adds value to the "target_amount" field.
+func (_u *WatchlistItemUpdate) AddTargetAmount(v int64) *WatchlistItemUpdate {
+	_u.mutation.AddTargetAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *WatchlistItemUpdate) SetCurrency(v string) *WatchlistItemUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *WatchlistItemUpdate) SetNillableCurrency(v *string) *WatchlistItemUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *WatchlistItemUpdate) SetCountry(v string) *WatchlistItemUpdate {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *WatchlistItemUpdate) SetNillableCountry(v *string) *WatchlistItemUpdate {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// ClearCountry clears the value of the "country" field.
+func (_u *WatchlistItemUpdate) ClearCountry() *WatchlistItemUpdate {
+	_u.mutation.ClearCountry()
+	return _u
+}
+
+// SetLastAmount sets the "last_amount" field.
+func (_u *WatchlistItemUpdate) SetLastAmount(v int64) *WatchlistItemUpdate {
+	_u.mutation.ResetLastAmount()
+	_u.mutation.SetLastAmount(v)
+	return _u
+}
+
+// SetNillableLastAmount sets the "last_amount" field if the given value is not nil.
+func (_u *WatchlistItemUpdate) SetNillableLastAmount(v *int64) *WatchlistItemUpdate {
+	if v != nil {
+		_u.SetLastAmount(*v)
+	}
+	return _u
+}
+
+// AddLastAmount adds value to the "last_amount" field.
+func (_u *WatchlistItemUpdate) AddLastAmount(v int64) *WatchlistItemUpdate {
+	_u.mutation.AddLastAmount(v)
+	return _u
+}
+
+// ClearLastAmount clears the value of the "last_amount" field.
+func (_u *WatchlistItemUpdate) ClearLastAmount() *WatchlistItemUpdate {
+	_u.mutation.ClearLastAmount()
+	return _u
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (_u *WatchlistItemUpdate) SetLastCheckedAt(v time.Time) *WatchlistItemUpdate {
+	_u.mutation.SetLastCheckedAt(v)
+	return _u
+}
+
+// SetNillableLastCheckedAt sets the "last_checked_at" field if the given value is not nil.
+func (_u *WatchlistItemUpdate) SetNillableLastCheckedAt(v *time.Time) *WatchlistItemUpdate {
+	if v != nil {
+		_u.SetLastCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastCheckedAt clears the value of the "last_checked_at" field.
+func (_u *WatchlistItemUpdate) ClearLastCheckedAt() *WatchlistItemUpdate {
+	_u.mutation.ClearLastCheckedAt()
+	return _u
+}
+
+// SetNotifiedAt sets the "notified_at" field.
+func (_u *WatchlistItemUpdate) SetNotifiedAt(v time.Time) *WatchlistItemUpdate {
+	_u.mutation.SetNotifiedAt(v)
+	return _u
+}
+
+// SetNillableNotifiedAt sets the "notified_at" field if the given value is not nil.
+func (_u *WatchlistItemUpdate) SetNillableNotifiedAt(v *time.Time) *WatchlistItemUpdate {
+	if v != nil {
+		_u.SetNotifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearNotifiedAt clears the value of the "notified_at" field.
+func (_u *WatchlistItemUpdate) ClearNotifiedAt() *WatchlistItemUpdate {
+	_u.mutation.ClearNotifiedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WatchlistItemUpdate) SetUpdatedAt(v time.Time) *WatchlistItemUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *WatchlistItemUpdate) SetUser(v *User) *WatchlistItemUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the WatchlistItemMutation object of the builder.
+func (_u *WatchlistItemUpdate) Mutation() *WatchlistItemMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *WatchlistItemUpdate) ClearUser() *WatchlistItemUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WatchlistItemUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WatchlistItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WatchlistItemUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WatchlistItemUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WatchlistItemUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := watchlistitem.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WatchlistItemUpdate) check() error {
+	if v, ok := _u.mutation.PageToken(); ok {
+		if err := watchlistitem.PageTokenValidator(v); err != nil {
+			return &ValidationError{Name: "page_token", err: fmt.Errorf(`ent: validator failed for field "WatchlistItem.page_token": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := watchlistitem.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "WatchlistItem.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetAmount(); ok {
+		if err := watchlistitem.TargetAmountValidator(v); err != nil {
+			return &ValidationError{Name: "target_amount", err: fmt.Errorf(`ent: validator failed for field "WatchlistItem.target_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := watchlistitem.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "WatchlistItem.currency": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WatchlistItem.user"`)
+	}
+	return nil
+}
+
+func (_u *WatchlistItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(watchlistitem.Table, watchlistitem.Columns, sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ProductKey(); ok {
+		_spec.SetField(watchlistitem.FieldProductKey, field.TypeString, value)
+	}
+	if _u.mutation.ProductKeyCleared() {
+		_spec.ClearField(watchlistitem.FieldProductKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.PageToken(); ok {
+		_spec.SetField(watchlistitem.FieldPageToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(watchlistitem.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Link(); ok {
+		_spec.SetField(watchlistitem.FieldLink, field.TypeString, value)
+	}
+	if _u.mutation.LinkCleared() {
+		_spec.ClearField(watchlistitem.FieldLink, field.TypeString)
+	}
+	if value, ok := _u.mutation.Image(); ok {
+		_spec.SetField(watchlistitem.FieldImage, field.TypeString, value)
+	}
+	if _u.mutation.ImageCleared() {
+		_spec.ClearField(watchlistitem.FieldImage, field.TypeString)
+	}
+	if value, ok := _u.mutation.TargetAmount(); ok {
+		_spec.SetField(watchlistitem.FieldTargetAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTargetAmount(); ok {
+		_spec.AddField(watchlistitem.FieldTargetAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(watchlistitem.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(watchlistitem.FieldCountry, field.TypeString, value)
+	}
+	if _u.mutation.CountryCleared() {
+		_spec.ClearField(watchlistitem.FieldCountry, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastAmount(); ok {
+		_spec.SetField(watchlistitem.FieldLastAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastAmount(); ok {
+		_spec.AddField(watchlistitem.FieldLastAmount, field.TypeInt64, value)
+	}
+	if _u.mutation.LastAmountCleared() {
+		_spec.ClearField(watchlistitem.FieldLastAmount, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.LastCheckedAt(); ok {
+		_spec.SetField(watchlistitem.FieldLastCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastCheckedAtCleared() {
+		_spec.ClearField(watchlistitem.FieldLastCheckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.NotifiedAt(); ok {
+		_spec.SetField(watchlistitem.FieldNotifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.NotifiedAtCleared() {
+		_spec.ClearField(watchlistitem.FieldNotifiedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(watchlistitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlistitem.UserTable,
+			Columns: []string{watchlistitem.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlistitem.UserTable,
+			Columns: []string{watchlistitem.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{watchlistitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WatchlistItemUpdateOne is the builder for updating a single WatchlistItem entity.
+type WatchlistItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WatchlistItemMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *WatchlistItemUpdateOne) SetUserID(v uuid.UUID) *WatchlistItemUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *WatchlistItemUpdateOne) SetNillableUserID(v *uuid.UUID) *WatchlistItemUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetProductKey sets the "product_key" field.
+func (_u *WatchlistItemUpdateOne) SetProductKey(v string) *WatchlistItemUpdateOne {
+	_u.mutation.SetProductKey(v)
+	return _u
+}
+
+// SetNillableProductKey sets the "product_key" field if the given value is not nil.
+func (_u *WatchlistItemUpdateOne) SetNillableProductKey(v *string) *WatchlistItemUpdateOne {
+	if v != nil {
+		_u.SetProductKey(*v)
+	}
+	return _u
+}
+
+// ClearProductKey clears the value of the "product_key" field.
+func (_u *WatchlistItemUpdateOne) ClearProductKey() *WatchlistItemUpdateOne {
+	_u.mutation.ClearProductKey()
+	return _u
+}
+
+// SetPageToken sets the "page_token" field.
+func (_u *WatchlistItemUpdateOne) SetPageToken(v string) *WatchlistItemUpdateOne {
+	_u.mutation.SetPageToken(v)
+	return _u
+}
+
+// SetNillablePageToken sets the "page_token" field if the given value is not nil.
+func (_u *WatchlistItemUpdateOne) SetNillablePageToken(v *string) *WatchlistItemUpdateOne {
+	if v != nil {
+		_u.SetPageToken(*v)
+	}
+	return _u
+}
+
+// SetTitle sets the "title" field.
+func (_u *WatchlistItemUpdateOne) SetTitle(v string) *WatchlistItemUpdateOne {
+	_u.mutation.SetTitle(v)
+	return _u
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (_u *WatchlistItemUpdateOne) SetNillableTitle(v *string) *WatchlistItemUpdateOne {
+	if v != nil {
+		_u.SetTitle(*v)
+	}
+	return _u
+}
+
+// SetLink sets the "link" field.
+func (_u *WatchlistItemUpdateOne) SetLink(v string) *WatchlistItemUpdateOne {
+	_u.mutation.SetLink(v)
+	return _u
+}
+
+// SetNillableLink sets the "link" field if the given value is not nil.
+func (_u *WatchlistItemUpdateOne) SetNillableLink(v *string) *WatchlistItemUpdateOne {
+	if v != nil {
+		_u.SetLink(*v)
+	}
+	return _u
+}
+
+// ClearLink clears the value of the "link" field.
+func (_u *WatchlistItemUpdateOne) ClearLink() *WatchlistItemUpdateOne {
+	_u.mutation.ClearLink()
+	return _u
+}
+
+// SetImage sets the "image" field.
+func (_u *WatchlistItemUpdateOne) SetImage(v string) *WatchlistItemUpdateOne {
+	_u.mutation.SetImage(v)
+	return _u
+}
+
+// SetNillableImage sets the "image" field if the given value is not nil.
+func (_u *WatchlistItemUpdateOne) SetNillableImage(v *string) *WatchlistItemUpdateOne {
+	if v != nil {
+		_u.SetImage(*v)
+	}
+	return _u
+}
+
+// ClearImage clears the value of the "image" field.
+func (_u *WatchlistItemUpdateOne) ClearImage() *WatchlistItemUpdateOne {
+	_u.mutation.ClearImage()
+	return _u
+}
+
+// SetTargetAmount sets the "target_amount" field.
+func (_u *WatchlistItemUpdateOne) SetTargetAmount(v int64) *WatchlistItemUpdateOne {
+	_u.mutation.ResetTargetAmount()
+	_u.mutation.SetTargetAmount(v)
+	return _u
+}
+
+// SetNillableTargetAmount sets the "target_amount" field if the given value is not nil.
+func (_u *WatchlistItemUpdateOne) SetNillableTargetAmount(v *int64) *WatchlistItemUpdateOne {
+	if v != nil {
+		_u.SetTargetAmount(*v)
+	}
+	return _u
+}
+
+// AddTargetAmount adds value to the "target_amount" field.
+func (_u *WatchlistItemUpdateOne) AddTargetAmount(v int64) *WatchlistItemUpdateOne {
+	_u.mutation.AddTargetAmount(v)
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *WatchlistItemUpdateOne) SetCurrency(v string) *WatchlistItemUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *WatchlistItemUpdateOne) SetNillableCurrency(v *string) *WatchlistItemUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetCountry sets the "country" field.
+func (_u *WatchlistItemUpdateOne) SetCountry(v string) *WatchlistItemUpdateOne {
+	_u.mutation.SetCountry(v)
+	return _u
+}
+
+// SetNillableCountry sets the "country" field if the given value is not nil.
+func (_u *WatchlistItemUpdateOne) SetNillableCountry(v *string) *WatchlistItemUpdateOne {
+	if v != nil {
+		_u.SetCountry(*v)
+	}
+	return _u
+}
+
+// ClearCountry clears the value of the "country" field.
+func (_u *WatchlistItemUpdateOne) ClearCountry() *WatchlistItemUpdateOne {
+	_u.mutation.ClearCountry()
+	return _u
+}
+
+// SetLastAmount sets the "last_amount" field.
+func (_u *WatchlistItemUpdateOne) SetLastAmount(v int64) *WatchlistItemUpdateOne {
+	_u.mutation.ResetLastAmount()
+	_u.mutation.SetLastAmount(v)
+	return _u
+}
+
+// SetNillableLastAmount sets the "last_amount" field if the given value is not nil.
+func (_u *WatchlistItemUpdateOne) SetNillableLastAmount(v *int64) *WatchlistItemUpdateOne {
+	if v != nil {
+		_u.SetLastAmount(*v)
+	}
+	return _u
+}
+
+// AddLastAmount adds value to the "last_amount" field.
+func (_u *WatchlistItemUpdateOne) AddLastAmount(v int64) *WatchlistItemUpdateOne {
+	_u.mutation.AddLastAmount(v)
+	return _u
+}
+
+// ClearLastAmount clears the value of the "last_amount" field.
+func (_u *WatchlistItemUpdateOne) ClearLastAmount() *WatchlistItemUpdateOne {
+	_u.mutation.ClearLastAmount()
+	return _u
+}
+
+// SetLastCheckedAt sets the "last_checked_at" field.
+func (_u *WatchlistItemUpdateOne) SetLastCheckedAt(v time.Time) *WatchlistItemUpdateOne {
+	_u.mutation.SetLastCheckedAt(v)
+	return _u
+}
+
+// SetNillableLastCheckedAt sets the "last_checked_at" field if the given value is not nil.
+func (_u *WatchlistItemUpdateOne) SetNillableLastCheckedAt(v *time.Time) *WatchlistItemUpdateOne {
+	if v != nil {
+		_u.SetLastCheckedAt(*v)
+	}
+	return _u
+}
+
+// ClearLastCheckedAt clears the value of the "last_checked_at" field.
+func (_u *WatchlistItemUpdateOne) ClearLastCheckedAt() *WatchlistItemUpdateOne {
+	_u.mutation.ClearLastCheckedAt()
+	return _u
+}
+
+// SetNotifiedAt sets the "notified_at" field.
+func (_u *WatchlistItemUpdateOne) SetNotifiedAt(v time.Time) *WatchlistItemUpdateOne {
+	_u.mutation.SetNotifiedAt(v)
+	return _u
+}
+
+// SetNillableNotifiedAt sets the "notified_at" field if the given value is not nil.
+func (_u *WatchlistItemUpdateOne) SetNillableNotifiedAt(v *time.Time) *WatchlistItemUpdateOne {
+	if v != nil {
+		_u.SetNotifiedAt(*v)
+	}
+	return _u
+}
+
+// ClearNotifiedAt clears the value of the "notified_at" field.
+func (_u *WatchlistItemUpdateOne) ClearNotifiedAt() *WatchlistItemUpdateOne {
+	_u.mutation.ClearNotifiedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WatchlistItemUpdateOne) SetUpdatedAt(v time.Time) *WatchlistItemUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *WatchlistItemUpdateOne) SetUser(v *User) *WatchlistItemUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// Mutation returns the WatchlistItemMutation object of the builder.
+func (_u *WatchlistItemUpdateOne) Mutation() *WatchlistItemMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *WatchlistItemUpdateOne) ClearUser() *WatchlistItemUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// Where appends a list predicates to the WatchlistItemUpdate builder.
+func (_u *WatchlistItemUpdateOne) Where(ps ...predicate.WatchlistItem) *WatchlistItemUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WatchlistItemUpdateOne) Select(field string, fields ...string) *WatchlistItemUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WatchlistItem entity.
+func (_u *WatchlistItemUpdateOne) Save(ctx context.Context) (*WatchlistItem, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WatchlistItemUpdateOne) SaveX(ctx context.Context) *WatchlistItem {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WatchlistItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WatchlistItemUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WatchlistItemUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := watchlistitem.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WatchlistItemUpdateOne) check() error {
+	if v, ok := _u.mutation.PageToken(); ok {
+		if err := watchlistitem.PageTokenValidator(v); err != nil {
+			return &ValidationError{Name: "page_token", err: fmt.Errorf(`ent: validator failed for field "WatchlistItem.page_token": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Title(); ok {
+		if err := watchlistitem.TitleValidator(v); err != nil {
+			return &ValidationError{Name: "title", err: fmt.Errorf(`ent: validator failed for field "WatchlistItem.title": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TargetAmount(); ok {
+		if err := watchlistitem.TargetAmountValidator(v); err != nil {
+			return &ValidationError{Name: "target_amount", err: fmt.Errorf(`ent: validator failed for field "WatchlistItem.target_amount": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := watchlistitem.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "WatchlistItem.currency": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WatchlistItem.user"`)
+	}
+	return nil
+}
+
+func (_u *WatchlistItemUpdateOne) sqlSave(ctx context.Context) (_node *WatchlistItem, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(watchlistitem.Table, watchlistitem.Columns, sqlgraph.NewFieldSpec(watchlistitem.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WatchlistItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, watchlistitem.FieldID)
+		for _, f := range fields {
+			if !watchlistitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != watchlistitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ProductKey(); ok {
+		_spec.SetField(watchlistitem.FieldProductKey, field.TypeString, value)
+	}
+	if _u.mutation.ProductKeyCleared() {
+		_spec.ClearField(watchlistitem.FieldProductKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.PageToken(); ok {
+		_spec.SetField(watchlistitem.FieldPageToken, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Title(); ok {
+		_spec.SetField(watchlistitem.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Link(); ok {
+		_spec.SetField(watchlistitem.FieldLink, field.TypeString, value)
+	}
+	if _u.mutation.LinkCleared() {
+		_spec.ClearField(watchlistitem.FieldLink, field.TypeString)
+	}
+	if value, ok := _u.mutation.Image(); ok {
+		_spec.SetField(watchlistitem.FieldImage, field.TypeString, value)
+	}
+	if _u.mutation.ImageCleared() {
+		_spec.ClearField(watchlistitem.FieldImage, field.TypeString)
+	}
+	if value, ok := _u.mutation.TargetAmount(); ok {
+		_spec.SetField(watchlistitem.FieldTargetAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedTargetAmount(); ok {
+		_spec.AddField(watchlistitem.FieldTargetAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(watchlistitem.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Country(); ok {
+		_spec.SetField(watchlistitem.FieldCountry, field.TypeString, value)
+	}
+	if _u.mutation.CountryCleared() {
+		_spec.ClearField(watchlistitem.FieldCountry, field.TypeString)
+	}
+	if value, ok := _u.mutation.LastAmount(); ok {
+		_spec.SetField(watchlistitem.FieldLastAmount, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedLastAmount(); ok {
+		_spec.AddField(watchlistitem.FieldLastAmount, field.TypeInt64, value)
+	}
+	if _u.mutation.LastAmountCleared() {
+		_spec.ClearField(watchlistitem.FieldLastAmount, field.TypeInt64)
+	}
+	if value, ok := _u.mutation.LastCheckedAt(); ok {
+		_spec.SetField(watchlistitem.FieldLastCheckedAt, field.TypeTime, value)
+	}
+	if _u.mutation.LastCheckedAtCleared() {
+		_spec.ClearField(watchlistitem.FieldLastCheckedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.NotifiedAt(); ok {
+		_spec.SetField(watchlistitem.FieldNotifiedAt, field.TypeTime, value)
+	}
+	if _u.mutation.NotifiedAtCleared() {
+		_spec.ClearField(watchlistitem.FieldNotifiedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(watchlistitem.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlistitem.UserTable,
+			Columns: []string{watchlistitem.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   watchlistitem.UserTable,
+			Columns: []string{watchlistitem.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WatchlistItem{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{watchlistitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
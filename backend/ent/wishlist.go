@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"mylittleprice/ent/user"
+	"mylittleprice/ent/wishlist"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// Wishlist is the model entity for the Wishlist schema.
+type Wishlist struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Shared holds the value of the "shared" field.
+	Shared bool `json:"shared,omitempty"`
+	// ShareVersion holds the value of the "share_version" field.
+	ShareVersion int `json:"share_version,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WishlistQuery when eager-loading is set.
+	Edges        WishlistEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WishlistEdges holds the relations/edges for other nodes in the graph.
+type WishlistEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Items holds the value of the items edge.
+	Items []*WishlistItem `json:"items,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WishlistEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ItemsOrErr returns the Items value or an error if the edge
+// was not loaded in eager-loading.
+func (e WishlistEdges) ItemsOrErr() ([]*WishlistItem, error) {
+	if e.loadedTypes[1] {
+		return e.Items, nil
+	}
+	return nil, &NotLoadedError{edge: "items"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Wishlist) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case wishlist.FieldShared:
+			values[i] = new(sql.NullBool)
+		case wishlist.FieldShareVersion:
+			values[i] = new(sql.NullInt64)
+		case wishlist.FieldName:
+			values[i] = new(sql.NullString)
+		case wishlist.FieldCreatedAt, wishlist.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case wishlist.FieldID, wishlist.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Wishlist fields.
+func (_m *Wishlist) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case wishlist.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case wishlist.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case wishlist.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case wishlist.FieldShared:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field shared", values[i])
+			} else if value.Valid {
+				_m.Shared = value.Bool
+			}
+		case wishlist.FieldShareVersion:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field share_version", values[i])
+			} else if value.Valid {
+				_m.ShareVersion = int(value.Int64)
+			}
+		case wishlist.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case wishlist.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Wishlist.
+// This includes values selected through modifiers, order, etc.
+func (_m *Wishlist) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Wishlist entity.
+func (_m *Wishlist) QueryUser() *UserQuery {
+	return NewWishlistClient(_m.config).QueryUser(_m)
+}
+
+// QueryItems queries the "items" edge of the Wishlist entity.
+func (_m *Wishlist) QueryItems() *WishlistItemQuery {
+	return NewWishlistClient(_m.config).QueryItems(_m)
+}
+
+// Update returns a builder for updating this Wishlist.
+// Note that you need to call Wishlist.Unwrap() before calling this method if this Wishlist
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Wishlist) Update() *WishlistUpdateOne {
+	return NewWishlistClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Wishlist entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Wishlist) Unwrap() *Wishlist {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Wishlist is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Wishlist) String() string {
+	var builder strings.Builder
+	builder.WriteString("Wishlist(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("shared=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Shared))
+	builder.WriteString(", ")
+	builder.WriteString("share_version=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ShareVersion))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Wishlists is a parsable slice of Wishlist.
+type Wishlists []*Wishlist
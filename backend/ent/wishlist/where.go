@@ -0,0 +1,363 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wishlist
+
+import (
+	"mylittleprice/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldEQ(FieldName, v))
+}
+
+// Shared applies equality check predicate on the "shared" field. It's identical to SharedEQ.
+func Shared(v bool) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldEQ(FieldShared, v))
+}
+
+// ShareVersion applies equality check predicate on the "share_version" field. It's identical to ShareVersionEQ.
+func ShareVersion(v int) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldEQ(FieldShareVersion, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldContainsFold(FieldName, v))
+}
+
+// SharedEQ applies the EQ predicate on the "shared" field.
+func SharedEQ(v bool) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldEQ(FieldShared, v))
+}
+
+// SharedNEQ applies the NEQ predicate on the "shared" field.
+func SharedNEQ(v bool) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldNEQ(FieldShared, v))
+}
+
+// ShareVersionEQ applies the EQ predicate on the "share_version" field.
+func ShareVersionEQ(v int) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldEQ(FieldShareVersion, v))
+}
+
+// ShareVersionNEQ applies the NEQ predicate on the "share_version" field.
+func ShareVersionNEQ(v int) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldNEQ(FieldShareVersion, v))
+}
+
+// ShareVersionIn applies the In predicate on the "share_version" field.
+func ShareVersionIn(vs ...int) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldIn(FieldShareVersion, vs...))
+}
+
+// ShareVersionNotIn applies the NotIn predicate on the "share_version" field.
+func ShareVersionNotIn(vs ...int) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldNotIn(FieldShareVersion, vs...))
+}
+
+// ShareVersionGT applies the GT predicate on the "share_version" field.
+func ShareVersionGT(v int) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldGT(FieldShareVersion, v))
+}
+
+// ShareVersionGTE applies the GTE predicate on the "share_version" field.
+func ShareVersionGTE(v int) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldGTE(FieldShareVersion, v))
+}
+
+// ShareVersionLT applies the LT predicate on the "share_version" field.
+func ShareVersionLT(v int) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldLT(FieldShareVersion, v))
+}
+
+// ShareVersionLTE applies the LTE predicate on the "share_version" field.
+func ShareVersionLTE(v int) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldLTE(FieldShareVersion, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Wishlist {
+	return predicate.Wishlist(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Wishlist {
+	return predicate.Wishlist(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Wishlist {
+	return predicate.Wishlist(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasItems applies the HasEdge predicate on the "items" edge.
+func HasItems() predicate.Wishlist {
+	return predicate.Wishlist(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ItemsTable, ItemsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasItemsWith applies the HasEdge predicate on the "items" edge with a given conditions (other predicates).
+func HasItemsWith(preds ...predicate.WishlistItem) predicate.Wishlist {
+	return predicate.Wishlist(func(s *sql.Selector) {
+		step := newItemsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Wishlist) predicate.Wishlist {
+	return predicate.Wishlist(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Wishlist) predicate.Wishlist {
+	return predicate.Wishlist(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Wishlist) predicate.Wishlist {
+	return predicate.Wishlist(sql.NotPredicates(p))
+}
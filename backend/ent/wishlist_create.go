@@ -0,0 +1,386 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mylittleprice/ent/user"
+	"mylittleprice/ent/wishlist"
+	"mylittleprice/ent/wishlistitem"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WishlistCreate is the builder for creating a Wishlist entity.
+type WishlistCreate struct {
+	config
+	mutation *WishlistMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *WishlistCreate) SetUserID(v uuid.UUID) *WishlistCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *WishlistCreate) SetName(v string) *WishlistCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetShared sets the "shared" field.
+func (_c *WishlistCreate) SetShared(v bool) *WishlistCreate {
+	_c.mutation.SetShared(v)
+	return _c
+}
+
+// SetNillableShared sets the "shared" field if the given value is not nil.
+func (_c *WishlistCreate) SetNillableShared(v *bool) *WishlistCreate {
+	if v != nil {
+		_c.SetShared(*v)
+	}
+	return _c
+}
+
+// SetShareVersion sets the "share_version" field.
+func (_c *WishlistCreate) SetShareVersion(v int) *WishlistCreate {
+	_c.mutation.SetShareVersion(v)
+	return _c
+}
+
+// SetNillableShareVersion sets the "share_version" field if the given value is not nil.
+func (_c *WishlistCreate) SetNillableShareVersion(v *int) *WishlistCreate {
+	if v != nil {
+		_c.SetShareVersion(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WishlistCreate) SetCreatedAt(v time.Time) *WishlistCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WishlistCreate) SetNillableCreatedAt(v *time.Time) *WishlistCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *WishlistCreate) SetUpdatedAt(v time.Time) *WishlistCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *WishlistCreate) SetNillableUpdatedAt(v *time.Time) *WishlistCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WishlistCreate) SetID(v uuid.UUID) *WishlistCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *WishlistCreate) SetNillableID(v *uuid.UUID) *WishlistCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *WishlistCreate) SetUser(v *User) *WishlistCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// AddItemIDs adds the "items" edge to the WishlistItem entity by IDs.
+func (_c *WishlistCreate) AddItemIDs(ids ...uuid.UUID) *WishlistCreate {
+	_c.mutation.AddItemIDs(ids...)
+	return _c
+}
+
+// AddItems adds the "items" edges to the WishlistItem entity.
+func (_c *WishlistCreate) AddItems(v ...*WishlistItem) *WishlistCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddItemIDs(ids...)
+}
+
+// Mutation returns the WishlistMutation object of the builder.
+func (_c *WishlistCreate) Mutation() *WishlistMutation {
+	return _c.mutation
+}
+
+// Save creates the Wishlist in the database.
+func (_c *WishlistCreate) Save(ctx context.Context) (*Wishlist, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WishlistCreate) SaveX(ctx context.Context) *Wishlist {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WishlistCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WishlistCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WishlistCreate) defaults() {
+	if _, ok := _c.mutation.Shared(); !ok {
+		v := wishlist.DefaultShared
+		_c.mutation.SetShared(v)
+	}
+	if _, ok := _c.mutation.ShareVersion(); !ok {
+		v := wishlist.DefaultShareVersion
+		_c.mutation.SetShareVersion(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := wishlist.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := wishlist.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := wishlist.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WishlistCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Wishlist.user_id"`)}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Wishlist.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := wishlist.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Wishlist.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Shared(); !ok {
+		return &ValidationError{Name: "shared", err: errors.New(`ent: missing required field "Wishlist.shared"`)}
+	}
+	if _, ok := _c.mutation.ShareVersion(); !ok {
+		return &ValidationError{Name: "share_version", err: errors.New(`ent: missing required field "Wishlist.share_version"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Wishlist.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Wishlist.updated_at"`)}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Wishlist.user"`)}
+	}
+	return nil
+}
+
+func (_c *WishlistCreate) sqlSave(ctx context.Context) (*Wishlist, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WishlistCreate) createSpec() (*Wishlist, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Wishlist{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(wishlist.Table, sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(wishlist.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.Shared(); ok {
+		_spec.SetField(wishlist.FieldShared, field.TypeBool, value)
+		_node.Shared = value
+	}
+	if value, ok := _c.mutation.ShareVersion(); ok {
+		_spec.SetField(wishlist.FieldShareVersion, field.TypeInt, value)
+		_node.ShareVersion = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(wishlist.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(wishlist.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wishlist.UserTable,
+			Columns: []string{wishlist.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wishlist.ItemsTable,
+			Columns: []string{wishlist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WishlistCreateBulk is the builder for creating many Wishlist entities in bulk.
+type WishlistCreateBulk struct {
+	config
+	err      error
+	builders []*WishlistCreate
+}
+
+// Save creates the Wishlist entities in the database.
+func (_c *WishlistCreateBulk) Save(ctx context.Context) ([]*Wishlist, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Wishlist, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WishlistMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WishlistCreateBulk) SaveX(ctx context.Context) []*Wishlist {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WishlistCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WishlistCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
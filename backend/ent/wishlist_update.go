@@ -0,0 +1,639 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mylittleprice/ent/predicate"
+	"mylittleprice/ent/user"
+	"mylittleprice/ent/wishlist"
+	"mylittleprice/ent/wishlistitem"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WishlistUpdate is the builder for updating Wishlist entities.
+type WishlistUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WishlistMutation
+}
+
+// Where appends a list predicates to the WishlistUpdate builder.
+func (_u *WishlistUpdate) Where(ps ...predicate.Wishlist) *WishlistUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *WishlistUpdate) SetUserID(v uuid.UUID) *WishlistUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *WishlistUpdate) SetNillableUserID(v *uuid.UUID) *WishlistUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *WishlistUpdate) SetName(v string) *WishlistUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *WishlistUpdate) SetNillableName(v *string) *WishlistUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetShared sets the "shared" field.
+func (_u *WishlistUpdate) SetShared(v bool) *WishlistUpdate {
+	_u.mutation.SetShared(v)
+	return _u
+}
+
+// SetNillableShared sets the "shared" field if the given value is not nil.
+func (_u *WishlistUpdate) SetNillableShared(v *bool) *WishlistUpdate {
+	if v != nil {
+		_u.SetShared(*v)
+	}
+	return _u
+}
+
+// SetShareVersion sets the "share_version" field.
+func (_u *WishlistUpdate) SetShareVersion(v int) *WishlistUpdate {
+	_u.mutation.ResetShareVersion()
+	_u.mutation.SetShareVersion(v)
+	return _u
+}
+
+// SetNillableShareVersion sets the "share_version" field if the given value is not nil.
+func (_u *WishlistUpdate) SetNillableShareVersion(v *int) *WishlistUpdate {
+	if v != nil {
+		_u.SetShareVersion(*v)
+	}
+	return _u
+}
+
+// AddShareVersion adds value to the "share_version" field.
+func (_u *WishlistUpdate) AddShareVersion(v int) *WishlistUpdate {
+	_u.mutation.AddShareVersion(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WishlistUpdate) SetUpdatedAt(v time.Time) *WishlistUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *WishlistUpdate) SetUser(v *User) *WishlistUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// AddItemIDs adds the "items" edge to the WishlistItem entity by IDs.
+func (_u *WishlistUpdate) AddItemIDs(ids ...uuid.UUID) *WishlistUpdate {
+	_u.mutation.AddItemIDs(ids...)
+	return _u
+}
+
+// AddItems adds the "items" edges to the WishlistItem entity.
+func (_u *WishlistUpdate) AddItems(v ...*WishlistItem) *WishlistUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemIDs(ids...)
+}
+
+// Mutation returns the WishlistMutation object of the builder.
+func (_u *WishlistUpdate) Mutation() *WishlistMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *WishlistUpdate) ClearUser() *WishlistUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearItems clears all "items" edges to the WishlistItem entity.
+func (_u *WishlistUpdate) ClearItems() *WishlistUpdate {
+	_u.mutation.ClearItems()
+	return _u
+}
+
+// RemoveItemIDs removes the "items" edge to WishlistItem entities by IDs.
+func (_u *WishlistUpdate) RemoveItemIDs(ids ...uuid.UUID) *WishlistUpdate {
+	_u.mutation.RemoveItemIDs(ids...)
+	return _u
+}
+
+// RemoveItems removes "items" edges to WishlistItem entities.
+func (_u *WishlistUpdate) RemoveItems(v ...*WishlistItem) *WishlistUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WishlistUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WishlistUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WishlistUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WishlistUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WishlistUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := wishlist.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WishlistUpdate) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := wishlist.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Wishlist.name": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Wishlist.user"`)
+	}
+	return nil
+}
+
+func (_u *WishlistUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wishlist.Table, wishlist.Columns, sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(wishlist.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Shared(); ok {
+		_spec.SetField(wishlist.FieldShared, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ShareVersion(); ok {
+		_spec.SetField(wishlist.FieldShareVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedShareVersion(); ok {
+		_spec.AddField(wishlist.FieldShareVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(wishlist.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wishlist.UserTable,
+			Columns: []string{wishlist.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wishlist.UserTable,
+			Columns: []string{wishlist.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wishlist.ItemsTable,
+			Columns: []string{wishlist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemsIDs(); len(nodes) > 0 && !_u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wishlist.ItemsTable,
+			Columns: []string{wishlist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wishlist.ItemsTable,
+			Columns: []string{wishlist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wishlist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WishlistUpdateOne is the builder for updating a single Wishlist entity.
+type WishlistUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WishlistMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *WishlistUpdateOne) SetUserID(v uuid.UUID) *WishlistUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *WishlistUpdateOne) SetNillableUserID(v *uuid.UUID) *WishlistUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *WishlistUpdateOne) SetName(v string) *WishlistUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *WishlistUpdateOne) SetNillableName(v *string) *WishlistUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetShared sets the "shared" field.
+func (_u *WishlistUpdateOne) SetShared(v bool) *WishlistUpdateOne {
+	_u.mutation.SetShared(v)
+	return _u
+}
+
+// SetNillableShared sets the "shared" field if the given value is not nil.
+func (_u *WishlistUpdateOne) SetNillableShared(v *bool) *WishlistUpdateOne {
+	if v != nil {
+		_u.SetShared(*v)
+	}
+	return _u
+}
+
+// SetShareVersion sets the "share_version" field.
+func (_u *WishlistUpdateOne) SetShareVersion(v int) *WishlistUpdateOne {
+	_u.mutation.ResetShareVersion()
+	_u.mutation.SetShareVersion(v)
+	return _u
+}
+
+// SetNillableShareVersion sets the "share_version" field if the given value is not nil.
+func (_u *WishlistUpdateOne) SetNillableShareVersion(v *int) *WishlistUpdateOne {
+	if v != nil {
+		_u.SetShareVersion(*v)
+	}
+	return _u
+}
+
+// AddShareVersion adds value to the "share_version" field.
+func (_u *WishlistUpdateOne) AddShareVersion(v int) *WishlistUpdateOne {
+	_u.mutation.AddShareVersion(v)
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *WishlistUpdateOne) SetUpdatedAt(v time.Time) *WishlistUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *WishlistUpdateOne) SetUser(v *User) *WishlistUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// AddItemIDs adds the "items" edge to the WishlistItem entity by IDs.
+func (_u *WishlistUpdateOne) AddItemIDs(ids ...uuid.UUID) *WishlistUpdateOne {
+	_u.mutation.AddItemIDs(ids...)
+	return _u
+}
+
+// AddItems adds the "items" edges to the WishlistItem entity.
+func (_u *WishlistUpdateOne) AddItems(v ...*WishlistItem) *WishlistUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddItemIDs(ids...)
+}
+
+// Mutation returns the WishlistMutation object of the builder.
+func (_u *WishlistUpdateOne) Mutation() *WishlistMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *WishlistUpdateOne) ClearUser() *WishlistUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearItems clears all "items" edges to the WishlistItem entity.
+func (_u *WishlistUpdateOne) ClearItems() *WishlistUpdateOne {
+	_u.mutation.ClearItems()
+	return _u
+}
+
+// RemoveItemIDs removes the "items" edge to WishlistItem entities by IDs.
+func (_u *WishlistUpdateOne) RemoveItemIDs(ids ...uuid.UUID) *WishlistUpdateOne {
+	_u.mutation.RemoveItemIDs(ids...)
+	return _u
+}
+
+// RemoveItems removes "items" edges to WishlistItem entities.
+func (_u *WishlistUpdateOne) RemoveItems(v ...*WishlistItem) *WishlistUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveItemIDs(ids...)
+}
+
+// Where appends a list predicates to the WishlistUpdate builder.
+func (_u *WishlistUpdateOne) Where(ps ...predicate.Wishlist) *WishlistUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WishlistUpdateOne) Select(field string, fields ...string) *WishlistUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Wishlist entity.
+func (_u *WishlistUpdateOne) Save(ctx context.Context) (*Wishlist, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WishlistUpdateOne) SaveX(ctx context.Context) *Wishlist {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WishlistUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WishlistUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *WishlistUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := wishlist.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WishlistUpdateOne) check() error {
+	if v, ok := _u.mutation.Name(); ok {
+		if err := wishlist.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Wishlist.name": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Wishlist.user"`)
+	}
+	return nil
+}
+
+func (_u *WishlistUpdateOne) sqlSave(ctx context.Context) (_node *Wishlist, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wishlist.Table, wishlist.Columns, sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Wishlist.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wishlist.FieldID)
+		for _, f := range fields {
+			if !wishlist.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wishlist.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(wishlist.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Shared(); ok {
+		_spec.SetField(wishlist.FieldShared, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ShareVersion(); ok {
+		_spec.SetField(wishlist.FieldShareVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedShareVersion(); ok {
+		_spec.AddField(wishlist.FieldShareVersion, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(wishlist.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wishlist.UserTable,
+			Columns: []string{wishlist.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wishlist.UserTable,
+			Columns: []string{wishlist.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wishlist.ItemsTable,
+			Columns: []string{wishlist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedItemsIDs(); len(nodes) > 0 && !_u.mutation.ItemsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wishlist.ItemsTable,
+			Columns: []string{wishlist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.ItemsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   wishlist.ItemsTable,
+			Columns: []string{wishlist.ItemsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Wishlist{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wishlist.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
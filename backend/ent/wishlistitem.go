@@ -0,0 +1,196 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"mylittleprice/ent/wishlist"
+	"mylittleprice/ent/wishlistitem"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// WishlistItem is the model entity for the WishlistItem schema.
+type WishlistItem struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// WishlistID holds the value of the "wishlist_id" field.
+	WishlistID uuid.UUID `json:"wishlist_id,omitempty"`
+	// PageToken holds the value of the "page_token" field.
+	PageToken string `json:"page_token,omitempty"`
+	// ProductKey holds the value of the "product_key" field.
+	ProductKey string `json:"product_key,omitempty"`
+	// Product holds the value of the "product" field.
+	Product map[string]interface{} `json:"product,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the WishlistItemQuery when eager-loading is set.
+	Edges        WishlistItemEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// WishlistItemEdges holds the relations/edges for other nodes in the graph.
+type WishlistItemEdges struct {
+	// Wishlist holds the value of the wishlist edge.
+	Wishlist *Wishlist `json:"wishlist,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// WishlistOrErr returns the Wishlist value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e WishlistItemEdges) WishlistOrErr() (*Wishlist, error) {
+	if e.Wishlist != nil {
+		return e.Wishlist, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: wishlist.Label}
+	}
+	return nil, &NotLoadedError{edge: "wishlist"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*WishlistItem) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case wishlistitem.FieldProduct:
+			values[i] = new([]byte)
+		case wishlistitem.FieldPageToken, wishlistitem.FieldProductKey, wishlistitem.FieldNote:
+			values[i] = new(sql.NullString)
+		case wishlistitem.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case wishlistitem.FieldID, wishlistitem.FieldWishlistID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the WishlistItem fields.
+func (_m *WishlistItem) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case wishlistitem.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case wishlistitem.FieldWishlistID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field wishlist_id", values[i])
+			} else if value != nil {
+				_m.WishlistID = *value
+			}
+		case wishlistitem.FieldPageToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field page_token", values[i])
+			} else if value.Valid {
+				_m.PageToken = value.String
+			}
+		case wishlistitem.FieldProductKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field product_key", values[i])
+			} else if value.Valid {
+				_m.ProductKey = value.String
+			}
+		case wishlistitem.FieldProduct:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field product", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Product); err != nil {
+					return fmt.Errorf("unmarshal field product: %w", err)
+				}
+			}
+		case wishlistitem.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				_m.Note = value.String
+			}
+		case wishlistitem.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the WishlistItem.
+// This includes values selected through modifiers, order, etc.
+func (_m *WishlistItem) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryWishlist queries the "wishlist" edge of the WishlistItem entity.
+func (_m *WishlistItem) QueryWishlist() *WishlistQuery {
+	return NewWishlistItemClient(_m.config).QueryWishlist(_m)
+}
+
+// Update returns a builder for updating this WishlistItem.
+// Note that you need to call WishlistItem.Unwrap() before calling this method if this WishlistItem
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *WishlistItem) Update() *WishlistItemUpdateOne {
+	return NewWishlistItemClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the WishlistItem entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *WishlistItem) Unwrap() *WishlistItem {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: WishlistItem is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *WishlistItem) String() string {
+	var builder strings.Builder
+	builder.WriteString("WishlistItem(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("wishlist_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WishlistID))
+	builder.WriteString(", ")
+	builder.WriteString("page_token=")
+	builder.WriteString(_m.PageToken)
+	builder.WriteString(", ")
+	builder.WriteString("product_key=")
+	builder.WriteString(_m.ProductKey)
+	builder.WriteString(", ")
+	builder.WriteString("product=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Product))
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(_m.Note)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// WishlistItems is a parsable slice of WishlistItem.
+type WishlistItems []*WishlistItem
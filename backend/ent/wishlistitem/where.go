@@ -0,0 +1,405 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wishlistitem
+
+import (
+	"mylittleprice/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLTE(FieldID, id))
+}
+
+// WishlistID applies equality check predicate on the "wishlist_id" field. It's identical to WishlistIDEQ.
+func WishlistID(v uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldWishlistID, v))
+}
+
+// PageToken applies equality check predicate on the "page_token" field. It's identical to PageTokenEQ.
+func PageToken(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldPageToken, v))
+}
+
+// ProductKey applies equality check predicate on the "product_key" field. It's identical to ProductKeyEQ.
+func ProductKey(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldProductKey, v))
+}
+
+// Note applies equality check predicate on the "note" field. It's identical to NoteEQ.
+func Note(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldNote, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// WishlistIDEQ applies the EQ predicate on the "wishlist_id" field.
+func WishlistIDEQ(v uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldWishlistID, v))
+}
+
+// WishlistIDNEQ applies the NEQ predicate on the "wishlist_id" field.
+func WishlistIDNEQ(v uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldWishlistID, v))
+}
+
+// WishlistIDIn applies the In predicate on the "wishlist_id" field.
+func WishlistIDIn(vs ...uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldWishlistID, vs...))
+}
+
+// WishlistIDNotIn applies the NotIn predicate on the "wishlist_id" field.
+func WishlistIDNotIn(vs ...uuid.UUID) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldWishlistID, vs...))
+}
+
+// PageTokenEQ applies the EQ predicate on the "page_token" field.
+func PageTokenEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldPageToken, v))
+}
+
+// PageTokenNEQ applies the NEQ predicate on the "page_token" field.
+func PageTokenNEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldPageToken, v))
+}
+
+// PageTokenIn applies the In predicate on the "page_token" field.
+func PageTokenIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldPageToken, vs...))
+}
+
+// PageTokenNotIn applies the NotIn predicate on the "page_token" field.
+func PageTokenNotIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldPageToken, vs...))
+}
+
+// PageTokenGT applies the GT predicate on the "page_token" field.
+func PageTokenGT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGT(FieldPageToken, v))
+}
+
+// PageTokenGTE applies the GTE predicate on the "page_token" field.
+func PageTokenGTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGTE(FieldPageToken, v))
+}
+
+// PageTokenLT applies the LT predicate on the "page_token" field.
+func PageTokenLT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLT(FieldPageToken, v))
+}
+
+// PageTokenLTE applies the LTE predicate on the "page_token" field.
+func PageTokenLTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLTE(FieldPageToken, v))
+}
+
+// PageTokenContains applies the Contains predicate on the "page_token" field.
+func PageTokenContains(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContains(FieldPageToken, v))
+}
+
+// PageTokenHasPrefix applies the HasPrefix predicate on the "page_token" field.
+func PageTokenHasPrefix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasPrefix(FieldPageToken, v))
+}
+
+// PageTokenHasSuffix applies the HasSuffix predicate on the "page_token" field.
+func PageTokenHasSuffix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasSuffix(FieldPageToken, v))
+}
+
+// PageTokenIsNil applies the IsNil predicate on the "page_token" field.
+func PageTokenIsNil() predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIsNull(FieldPageToken))
+}
+
+// PageTokenNotNil applies the NotNil predicate on the "page_token" field.
+func PageTokenNotNil() predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotNull(FieldPageToken))
+}
+
+// PageTokenEqualFold applies the EqualFold predicate on the "page_token" field.
+func PageTokenEqualFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEqualFold(FieldPageToken, v))
+}
+
+// PageTokenContainsFold applies the ContainsFold predicate on the "page_token" field.
+func PageTokenContainsFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContainsFold(FieldPageToken, v))
+}
+
+// ProductKeyEQ applies the EQ predicate on the "product_key" field.
+func ProductKeyEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldProductKey, v))
+}
+
+// ProductKeyNEQ applies the NEQ predicate on the "product_key" field.
+func ProductKeyNEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldProductKey, v))
+}
+
+// ProductKeyIn applies the In predicate on the "product_key" field.
+func ProductKeyIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldProductKey, vs...))
+}
+
+// ProductKeyNotIn applies the NotIn predicate on the "product_key" field.
+func ProductKeyNotIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldProductKey, vs...))
+}
+
+// ProductKeyGT applies the GT predicate on the "product_key" field.
+func ProductKeyGT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGT(FieldProductKey, v))
+}
+
+// ProductKeyGTE applies the GTE predicate on the "product_key" field.
+func ProductKeyGTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGTE(FieldProductKey, v))
+}
+
+// ProductKeyLT applies the LT predicate on the "product_key" field.
+func ProductKeyLT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLT(FieldProductKey, v))
+}
+
+// ProductKeyLTE applies the LTE predicate on the "product_key" field.
+func ProductKeyLTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLTE(FieldProductKey, v))
+}
+
+// ProductKeyContains applies the Contains predicate on the "product_key" field.
+func ProductKeyContains(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContains(FieldProductKey, v))
+}
+
+// ProductKeyHasPrefix applies the HasPrefix predicate on the "product_key" field.
+func ProductKeyHasPrefix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasPrefix(FieldProductKey, v))
+}
+
+// ProductKeyHasSuffix applies the HasSuffix predicate on the "product_key" field.
+func ProductKeyHasSuffix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasSuffix(FieldProductKey, v))
+}
+
+// ProductKeyIsNil applies the IsNil predicate on the "product_key" field.
+func ProductKeyIsNil() predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIsNull(FieldProductKey))
+}
+
+// ProductKeyNotNil applies the NotNil predicate on the "product_key" field.
+func ProductKeyNotNil() predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotNull(FieldProductKey))
+}
+
+// ProductKeyEqualFold applies the EqualFold predicate on the "product_key" field.
+func ProductKeyEqualFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEqualFold(FieldProductKey, v))
+}
+
+// ProductKeyContainsFold applies the ContainsFold predicate on the "product_key" field.
+func ProductKeyContainsFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContainsFold(FieldProductKey, v))
+}
+
+// NoteEQ applies the EQ predicate on the "note" field.
+func NoteEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldNote, v))
+}
+
+// NoteNEQ applies the NEQ predicate on the "note" field.
+func NoteNEQ(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldNote, v))
+}
+
+// NoteIn applies the In predicate on the "note" field.
+func NoteIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldNote, vs...))
+}
+
+// NoteNotIn applies the NotIn predicate on the "note" field.
+func NoteNotIn(vs ...string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldNote, vs...))
+}
+
+// NoteGT applies the GT predicate on the "note" field.
+func NoteGT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGT(FieldNote, v))
+}
+
+// NoteGTE applies the GTE predicate on the "note" field.
+func NoteGTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGTE(FieldNote, v))
+}
+
+// NoteLT applies the LT predicate on the "note" field.
+func NoteLT(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLT(FieldNote, v))
+}
+
+// NoteLTE applies the LTE predicate on the "note" field.
+func NoteLTE(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLTE(FieldNote, v))
+}
+
+// NoteContains applies the Contains predicate on the "note" field.
+func NoteContains(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContains(FieldNote, v))
+}
+
+// NoteHasPrefix applies the HasPrefix predicate on the "note" field.
+func NoteHasPrefix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasPrefix(FieldNote, v))
+}
+
+// NoteHasSuffix applies the HasSuffix predicate on the "note" field.
+func NoteHasSuffix(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldHasSuffix(FieldNote, v))
+}
+
+// NoteIsNil applies the IsNil predicate on the "note" field.
+func NoteIsNil() predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIsNull(FieldNote))
+}
+
+// NoteNotNil applies the NotNil predicate on the "note" field.
+func NoteNotNil() predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotNull(FieldNote))
+}
+
+// NoteEqualFold applies the EqualFold predicate on the "note" field.
+func NoteEqualFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEqualFold(FieldNote, v))
+}
+
+// NoteContainsFold applies the ContainsFold predicate on the "note" field.
+func NoteContainsFold(v string) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldContainsFold(FieldNote, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasWishlist applies the HasEdge predicate on the "wishlist" edge.
+func HasWishlist() predicate.WishlistItem {
+	return predicate.WishlistItem(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, WishlistTable, WishlistColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasWishlistWith applies the HasEdge predicate on the "wishlist" edge with a given conditions (other predicates).
+func HasWishlistWith(preds ...predicate.Wishlist) predicate.WishlistItem {
+	return predicate.WishlistItem(func(s *sql.Selector) {
+		step := newWishlistStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.WishlistItem) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.WishlistItem) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.WishlistItem) predicate.WishlistItem {
+	return predicate.WishlistItem(sql.NotPredicates(p))
+}
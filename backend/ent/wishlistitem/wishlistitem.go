@@ -0,0 +1,116 @@
+// Code generated by ent, DO NOT EDIT.
+
+package wishlistitem
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the wishlistitem type in the database.
+	Label = "wishlist_item"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldWishlistID holds the string denoting the wishlist_id field in the database.
+	FieldWishlistID = "wishlist_id"
+	// FieldPageToken holds the string denoting the page_token field in the database.
+	FieldPageToken = "page_token"
+	// FieldProductKey holds the string denoting the product_key field in the database.
+	FieldProductKey = "product_key"
+	// FieldProduct holds the string denoting the product field in the database.
+	FieldProduct = "product"
+	// FieldNote holds the string denoting the note field in the database.
+	FieldNote = "note"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeWishlist holds the string denoting the wishlist edge name in mutations.
+	EdgeWishlist = "wishlist"
+	// Table holds the table name of the wishlistitem in the database.
+	Table = "wishlist_items"
+	// WishlistTable is the table that holds the wishlist relation/edge.
+	WishlistTable = "wishlist_items"
+	// WishlistInverseTable is the table name for the Wishlist entity.
+	// It exists in this package in order to avoid circular dependency with the "wishlist" package.
+	WishlistInverseTable = "wishlists"
+	// WishlistColumn is the table column denoting the wishlist relation/edge.
+	WishlistColumn = "wishlist_id"
+)
+
+// Columns holds all SQL columns for wishlistitem fields.
+var Columns = []string{
+	FieldID,
+	FieldWishlistID,
+	FieldPageToken,
+	FieldProductKey,
+	FieldProduct,
+	FieldNote,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the WishlistItem queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByWishlistID orders the results by the wishlist_id field.
+func ByWishlistID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWishlistID, opts...).ToFunc()
+}
+
+// ByPageToken orders the results by the page_token field.
+func ByPageToken(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPageToken, opts...).ToFunc()
+}
+
+// ByProductKey orders the results by the product_key field.
+func ByProductKey(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProductKey, opts...).ToFunc()
+}
+
+// ByNote orders the results by the note field.
+func ByNote(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldNote, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByWishlistField orders the results by wishlist field.
+func ByWishlistField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newWishlistStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newWishlistStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(WishlistInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, WishlistTable, WishlistColumn),
+	)
+}
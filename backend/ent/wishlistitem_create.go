@@ -0,0 +1,328 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mylittleprice/ent/wishlist"
+	"mylittleprice/ent/wishlistitem"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WishlistItemCreate is the builder for creating a WishlistItem entity.
+type WishlistItemCreate struct {
+	config
+	mutation *WishlistItemMutation
+	hooks    []Hook
+}
+
+// SetWishlistID sets the "wishlist_id" field.
+func (_c *WishlistItemCreate) SetWishlistID(v uuid.UUID) *WishlistItemCreate {
+	_c.mutation.SetWishlistID(v)
+	return _c
+}
+
+// SetPageToken sets the "page_token" field.
+func (_c *WishlistItemCreate) SetPageToken(v string) *WishlistItemCreate {
+	_c.mutation.SetPageToken(v)
+	return _c
+}
+
+// SetNillablePageToken sets the "page_token" field if the given value is not nil.
+func (_c *WishlistItemCreate) SetNillablePageToken(v *string) *WishlistItemCreate {
+	if v != nil {
+		_c.SetPageToken(*v)
+	}
+	return _c
+}
+
+// SetProductKey sets the "product_key" field.
+func (_c *WishlistItemCreate) SetProductKey(v string) *WishlistItemCreate {
+	_c.mutation.SetProductKey(v)
+	return _c
+}
+
+// SetNillableProductKey sets the "product_key" field if the given value is not nil.
+func (_c *WishlistItemCreate) SetNillableProductKey(v *string) *WishlistItemCreate {
+	if v != nil {
+		_c.SetProductKey(*v)
+	}
+	return _c
+}
+
+// SetProduct sets the "product" field.
+func (_c *WishlistItemCreate) SetProduct(v map[string]interface{}) *WishlistItemCreate {
+	_c.mutation.SetProduct(v)
+	return _c
+}
+
+// SetNote sets the "note" field.
+func (_c *WishlistItemCreate) SetNote(v string) *WishlistItemCreate {
+	_c.mutation.SetNote(v)
+	return _c
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_c *WishlistItemCreate) SetNillableNote(v *string) *WishlistItemCreate {
+	if v != nil {
+		_c.SetNote(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *WishlistItemCreate) SetCreatedAt(v time.Time) *WishlistItemCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *WishlistItemCreate) SetNillableCreatedAt(v *time.Time) *WishlistItemCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *WishlistItemCreate) SetID(v uuid.UUID) *WishlistItemCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *WishlistItemCreate) SetNillableID(v *uuid.UUID) *WishlistItemCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetWishlist sets the "wishlist" edge to the Wishlist entity.
+func (_c *WishlistItemCreate) SetWishlist(v *Wishlist) *WishlistItemCreate {
+	return _c.SetWishlistID(v.ID)
+}
+
+// Mutation returns the WishlistItemMutation object of the builder.
+func (_c *WishlistItemCreate) Mutation() *WishlistItemMutation {
+	return _c.mutation
+}
+
+// Save creates the WishlistItem in the database.
+func (_c *WishlistItemCreate) Save(ctx context.Context) (*WishlistItem, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *WishlistItemCreate) SaveX(ctx context.Context) *WishlistItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WishlistItemCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WishlistItemCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *WishlistItemCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := wishlistitem.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := wishlistitem.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *WishlistItemCreate) check() error {
+	if _, ok := _c.mutation.WishlistID(); !ok {
+		return &ValidationError{Name: "wishlist_id", err: errors.New(`ent: missing required field "WishlistItem.wishlist_id"`)}
+	}
+	if _, ok := _c.mutation.Product(); !ok {
+		return &ValidationError{Name: "product", err: errors.New(`ent: missing required field "WishlistItem.product"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "WishlistItem.created_at"`)}
+	}
+	if len(_c.mutation.WishlistIDs()) == 0 {
+		return &ValidationError{Name: "wishlist", err: errors.New(`ent: missing required edge "WishlistItem.wishlist"`)}
+	}
+	return nil
+}
+
+func (_c *WishlistItemCreate) sqlSave(ctx context.Context) (*WishlistItem, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *WishlistItemCreate) createSpec() (*WishlistItem, *sqlgraph.CreateSpec) {
+	var (
+		_node = &WishlistItem{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(wishlistitem.Table, sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.PageToken(); ok {
+		_spec.SetField(wishlistitem.FieldPageToken, field.TypeString, value)
+		_node.PageToken = value
+	}
+	if value, ok := _c.mutation.ProductKey(); ok {
+		_spec.SetField(wishlistitem.FieldProductKey, field.TypeString, value)
+		_node.ProductKey = value
+	}
+	if value, ok := _c.mutation.Product(); ok {
+		_spec.SetField(wishlistitem.FieldProduct, field.TypeJSON, value)
+		_node.Product = value
+	}
+	if value, ok := _c.mutation.Note(); ok {
+		_spec.SetField(wishlistitem.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(wishlistitem.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := _c.mutation.WishlistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wishlistitem.WishlistTable,
+			Columns: []string{wishlistitem.WishlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.WishlistID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// WishlistItemCreateBulk is the builder for creating many WishlistItem entities in bulk.
+type WishlistItemCreateBulk struct {
+	config
+	err      error
+	builders []*WishlistItemCreate
+}
+
+// Save creates the WishlistItem entities in the database.
+func (_c *WishlistItemCreateBulk) Save(ctx context.Context) ([]*WishlistItem, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*WishlistItem, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*WishlistItemMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *WishlistItemCreateBulk) SaveX(ctx context.Context) []*WishlistItem {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *WishlistItemCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *WishlistItemCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,481 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"mylittleprice/ent/predicate"
+	"mylittleprice/ent/wishlist"
+	"mylittleprice/ent/wishlistitem"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// WishlistItemUpdate is the builder for updating WishlistItem entities.
+type WishlistItemUpdate struct {
+	config
+	hooks    []Hook
+	mutation *WishlistItemMutation
+}
+
+// Where appends a list predicates to the WishlistItemUpdate builder.
+func (_u *WishlistItemUpdate) Where(ps ...predicate.WishlistItem) *WishlistItemUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetWishlistID sets the "wishlist_id" field.
+func (_u *WishlistItemUpdate) SetWishlistID(v uuid.UUID) *WishlistItemUpdate {
+	_u.mutation.SetWishlistID(v)
+	return _u
+}
+
+// SetNillableWishlistID sets the "wishlist_id" field if the given value is not nil.
+func (_u *WishlistItemUpdate) SetNillableWishlistID(v *uuid.UUID) *WishlistItemUpdate {
+	if v != nil {
+		_u.SetWishlistID(*v)
+	}
+	return _u
+}
+
+// SetPageToken sets the "page_token" field.
+func (_u *WishlistItemUpdate) SetPageToken(v string) *WishlistItemUpdate {
+	_u.mutation.SetPageToken(v)
+	return _u
+}
+
+// SetNillablePageToken sets the "page_token" field if the given value is not nil.
+func (_u *WishlistItemUpdate) SetNillablePageToken(v *string) *WishlistItemUpdate {
+	if v != nil {
+		_u.SetPageToken(*v)
+	}
+	return _u
+}
+
+// ClearPageToken clears the value of the "page_token" field.
+func (_u *WishlistItemUpdate) ClearPageToken() *WishlistItemUpdate {
+	_u.mutation.ClearPageToken()
+	return _u
+}
+
+// SetProductKey sets the "product_key" field.
+func (_u *WishlistItemUpdate) SetProductKey(v string) *WishlistItemUpdate {
+	_u.mutation.SetProductKey(v)
+	return _u
+}
+
+// SetNillableProductKey sets the "product_key" field if the given value is not nil.
+func (_u *WishlistItemUpdate) SetNillableProductKey(v *string) *WishlistItemUpdate {
+	if v != nil {
+		_u.SetProductKey(*v)
+	}
+	return _u
+}
+
+// ClearProductKey clears the value of the "product_key" field.
+func (_u *WishlistItemUpdate) ClearProductKey() *WishlistItemUpdate {
+	_u.mutation.ClearProductKey()
+	return _u
+}
+
+// SetProduct sets the "product" field.
+func (_u *WishlistItemUpdate) SetProduct(v map[string]interface{}) *WishlistItemUpdate {
+	_u.mutation.SetProduct(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *WishlistItemUpdate) SetNote(v string) *WishlistItemUpdate {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *WishlistItemUpdate) SetNillableNote(v *string) *WishlistItemUpdate {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *WishlistItemUpdate) ClearNote() *WishlistItemUpdate {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetWishlist sets the "wishlist" edge to the Wishlist entity.
+func (_u *WishlistItemUpdate) SetWishlist(v *Wishlist) *WishlistItemUpdate {
+	return _u.SetWishlistID(v.ID)
+}
+
+// Mutation returns the WishlistItemMutation object of the builder.
+func (_u *WishlistItemUpdate) Mutation() *WishlistItemMutation {
+	return _u.mutation
+}
+
+// ClearWishlist clears the "wishlist" edge to the Wishlist entity.
+func (_u *WishlistItemUpdate) ClearWishlist() *WishlistItemUpdate {
+	_u.mutation.ClearWishlist()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *WishlistItemUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WishlistItemUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *WishlistItemUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WishlistItemUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WishlistItemUpdate) check() error {
+	if _u.mutation.WishlistCleared() && len(_u.mutation.WishlistIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WishlistItem.wishlist"`)
+	}
+	return nil
+}
+
+func (_u *WishlistItemUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wishlistitem.Table, wishlistitem.Columns, sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PageToken(); ok {
+		_spec.SetField(wishlistitem.FieldPageToken, field.TypeString, value)
+	}
+	if _u.mutation.PageTokenCleared() {
+		_spec.ClearField(wishlistitem.FieldPageToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProductKey(); ok {
+		_spec.SetField(wishlistitem.FieldProductKey, field.TypeString, value)
+	}
+	if _u.mutation.ProductKeyCleared() {
+		_spec.ClearField(wishlistitem.FieldProductKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Product(); ok {
+		_spec.SetField(wishlistitem.FieldProduct, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(wishlistitem.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(wishlistitem.FieldNote, field.TypeString)
+	}
+	if _u.mutation.WishlistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wishlistitem.WishlistTable,
+			Columns: []string{wishlistitem.WishlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WishlistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wishlistitem.WishlistTable,
+			Columns: []string{wishlistitem.WishlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wishlistitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// WishlistItemUpdateOne is the builder for updating a single WishlistItem entity.
+type WishlistItemUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *WishlistItemMutation
+}
+
+// SetWishlistID sets the "wishlist_id" field.
+func (_u *WishlistItemUpdateOne) SetWishlistID(v uuid.UUID) *WishlistItemUpdateOne {
+	_u.mutation.SetWishlistID(v)
+	return _u
+}
+
+// SetNillableWishlistID sets the "wishlist_id" field if the given value is not nil.
+func (_u *WishlistItemUpdateOne) SetNillableWishlistID(v *uuid.UUID) *WishlistItemUpdateOne {
+	if v != nil {
+		_u.SetWishlistID(*v)
+	}
+	return _u
+}
+
+// SetPageToken sets the "page_token" field.
+func (_u *WishlistItemUpdateOne) SetPageToken(v string) *WishlistItemUpdateOne {
+	_u.mutation.SetPageToken(v)
+	return _u
+}
+
+// SetNillablePageToken sets the "page_token" field if the given value is not nil.
+func (_u *WishlistItemUpdateOne) SetNillablePageToken(v *string) *WishlistItemUpdateOne {
+	if v != nil {
+		_u.SetPageToken(*v)
+	}
+	return _u
+}
+
+// ClearPageToken clears the value of the "page_token" field.
+func (_u *WishlistItemUpdateOne) ClearPageToken() *WishlistItemUpdateOne {
+	_u.mutation.ClearPageToken()
+	return _u
+}
+
+// SetProductKey sets the "product_key" field.
+func (_u *WishlistItemUpdateOne) SetProductKey(v string) *WishlistItemUpdateOne {
+	_u.mutation.SetProductKey(v)
+	return _u
+}
+
+// SetNillableProductKey sets the "product_key" field if the given value is not nil.
+func (_u *WishlistItemUpdateOne) SetNillableProductKey(v *string) *WishlistItemUpdateOne {
+	if v != nil {
+		_u.SetProductKey(*v)
+	}
+	return _u
+}
+
+// ClearProductKey clears the value of the "product_key" field.
+func (_u *WishlistItemUpdateOne) ClearProductKey() *WishlistItemUpdateOne {
+	_u.mutation.ClearProductKey()
+	return _u
+}
+
+// SetProduct sets the "product" field.
+func (_u *WishlistItemUpdateOne) SetProduct(v map[string]interface{}) *WishlistItemUpdateOne {
+	_u.mutation.SetProduct(v)
+	return _u
+}
+
+// SetNote sets the "note" field.
+func (_u *WishlistItemUpdateOne) SetNote(v string) *WishlistItemUpdateOne {
+	_u.mutation.SetNote(v)
+	return _u
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (_u *WishlistItemUpdateOne) SetNillableNote(v *string) *WishlistItemUpdateOne {
+	if v != nil {
+		_u.SetNote(*v)
+	}
+	return _u
+}
+
+// ClearNote clears the value of the "note" field.
+func (_u *WishlistItemUpdateOne) ClearNote() *WishlistItemUpdateOne {
+	_u.mutation.ClearNote()
+	return _u
+}
+
+// SetWishlist sets the "wishlist" edge to the Wishlist entity.
+func (_u *WishlistItemUpdateOne) SetWishlist(v *Wishlist) *WishlistItemUpdateOne {
+	return _u.SetWishlistID(v.ID)
+}
+
+// Mutation returns the WishlistItemMutation object of the builder.
+func (_u *WishlistItemUpdateOne) Mutation() *WishlistItemMutation {
+	return _u.mutation
+}
+
+// ClearWishlist clears the "wishlist" edge to the Wishlist entity.
+func (_u *WishlistItemUpdateOne) ClearWishlist() *WishlistItemUpdateOne {
+	_u.mutation.ClearWishlist()
+	return _u
+}
+
+// Where appends a list predicates to the WishlistItemUpdate builder.
+func (_u *WishlistItemUpdateOne) Where(ps ...predicate.WishlistItem) *WishlistItemUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *WishlistItemUpdateOne) Select(field string, fields ...string) *WishlistItemUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated WishlistItem entity.
+func (_u *WishlistItemUpdateOne) Save(ctx context.Context) (*WishlistItem, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *WishlistItemUpdateOne) SaveX(ctx context.Context) *WishlistItem {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *WishlistItemUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *WishlistItemUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *WishlistItemUpdateOne) check() error {
+	if _u.mutation.WishlistCleared() && len(_u.mutation.WishlistIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "WishlistItem.wishlist"`)
+	}
+	return nil
+}
+
+func (_u *WishlistItemUpdateOne) sqlSave(ctx context.Context) (_node *WishlistItem, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(wishlistitem.Table, wishlistitem.Columns, sqlgraph.NewFieldSpec(wishlistitem.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "WishlistItem.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, wishlistitem.FieldID)
+		for _, f := range fields {
+			if !wishlistitem.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != wishlistitem.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.PageToken(); ok {
+		_spec.SetField(wishlistitem.FieldPageToken, field.TypeString, value)
+	}
+	if _u.mutation.PageTokenCleared() {
+		_spec.ClearField(wishlistitem.FieldPageToken, field.TypeString)
+	}
+	if value, ok := _u.mutation.ProductKey(); ok {
+		_spec.SetField(wishlistitem.FieldProductKey, field.TypeString, value)
+	}
+	if _u.mutation.ProductKeyCleared() {
+		_spec.ClearField(wishlistitem.FieldProductKey, field.TypeString)
+	}
+	if value, ok := _u.mutation.Product(); ok {
+		_spec.SetField(wishlistitem.FieldProduct, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Note(); ok {
+		_spec.SetField(wishlistitem.FieldNote, field.TypeString, value)
+	}
+	if _u.mutation.NoteCleared() {
+		_spec.ClearField(wishlistitem.FieldNote, field.TypeString)
+	}
+	if _u.mutation.WishlistCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wishlistitem.WishlistTable,
+			Columns: []string{wishlistitem.WishlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.WishlistIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   wishlistitem.WishlistTable,
+			Columns: []string{wishlistitem.WishlistColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(wishlist.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &WishlistItem{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{wishlistitem.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -13,7 +13,6 @@ require (
 	github.com/lib/pq v1.10.9
 	github.com/prometheus/client_golang v1.20.4
 	github.com/redis/go-redis/v9 v9.16.0
-	github.com/serpapi/google-search-results-golang v0.0.0-20240325113416-ec93f510648e
 	golang.org/x/crypto v0.44.0
 	google.golang.org/genai v1.34.0
 )
@@ -51,6 +51,15 @@ func SetupRoutes(app *fiber.App, c *container.Container) {
 	// User preferences routes (authenticated)
 	setupPreferencesRoutes(api, c)
 
+	// Price-drop watchlist routes (authenticated)
+	setupWatchlistRoutes(api, c)
+
+	// Wishlist routes (authenticated, shared links public)
+	setupWishlistRoutes(api, c)
+
+	// Conversation share routes (owner authenticated, share links public)
+	setupShareRoutes(api, c)
+
 	// Stats routes
 	setupStatsRoutes(api, c)
 
@@ -59,6 +68,9 @@ func SetupRoutes(app *fiber.App, c *container.Container) {
 
 	// Contact form routes
 	setupContactRoutes(api, c)
+
+	// Admin routes (X-Admin-Token)
+	setupAdminRoutes(api, c)
 }
 
 func setupAuthRoutes(api fiber.Router, c *container.Container) {
@@ -133,17 +145,29 @@ func setupWebSocketRoutes(app *fiber.App, c *container.Container) {
 
 func setupChatRoutes(api fiber.Router, c *container.Container) {
 	chatHandler := handlers.NewChatHandler(c)
+	usageHandler := handlers.NewUsageHandler(c)
 	optionalAuthMiddleware := middleware.OptionalAuthMiddleware(c.JWTService)
 	sessionOwnership := c.SessionOwnershipChecker.ValidateSessionOwnership()
 
 	api.Post("/chat", optionalAuthMiddleware, chatHandler.HandleChat)
+	api.Post("/chat/stream", optionalAuthMiddleware, chatHandler.HandleChatStream)                          // Same as /chat, but as Server-Sent Events with progress
 	api.Get("/chat/messages/since", optionalAuthMiddleware, sessionOwnership, chatHandler.GetMessagesSince) // Reconnect endpoint with ownership check
 	api.Get("/chat/messages", optionalAuthMiddleware, sessionOwnership, chatHandler.GetSessionMessages)     // Get messages with ownership check
+	api.Get("/chat/cost", optionalAuthMiddleware, sessionOwnership, usageHandler.GetSessionCost)            // Tokens and cost spent on a session
 }
 
 func setupProductRoutes(api fiber.Router, c *container.Container) {
 	productHandler := handlers.NewProductHandler(c)
-	api.Post("/product-details", productHandler.HandleProductDetails)
+	optionalAuthMiddleware := middleware.OptionalAuthMiddleware(c.JWTService)
+	api.Post("/product-details", optionalAuthMiddleware, productHandler.HandleProductDetails)
+
+	// Further pages of a search's results ("show more"), sorted/filtered views
+	searchResultsHandler := handlers.NewSearchResultsHandler(c)
+	api.Get("/search/:id/results", optionalAuthMiddleware, searchResultsHandler.GetSearchResults)
+	api.Post("/search/:id/refine", optionalAuthMiddleware, searchResultsHandler.RefineSearchResults)
+
+	// Observed prices of a product over time, for charting
+	api.Get("/products/price-history", handlers.NewPriceHistoryHandler(c).GetPriceHistory)
 }
 
 func setupSearchHistoryRoutes(api fiber.Router, c *container.Container) {
@@ -185,6 +209,53 @@ func setupPreferencesRoutes(api fiber.Router, c *container.Container) {
 	userGroup := api.Group("/user", authMiddleware)
 	userGroup.Get("/preferences", preferencesHandler.GetUserPreferences)
 	userGroup.Put("/preferences", preferencesHandler.UpdateUserPreferences)
+
+	// Own token usage and cost per day
+	usageHandler := handlers.NewUsageHandler(c)
+	userGroup.Get("/usage", usageHandler.GetUserUsage)
+}
+
+func setupWatchlistRoutes(api fiber.Router, c *container.Container) {
+	watchlistHandler := handlers.NewWatchlistHandler(c)
+	authMiddleware := middleware.AuthMiddleware(c.JWTService)
+
+	watchlist := api.Group("/watchlist", authMiddleware)
+	watchlist.Get("/", watchlistHandler.GetWatchlist)
+	watchlist.Post("/", watchlistHandler.AddToWatchlist)
+	watchlist.Put("/:id", watchlistHandler.UpdateWatchlistItem)
+	watchlist.Delete("/:id", watchlistHandler.RemoveFromWatchlist)
+}
+
+func setupWishlistRoutes(api fiber.Router, c *container.Container) {
+	wishlistHandler := handlers.NewWishlistHandler(c)
+	authMiddleware := middleware.AuthMiddleware(c.JWTService)
+
+	// Public read-only view of a shared wishlist
+	api.Get("/wishlists/shared/:token", wishlistHandler.GetSharedWishlist)
+
+	wishlists := api.Group("/user/wishlists", authMiddleware)
+	wishlists.Get("/", wishlistHandler.GetWishlists)
+	wishlists.Post("/", wishlistHandler.CreateWishlist)
+	wishlists.Get("/:id", wishlistHandler.GetWishlist)
+	wishlists.Put("/:id", wishlistHandler.RenameWishlist)
+	wishlists.Delete("/:id", wishlistHandler.DeleteWishlist)
+	wishlists.Post("/:id/items", wishlistHandler.AddWishlistItem)
+	wishlists.Delete("/:id/items/:itemId", wishlistHandler.RemoveWishlistItem)
+	wishlists.Post("/:id/share", wishlistHandler.ShareWishlist)
+	wishlists.Delete("/:id/share", wishlistHandler.UnshareWishlist)
+}
+
+func setupShareRoutes(api fiber.Router, c *container.Container) {
+	shareHandler := handlers.NewShareHandler(c)
+	authMiddleware := middleware.AuthMiddleware(c.JWTService)
+
+	shares := api.Group("/shares")
+	shares.Post("/", authMiddleware, shareHandler.CreateShare)
+	shares.Get("/", authMiddleware, shareHandler.GetShares)
+	shares.Delete("/:id", authMiddleware, shareHandler.RevokeShare)
+
+	// Public read-only view of a shared conversation
+	shares.Get("/:token", shareHandler.GetSharedConversation)
 }
 
 func setupStatsRoutes(api fiber.Router, c *container.Container) {
@@ -225,7 +296,7 @@ func setupStatsRoutes(api fiber.Router, c *container.Container) {
 		tokenStats := c.GeminiService.GetTokenStats()
 
 		return ctx.JSON(fiber.Map{
-			"token_usage": tokenStats,
+			"token_usage": tokenStats, // This replica since its start; all replicas at /api/admin/usage
 			"timestamp":   time.Now(),
 		})
 	})
@@ -300,3 +371,14 @@ func setupContactRoutes(api fiber.Router, c *container.Container) {
 	// Public endpoint - anyone can submit contact forms
 	api.Post("/contact", contactRateLimiter, contactHandler.SubmitContactForm)
 }
+
+func setupAdminRoutes(api fiber.Router, c *container.Container) {
+	admin := api.Group("/admin", middleware.AdminMiddleware(c.Config.AdminAPIToken))
+	adminHandler := handlers.NewAdminHandler(c)
+
+	admin.Post("/taxonomy/reload", adminHandler.ReloadTaxonomy)
+
+	// Persistent usage aggregated by day, model or user; per-user usage
+	// stays behind the admin token
+	admin.Get("/usage", handlers.NewUsageHandler(c).GetUsageStats)
+}
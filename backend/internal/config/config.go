@@ -3,6 +3,7 @@ package config
 import (
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 	"time"
@@ -47,6 +48,9 @@ type Config struct {
 	JWTAccessTTL     time.Duration
 	JWTRefreshTTL    time.Duration
 
+	// Admin API
+	AdminAPIToken string // X-Admin-Token of the /api/admin endpoints, which are disabled when empty
+
 	// Google OAuth
 	GoogleClientID     string
 	GoogleClientSecret string
@@ -58,10 +62,68 @@ type Config struct {
 	MaxSearchesPerSession    int // Added from .env
 	AnonymousSearchLimit     int // Maximum searches allowed for anonymous users
 
+	// Quotas
+	QuotaEnabled   bool   // Enforce per-plan search, token and product details budgets
+	QuotaPlansFile string // Optional JSON file overriding the built-in plan budgets
+
 	// API Keys
 	GeminiAPIKeys []string
 	SerpAPIKeys   []string
 
+	// Product Search Providers
+	ProductSearchProviders []string      // "serpapi", "fixture", "feed"; several are queried concurrently and merged
+	ProductSearchTimeout   time.Duration // Per-provider timeout when several providers are queried
+	SearchFixturesFile     string        // JSON product file for the fixture provider
+	MerchantFeedURL        string        // Base URL of the JSON merchant feed provider
+	MerchantFeedAPIKey     string        // Optional bearer token for the merchant feed
+
+	// Search Result Pagination
+	SearchPageSize   int           // Products per page of a search ("show more" returns the next page)
+	SearchResultsTTL time.Duration // How long a search's full result set can be paged
+
+	// Semantic Search Cache
+	SemanticCacheEnabled   bool
+	SemanticCacheThreshold float64 // Minimum query similarity to reuse cached results
+
+	// Currency Conversion
+	FXEnabled         bool          // Convert product prices to the user's currency
+	FXSources         []string      // "file", "ecb"; earlier sources win for currencies several provide
+	FXRatesFile       string        // JSON or CSV rate table of the file source
+	FXECBURL          string        // ECB-style XML reference rates feed
+	FXRefreshInterval time.Duration // How often rates are reloaded from the sources
+
+	// Price History
+	PriceHistoryEnabled bool // Record the prices of search results and product details
+
+	// Price-drop Watchlist
+	WatchlistEnabled         bool          // Re-check watched products and notify users of price drops
+	WatchlistRecheckInterval time.Duration // How often each watched product is re-checked
+	WatchlistDailyBudget     int           // Product details requests the re-checks may make per UTC day, across replicas
+	WatchlistMaxItems        int           // Watched products per user
+
+	// LLM Provider Selection
+	LLMProvider        string // "gemini" or "openai"
+	EmbeddingProvider  string // "gemini", "openai" or "local" (defaults to LLMProvider if it can embed)
+	LLMFunctionCalling bool   // Kill switch for the product tools: off falls back to the search JSON protocol
+
+	// Embedding Batching and Fallback
+	EmbeddingBatchSize       int  // Texts per embedding request
+	EmbeddingWorkers         int  // Concurrent embedding requests
+	EmbeddingFallback        bool // Embed locally when the provider fails
+	LocalEmbeddingDimensions int  // Vector size of the local embedder
+
+	// Token Usage Accounting
+	UsageTracking bool   // Persist tokens and cost of every LLM/embedding call
+	LLMPricesFile string // Optional JSON price table overriding the built-in model prices
+
+	// OpenAI-compatible Provider (OpenAI, llama.cpp server, Ollama, vLLM)
+	OpenAIBaseURL        string
+	OpenAIAPIKey         string
+	OpenAIModel          string
+	OpenAIFallbackModel  string
+	OpenAIEmbeddingModel string
+	OpenAITimeout        time.Duration
+
 	// Gemini Configuration
 	GeminiModel           string
 	GeminiFallbackModel   string // Fallback model for retries
@@ -69,6 +131,10 @@ type Config struct {
 	GeminiMaxOutputTokens int
 	GeminiUseGrounding    bool
 
+	// Gemini Prompt Caching (explicit cached contents for the system prompt)
+	GeminiPromptCache    bool
+	GeminiPromptCacheTTL time.Duration
+
 	// Grounding Strategy Settings
 	GeminiGroundingMode     string // "conservative", "balanced", "aggressive"
 	GeminiGroundingMinWords int
@@ -99,6 +165,16 @@ type Config struct {
 	EmbeddingCategoryDetectionThresh float64
 	CacheQueryEmbeddingTTL           int
 
+	// Category Taxonomy
+	TaxonomyFile string // JSON category tree, the built-in one when empty
+
+	// SerpAPI Client
+	SerpAPIBaseURL          string        // serpapi.com, or a local stand-in server
+	SerpAPISearchTimeout    time.Duration // Deadline of one google_shopping call
+	SerpAPIDetailsTimeout   time.Duration // Deadline of one google_immersive_product call
+	SerpAPIMaxIdleConns     int           // Pooled keep-alive connections
+	SerpAPIMaxResponseBytes int64         // Larger responses are rejected
+
 	// SERP Relevance Thresholds
 	SerpThresholdExact      float64
 	SerpThresholdParameters float64
@@ -106,14 +182,13 @@ type Config struct {
 	SerpLogTopResultsCount  int
 	SerpFallbackMinResults  int
 
-	// SERP Scoring Weights
-	SerpScorePhraseMatch     float64
-	SerpScoreAllWords        float64
-	SerpScorePartialWords    float64
-	SerpScoreWordOrderWeight float64
-	SerpScoreBrandMatch      float64
-	SerpScoreModelMatch      float64
-	SerpMinWordLength        int
+	// SERP Ranking (weights of the re-ranker's scorers, 0 disables a scorer)
+	RankingWeightBM25        float64
+	RankingWeightEmbedding   float64
+	RankingWeightModelNumber float64
+	RankingWeightRating      float64
+	RankingWeightPosition    float64
+	RankingDebug             bool // Send and log per-product score breakdowns
 	SerpModelNumberMinLength int
 
 	// SERP Max Products
@@ -196,14 +271,24 @@ func Load() (*Config, error) {
 		MaxMessagesPerSession: getEnvAsInt("MAX_MESSAGES_PER_SESSION", 8),
 		MaxSearchesPerSession: getEnvAsInt("MAX_SEARCHES_PER_SESSION", 3),
 		AnonymousSearchLimit:  getEnvAsInt("ANONYMOUS_SEARCH_LIMIT", 3),
+		QuotaEnabled:          getEnvAsBool("QUOTA_ENABLED", true),
+		QuotaPlansFile:        getEnv("QUOTA_PLANS_FILE", ""),
 		GeminiAPIKeys:         getEnvAsSlice("GEMINI_API_KEYS", []string{}),
 		SerpAPIKeys:           getEnvAsSlice("SERP_API_KEYS", []string{}),
+		LLMProvider:           getEnv("LLM_PROVIDER", "gemini"),
+		LLMFunctionCalling:    getEnvAsBool("LLM_FUNCTION_CALLING", true),
+		UsageTracking:         getEnvAsBool("USAGE_TRACKING", true),
+		LLMPricesFile:         getEnv("LLM_PRICES_FILE", ""),
 		GeminiModel:           getEnv("GEMINI_MODEL", "gemini-flash-latest"),
 		GeminiFallbackModel:   getEnv("GEMINI_FALLBACK_MODEL", "gemini-flash-lite-latest"),
 		GeminiTemperature:     float32(getEnvAsFloat("GEMINI_TEMPERATURE", 0.7)),
 		GeminiMaxOutputTokens: getEnvAsInt("GEMINI_MAX_OUTPUT_TOKENS", 8192),
 		GeminiUseGrounding:    getEnvAsBool("GEMINI_USE_GROUNDING", true),
 
+		// Gemini Prompt Caching
+		GeminiPromptCache:    getEnvAsBool("GEMINI_PROMPT_CACHE", true),
+		GeminiPromptCacheTTL: time.Duration(getEnvAsInt("GEMINI_PROMPT_CACHE_TTL", 3600)) * time.Second,
+
 		// Grounding Strategy
 		GeminiGroundingMode:     getEnv("GEMINI_GROUNDING_MODE", "balanced"),
 		GeminiGroundingMinWords: getEnvAsInt("GEMINI_GROUNDING_MIN_WORDS", 2),
@@ -229,11 +314,69 @@ func Load() (*Config, error) {
 		GeminiTranslationTemperature: float32(getEnvAsFloat("GEMINI_TRANSLATION_TEMPERATURE", 0.3)),
 		GeminiTranslationMaxTokens:   getEnvAsInt("GEMINI_TRANSLATION_MAX_TOKENS", 100),
 
+		// OpenAI-compatible Provider
+		OpenAIBaseURL:        getEnv("OPENAI_BASE_URL", "http://localhost:11434/v1"),
+		OpenAIAPIKey:         getEnv("OPENAI_API_KEY", ""),
+		OpenAIModel:          getEnv("OPENAI_MODEL", "llama3.1"),
+		OpenAIFallbackModel:  getEnv("OPENAI_FALLBACK_MODEL", ""),
+		OpenAIEmbeddingModel: getEnv("OPENAI_EMBEDDING_MODEL", "nomic-embed-text"),
+		OpenAITimeout:        time.Duration(getEnvAsInt("OPENAI_TIMEOUT", 60)) * time.Second,
+
 		// Embedding Settings
 		GeminiEmbeddingModel:             getEnv("GEMINI_EMBEDDING_MODEL", "text-embedding-004"),
 		EmbeddingCategoryDetectionThresh: getEnvAsFloat("EMBEDDING_CATEGORY_DETECTION_THRESHOLD", 0.6),
 		CacheQueryEmbeddingTTL:           getEnvAsInt("CACHE_QUERY_EMBEDDING_TTL", 86400),
 
+		// Category Taxonomy
+		TaxonomyFile: getEnv("TAXONOMY_FILE", ""),
+
+		// Admin API
+		AdminAPIToken: getEnv("ADMIN_API_TOKEN", ""),
+
+		// Product Search Providers
+		ProductSearchProviders: getEnvAsSlice("PRODUCT_SEARCH_PROVIDERS", []string{"serpapi"}),
+		ProductSearchTimeout:   time.Duration(getEnvAsInt("PRODUCT_SEARCH_TIMEOUT", 20)) * time.Second,
+		SearchFixturesFile:     getEnv("SEARCH_FIXTURES_FILE", "./fixtures/products.json"),
+		MerchantFeedURL:        getEnv("MERCHANT_FEED_URL", ""),
+		MerchantFeedAPIKey:     getEnv("MERCHANT_FEED_API_KEY", ""),
+
+		// Search Result Pagination
+		SearchPageSize:   getEnvAsInt("SEARCH_PAGE_SIZE", 10),
+		SearchResultsTTL: time.Duration(getEnvAsInt("SEARCH_RESULTS_TTL", 86400)) * time.Second,
+
+		// Semantic Search Cache
+		SemanticCacheEnabled:   getEnvAsBool("SEMANTIC_CACHE_ENABLED", true),
+		SemanticCacheThreshold: getEnvAsFloat("SEMANTIC_CACHE_THRESHOLD", 0.92),
+
+		// Embedding Batching and Fallback
+		EmbeddingBatchSize:       getEnvAsInt("EMBEDDING_BATCH_SIZE", 50),
+		EmbeddingWorkers:         getEnvAsInt("EMBEDDING_WORKERS", 4),
+		EmbeddingFallback:        getEnvAsBool("EMBEDDING_FALLBACK", true),
+		LocalEmbeddingDimensions: getEnvAsInt("LOCAL_EMBEDDING_DIMENSIONS", 256),
+
+		// Currency Conversion
+		FXEnabled:         getEnvAsBool("FX_ENABLED", true),
+		FXSources:         getEnvAsSlice("FX_SOURCES", []string{"ecb"}),
+		FXRatesFile:       getEnv("FX_RATES_FILE", ""),
+		FXECBURL:          getEnv("FX_ECB_URL", "https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml"),
+		FXRefreshInterval: time.Duration(getEnvAsInt("FX_REFRESH_INTERVAL", 21600)) * time.Second,
+
+		// Price History
+		PriceHistoryEnabled: getEnvAsBool("PRICE_HISTORY_ENABLED", true),
+
+		// Price-drop Watchlist
+		WatchlistEnabled:         getEnvAsBool("WATCHLIST_ENABLED", true),
+		WatchlistRecheckInterval: time.Duration(getEnvAsInt("WATCHLIST_RECHECK_INTERVAL", 21600)) * time.Second,
+		WatchlistDailyBudget:     getEnvAsInt("WATCHLIST_DAILY_BUDGET", 200),
+		WatchlistMaxItems:        getEnvAsInt("WATCHLIST_MAX_ITEMS", 50),
+
+		// SerpAPI Client
+		SerpAPIBaseURL:          getEnv("SERP_API_BASE_URL", "https://serpapi.com"),
+		SerpAPISearchTimeout:    time.Duration(getEnvAsInt("SERP_API_SEARCH_TIMEOUT", 20)) * time.Second,
+		SerpAPIDetailsTimeout:   time.Duration(getEnvAsInt("SERP_API_DETAILS_TIMEOUT", 15)) * time.Second,
+		SerpAPIMaxIdleConns:     getEnvAsInt("SERP_API_MAX_IDLE_CONNS", 20),
+		SerpAPIMaxResponseBytes: int64(getEnvAsInt("SERP_API_MAX_RESPONSE_BYTES", 5*1024*1024)),
+
 		// SERP Relevance Thresholds
 		SerpThresholdExact:      getEnvAsFloat("SERP_THRESHOLD_EXACT", 0.4),
 		SerpThresholdParameters: getEnvAsFloat("SERP_THRESHOLD_PARAMETERS", 0.2),
@@ -241,14 +384,13 @@ func Load() (*Config, error) {
 		SerpLogTopResultsCount:  getEnvAsInt("SERP_LOG_TOP_RESULTS_COUNT", 5),
 		SerpFallbackMinResults:  getEnvAsInt("SERP_FALLBACK_MIN_RESULTS", 3),
 
-		// SERP Scoring Weights
-		SerpScorePhraseMatch:     getEnvAsFloat("SERP_SCORE_PHRASE_MATCH", 1.0),
-		SerpScoreAllWords:        getEnvAsFloat("SERP_SCORE_ALL_WORDS", 0.6),
-		SerpScorePartialWords:    getEnvAsFloat("SERP_SCORE_PARTIAL_WORDS", 0.5),
-		SerpScoreWordOrderWeight: getEnvAsFloat("SERP_SCORE_WORD_ORDER_WEIGHT", 0.2),
-		SerpScoreBrandMatch:      getEnvAsFloat("SERP_SCORE_BRAND_MATCH", 0.3),
-		SerpScoreModelMatch:      getEnvAsFloat("SERP_SCORE_MODEL_MATCH", 0.3),
-		SerpMinWordLength:        getEnvAsInt("SERP_MIN_WORD_LENGTH", 2),
+		// SERP Ranking
+		RankingWeightBM25:        getEnvAsFloat("RANKING_WEIGHT_BM25", 0.35),
+		RankingWeightEmbedding:   getEnvAsFloat("RANKING_WEIGHT_EMBEDDING", 0.25),
+		RankingWeightModelNumber: getEnvAsFloat("RANKING_WEIGHT_MODEL_NUMBER", 0.2),
+		RankingWeightRating:      getEnvAsFloat("RANKING_WEIGHT_RATING", 0.1),
+		RankingWeightPosition:    getEnvAsFloat("RANKING_WEIGHT_POSITION", 0.1),
+		RankingDebug:             getEnvAsBool("RANKING_DEBUG", false),
 		SerpModelNumberMinLength: getEnvAsInt("SERP_MODEL_NUMBER_MIN_LENGTH", 2),
 
 		// SERP Max Products
@@ -288,6 +430,8 @@ func Load() (*Config, error) {
 		LokiServiceName:   getEnv("LOKI_SERVICE_NAME", "mylittleprice-backend"),
 	}
 
+	config.EmbeddingProvider = getEnv("EMBEDDING_PROVIDER", config.defaultEmbeddingProvider())
+
 	if err := config.validate(); err != nil {
 		return nil, err
 	}
@@ -295,13 +439,103 @@ func Load() (*Config, error) {
 	return config, nil
 }
 
+// defaultEmbeddingProvider is LLMProvider, or the local embedder when
+// LLMProvider has no way to embed (no Gemini key, no OpenAI embedding model)
+func (c *Config) defaultEmbeddingProvider() string {
+	switch {
+	case c.LLMProvider == "gemini" && len(c.GeminiAPIKeys) == 0:
+		return "local"
+	case c.LLMProvider == "openai" && c.OpenAIEmbeddingModel == "":
+		return "local"
+	}
+	return c.LLMProvider
+}
+
 func (c *Config) validate() error {
-	if len(c.GeminiAPIKeys) == 0 {
+	// Validate LLM providers
+	validProviders := []string{"gemini", "openai"}
+	if !slices.Contains(validProviders, c.LLMProvider) {
+		return fmt.Errorf("LLM_PROVIDER must be one of: %v", validProviders)
+	}
+	validEmbeddingProviders := append(slices.Clone(validProviders), "local")
+	if !slices.Contains(validEmbeddingProviders, c.EmbeddingProvider) {
+		return fmt.Errorf("EMBEDDING_PROVIDER must be one of: %v", validEmbeddingProviders)
+	}
+	if c.EmbeddingBatchSize < 1 || c.EmbeddingWorkers < 1 || c.LocalEmbeddingDimensions < 1 {
+		return fmt.Errorf("EMBEDDING_BATCH_SIZE, EMBEDDING_WORKERS and LOCAL_EMBEDDING_DIMENSIONS must be at least 1")
+	}
+
+	// Gemini keys are only needed when Gemini serves chat or embeddings
+	if (c.LLMProvider == "gemini" || c.EmbeddingProvider == "gemini") && len(c.GeminiAPIKeys) == 0 {
 		return fmt.Errorf("at least one GEMINI_API_KEY is required")
 	}
 
-	if len(c.SerpAPIKeys) == 0 {
-		return fmt.Errorf("at least one SERP_API_KEY is required")
+	if c.LLMProvider == "openai" || c.EmbeddingProvider == "openai" {
+		if c.OpenAIBaseURL == "" {
+			return fmt.Errorf("OPENAI_BASE_URL is required when using the openai provider")
+		}
+		if c.LLMProvider == "openai" && c.OpenAIModel == "" {
+			return fmt.Errorf("OPENAI_MODEL is required when LLM_PROVIDER=openai")
+		}
+		if c.EmbeddingProvider == "openai" && c.OpenAIEmbeddingModel == "" {
+			return fmt.Errorf("OPENAI_EMBEDDING_MODEL is required when EMBEDDING_PROVIDER=openai")
+		}
+	}
+
+	for _, provider := range c.ProductSearchProviders {
+		switch provider {
+		case "serpapi":
+			if len(c.SerpAPIKeys) == 0 {
+				return fmt.Errorf("at least one SERP_API_KEY is required")
+			}
+		case "fixture":
+			if c.SearchFixturesFile == "" {
+				return fmt.Errorf("SEARCH_FIXTURES_FILE is required when using the fixture search provider")
+			}
+		case "feed":
+			if c.MerchantFeedURL == "" {
+				return fmt.Errorf("MERCHANT_FEED_URL is required when using the feed search provider")
+			}
+		default:
+			return fmt.Errorf("PRODUCT_SEARCH_PROVIDERS must contain only serpapi, fixture or feed, got %q", provider)
+		}
+	}
+
+	if c.FXEnabled {
+		for _, source := range c.FXSources {
+			switch source {
+			case "file":
+				if c.FXRatesFile == "" {
+					return fmt.Errorf("FX_RATES_FILE is required when using the file rate source")
+				}
+			case "ecb":
+				if c.FXECBURL == "" {
+					return fmt.Errorf("FX_ECB_URL is required when using the ecb rate source")
+				}
+			default:
+				return fmt.Errorf("FX_SOURCES must contain only file or ecb, got %q", source)
+			}
+		}
+		if c.FXRefreshInterval < time.Minute {
+			return fmt.Errorf("FX_REFRESH_INTERVAL must be at least 60 seconds")
+		}
+	}
+
+	if c.WatchlistEnabled {
+		if c.WatchlistRecheckInterval < time.Hour {
+			return fmt.Errorf("WATCHLIST_RECHECK_INTERVAL must be at least 3600 seconds")
+		}
+		if c.WatchlistDailyBudget < 1 || c.WatchlistMaxItems < 1 {
+			return fmt.Errorf("WATCHLIST_DAILY_BUDGET and WATCHLIST_MAX_ITEMS must be positive")
+		}
+	}
+
+	if c.SemanticCacheEnabled && (c.SemanticCacheThreshold <= 0 || c.SemanticCacheThreshold > 1) {
+		return fmt.Errorf("SEMANTIC_CACHE_THRESHOLD must be above 0 and at most 1")
+	}
+
+	if c.SearchPageSize < 1 || c.SearchPageSize > 50 {
+		return fmt.Errorf("SEARCH_PAGE_SIZE must be between 1 and 50, got %d", c.SearchPageSize)
 	}
 
 	// Validate Google OAuth config (required for authentication)
@@ -335,6 +569,19 @@ func (c *Config) validate() error {
 		return fmt.Errorf("MAX_SEARCHES_PER_SESSION must be between 1 and 10")
 	}
 
+	// Validate ranking weights
+	rankingWeights := []float64{c.RankingWeightBM25, c.RankingWeightEmbedding, c.RankingWeightModelNumber, c.RankingWeightRating, c.RankingWeightPosition}
+	var totalRankingWeight float64
+	for _, weight := range rankingWeights {
+		if weight < 0 {
+			return fmt.Errorf("RANKING_WEIGHT_* must not be negative")
+		}
+		totalRankingWeight += weight
+	}
+	if totalRankingWeight == 0 {
+		return fmt.Errorf("at least one RANKING_WEIGHT_* must be above 0")
+	}
+
 	return nil
 }
 
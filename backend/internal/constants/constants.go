@@ -92,12 +92,21 @@ const (
 	ErrCodeSessionExpired     = "SESSION_EXPIRED"
 	ErrCodeMaxSearchesReached = "MAX_SEARCHES_REACHED"
 	ErrCodeMaxMessagesReached = "MAX_MESSAGES_REACHED"
+	ErrCodeQuotaExceeded      = "QUOTA_EXCEEDED"
 	ErrCodeInvalidRequest     = "INVALID_REQUEST"
 	ErrCodeValidationError    = "VALIDATION_ERROR"
 	ErrCodeAIError            = "AI_ERROR"
 	ErrCodeSearchError        = "SEARCH_ERROR"
 	ErrCodeCacheError         = "CACHE_ERROR"
 	ErrCodeInternalError      = "INTERNAL_ERROR"
+
+	// Upstream (LLM / search API) failures, see internal/upstream
+	ErrCodeUpstreamRateLimited    = "UPSTREAM_RATE_LIMITED"
+	ErrCodeUpstreamQuotaExhausted = "UPSTREAM_QUOTA_EXHAUSTED"
+	ErrCodeUpstreamAuthInvalid    = "UPSTREAM_AUTH_INVALID"
+	ErrCodeUpstreamUnavailable    = "UPSTREAM_UNAVAILABLE"
+	ErrCodeUpstreamBadRequest     = "UPSTREAM_BAD_REQUEST"
+	ErrCodeContentBlocked         = "CONTENT_BLOCKED"
 )
 
 // ═══════════════════════════════════════════════════════════
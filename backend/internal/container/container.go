@@ -12,7 +12,6 @@ import (
 	_ "github.com/lib/pq"
 	"github.com/redis/go-redis/v9"
 	"github.com/redis/go-redis/v9/maintnotifications"
-	"google.golang.org/genai"
 
 	"mylittleprice/ent"
 	"mylittleprice/internal/config"
@@ -34,9 +33,18 @@ type Container struct {
 	SerpRotator   *utils.KeyRotator
 	JWTService    *utils.JWTService
 
+	LLMProvider services.LLMProvider
+	Embedder    services.Embedder
+
+	TaxonomyService         *services.TaxonomyService
 	EmbeddingService        *services.EmbeddingService
 	GeminiService           *services.GeminiService
 	SerpService             *services.SerpService
+	ProductSearch           services.ProductSearchProvider
+	SearchResults           *services.SearchResultsService
+	FXService               *services.FXService           // nil when FX_ENABLED is off
+	PriceHistoryService     *services.PriceHistoryService // nil when PRICE_HISTORY_ENABLED is off
+	WatchlistService        *services.WatchlistService    // nil when WATCHLIST_ENABLED is off
 	CacheService            *services.CacheService
 	SessionService          *services.SessionService
 	MessageService          *services.MessageService
@@ -45,7 +53,11 @@ type Container struct {
 	AuthService             *services.AuthService
 	EmailService            *services.EmailService
 	SearchHistoryService    *services.SearchHistoryService
+	UsageService            *services.UsageService // nil when USAGE_TRACKING is off
+	QuotaService            *services.QuotaService // nil when QUOTA_ENABLED is off
 	PreferencesService      *services.PreferencesService
+	WishlistService         *services.WishlistService
+	ShareService            *services.ShareService
 	CleanupService          *services.CleanupService
 	SessionOwnershipChecker *middleware.SessionOwnershipValidator
 }
@@ -213,24 +225,113 @@ func (c *Container) initServices() error {
 	c.SessionService.SetAuthService(c.AuthService)
 	utils.LogInfo(c.ctx, "Session service initialized")
 
-	apiKey, _, _ := c.GeminiRotator.GetNextKey()
-	geminiClient, _ := genai.NewClient(c.ctx, &genai.ClientConfig{
-		APIKey:  apiKey,
-		Backend: genai.BackendGeminiAPI,
-	})
+	// Initialize LLM and embedding providers (selected by LLM_PROVIDER / EMBEDDING_PROVIDER)
+	llmProvider, err := services.NewLLMProvider(c.Config, c.GeminiRotator)
+	if err != nil {
+		return fmt.Errorf("failed to initialize LLM provider: %w", err)
+	}
+	c.LLMProvider = llmProvider
+
+	embedder, err := services.NewEmbedder(c.Config, c.GeminiRotator, llmProvider)
+	if err != nil {
+		return fmt.Errorf("failed to initialize embedder: %w", err)
+	}
+	c.Embedder = embedder
+
+	// Record usage of every call (wrapped after NewEmbedder, which may reuse the LLM provider).
+	// The LLM provider is always metered so token quotas work without usage tracking.
+	if c.Config.UsageTracking {
+		prices, err := services.NewPriceTable(c.Config.LLMPricesFile)
+		if err != nil {
+			return fmt.Errorf("failed to load LLM price table: %w", err)
+		}
+		c.UsageService = services.NewUsageService(c.Ent, prices)
+		if embedder.Name() != services.EmbeddingProviderLocal {
+			c.Embedder = services.NewMeteredEmbedder(embedder, c.Config, c.UsageService)
+		}
+		utils.LogInfo(c.ctx, "Usage tracking initialized")
+	}
+	c.LLMProvider = services.NewMeteredLLMProvider(llmProvider, c.UsageService)
+
+	if c.Config.QuotaEnabled {
+		c.QuotaService, err = services.NewQuotaService(c.Redis, c.Ent, c.Config)
+		if err != nil {
+			return fmt.Errorf("failed to initialize quota service: %w", err)
+		}
+		utils.LogInfo(c.ctx, "Quota service initialized")
+	}
+
+	utils.LogInfo(c.ctx, "LLM providers initialized",
+		slog.String("llm", llmProvider.Name()),
+		slog.String("model", llmProvider.Model()),
+		slog.String("embeddings", embedder.Name()),
+	)
 
-	c.EmbeddingService = services.NewEmbeddingService(geminiClient, c.Redis, c.Config)
+	c.TaxonomyService, err = services.NewTaxonomyService(c.Config)
+	if err != nil {
+		return fmt.Errorf("failed to load category taxonomy: %w", err)
+	}
+	utils.LogInfo(c.ctx, "Category taxonomy loaded",
+		slog.String("version", c.TaxonomyService.Current().Version()),
+		slog.Int("categories", c.TaxonomyService.Current().Len()),
+	)
+
+	c.EmbeddingService = services.NewEmbeddingService(c.Embedder, c.Redis, c.TaxonomyService, c.Config)
 	utils.LogInfo(c.ctx, "Embedding service initialized")
 
-	c.CacheService = services.NewCacheService(c.Redis, c.Config, c.EmbeddingService)
+	// Cache metrics are used while the semantic index is rebuilt, before RegisterMetrics
+	metrics.RegisterSearchCacheMetrics()
+
+	var semanticCache *services.SemanticCache
+	if c.Config.SemanticCacheEnabled {
+		semanticCache = services.NewSemanticCache(c.Redis, c.EmbeddingService, c.Config)
+		if err := semanticCache.Rebuild(c.ctx); err != nil {
+			utils.LogWarn(c.ctx, "failed to rebuild semantic cache index", slog.Any("error", err))
+		}
+		utils.LogInfo(c.ctx, "Semantic search cache initialized")
+	}
+
+	c.CacheService = services.NewCacheService(c.Redis, c.Config, c.EmbeddingService, semanticCache)
 
-	c.GeminiService = services.NewGeminiService(c.GeminiRotator, c.Config, c.EmbeddingService)
+	c.GeminiService = services.NewGeminiService(c.LLMProvider, c.Config, c.EmbeddingService, c.TaxonomyService)
 	utils.LogInfo(c.ctx, "Smart grounding configured",
 		slog.String("mode", c.Config.GeminiGroundingMode),
 		slog.Bool("enabled", c.Config.GeminiUseGrounding),
 	)
 
-	c.SerpService = services.NewSerpService(c.SerpRotator, c.Config)
+	c.SerpService = services.NewSerpService(c.SerpRotator, services.NewRanker(c.Config, c.EmbeddingService), c.Config)
+
+	c.ProductSearch, err = services.NewProductSearchProvider(c.Config, c.SerpService)
+	if err != nil {
+		return fmt.Errorf("failed to initialize product search: %w", err)
+	}
+	utils.LogInfo(c.ctx, "Product search initialized", slog.String("provider", c.ProductSearch.Name()))
+
+	c.SearchResults = services.NewSearchResultsService(c.Redis, c.Config)
+
+	if c.Config.FXEnabled {
+		c.FXService, err = services.NewFXService(c.Ent, c.Config)
+		if err != nil {
+			return fmt.Errorf("failed to initialize currency conversion: %w", err)
+		}
+		// Rates from the last refresh serve until the refresh job has run
+		if err := c.FXService.LoadStored(c.ctx); err != nil {
+			utils.LogWarn(c.ctx, "failed to load stored exchange rates", slog.Any("error", err))
+		}
+		utils.LogInfo(c.ctx, "Currency conversion initialized", slog.Any("sources", c.Config.FXSources))
+	}
+
+	// Record the prices the product search returns (wrapped after FX, which converts histories)
+	if c.Config.PriceHistoryEnabled {
+		c.PriceHistoryService = services.NewPriceHistoryService(c.Ent, c.FXService)
+		c.ProductSearch = services.NewPriceRecordingProvider(c.ProductSearch, c.PriceHistoryService)
+		utils.LogInfo(c.ctx, "Price history initialized")
+	}
+
+	if c.Config.WatchlistEnabled {
+		c.WatchlistService = services.NewWatchlistService(c.Ent, c.Redis, c.ProductSearch, c.FXService, c.EmailService, c.Config)
+		utils.LogInfo(c.ctx, "Watchlist initialized", slog.Int("daily_budget", c.Config.WatchlistDailyBudget))
+	}
 
 	c.SearchHistoryService = services.NewSearchHistoryService(c.Ent)
 	utils.LogInfo(c.ctx, "Search history service initialized")
@@ -238,6 +339,12 @@ func (c *Container) initServices() error {
 	c.PreferencesService = services.NewPreferencesService(c.Ent, c.AuthService)
 	utils.LogInfo(c.ctx, "Preferences service initialized")
 
+	c.WishlistService = services.NewWishlistService(c.Ent, c.Config)
+	utils.LogInfo(c.ctx, "Wishlist service initialized")
+
+	c.ShareService = services.NewShareService(c.Ent, c.SessionService, c.MessageService, c.Config)
+	utils.LogInfo(c.ctx, "Conversation share service initialized")
+
 	c.CleanupService = services.NewCleanupService(c.Ent)
 	utils.LogInfo(c.ctx, "Cleanup service initialized")
 
@@ -255,6 +362,20 @@ func (c *Container) initServices() error {
 func (c *Container) Close() error {
 	utils.LogInfo(c.ctx, "shutting down container")
 
+	// Flush queued usage records before the database is closed
+	if c.UsageService != nil {
+		c.UsageService.Close()
+	}
+	if c.PriceHistoryService != nil {
+		c.PriceHistoryService.Close()
+	}
+	if c.WatchlistService != nil {
+		c.WatchlistService.Close()
+	}
+	if c.CacheService != nil {
+		c.CacheService.Close()
+	}
+
 	// Close Ent client
 	if c.Ent != nil {
 		if err := c.Ent.Close(); err != nil {
@@ -277,10 +398,11 @@ func (c *Container) Close() error {
 	return nil
 }
 
-// RegisterMetrics registers all WebSocket and Session metrics
+// RegisterMetrics registers all WebSocket, Session and Search cache metrics
 func (c *Container) RegisterMetrics() {
 	metrics.RegisterWebSocketMetrics()
 	metrics.RegisterSessionMetrics()
+	metrics.RegisterSearchCacheMetrics()
 }
 
 func (c *Container) HealthCheck() map[string]interface{} {
@@ -298,8 +420,13 @@ func (c *Container) HealthCheck() map[string]interface{} {
 			"mode":    c.Config.GeminiGroundingMode,
 			"enabled": c.Config.GeminiUseGrounding,
 		},
+		"llm": map[string]interface{}{
+			"provider": c.LLMProvider.Name(),
+			"model":    c.LLMProvider.Model(),
+		},
 		"embedding": map[string]interface{}{
-			"status": "ok",
+			"provider": c.Embedder.Name(),
+			"status":   "ok",
 		},
 	}
 
@@ -0,0 +1,222 @@
+package domain
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// ═══════════════════════════════════════════════════════════
+// MONEY
+// ═══════════════════════════════════════════════════════════
+
+// ErrInvalidMoney is returned by ParseMoney when the text has no amount
+var ErrInvalidMoney = errors.New("invalid money amount")
+
+// Money is an amount in minor units (cents, Rappen...) of an ISO 4217
+// currency. Currency is empty when it couldn't be determined.
+type Money struct {
+	Amount   int64    `json:"amount"`
+	Currency Currency `json:"currency,omitempty"`
+}
+
+// currencyExponents lists currencies without 2 decimal places
+var currencyExponents = map[Currency]int{
+	"JPY": 0, "KRW": 0, "VND": 0, "CLP": 0, "ISK": 0, "PYG": 0, "UGX": 0,
+	"BHD": 3, "JOD": 3, "KWD": 3, "OMR": 3, "TND": 3,
+}
+
+// MinorUnits returns the number of decimal places of the currency
+func (c Currency) MinorUnits() int {
+	if exponent, ok := currencyExponents[c]; ok {
+		return exponent
+	}
+	return 2
+}
+
+// NewMoney converts an amount in major units (e.g. 1299.95) to Money
+func NewMoney(major float64, currency Currency) Money {
+	scale := math.Pow10(currency.MinorUnits())
+	return Money{Amount: int64(math.Round(major * scale)), Currency: currency}
+}
+
+// Major returns the amount in major units
+func (m Money) Major() float64 {
+	return float64(m.Amount) / math.Pow10(m.Currency.MinorUnits())
+}
+
+// IsZero reports whether the amount is zero (or unknown)
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// String formats the amount with its currency code, e.g. "1299.00 CHF"
+func (m Money) String() string {
+	amount := strconv.FormatFloat(m.Major(), 'f', m.Currency.MinorUnits(), 64)
+	if m.Currency == "" {
+		return amount
+	}
+	return amount + " " + string(m.Currency)
+}
+
+// UnmarshalJSON also accepts a bare number, the major-unit float64 prices
+// stored before Money existed
+func (m *Money) UnmarshalJSON(data []byte) error {
+	var major float64
+	if err := json.Unmarshal(data, &major); err == nil {
+		*m = NewMoney(major, "")
+		return nil
+	}
+
+	type plain Money
+	return json.Unmarshal(data, (*plain)(m))
+}
+
+// currencySymbols maps symbols and abbreviations to currencies, longest first
+// so that "US$" wins over "$"
+var currencySymbols = []struct {
+	symbol   string
+	currency Currency
+}{
+	{"US$", CurrencyUSD}, {"CA$", "CAD"}, {"AU$", "AUD"}, {"NZ$", "NZD"},
+	{"C$", "CAD"}, {"A$", "AUD"},
+	{"Fr.", CurrencyCHF}, {"fr.", CurrencyCHF}, {"Rs.", "INR"}, {"zł", "PLN"},
+	{"€", CurrencyEUR}, {"£", CurrencyGBP}, {"₹", "INR"}, {"¥", "JPY"}, {"₩", "KRW"},
+	{"$", CurrencyUSD},
+}
+
+// dollarCurrencies use "$" on their own; a bare "$" means them in their country
+var dollarCurrencies = map[Currency]bool{
+	CurrencyUSD: true, "CAD": true, "AUD": true, "NZD": true, "SGD": true, "HKD": true, "MXN": true,
+}
+
+var currencyCodePattern = regexp.MustCompile(`\b[A-Z]{3}\b`)
+
+// knownCurrencyCodes are the ISO codes recognised in price text
+var knownCurrencyCodes = map[string]bool{
+	"CHF": true, "EUR": true, "USD": true, "GBP": true, "INR": true, "JPY": true,
+	"CAD": true, "AUD": true, "NZD": true, "SEK": true, "NOK": true, "DKK": true,
+	"PLN": true, "CZK": true, "HUF": true, "RON": true, "BGN": true, "UAH": true,
+	"TRY": true, "CNY": true, "HKD": true, "SGD": true, "KRW": true, "MXN": true,
+	"BRL": true, "ZAR": true, "AED": true, "ILS": true,
+}
+
+// ParseMoney reads a display price such as "1.299,00 €", "CHF 1'299.–",
+// "₹1,29,999.00" or "$24.99". The currency comes from an ISO code or symbol
+// before or after the amount, else fallback. Grouping is recognised by
+// position: with both '.' and ',' the last one is the decimal separator;
+// a single separator followed by exactly 3 digits, or repeated, groups
+// thousands; apostrophes and spaces always group.
+func ParseMoney(text string, fallback Currency) (Money, error) {
+	currency := detectCurrency(text, fallback)
+
+	number, ok := extractAmount(text)
+	if !ok {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidMoney, text)
+	}
+
+	integer, fraction := splitDecimal(number)
+	major, err := strconv.ParseFloat(integer+"."+fraction+"0", 64)
+	if err != nil {
+		return Money{}, fmt.Errorf("%w: %q", ErrInvalidMoney, text)
+	}
+	return NewMoney(major, currency), nil
+}
+
+// ParsePrice returns the Money of a provider's display price. A numeric
+// price the provider extracted itself wins over the parsed amount; the
+// currency comes from the display price, else fallback. nil means unknown.
+func ParsePrice(display string, extracted float64, fallback Currency) *Money {
+	money, err := ParseMoney(display, fallback)
+	if extracted > 0 {
+		currency := fallback
+		if err == nil {
+			currency = money.Currency
+		}
+		money = NewMoney(extracted, currency)
+		return &money
+	}
+	if err != nil {
+		return nil
+	}
+	return &money
+}
+
+func detectCurrency(text string, fallback Currency) Currency {
+	for _, code := range currencyCodePattern.FindAllString(text, -1) {
+		if knownCurrencyCodes[code] {
+			return Currency(code)
+		}
+	}
+	for _, s := range currencySymbols {
+		if !strings.Contains(text, s.symbol) {
+			continue
+		}
+		if s.symbol == "$" && dollarCurrencies[fallback] {
+			return fallback
+		}
+		return s.currency
+	}
+	return fallback
+}
+
+// isGroupSpace reports spaces used as thousands separators
+func isGroupSpace(r rune) bool {
+	return r == ' ' || r == '\u00a0' || r == '\u202f' || r == '\u2009'
+}
+
+// extractAmount returns the first run of digits and separators with the
+// spaces and apostrophes removed, e.g. "1'299." -> "1299"
+func extractAmount(text string) (string, bool) {
+	start := strings.IndexFunc(text, unicode.IsDigit)
+	if start < 0 {
+		return "", false
+	}
+
+	var number strings.Builder
+	for _, r := range text[start:] {
+		switch {
+		case unicode.IsDigit(r):
+			number.WriteRune(r)
+		case r == '.' || r == ',':
+			number.WriteRune(r)
+		case r == '\'' || r == '’' || isGroupSpace(r):
+			// Grouping only
+		default:
+			return strings.TrimRight(number.String(), ".,"), true
+		}
+	}
+	return strings.TrimRight(number.String(), ".,"), true
+}
+
+// splitDecimal splits a number with '.'/',' separators into its integer
+// digits and fraction digits
+func splitDecimal(number string) (string, string) {
+	last := strings.LastIndexAny(number, ".,")
+	if last < 0 {
+		return number, ""
+	}
+
+	separator := number[last]
+	other := byte(',')
+	if separator == ',' {
+		other = '.'
+	}
+	digitsAfter := len(number) - last - 1
+
+	isDecimal := strings.IndexByte(number, other) >= 0 ||
+		(strings.Count(number, string(separator)) == 1 && digitsAfter != 3)
+	if !isDecimal {
+		return stripSeparators(number), ""
+	}
+	return stripSeparators(number[:last]), number[last+1:]
+}
+
+func stripSeparators(number string) string {
+	return strings.NewReplacer(".", "", ",", "").Replace(number)
+}
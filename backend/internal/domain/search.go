@@ -76,32 +76,11 @@ func NewSearchContext(query string, searchType SearchType, locale Locale) Search
 // PRODUCT CATEGORY
 // ═══════════════════════════════════════════════════════════
 
-// Category represents product category
+// Category is the ID of a category of the product taxonomy. Categories are
+// loaded from TAXONOMY_FILE (see services.Taxonomy), so they are not
+// enumerated here.
 type Category string
 
-const (
-	CategoryElectronics Category = "electronics"
-	CategoryClothing    Category = "clothing"
-	CategoryFurniture   Category = "furniture"
-	CategoryKitchen     Category = "kitchen"
-	CategorySports      Category = "sports"
-	CategoryTools       Category = "tools"
-	CategoryDecor       Category = "decor"
-	CategoryTextiles    Category = "textiles"
-)
-
-// IsValid checks if category is valid
-func (c Category) IsValid() bool {
-	switch c {
-	case CategoryElectronics, CategoryClothing, CategoryFurniture,
-		CategoryKitchen, CategorySports, CategoryTools,
-		CategoryDecor, CategoryTextiles:
-		return true
-	default:
-		return false
-	}
-}
-
 // String returns string representation
 func (c Category) String() string {
 	return string(c)
@@ -1,5 +1,11 @@
 package domain
 
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
 // ═══════════════════════════════════════════════════════════
 // SERP API TYPES (Google Shopping)
 // ═══════════════════════════════════════════════════════════
@@ -29,17 +35,54 @@ type ShoppingItem struct {
 	Rating      float32 `json:"rating,omitempty"`
 	Reviews     int     `json:"reviews,omitempty"`
 	Delivery    string  `json:"delivery,omitempty"`
+	// Numeric price and second-hand condition ("used", "refurbished"...)
+	ExtractedPrice float64 `json:"extracted_price,omitempty"`
+	Condition      string  `json:"condition,omitempty"`
 	// Google Immersive Product token for detailed view
 	PageToken string `json:"page_token,omitempty"`
 	// Alternative fields from different SERP responses
 	SerpAPILink string `json:"serpapi_link,omitempty"`
 }
 
-// GoogleImmersiveProductResponse represents detailed product info
+// GoogleImmersiveProductResponse is a google_immersive_product response,
+// the format every product search provider returns product details in
 type GoogleImmersiveProductResponse struct {
 	SearchMetadata   SearchMetadata      `json:"search_metadata"`
 	SearchParameters ImmersiveParameters `json:"search_parameters"`
-	ProductResults   ProductResults      `json:"product_results"`
+	ProductResults   *ProductResults     `json:"product_results"`
+}
+
+// DecodeImmersiveProduct decodes and validates product details fetched as
+// untyped JSON (or cached that way). Product results are decoded leniently,
+// see ProductResults.UnmarshalJSON.
+func DecodeImmersiveProduct(data map[string]interface{}) (*GoogleImmersiveProductResponse, error) {
+	raw, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("invalid product data: %w", err)
+	}
+	var response GoogleImmersiveProductResponse
+	if err := json.Unmarshal(raw, &response); err != nil {
+		return nil, fmt.Errorf("invalid product data: %w", err)
+	}
+	if err := response.Validate(); err != nil {
+		return nil, err
+	}
+	return &response, nil
+}
+
+// Validate checks that the response has a product. Offers that can't be
+// shown are dropped rather than failing the whole product.
+func (r *GoogleImmersiveProductResponse) Validate() error {
+	if r.ProductResults == nil {
+		return fmt.Errorf("invalid product data: no product_results")
+	}
+	if strings.TrimSpace(r.ProductResults.Title) == "" {
+		return fmt.Errorf("invalid product data: product has no title")
+	}
+
+	r.ProductResults.Stores = validOffers(r.ProductResults.Stores)
+	r.ProductResults.Sellers = validOffers(r.ProductResults.Sellers)
+	return nil
 }
 
 type ImmersiveParameters struct {
@@ -55,17 +98,59 @@ type ProductResults struct {
 	Rating          float32           `json:"rating,omitempty"`
 	Reviews         int               `json:"reviews,omitempty"`
 	Thumbnails      []string          `json:"thumbnails"`
-	Offers          []Offer           `json:"offers"`
+	Stores          []Offer           `json:"stores,omitempty"`  // With more_stores=true
+	Sellers         []Offer           `json:"sellers,omitempty"` // Older responses
 	Variants        []Variant         `json:"variants,omitempty"`
 	MoreOptions     []MoreOption      `json:"more_options,omitempty"`
 	Videos          []Video           `json:"videos,omitempty"`
 	AboutTheProduct AboutProduct      `json:"about_the_product,omitempty"`
 	Specifications  []Spec            `json:"specifications,omitempty"`
-	Ratings         []RatingBreakdown `json:"ratings,omitempty"`
+	RatingBreakdown []RatingBreakdown `json:"rating_breakdown,omitempty"`
+	Ratings         []RatingBreakdown `json:"ratings,omitempty"` // Older name of RatingBreakdown
 	ReviewsImages   []string          `json:"reviews_images,omitempty"`
 	StoresNextToken string            `json:"stores_next_page_token,omitempty"`
 }
 
+// UnmarshalJSON decodes the product field by field, so that an optional
+// field of an unexpected type is left empty and malformed list entries are
+// dropped, instead of failing the whole product
+func (p *ProductResults) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	*p = ProductResults{
+		Title:           decodeField[string](fields["title"]),
+		Description:     decodeField[string](fields["description"]),
+		Price:           decodeField[string](fields["price"]),
+		ExtractedPrice:  decodeField[float64](fields["extracted_price"]),
+		Rating:          decodeField[float32](fields["rating"]),
+		Reviews:         decodeField[int](fields["reviews"]),
+		Thumbnails:      decodeEach[string](fields["thumbnails"]),
+		Stores:          decodeEach[Offer](fields["stores"]),
+		Sellers:         decodeEach[Offer](fields["sellers"]),
+		Variants:        decodeEach[Variant](fields["variants"]),
+		MoreOptions:     decodeEach[MoreOption](fields["more_options"]),
+		Videos:          decodeEach[Video](fields["videos"]),
+		AboutTheProduct: decodeField[AboutProduct](fields["about_the_product"]),
+		Specifications:  decodeEach[Spec](fields["specifications"]),
+		RatingBreakdown: decodeEach[RatingBreakdown](fields["rating_breakdown"]),
+		Ratings:         decodeEach[RatingBreakdown](fields["ratings"]),
+		ReviewsImages:   decodeEach[string](fields["reviews_images"]),
+		StoresNextToken: decodeField[string](fields["stores_next_page_token"]),
+	}
+	return nil
+}
+
+// Offers returns the stores selling the product, or the sellers of older responses
+func (p *ProductResults) Offers() []Offer {
+	if len(p.Stores) > 0 {
+		return p.Stores
+	}
+	return p.Sellers
+}
+
 type AboutProduct struct {
 	Description string   `json:"description"`
 	Highlights  []string `json:"highlights,omitempty"`
@@ -81,16 +166,82 @@ type RatingBreakdown struct {
 	Amount int `json:"amount"`
 }
 
+// Offer is a store selling the product
 type Offer struct {
-	Merchant       string  `json:"merchant"`
-	MerchantLink   string  `json:"merchant_link"`
-	Price          string  `json:"price"`
-	ExtractedPrice float64 `json:"extracted_price,omitempty"`
-	Availability   string  `json:"availability,omitempty"`
-	Shipping       string  `json:"shipping,omitempty"`
-	Rating         float32 `json:"rating,omitempty"`
-	Reviews        int     `json:"reviews,omitempty"`
-	Link           string  `json:"link"`
+	Name                   string   `json:"name"`
+	Logo                   string   `json:"logo,omitempty"`
+	Link                   string   `json:"link"`
+	Title                  string   `json:"title,omitempty"`
+	Price                  string   `json:"price"`
+	ExtractedPrice         float64  `json:"extracted_price,omitempty"`
+	Currency               string   `json:"currency,omitempty"`
+	Availability           string   `json:"availability,omitempty"`
+	Shipping               string   `json:"shipping,omitempty"`
+	ShippingExtracted      float64  `json:"shipping_extracted,omitempty"`
+	Total                  string   `json:"total,omitempty"`
+	ExtractedTotal         float64  `json:"extracted_total,omitempty"`
+	Rating                 float32  `json:"rating,omitempty"`
+	Reviews                int      `json:"reviews,omitempty"`
+	PaymentMethods         string   `json:"payment_methods,omitempty"`
+	Tag                    string   `json:"tag,omitempty"`
+	DetailsAndOffers       []string `json:"details_and_offers,omitempty"`
+	MonthlyPaymentDuration int      `json:"monthly_payment_duration,omitempty"`
+	DownPayment            string   `json:"down_payment,omitempty"`
+}
+
+// Validate checks that the offer names a store and has no negative amounts
+func (o Offer) Validate() error {
+	switch {
+	case strings.TrimSpace(o.Name) == "":
+		return fmt.Errorf("offer has no store name")
+	case o.ExtractedPrice < 0 || o.ShippingExtracted < 0 || o.ExtractedTotal < 0:
+		return fmt.Errorf("offer of %s has a negative amount", o.Name)
+	case o.Rating < 0 || o.Rating > 5:
+		return fmt.Errorf("offer of %s has a rating of %.1f", o.Name, o.Rating)
+	}
+	return nil
+}
+
+func validOffers(offers []Offer) []Offer {
+	valid := offers[:0]
+	for _, offer := range offers {
+		if offer.Validate() == nil {
+			valid = append(valid, offer)
+		}
+	}
+	return valid
+}
+
+// decodeField decodes an optional field, or returns the zero value if it's
+// missing or of another type
+func decodeField[T any](raw json.RawMessage) T {
+	var value T
+	if len(raw) == 0 {
+		return value
+	}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		var zero T
+		return zero
+	}
+	return value
+}
+
+// decodeEach decodes an optional list, dropping the entries that don't
+// decode as T. Anything but a list decodes as an empty one.
+func decodeEach[T any](raw json.RawMessage) []T {
+	var entries []json.RawMessage
+	if len(raw) == 0 || json.Unmarshal(raw, &entries) != nil {
+		return nil
+	}
+
+	values := make([]T, 0, len(entries))
+	for _, entry := range entries {
+		var value T
+		if err := json.Unmarshal(entry, &value); err == nil {
+			values = append(values, value)
+		}
+	}
+	return values
 }
 
 type Variant struct {
@@ -98,20 +249,37 @@ type Variant struct {
 	Items []VariantItem `json:"items"`
 }
 
+// UnmarshalJSON keeps the variant if its items aren't a list of VariantItem
+func (v *Variant) UnmarshalJSON(data []byte) error {
+	var fields map[string]json.RawMessage
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return err
+	}
+
+	*v = Variant{
+		Title: decodeField[string](fields["title"]),
+		Items: decodeEach[VariantItem](fields["items"]),
+	}
+	return nil
+}
+
 type VariantItem struct {
 	Name        string `json:"name"`
 	Selected    bool   `json:"selected,omitempty"`
 	Available   bool   `json:"available"`
-	SerpAPILink string `json:"serpapi_link"`
+	SerpAPILink string `json:"serpapi_link,omitempty"`
+	PageToken   string `json:"page_token,omitempty"`
 }
 
 type MoreOption struct {
-	Title       string  `json:"title"`
-	Thumbnail   string  `json:"thumbnail"`
-	Price       string  `json:"price"`
-	Rating      float32 `json:"rating,omitempty"`
-	Reviews     int     `json:"reviews,omitempty"`
-	SerpAPILink string  `json:"serpapi_link"`
+	Title          string  `json:"title"`
+	Thumbnail      string  `json:"thumbnail"`
+	Price          string  `json:"price"`
+	ExtractedPrice float64 `json:"extracted_price,omitempty"`
+	Rating         float32 `json:"rating,omitempty"`
+	Reviews        int     `json:"reviews,omitempty"`
+	SerpAPILink    string  `json:"serpapi_link,omitempty"`
+	PageToken      string  `json:"page_token,omitempty"`
 }
 
 type Video struct {
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"mylittleprice/internal/constants"
+	"mylittleprice/internal/upstream"
 )
 
 // AppError represents a structured application error
@@ -65,6 +66,12 @@ var (
 		Message:    "Maximum messages per session reached",
 		HTTPStatus: 429,
 	}
+
+	ErrQuotaExceeded = &AppError{
+		Code:       constants.ErrCodeQuotaExceeded,
+		Message:    "Plan quota exceeded",
+		HTTPStatus: 429,
+	}
 )
 
 // Validation Errors
@@ -166,6 +173,71 @@ var (
 	}
 )
 
+// Upstream Errors
+var (
+	ErrUpstreamRateLimited = &AppError{
+		Code:       constants.ErrCodeUpstreamRateLimited,
+		Message:    "The service is busy right now. Please try again in a moment.",
+		HTTPStatus: 429,
+	}
+
+	ErrUpstreamQuotaExhausted = &AppError{
+		Code:       constants.ErrCodeUpstreamQuotaExhausted,
+		Message:    "The service has reached its usage limit. Please try again later.",
+		HTTPStatus: 503,
+	}
+
+	ErrUpstreamAuthInvalid = &AppError{
+		Code:       constants.ErrCodeUpstreamAuthInvalid,
+		Message:    "The service is misconfigured. Please try again later.",
+		HTTPStatus: 502,
+	}
+
+	ErrUpstreamUnavailable = &AppError{
+		Code:       constants.ErrCodeUpstreamUnavailable,
+		Message:    "The service is temporarily unavailable. Please try again.",
+		HTTPStatus: 503,
+	}
+
+	ErrUpstreamBadRequest = &AppError{
+		Code:       constants.ErrCodeUpstreamBadRequest,
+		Message:    "The request could not be processed. Please rephrase it.",
+		HTTPStatus: 502,
+	}
+
+	ErrContentBlocked = &AppError{
+		Code:       constants.ErrCodeContentBlocked,
+		Message:    "This request was blocked by content safety filters. Please rephrase it.",
+		HTTPStatus: 422,
+	}
+)
+
+// FromUpstream maps a classified upstream error to its AppError (with err as
+// the cause). It returns nil if err was not classified.
+func FromUpstream(err error) *AppError {
+	upstreamErr, ok := upstream.As(err)
+	if !ok {
+		return nil
+	}
+
+	switch upstreamErr.Kind {
+	case upstream.KindRateLimited:
+		return ErrUpstreamRateLimited.WithCause(err)
+	case upstream.KindQuotaExhausted:
+		return ErrUpstreamQuotaExhausted.WithCause(err)
+	case upstream.KindAuthInvalid:
+		return ErrUpstreamAuthInvalid.WithCause(err)
+	case upstream.KindTransient:
+		return ErrUpstreamUnavailable.WithCause(err)
+	case upstream.KindBadRequest:
+		return ErrUpstreamBadRequest.WithCause(err)
+	case upstream.KindSafetyBlocked:
+		return ErrContentBlocked.WithCause(err)
+	default:
+		return nil
+	}
+}
+
 // ═══════════════════════════════════════════════════════════
 // ERROR RESPONSE HELPERS
 // ═══════════════════════════════════════════════════════════
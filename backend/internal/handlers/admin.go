@@ -0,0 +1,39 @@
+package handlers
+
+import (
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+
+	"mylittleprice/internal/container"
+	"mylittleprice/internal/models"
+)
+
+type AdminHandler struct {
+	container *container.Container
+}
+
+func NewAdminHandler(container *container.Container) *AdminHandler {
+	return &AdminHandler{
+		container: container,
+	}
+}
+
+// ReloadTaxonomy reloads the category taxonomy from TAXONOMY_FILE on this
+// replica. Category embeddings are regenerated on the next detection.
+// POST /api/admin/taxonomy/reload
+func (h *AdminHandler) ReloadTaxonomy(c *fiber.Ctx) error {
+	taxonomy, err := h.container.TaxonomyService.Reload()
+	if err != nil {
+		log.Printf("Error reloading taxonomy: %v", err)
+		return c.Status(fiber.StatusUnprocessableEntity).JSON(models.ErrorResponse{
+			Error:   "TAXONOMY_RELOAD_ERROR",
+			Message: err.Error(),
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"version":    taxonomy.Version(),
+		"categories": taxonomy.Len(),
+	})
+}
@@ -1,6 +1,9 @@
 package handlers
 
 import (
+	"bufio"
+	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -9,6 +12,7 @@ import (
 
 	"mylittleprice/internal/container"
 	"mylittleprice/internal/models"
+	"mylittleprice/internal/services"
 )
 
 type ChatHandler struct {
@@ -48,14 +52,18 @@ func (h *ChatHandler) HandleChat(c *fiber.Ctx) error {
 		Currency:        req.Currency,
 		NewSearch:       req.NewSearch,
 		CurrentCategory: "",
+		BrowserID:       req.BrowserID,
 	}
 
-	result := h.processor.ProcessChat(processorReq)
+	result := h.processor.ProcessChat(c.UserContext(), processorReq)
+	setQuotaHeaders(c, result.Quota)
 
 	// Handle errors
 	if result.Error != nil {
 		statusCode := fiber.StatusInternalServerError
-		if result.Error.Code == "validation_error" {
+		if result.Error.HTTPStatus != 0 {
+			statusCode = result.Error.HTTPStatus
+		} else if result.Error.Code == "validation_error" {
 			statusCode = fiber.StatusBadRequest
 		}
 		return c.Status(statusCode).JSON(models.ErrorResponse{
@@ -64,8 +72,86 @@ func (h *ChatHandler) HandleChat(c *fiber.Ctx) error {
 		})
 	}
 
-	// Build response
-	response := models.ChatResponse{
+	return c.JSON(buildChatResponse(result))
+}
+
+// HandleChatStream processes a chat message like HandleChat but responds with
+// Server-Sent Events: "status" (processing stage), "chunk" (output text as it
+// is generated), then a final "complete" (ChatResponse) or "error" event.
+// POST /api/chat/stream
+func (h *ChatHandler) HandleChatStream(c *fiber.Ctx) error {
+	var req models.ChatRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "Failed to parse request body",
+		})
+	}
+
+	// Locals are not available inside the stream writer, so read them now
+	var userID *uuid.UUID
+	if uid, ok := c.Locals("user_id").(uuid.UUID); ok {
+		userID = &uid
+	}
+
+	// Headers go out before the turn runs, so report the quota as it stands now
+	if quotas := h.container.QuotaService; quotas != nil {
+		subject := quotas.Subject(c.Context(), userID, req.BrowserID, req.SessionID)
+		if status, err := quotas.Status(c.Context(), subject); err == nil {
+			setQuotaHeaders(c, status)
+		}
+	}
+
+	c.Set("Content-Type", "text/event-stream")
+	c.Set("Cache-Control", "no-cache")
+	c.Set("Connection", "keep-alive")
+	c.Set("X-Accel-Buffering", "no") // Disable proxy buffering (nginx)
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		// A failed write means the client went away - abort the turn
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+
+		processorReq := &ChatRequest{
+			SessionID:       req.SessionID,
+			UserID:          userID,
+			Message:         req.Message,
+			Country:         req.Country,
+			Language:        req.Language,
+			Currency:        req.Currency,
+			NewSearch:       req.NewSearch,
+			CurrentCategory: "",
+			BrowserID:       req.BrowserID,
+			OnProgress: func(event services.ProgressEvent) {
+				if err := writeSSEEvent(w, "status", event); err != nil {
+					cancel()
+				}
+			},
+			OnOutputChunk: func(delta string) {
+				if err := writeSSEEvent(w, "chunk", fiber.Map{"output": delta}); err != nil {
+					cancel()
+				}
+			},
+		}
+
+		result := h.processor.ProcessChat(ctx, processorReq)
+
+		if result.Error != nil {
+			writeSSEEvent(w, "error", models.ErrorResponse{
+				Error:   result.Error.Code,
+				Message: result.Error.Message,
+			})
+			return
+		}
+
+		writeSSEEvent(w, "complete", buildChatResponse(result))
+	})
+
+	return nil
+}
+
+func buildChatResponse(result *ChatProcessorResponse) models.ChatResponse {
+	return models.ChatResponse{
 		Type:         result.Type,
 		Output:       result.Output,
 		QuickReplies: result.QuickReplies,
@@ -74,9 +160,27 @@ func (h *ChatHandler) HandleChat(c *fiber.Ctx) error {
 		SessionID:    result.SessionID,
 		MessageCount: result.MessageCount,
 		SearchState:  result.SearchState,
+		SearchID:     result.SearchID,
+		NextCursor:   result.NextCursor,
+		TotalResults: result.TotalResults,
+	}
+}
+
+// writeSSEEvent writes one Server-Sent Event and flushes it to the client.
+// A returned error means the client is gone.
+func writeSSEEvent(w *bufio.Writer, event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		fmt.Printf("⚠️ Failed to marshal SSE %s event: %v\n", event, err)
+		return nil
 	}
 
-	return c.JSON(response)
+	fmt.Fprintf(w, "event: %s\ndata: %s\n\n", event, payload)
+	if err := w.Flush(); err != nil {
+		fmt.Printf("⚠️ Failed to flush SSE %s event: %v\n", event, err)
+		return err
+	}
+	return nil
 }
 
 func (h *ChatHandler) GetSessionMessages(c *fiber.Ctx) error {
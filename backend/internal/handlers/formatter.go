@@ -2,38 +2,6 @@ package handlers
 
 import "strings"
 
-func getStringValue(data map[string]interface{}, key string) string {
-	if val, ok := data[key].(string); ok {
-		return val
-	}
-	return ""
-}
-
-func getFloatValue(data map[string]interface{}, key string) float64 {
-	switch v := data[key].(type) {
-	case float64:
-		return v
-	case float32:
-		return float64(v)
-	case int:
-		return float64(v)
-	case int64:
-		return float64(v)
-	default:
-		return 0
-	}
-}
-
-func getIntValue(data map[string]interface{}, key string) int {
-	if val, ok := data[key].(float64); ok {
-		return int(val)
-	}
-	if val, ok := data[key].(int); ok {
-		return val
-	}
-	return 0
-}
-
 func getBoolValue(data map[string]interface{}, key string) bool {
 	if val, ok := data[key].(bool); ok {
 		return val
@@ -0,0 +1,74 @@
+package handlers
+
+import (
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+
+	"mylittleprice/internal/container"
+	"mylittleprice/internal/models"
+)
+
+const (
+	defaultPriceHistoryDays = 90
+	maxPriceHistoryDays     = 365
+)
+
+type PriceHistoryHandler struct {
+	container *container.Container
+}
+
+func NewPriceHistoryHandler(container *container.Container) *PriceHistoryHandler {
+	return &PriceHistoryHandler{
+		container: container,
+	}
+}
+
+// GetPriceHistory returns the price of a product over the last days, for
+// charting. product_key is a product card's product_key.
+// GET /api/products/price-history?product_key=xxx&currency=EUR&days=90
+func (h *PriceHistoryHandler) GetPriceHistory(c *fiber.Ctx) error {
+	if h.container.PriceHistoryService == nil {
+		return c.Status(fiber.StatusServiceUnavailable).JSON(models.ErrorResponse{
+			Error:   "PRICE_HISTORY_DISABLED",
+			Message: "Price history is disabled",
+		})
+	}
+
+	productKey := c.Query("product_key")
+	if productKey == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "product_key is required",
+		})
+	}
+
+	days := c.QueryInt("days", defaultPriceHistoryDays)
+	if days < 1 || days > maxPriceHistoryDays {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "days must be between 1 and 365",
+		})
+	}
+	since := time.Now().AddDate(0, 0, -days)
+
+	currency := strings.ToUpper(c.Query("currency"))
+	history, err := h.container.PriceHistoryService.GetHistory(c.Context(), productKey, currency, since)
+	if err != nil {
+		log.Printf("Error getting price history: %v", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Error:   "PRICE_HISTORY_FETCH_ERROR",
+			Message: "Failed to retrieve price history",
+		})
+	}
+	if history == nil {
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Error:   "not_found",
+			Message: "No prices observed for this product",
+		})
+	}
+
+	return c.JSON(history)
+}
@@ -2,16 +2,20 @@ package handlers
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
-	"strconv"
 	"strings"
 	"time"
 
 	"github.com/google/uuid"
 
 	"mylittleprice/internal/container"
+	"mylittleprice/internal/domain"
+	apperrors "mylittleprice/internal/errors"
 	"mylittleprice/internal/models"
 	"mylittleprice/internal/services"
+	"mylittleprice/internal/upstream"
 	"mylittleprice/internal/utils"
 )
 
@@ -40,6 +44,15 @@ type ChatRequest struct {
 	BrowserID         string // Persistent browser identifier for anonymous tracking
 	UserMessageID     string // Pre-generated UUID for user message (for consistent sync)
 	AssistantMessageID string // Pre-generated UUID for assistant message (for consistent sync)
+
+	// OnOutputChunk, if set, receives the assistant's output text incrementally
+	// while the model is generating. The final response Output is authoritative.
+	OnOutputChunk func(delta string)
+
+	// OnProgress, if set, receives stage events (thinking, searching...) as processing advances
+	OnProgress services.ProgressObserver
+
+	quotaSubject services.QuotaSubject // Resolved by ProcessChat when quotas are enabled
 }
 
 // ChatProcessorResponse represents the standardized response from chat processing
@@ -53,19 +66,29 @@ type ChatProcessorResponse struct {
 	SessionID          string
 	MessageCount       int
 	SearchState        *models.SearchStateResponse
+	Quota              *models.QuotaStatus // Remaining plan budgets, nil when quotas are disabled
+	SearchID           string              // Stored result set of the search, paged with NextCursor
+	NextCursor         string              // Empty when Products are all the results
+	TotalResults       int
 	Error              *ErrorInfo
 }
 
 // ErrorInfo contains error details
 type ErrorInfo struct {
-	Code    string
-	Message string
+	Code       string
+	Message    string
+	HTTPStatus int // Status for REST responses, 0 means the handler decides
 }
 
-// ProcessChat handles the main chat processing logic
-func (p *ChatProcessor) ProcessChat(req *ChatRequest) *ChatProcessorResponse {
+// cancelledOutput is stored as the assistant message of a cancelled turn
+const cancelledOutput = "Request cancelled."
+
+// ProcessChat handles the main chat processing logic.
+// Cancelling ctx aborts in-flight LLM/SERP calls and records a cancelled
+// assistant message instead of a reply.
+func (p *ChatProcessor) ProcessChat(ctx context.Context, req *ChatRequest) *ChatProcessorResponse {
 	// Create context with timeout for the entire operation
-	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	ctx, cancel := context.WithTimeout(ctx, 60*time.Second)
 	defer cancel()
 
 	// Add session_id and user_id to context for structured logging
@@ -73,6 +96,8 @@ func (p *ChatProcessor) ProcessChat(req *ChatRequest) *ChatProcessorResponse {
 	if req.UserID != nil {
 		ctx = utils.WithUserID(ctx, req.UserID.String())
 	}
+	ctx = services.WithProgressObserver(ctx, req.OnProgress)
+	ctx, tokenMeter := services.WithTokenMeter(ctx)
 
 	// Track metrics for message processing
 	start := time.Now()
@@ -80,6 +105,9 @@ func (p *ChatProcessor) ProcessChat(req *ChatRequest) *ChatProcessorResponse {
 	var response *ChatProcessorResponse
 
 	defer func() {
+		// Charge tokens of turns that ended early (a completed turn already did)
+		p.consumeTokens(ctx, req, tokenMeter)
+
 		// Record processing duration
 		duration := time.Since(start).Seconds()
 
@@ -141,10 +169,24 @@ func (p *ChatProcessor) ProcessChat(req *ChatRequest) *ChatProcessorResponse {
 		// Will be saved at the end with SaveSession()
 	}
 
-	// Check anonymous search limit (browser-based tracking)
+	// Check plan quotas (or, with quotas disabled, the anonymous browser limit)
 	anonymousLimit := p.container.Config.AnonymousSearchLimit
 	var anonymousSearchUsed int
-	if req.UserID == nil && req.BrowserID != "" {
+	if quotas := p.container.QuotaService; quotas != nil {
+		req.quotaSubject = quotas.Subject(ctx, req.UserID, req.BrowserID, session.SessionID)
+		quotaStatus, err := quotas.Check(ctx, req.quotaSubject, services.QuotaSearches, services.QuotaLLMTokens)
+		anonymousSearchUsed, anonymousLimit = anonymousSearchUsage(req, quotaStatus, anonymousLimit)
+
+		var exceeded *services.QuotaExceededError
+		if errors.As(err, &exceeded) {
+			utils.LogInfo(ctx, "quota exceeded",
+				slog.String("plan", exceeded.Plan),
+				slog.String("resource", string(exceeded.Resource)),
+			)
+			response = p.quotaExceededResponse(req, session, exceeded, quotaStatus, anonymousSearchUsed, anonymousLimit)
+			return response
+		}
+	} else if req.UserID == nil && req.BrowserID != "" {
 		// Get search count from Redis by browser ID
 		count, err := p.container.CacheService.GetAnonymousSearchCount(req.BrowserID)
 		if err != nil {
@@ -155,23 +197,10 @@ func (p *ChatProcessor) ProcessChat(req *ChatRequest) *ChatProcessorResponse {
 
 		// Check if limit reached
 		if anonymousSearchUsed >= anonymousLimit {
-			response = &ChatProcessorResponse{
-				Type:         "text",
-				Output:       "You've used all 3 free searches! Please sign up or log in to continue searching for products.",
-				SessionID:    req.SessionID,
-				MessageCount: session.MessageCount,
-				SearchState: &models.SearchStateResponse{
-					Status:                 string(session.SearchState.Status),
-					Category:               session.SearchState.Category,
-					CanContinue:            false,
-					SearchCount:            session.SearchState.SearchCount,
-					MaxSearches:            p.container.SessionService.GetMaxSearches(),
-					AnonymousSearchUsed:    anonymousSearchUsed,
-					AnonymousSearchLimit:   anonymousLimit,
-					RequiresAuthentication: true,
-					Message:                "Anonymous search limit reached - authentication required",
-				},
-			}
+			response = p.quotaExceededResponse(req, session, &services.QuotaExceededError{
+				Plan:     services.QuotaPlanAnonymous,
+				Resource: services.QuotaSearches,
+			}, nil, anonymousSearchUsed, anonymousLimit)
 			return response
 		}
 	}
@@ -200,20 +229,8 @@ func (p *ChatProcessor) ProcessChat(req *ChatRequest) *ChatProcessorResponse {
 
 	// Store user message
 	// Use pre-generated ID if provided, otherwise generate new one
-	var userMsgID uuid.UUID
-	if req.UserMessageID != "" {
-		parsedID, err := uuid.Parse(req.UserMessageID)
-		if err != nil {
-			userMsgID = uuid.New()
-		} else {
-			userMsgID = parsedID
-		}
-	} else {
-		userMsgID = uuid.New()
-	}
-
 	userMessage := &models.Message{
-		ID:        userMsgID,
+		ID:        parseMessageID(req.UserMessageID),
 		SessionID: session.ID,
 		Role:      "user",
 		Content:   req.Message,
@@ -240,6 +257,13 @@ func (p *ChatProcessor) ProcessChat(req *ChatRequest) *ChatProcessorResponse {
 	var geminiErr error
 	const maxProcessingRetries = 2
 
+	// With native function calling the model searches through tools during
+	// generation instead of returning search responses
+	var productTools *services.ProductToolExecutor
+	var toolExecutor services.ToolExecutor
+
+	services.ReportProgress(ctx, services.ProgressEvent{Stage: services.ProgressThinking})
+
 	for attempt := 0; attempt <= maxProcessingRetries; attempt++ {
 		if attempt > 0 {
 			utils.LogInfo(ctx, "retry processing attempt",
@@ -248,10 +272,28 @@ func (p *ChatProcessor) ProcessChat(req *ChatRequest) *ChatProcessorResponse {
 			)
 		}
 
-		geminiResponse, geminiErr = p.container.GeminiService.ProcessWithUniversalPrompt(
-			req.Message,
-			session,
-		)
+		if p.container.Config.LLMFunctionCalling {
+			productTools = services.NewProductToolExecutor(p.container.ProductSearch, p.container.CacheService, p.container.QuotaService, req.quotaSubject, p.container.Config, req.Country)
+			toolExecutor = productTools
+		}
+
+		// Only the first attempt is streamed; retries fall back to a single response
+		if req.OnOutputChunk != nil && attempt == 0 {
+			geminiResponse, geminiErr = p.container.GeminiService.ProcessWithUniversalPromptStream(
+				ctx,
+				req.Message,
+				session,
+				req.OnOutputChunk,
+				toolExecutor,
+			)
+		} else {
+			geminiResponse, geminiErr = p.container.GeminiService.ProcessWithUniversalPrompt(
+				ctx,
+				req.Message,
+				session,
+				toolExecutor,
+			)
+		}
 
 		// Success - break out of retry loop
 		if geminiErr == nil && geminiResponse != nil {
@@ -271,33 +313,54 @@ func (p *ChatProcessor) ProcessChat(req *ChatRequest) *ChatProcessorResponse {
 			)
 		}
 
-		// If this is the last attempt, use fallback response
-		if attempt == maxProcessingRetries {
-			utils.LogWarn(ctx, "all processing attempts failed, using fallback response")
+		if errors.Is(ctx.Err(), context.Canceled) {
+			response = p.cancelTurn(ctx, req, session)
+			return response
+		}
 
-			// Return helpful fallback response instead of error
-			response = &ChatProcessorResponse{
-				Type:         "dialogue",
-				Output:       "I'm having trouble processing your request right now. Could you please rephrase your question or try again in a moment?",
-				QuickReplies: []string{"Start over", "Try again"},
-				SessionID:    req.SessionID,
-				MessageCount: session.MessageCount,
-				SearchState: &models.SearchStateResponse{
-					Status:      string(session.SearchState.Status),
-					Category:    session.SearchState.Category,
-					CanContinue: session.SearchState.SearchCount < p.container.SessionService.GetMaxSearches(),
-					SearchCount: session.SearchState.SearchCount,
-					MaxSearches: p.container.SessionService.GetMaxSearches(),
-					Message:     "Temporary processing issue",
-				},
+		// Upstream failures other than transient ones are reported with their
+		// own error code; bad requests and safety blocks aren't worth retrying
+		if appErr := apperrors.FromUpstream(geminiErr); appErr != nil {
+			kind := upstream.KindOf(geminiErr)
+			giveUp := kind == upstream.KindBadRequest || kind == upstream.KindSafetyBlocked
+			if giveUp || (attempt == maxProcessingRetries && kind != upstream.KindTransient) {
+				utils.LogWarn(ctx, "upstream error reported to client",
+					slog.String("error_kind", string(kind)),
+					slog.String("code", appErr.Code),
+				)
+				response = &ChatProcessorResponse{
+					SessionID: req.SessionID,
+					Error: &ErrorInfo{
+						Code:       appErr.Code,
+						Message:    appErr.Message,
+						HTTPStatus: appErr.HTTPStatus,
+					},
+				}
+				return response
 			}
+		}
+
+		// If this is the last attempt (or the turn ran out of time), use fallback response
+		if attempt == maxProcessingRetries || ctx.Err() != nil {
+			utils.LogWarn(ctx, "all processing attempts failed, using fallback response")
+			response = p.fallbackResponse(req, session)
 			return response
 		}
 
-		// Wait a bit before retry (500ms, 1s)
+		// Wait a bit before retry (500ms, 1s), unless the turn ends meanwhile
 		if attempt < maxProcessingRetries {
 			retryDelay := time.Duration(500*(attempt+1)) * time.Millisecond
-			time.Sleep(retryDelay)
+			select {
+			case <-ctx.Done():
+				if errors.Is(ctx.Err(), context.Canceled) {
+					response = p.cancelTurn(ctx, req, session)
+					return response
+				}
+				utils.LogWarn(ctx, "turn timed out between processing attempts, using fallback response")
+				response = p.fallbackResponse(req, session)
+				return response
+			case <-time.After(retryDelay):
+			}
 		}
 	}
 
@@ -322,20 +385,8 @@ func (p *ChatProcessor) ProcessChat(req *ChatRequest) *ChatProcessorResponse {
 
 	// Create assistant message (but don't save yet - we may need to add products first)
 	// Use pre-generated ID if provided, otherwise generate new one
-	var assistantMsgID uuid.UUID
-	if req.AssistantMessageID != "" {
-		parsedID, err := uuid.Parse(req.AssistantMessageID)
-		if err != nil {
-			assistantMsgID = uuid.New()
-		} else {
-			assistantMsgID = parsedID
-		}
-	} else {
-		assistantMsgID = uuid.New()
-	}
-
 	assistantMessage := &models.Message{
-		ID:           assistantMsgID,
+		ID:           parseMessageID(req.AssistantMessageID),
 		SessionID:    session.ID,
 		Role:         "assistant",
 		Content:      geminiResponse.Output,
@@ -353,8 +404,17 @@ func (p *ChatProcessor) ProcessChat(req *ChatRequest) *ChatProcessorResponse {
 		MessageCount: session.MessageCount + 1,
 	}
 
-	// Handle search (intermediate search for verification/grounding)
-	if geminiResponse.ResponseType == "search" {
+	// Products found by the search_products tool replace the legacy search flow
+	var toolSearch *services.ToolSearch
+	if productTools != nil {
+		toolSearch = productTools.LastSearch()
+	}
+	if toolSearch != nil {
+		p.applyToolSearch(ctx, req, session, geminiResponse, toolSearch, response, assistantMessage)
+	}
+
+	// Handle search (the JSON protocol, when function calling is switched off)
+	if toolSearch == nil && geminiResponse.ResponseType == "search" {
 		searchLogAttrs := []any{
 			slog.String("phrase", geminiResponse.SearchPhrase),
 			slog.String("search_type", geminiResponse.SearchType),
@@ -373,19 +433,20 @@ func (p *ChatProcessor) ProcessChat(req *ChatRequest) *ChatProcessorResponse {
 			response.Output = "I need more details about what product you're looking for. Could you be more specific?"
 			response.Type = "dialogue"
 		} else {
-			products, translatedQuery, searchErr := p.performSearch(geminiResponse, req.Country, req.Language)
+			products, translatedQuery, searchErr := p.performSearch(ctx, geminiResponse, req.Country, req.Language)
 			if searchErr != nil {
 				utils.LogWarn(ctx, "search failed", slog.Any("error", searchErr))
 				response.Output = "Sorry, I couldn't find any products. Please try different keywords."
 				response.Type = "text"
 			} else if len(products) > 0 {
+				products = p.pageSearchResults(ctx, req, translatedQuery, geminiResponse.SearchType, geminiResponse.Category, products, response)
 				response.Products = products
 				response.ProductDescription = geminiResponse.ProductDescription // AI-generated description about products
 				response.SearchType = geminiResponse.SearchType
 
 				// Update last product
 				if len(products) > 0 {
-					price := parsePrice(products[0].Price)
+					price := productAmount(products[0], session.Currency)
 					session.SearchState.LastProduct = &models.ProductInfo{
 						Name:  products[0].Name,
 						Price: price,
@@ -393,12 +454,7 @@ func (p *ChatProcessor) ProcessChat(req *ChatRequest) *ChatProcessorResponse {
 				}
 
 				session.SearchState.SearchCount++
-				// Track anonymous search usage in Redis by browser ID
-				if req.UserID == nil && req.BrowserID != "" {
-					if err := p.container.CacheService.IncrementAnonymousSearchCount(req.BrowserID); err != nil {
-						utils.LogError(ctx, "failed to increment anonymous search count", err, slog.String("browser_id", req.BrowserID))
-					}
-				}
+				p.recordSearch(ctx, req)
 				// Add products and description to assistant message BEFORE saving
 				assistantMessage.Products = products
 				assistantMessage.ProductDescription = geminiResponse.ProductDescription
@@ -406,7 +462,7 @@ func (p *ChatProcessor) ProcessChat(req *ChatRequest) *ChatProcessorResponse {
 				// NEW: Update last search in conversation context
 				productInfoList := make([]models.ProductInfo, 0, len(products))
 				for _, p := range products {
-					price := parsePrice(p.Price)
+					price := productAmount(p, session.Currency)
 					productInfoList = append(productInfoList, models.ProductInfo{
 						Name:  p.Name,
 						Price: price,
@@ -421,107 +477,10 @@ func (p *ChatProcessor) ProcessChat(req *ChatRequest) *ChatProcessorResponse {
 		}
 	}
 
-	// Handle api_request (final product search to complete cycle)
-	if geminiResponse.ResponseType == "api_request" {
-		utils.LogInfo(ctx, "API request detected",
-			slog.String("api", geminiResponse.API),
-			slog.Any("params", geminiResponse.Params),
-		)
-
-		if geminiResponse.API == "google_shopping" && geminiResponse.Params != nil {
-			// Extract query from params
-			query, ok := geminiResponse.Params["q"].(string)
-			if !ok || query == "" {
-				utils.LogWarn(ctx, "missing or invalid 'q' parameter in api_request")
-				response.Output = "I need more details about what product you're looking for. Could you be more specific?"
-				response.Type = "dialogue"
-			} else {
-				// Perform the final search
-				utils.LogInfo(ctx, "final product search", slog.String("query", query))
-
-				// Create a temporary GeminiResponse for search
-				searchResp := &models.GeminiResponse{
-					SearchPhrase: query,
-					SearchType:   "exact", // Default to exact for final searches
-					Category:     geminiResponse.Category,
-					PriceFilter:  geminiResponse.PriceFilter,
-				}
-
-				products, translatedQuery, searchErr := p.performSearch(searchResp, req.Country, req.Language)
-				if searchErr != nil {
-					utils.LogWarn(ctx, "final search failed", slog.Any("error", searchErr))
-					response.Output = "Sorry, I couldn't find any products. Please try different keywords."
-					response.Type = "text"
-				} else if len(products) > 0 {
-					response.Products = products
-					response.SearchType = "exact"
-					response.Output = geminiResponse.Output // Use AI's message if provided
-
-					// Product description with fallback
-					productDesc := geminiResponse.ProductDescription
-					if productDesc == "" {
-						// Fallback: Generate description based on query
-						productDesc = p.generateFallbackDescription(query, geminiResponse.Category, req.Language)
-						utils.LogWarn(ctx, "using fallback product description",
-							slog.String("query", query),
-							slog.String("description", productDesc),
-						)
-					}
-					response.ProductDescription = productDesc
-
-					// Log product description for debugging
-					utils.LogInfo(ctx, "product description set",
-						slog.String("description", productDesc),
-						slog.Int("product_count", len(products)),
-						slog.Bool("is_fallback", geminiResponse.ProductDescription == ""),
-					)
-
-					// Update last product
-					if len(products) > 0 {
-						price := parsePrice(products[0].Price)
-						session.SearchState.LastProduct = &models.ProductInfo{
-							Name:  products[0].Name,
-							Price: price,
-						}
-					}
-
-					session.SearchState.SearchCount++
-					// Track anonymous search usage in Redis by browser ID
-					if req.UserID == nil && req.BrowserID != "" {
-						if err := p.container.CacheService.IncrementAnonymousSearchCount(req.BrowserID); err != nil {
-							utils.LogError(ctx, "failed to increment anonymous search count", err, slog.String("browser_id", req.BrowserID))
-						}
-					}
-					// Add products and description to assistant message BEFORE saving
-					assistantMessage.Products = products
-					assistantMessage.ProductDescription = productDesc
-
-					// NEW: Update last search in conversation context
-					productInfoList := make([]models.ProductInfo, 0, len(products))
-					for _, p := range products {
-						price := parsePrice(p.Price)
-						productInfoList = append(productInfoList, models.ProductInfo{
-							Name:  p.Name,
-							Price: price,
-						})
-					}
-					contextExtractor := p.container.GeminiService.GetContextExtractor()
-					contextExtractor.UpdateLastSearch(session, translatedQuery, searchResp.Category, productInfoList, "")
-
-					// Save search history
-					p.saveSearchHistory(req, session, searchResp, translatedQuery, products)
-
-					utils.LogInfo(ctx, "cycle completed", slog.Int("product_count", len(products)))
-				} else {
-					response.Output = "I couldn't find that exact product. Would you like to see similar alternatives?"
-					response.Type = "dialogue"
-				}
-			}
-		} else {
-			utils.LogWarn(ctx, "unsupported API", slog.String("api", geminiResponse.API))
-			response.Output = "I encountered an error processing your request. Please try again."
-			response.Type = "dialogue"
-		}
+	// Cancelled during search - discard partial results
+	if errors.Is(ctx.Err(), context.Canceled) {
+		response = p.cancelTurn(ctx, req, session)
+		return response
 	}
 
 	// IMPORTANT: Sync assistant message content with final response output
@@ -582,6 +541,8 @@ func (p *ChatProcessor) ProcessChat(req *ChatRequest) *ChatProcessorResponse {
 	// Update session state
 	session.SearchState.Status = models.SearchStatusIdle
 
+	services.ReportProgress(ctx, services.ProgressEvent{Stage: services.ProgressSaving})
+
 	// Save session once at the end with retry logic (CRITICAL!)
 	retryConfig := utils.RetryConfig{
 		MaxRetries:    3,
@@ -591,7 +552,7 @@ func (p *ChatProcessor) ProcessChat(req *ChatRequest) *ChatProcessorResponse {
 	}
 
 	saveErr := utils.RetryWithBackoff(ctx, func() error {
-		return p.container.SessionService.SaveSession(session)
+		return p.container.SessionService.SaveSession(ctx, session)
 	}, retryConfig)
 
 	if saveErr != nil {
@@ -611,12 +572,20 @@ func (p *ChatProcessor) ProcessChat(req *ChatRequest) *ChatProcessorResponse {
 		return response
 	}
 
-	// Build search state response with real-time anonymous count
+	// Build search state response with real-time quota usage
 	anonymousLimit = p.container.Config.AnonymousSearchLimit
 
-	// Get real-time anonymous search count from Redis
 	var currentAnonymousCount int
-	if req.UserID == nil && req.BrowserID != "" {
+	var quotaStatus *models.QuotaStatus
+	if quotas := p.container.QuotaService; quotas != nil {
+		p.consumeTokens(ctx, req, tokenMeter)
+		quotaStatus, err = quotas.Status(ctx, req.quotaSubject)
+		if err != nil {
+			utils.LogError(ctx, "failed to get quota status for response", err)
+		}
+		currentAnonymousCount, anonymousLimit = anonymousSearchUsage(req, quotaStatus, anonymousLimit)
+	} else if req.UserID == nil && req.BrowserID != "" {
+		// Get real-time anonymous search count from Redis
 		count, err := p.container.CacheService.GetAnonymousSearchCount(req.BrowserID)
 		if err != nil {
 			utils.LogError(ctx, "failed to get anonymous search count for response", err)
@@ -625,22 +594,200 @@ func (p *ChatProcessor) ProcessChat(req *ChatRequest) *ChatProcessorResponse {
 		currentAnonymousCount = count
 	}
 
-	requiresAuth := req.UserID == nil && currentAnonymousCount >= anonymousLimit
+	// With quotas, searches may also be capped for signed-in users (Remaining -1 is unlimited)
+	searchesLeft := req.UserID != nil || currentAnonymousCount < anonymousLimit
+	if quotaStatus != nil {
+		searchesLeft = quotaStatus.Resources[string(services.QuotaSearches)].Remaining != 0
+	}
+	requiresAuth := req.UserID == nil && !searchesLeft
 
 	response.SearchState = &models.SearchStateResponse{
 		Status:                 string(session.SearchState.Status),
 		Category:               session.SearchState.Category,
-		CanContinue:            session.SearchState.SearchCount < p.container.SessionService.GetMaxSearches() && !requiresAuth,
+		CanContinue:            session.SearchState.SearchCount < p.container.SessionService.GetMaxSearches() && searchesLeft,
 		SearchCount:            session.SearchState.SearchCount,
 		MaxSearches:            p.container.SessionService.GetMaxSearches(),
 		AnonymousSearchUsed:    currentAnonymousCount,
 		AnonymousSearchLimit:   anonymousLimit,
 		RequiresAuthentication: requiresAuth,
+		Quota:                  quotaStatus,
 	}
+	response.Quota = quotaStatus
 
 	return response
 }
 
+// quotaExceededResponse tells the user a budget is used up. Anonymous users
+// are asked to sign in; signed-in users are told when the budget renews.
+func (p *ChatProcessor) quotaExceededResponse(
+	req *ChatRequest,
+	session *models.ChatSession,
+	exceeded *services.QuotaExceededError,
+	quotaStatus *models.QuotaStatus,
+	anonymousSearchUsed, anonymousLimit int,
+) *ChatProcessorResponse {
+	var output, message string
+	requiresAuth := exceeded.Plan == services.QuotaPlanAnonymous
+	switch {
+	case requiresAuth && exceeded.Resource == services.QuotaSearches:
+		output = fmt.Sprintf("You've used all %d free searches! Please sign up or log in to continue searching for products.", anonymousLimit)
+		message = "Anonymous search limit reached - authentication required"
+	case requiresAuth:
+		output = "You've reached today's free usage limit! Please sign up or log in to continue."
+		message = "Anonymous usage limit reached - authentication required"
+	default:
+		output = fmt.Sprintf("You've reached the %s limit of your %s plan.", quotaResourceLabel(exceeded.Resource), exceeded.Plan)
+		if exceeded.ResetAt != nil {
+			output += fmt.Sprintf(" It resets on %s.", exceeded.ResetAt.Format("Jan 2, 15:04 UTC"))
+		}
+		message = "Quota exceeded: " + string(exceeded.Resource)
+	}
+
+	return &ChatProcessorResponse{
+		Type:         "text",
+		Output:       output,
+		SessionID:    req.SessionID,
+		MessageCount: session.MessageCount,
+		SearchState: &models.SearchStateResponse{
+			Status:                 string(session.SearchState.Status),
+			Category:               session.SearchState.Category,
+			CanContinue:            false,
+			SearchCount:            session.SearchState.SearchCount,
+			MaxSearches:            p.container.SessionService.GetMaxSearches(),
+			AnonymousSearchUsed:    anonymousSearchUsed,
+			AnonymousSearchLimit:   anonymousLimit,
+			RequiresAuthentication: requiresAuth,
+			Message:                message,
+			Quota:                  quotaStatus,
+		},
+		Quota: quotaStatus,
+	}
+}
+
+// recordSearch counts a completed search against the request's quota (or the
+// anonymous browser limit when quotas are disabled)
+func (p *ChatProcessor) recordSearch(ctx context.Context, req *ChatRequest) {
+	if quotas := p.container.QuotaService; quotas != nil {
+		if err := quotas.Consume(ctx, req.quotaSubject, services.QuotaSearches, 1); err != nil {
+			utils.LogError(ctx, "failed to consume search quota", err)
+		}
+		return
+	}
+
+	// Track anonymous search usage in Redis by browser ID
+	if req.UserID == nil && req.BrowserID != "" {
+		if err := p.container.CacheService.IncrementAnonymousSearchCount(req.BrowserID); err != nil {
+			utils.LogError(ctx, "failed to increment anonymous search count", err, slog.String("browser_id", req.BrowserID))
+		}
+	}
+}
+
+// consumeTokens charges the LLM tokens metered since the last call
+func (p *ChatProcessor) consumeTokens(ctx context.Context, req *ChatRequest, meter *services.TokenMeter) {
+	quotas := p.container.QuotaService
+	if quotas == nil || req.quotaSubject.Key == "" {
+		return
+	}
+	tokens := meter.Take()
+	if tokens == 0 {
+		return
+	}
+
+	// The turn may have been cancelled, the tokens were still spent
+	if err := quotas.Consume(context.WithoutCancel(ctx), req.quotaSubject, services.QuotaLLMTokens, tokens); err != nil {
+		utils.LogError(ctx, "failed to consume token quota", err)
+	}
+}
+
+// anonymousSearchUsage returns the anonymous search count and limit from the
+// quota status, or 0 and fallbackLimit for signed-in users
+func anonymousSearchUsage(req *ChatRequest, quotaStatus *models.QuotaStatus, fallbackLimit int) (used, limit int) {
+	if req.UserID != nil || quotaStatus == nil {
+		return 0, fallbackLimit
+	}
+	searches := quotaStatus.Resources[string(services.QuotaSearches)]
+	return int(searches.DailyUsed), int(searches.DailyLimit)
+}
+
+func quotaResourceLabel(resource services.QuotaResource) string {
+	switch resource {
+	case services.QuotaSearches:
+		return "search"
+	case services.QuotaLLMTokens:
+		return "AI usage"
+	default:
+		return "product details"
+	}
+}
+
+// fallbackResponse is the helpful reply sent instead of an error when every
+// processing attempt failed
+func (p *ChatProcessor) fallbackResponse(req *ChatRequest, session *models.ChatSession) *ChatProcessorResponse {
+	return &ChatProcessorResponse{
+		Type:         "dialogue",
+		Output:       "I'm having trouble processing your request right now. Could you please rephrase your question or try again in a moment?",
+		QuickReplies: []string{"Start over", "Try again"},
+		SessionID:    req.SessionID,
+		MessageCount: session.MessageCount,
+		SearchState: &models.SearchStateResponse{
+			Status:      string(session.SearchState.Status),
+			Category:    session.SearchState.Category,
+			CanContinue: session.SearchState.SearchCount < p.container.SessionService.GetMaxSearches(),
+			SearchCount: session.SearchState.SearchCount,
+			MaxSearches: p.container.SessionService.GetMaxSearches(),
+			Message:     "Temporary processing issue",
+		},
+	}
+}
+
+// cancelTurn records a cancelled assistant message for a turn aborted by the
+// client and saves the session, which already holds the user message
+func (p *ChatProcessor) cancelTurn(ctx context.Context, req *ChatRequest, session *models.ChatSession) *ChatProcessorResponse {
+	utils.LogInfo(ctx, "chat turn cancelled",
+		slog.String("user_message_id", req.UserMessageID),
+	)
+
+	assistantMessage := &models.Message{
+		ID:           parseMessageID(req.AssistantMessageID),
+		SessionID:    session.ID,
+		Role:         "assistant",
+		Content:      cancelledOutput,
+		ResponseType: "cancelled",
+		CreatedAt:    time.Now(),
+	}
+	if err := p.container.MessageService.AddMessageInMemory(session, assistantMessage); err != nil {
+		utils.LogWarn(ctx, "failed to store cancelled assistant message", slog.Any("error", err))
+	}
+
+	session.SearchState.Status = models.SearchStatusIdle
+
+	// The turn's context is already cancelled, so save with a detached one
+	saveCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), 10*time.Second)
+	defer cancel()
+
+	if err := p.container.SessionService.SaveSession(saveCtx, session); err != nil {
+		utils.LogError(ctx, "failed to save session after cancellation", err)
+	}
+
+	return &ChatProcessorResponse{
+		Type:         "cancelled",
+		Output:       cancelledOutput,
+		SessionID:    req.SessionID,
+		MessageCount: session.MessageCount,
+	}
+}
+
+// parseMessageID returns the pre-generated message ID, or a new one if it's
+// empty or not a valid UUID
+func parseMessageID(id string) uuid.UUID {
+	if id != "" {
+		if parsedID, err := uuid.Parse(id); err == nil {
+			return parsedID
+		}
+	}
+	return uuid.New()
+}
+
 // getOrCreateSession handles session retrieval or creation
 func (p *ChatProcessor) getOrCreateSession(req *ChatRequest) (*models.ChatSession, error) {
 	var session *models.ChatSession
@@ -718,13 +865,12 @@ func (p *ChatProcessor) getOrCreateSession(req *ChatRequest) (*models.ChatSessio
 }
 
 // performSearch executes product search with translation
-func (p *ChatProcessor) performSearch(geminiResp *models.GeminiResponse, country, language string) ([]models.ProductCard, string, error) {
-	ctx := context.Background()
-
+func (p *ChatProcessor) performSearch(ctx context.Context, geminiResp *models.GeminiResponse, country, language string) ([]models.ProductCard, string, error) {
 	// Translate query to English for better search results
 	utils.LogInfo(ctx, "translation check", slog.String("search_phrase", geminiResp.SearchPhrase))
+	services.ReportProgress(ctx, services.ProgressEvent{Stage: services.ProgressTranslating})
 
-	translatedQuery, err := p.container.GeminiService.TranslateToEnglish(geminiResp.SearchPhrase)
+	translatedQuery, err := p.container.GeminiService.TranslateToEnglish(ctx, geminiResp.SearchPhrase)
 	if err != nil {
 		utils.LogWarn(ctx, "translation failed, using original query", slog.Any("error", err))
 		translatedQuery = geminiResp.SearchPhrase
@@ -745,18 +891,21 @@ func (p *ChatProcessor) performSearch(geminiResp *models.GeminiResponse, country
 		)
 	}
 
-	utils.LogInfo(ctx, "sending to SERP", slog.String("query", translatedQuery))
+	utils.LogInfo(ctx, "sending to product search", slog.String("query", translatedQuery))
+	services.ReportProgress(ctx, services.ProgressEvent{Stage: services.ProgressSearching})
 
 	// NOTE: Price range is for visual display only, not used in actual search
 	// This allows broader search results while showing price guidance to users
-	products, _, err := p.container.SerpService.SearchWithCache(
+	products, err := services.SearchProductsWithCache(
 		ctx,
-		translatedQuery,
-		geminiResp.SearchType,
-		country,
-		nil, // minPrice - not used for search
-		nil, // maxPrice - not used for search
+		p.container.ProductSearch,
 		p.container.CacheService,
+		time.Duration(p.container.Config.CacheSerpTTL)*time.Second,
+		services.ProductSearchQuery{
+			Query:      translatedQuery,
+			SearchType: geminiResp.SearchType,
+			Country:    country,
+		},
 	)
 
 	if err != nil {
@@ -766,6 +915,96 @@ func (p *ChatProcessor) performSearch(geminiResp *models.GeminiResponse, country
 	return products, translatedQuery, nil
 }
 
+// firstPageSize returns how many products the first page of a search in the
+// category shows: the taxonomy node's max_products, or the default page size
+func (p *ChatProcessor) firstPageSize(category string) int {
+	if size := p.container.TaxonomyService.Current().MaxProducts(category); size > 0 {
+		return size
+	}
+	return p.container.Config.SearchPageSize
+}
+
+// pageSearchResults stores the full result set of a search and puts its
+// cursor on the response. It returns the first page in the user's currency,
+// sized for the category, which is what the turn shows and records; if the
+// set can't be stored, the first page is all there is.
+func (p *ChatProcessor) pageSearchResults(ctx context.Context, req *ChatRequest, query, searchType, category string, products []models.ProductCard, response *ChatProcessorResponse) []models.ProductCard {
+	pageSize := p.firstPageSize(category)
+	page, err := p.container.SearchResults.Save(ctx, req.SessionID, req.UserID, query, searchType, products, pageSize)
+	if err != nil {
+		utils.LogWarn(ctx, "failed to store search results", slog.Any("error", err))
+		firstPage := products[:min(len(products), pageSize)]
+		return p.container.FXService.ConvertCards(firstPage, req.Currency)
+	}
+
+	response.SearchID = page.SearchID
+	response.NextCursor = page.NextCursor
+	response.TotalResults = page.Total
+	return p.container.FXService.ConvertCards(page.Products, req.Currency)
+}
+
+// applyToolSearch attaches the products of the model's last search_products
+// call to the response and does the same bookkeeping as a regular search
+func (p *ChatProcessor) applyToolSearch(
+	ctx context.Context,
+	req *ChatRequest,
+	session *models.ChatSession,
+	geminiResp *models.GeminiResponse,
+	toolSearch *services.ToolSearch,
+	response *ChatProcessorResponse,
+	assistantMessage *models.Message,
+) {
+	products := p.pageSearchResults(ctx, req, toolSearch.Query, toolSearch.SearchType, geminiResp.Category, toolSearch.Products, response)
+
+	productDesc := geminiResp.ProductDescription
+	if productDesc == "" {
+		productDesc = p.generateFallbackDescription(toolSearch.Query, geminiResp.Category, req.Language)
+	}
+
+	utils.LogInfo(ctx, "tool search results attached",
+		slog.String("query", toolSearch.Query),
+		slog.String("search_type", toolSearch.SearchType),
+		slog.Int("product_count", len(products)),
+		slog.Bool("is_fallback_description", geminiResp.ProductDescription == ""),
+	)
+
+	response.Products = products
+	response.ProductDescription = productDesc
+	response.SearchType = toolSearch.SearchType
+
+	price := productAmount(products[0], session.Currency)
+	session.SearchState.LastProduct = &models.ProductInfo{
+		Name:  products[0].Name,
+		Price: price,
+	}
+
+	session.SearchState.SearchCount++
+	// With quotas, the tool executor already charged each search_products call
+	if p.container.QuotaService == nil {
+		p.recordSearch(ctx, req)
+	}
+
+	assistantMessage.Products = products
+	assistantMessage.ProductDescription = productDesc
+
+	productInfoList := make([]models.ProductInfo, 0, len(products))
+	for _, product := range products {
+		productInfoList = append(productInfoList, models.ProductInfo{
+			Name:  product.Name,
+			Price: productAmount(product, session.Currency),
+		})
+	}
+	contextExtractor := p.container.GeminiService.GetContextExtractor()
+	contextExtractor.UpdateLastSearch(session, toolSearch.Query, geminiResp.Category, productInfoList, "")
+
+	searchResp := &models.GeminiResponse{
+		SearchPhrase: toolSearch.Query,
+		SearchType:   toolSearch.SearchType,
+		Category:     geminiResp.Category,
+	}
+	p.saveSearchHistory(req, session, searchResp, toolSearch.Query, products)
+}
+
 // saveSearchHistory saves the search to history
 func (p *ChatProcessor) saveSearchHistory(req *ChatRequest, session *models.ChatSession, geminiResp *models.GeminiResponse, translatedQuery string, products []models.ProductCard) {
 	// Set currency from request or use default
@@ -808,17 +1047,14 @@ func (p *ChatProcessor) saveSearchHistory(req *ChatRequest, session *models.Chat
 	}()
 }
 
-// parsePrice extracts numeric price from price string
-func parsePrice(priceStr string) float64 {
-	priceStr = strings.ReplaceAll(priceStr, "$", "")
-	priceStr = strings.ReplaceAll(priceStr, "€", "")
-	priceStr = strings.ReplaceAll(priceStr, "£", "")
-	priceStr = strings.ReplaceAll(priceStr, "CHF", "")
-	priceStr = strings.TrimSpace(priceStr)
-	priceStr = strings.ReplaceAll(priceStr, ",", "")
-
-	price, _ := strconv.ParseFloat(priceStr, 64)
-	return price
+// productAmount returns the price of a product card, parsing the display
+// price in the session currency when the search provider didn't
+func productAmount(card models.ProductCard, currency string) domain.Money {
+	if card.Amount != nil {
+		return *card.Amount
+	}
+	amount, _ := domain.ParseMoney(card.Price, domain.Currency(currency))
+	return amount
 }
 
 // generateFallbackDescription creates a detailed product description when Gemini fails to provide one
@@ -1,11 +1,11 @@
 package handlers
 
 import (
-	"time"
-
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 
 	"mylittleprice/internal/container"
+	apperrors "mylittleprice/internal/errors"
 	"mylittleprice/internal/models"
 )
 
@@ -39,18 +39,35 @@ func (h *ProductHandler) HandleProductDetails(c *fiber.Ctx) error {
 		req.Country = h.container.Config.DefaultCountry
 	}
 
-	cachedProduct, err := h.container.CacheService.GetProductByToken(req.PageToken)
-	if err == nil && cachedProduct != nil {
-		return h.formatProductResponse(c, cachedProduct)
+	var userID *uuid.UUID
+	if uid, ok := c.Locals("user_id").(uuid.UUID); ok {
+		userID = &uid
 	}
 
-	startTime := time.Now()
-	productDetails, keyIndex, err := h.container.SerpService.GetProductDetailsByToken(c.UserContext(), req.PageToken)
-	responseTime := time.Since(startTime)
+	quotas := h.container.QuotaService
+	quotaSubject, quotaStatus, quotaErr := checkProductDetailsQuota(c.UserContext(), quotas, userID, req.BrowserID, "")
+	if quotaErr != nil {
+		setQuotaHeaders(c, quotaStatus)
+		return c.Status(quotaErr.HTTPStatus).JSON(models.ErrorResponse{
+			Error:   quotaErr.Code,
+			Message: quotaErr.Message,
+		})
+	}
 
-	h.container.SerpRotator.RecordUsage(keyIndex, err == nil, responseTime)
+	cachedProduct, err := h.container.CacheService.GetProductByToken(req.PageToken)
+	if err == nil && cachedProduct != nil {
+		setQuotaHeaders(c, consumeProductDetailsQuota(c.UserContext(), quotas, quotaSubject))
+		return h.formatProductResponse(c, cachedProduct, req.Currency)
+	}
 
+	productDetails, err := h.container.ProductSearch.GetDetails(c.UserContext(), req.PageToken, req.Country)
 	if err != nil {
+		if appErr := apperrors.FromUpstream(err); appErr != nil {
+			return c.Status(appErr.HTTPStatus).JSON(models.ErrorResponse{
+				Error:   appErr.Code,
+				Message: appErr.Message,
+			})
+		}
 		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
 			Error:   "fetch_error",
 			Message: "Failed to fetch product details",
@@ -61,10 +78,12 @@ func (h *ProductHandler) HandleProductDetails(c *fiber.Ctx) error {
 		c.Context().Logger().Printf("Warning: Failed to cache product details: %v", err)
 	}
 
-	return h.formatProductResponse(c, productDetails)
+	setQuotaHeaders(c, consumeProductDetailsQuota(c.UserContext(), quotas, quotaSubject))
+	return h.formatProductResponse(c, productDetails, req.Currency)
 }
 
-func (h *ProductHandler) formatProductResponse(c *fiber.Ctx, productData map[string]interface{}) error {
+// formatProductResponse sends the product details with offers in currency
+func (h *ProductHandler) formatProductResponse(c *fiber.Ctx, productData map[string]interface{}, currency string) error {
 	response, err := FormatProductDetails(productData)
 	if err != nil {
 		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
@@ -72,6 +91,7 @@ func (h *ProductHandler) formatProductResponse(c *fiber.Ctx, productData map[str
 			Message: err.Error(),
 		})
 	}
+	response.Offers = h.container.FXService.ConvertOffers(response.Offers, currency)
 
-	return c.JSON(response)
+	return c.JSON(withBestOffer(response))
 }
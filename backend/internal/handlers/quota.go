@@ -0,0 +1,90 @@
+package handlers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	apperrors "mylittleprice/internal/errors"
+	"mylittleprice/internal/models"
+	"mylittleprice/internal/services"
+)
+
+// checkProductDetailsQuota resolves the requester's quota subject and checks
+// that a product details lookup is left. The subject is nil when quotas are
+// disabled or the requester can't be identified; consume it with
+// consumeProductDetailsQuota after a successful lookup.
+func checkProductDetailsQuota(
+	ctx context.Context,
+	quotas *services.QuotaService,
+	userID *uuid.UUID,
+	browserID, sessionID string,
+) (*services.QuotaSubject, *models.QuotaStatus, *apperrors.AppError) {
+	if quotas == nil || (userID == nil && browserID == "" && sessionID == "") {
+		return nil, nil, nil
+	}
+
+	subject := quotas.Subject(ctx, userID, browserID, sessionID)
+	status, err := quotas.Check(ctx, subject, services.QuotaProductDetails)
+	var exceeded *services.QuotaExceededError
+	if errors.As(err, &exceeded) {
+		message := fmt.Sprintf("Product details limit of the %s plan reached", exceeded.Plan)
+		if exceeded.ResetAt != nil {
+			message += ", resets at " + exceeded.ResetAt.UTC().Format(time.RFC3339)
+		}
+		return &subject, status, apperrors.ErrQuotaExceeded.WithMessage(message)
+	}
+	return &subject, status, nil
+}
+
+// consumeProductDetailsQuota charges one product details lookup and returns
+// the updated status
+func consumeProductDetailsQuota(ctx context.Context, quotas *services.QuotaService, subject *services.QuotaSubject) *models.QuotaStatus {
+	if subject == nil {
+		return nil
+	}
+
+	if err := quotas.Consume(ctx, *subject, services.QuotaProductDetails, 1); err != nil {
+		fmt.Printf("⚠️ Failed to consume product details quota: %v\n", err)
+	}
+	status, err := quotas.Status(ctx, *subject)
+	if err != nil {
+		return nil
+	}
+	return status
+}
+
+// setQuotaHeaders reports the remaining plan budgets in X-Quota-* headers.
+// Remaining values of -1 mean unlimited; X-Quota-Reset is the earliest renewal.
+func setQuotaHeaders(c *fiber.Ctx, status *models.QuotaStatus) {
+	if status == nil {
+		return
+	}
+
+	c.Set("X-Quota-Plan", status.Plan)
+	headers := map[services.QuotaResource]string{
+		services.QuotaSearches:       "X-Quota-Searches-Remaining",
+		services.QuotaLLMTokens:      "X-Quota-Tokens-Remaining",
+		services.QuotaProductDetails: "X-Quota-Product-Details-Remaining",
+	}
+
+	var resetAt *time.Time
+	for resource, header := range headers {
+		resourceStatus, ok := status.Resources[string(resource)]
+		if !ok {
+			continue
+		}
+		c.Set(header, strconv.FormatInt(resourceStatus.Remaining, 10))
+		if resourceStatus.ResetAt != nil && (resetAt == nil || resourceStatus.ResetAt.Before(*resetAt)) {
+			resetAt = resourceStatus.ResetAt
+		}
+	}
+	if resetAt != nil {
+		c.Set("X-Quota-Reset", resetAt.UTC().Format(time.RFC3339))
+	}
+}
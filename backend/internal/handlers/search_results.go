@@ -0,0 +1,91 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"mylittleprice/internal/container"
+	"mylittleprice/internal/models"
+	"mylittleprice/internal/services"
+)
+
+type SearchResultsHandler struct {
+	container *container.Container
+}
+
+func NewSearchResultsHandler(container *container.Container) *SearchResultsHandler {
+	return &SearchResultsHandler{
+		container: container,
+	}
+}
+
+// GetSearchResults returns the next page of a search's results. Paging
+// doesn't run a new product search or count against search limits.
+// GET /api/search/:id/results?cursor=xxx&limit=10&currency=EUR
+func (h *SearchResultsHandler) GetSearchResults(c *fiber.Ctx) error {
+	var userID *uuid.UUID
+	if uid, ok := c.Locals("user_id").(uuid.UUID); ok {
+		userID = &uid
+	}
+
+	page, err := h.container.SearchResults.Page(c.Context(), c.Params("id"), userID, c.Query("cursor"), c.QueryInt("limit", 0))
+	if err != nil {
+		code, message, status := searchResultsError(err)
+		return c.Status(status).JSON(models.ErrorResponse{
+			Error:   code,
+			Message: message,
+		})
+	}
+
+	page.Products = h.container.FXService.ConvertCards(page.Products, c.Query("currency"))
+	return c.JSON(page)
+}
+
+// RefineSearchResults sorts and filters a search's results into a new search
+// (paged like any other) and returns its first page. No new product search is run.
+// POST /api/search/:id/refine?currency=EUR
+func (h *SearchResultsHandler) RefineSearchResults(c *fiber.Ctx) error {
+	var refinement models.SearchRefinement
+	if err := c.BodyParser(&refinement); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "Failed to parse request body",
+		})
+	}
+
+	var userID *uuid.UUID
+	if uid, ok := c.Locals("user_id").(uuid.UUID); ok {
+		userID = &uid
+	}
+
+	page, err := h.container.SearchResults.Refine(c.Context(), c.Params("id"), userID, refinement)
+	if err != nil {
+		code, message, status := searchResultsError(err)
+		return c.Status(status).JSON(models.ErrorResponse{
+			Error:   code,
+			Message: message,
+		})
+	}
+
+	page.Products = h.container.FXService.ConvertCards(page.Products, c.Query("currency"))
+	return c.JSON(page)
+}
+
+// searchResultsError maps a SearchResultsService error to an error code,
+// message and HTTP status
+func searchResultsError(err error) (string, string, int) {
+	switch {
+	case errors.Is(err, services.ErrSearchNotFound):
+		return "SEARCH_NOT_FOUND", "Search results not found or expired", fiber.StatusNotFound
+	case errors.Is(err, services.ErrInvalidCursor):
+		return "INVALID_CURSOR", "Invalid cursor", fiber.StatusBadRequest
+	case errors.Is(err, services.ErrInvalidRefinement):
+		return "INVALID_REFINEMENT", err.Error(), fiber.StatusBadRequest
+	default:
+		log.Printf("Error getting search results: %v", err)
+		return "SEARCH_RESULTS_ERROR", "Failed to retrieve search results", fiber.StatusInternalServerError
+	}
+}
@@ -0,0 +1,135 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"mylittleprice/internal/container"
+	"mylittleprice/internal/middleware"
+	"mylittleprice/internal/models"
+	"mylittleprice/internal/services"
+)
+
+type ShareHandler struct {
+	container *container.Container
+}
+
+func NewShareHandler(container *container.Container) *ShareHandler {
+	return &ShareHandler{
+		container: container,
+	}
+}
+
+// CreateShare snapshots one of the user's conversations into a public
+// read-only link
+// POST /api/shares
+func (h *ShareHandler) CreateShare(c *fiber.Ctx) error {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		return authRequired(c)
+	}
+
+	var req models.CreateShareRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "Failed to parse request body",
+		})
+	}
+
+	share, err := h.container.ShareService.Create(c.Context(), userID, req)
+	if err != nil {
+		return shareError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(share)
+}
+
+// GetShares returns the authenticated user's share links
+// GET /api/shares
+func (h *ShareHandler) GetShares(c *fiber.Ctx) error {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		return authRequired(c)
+	}
+
+	shares, err := h.container.ShareService.List(c.Context(), userID)
+	if err != nil {
+		return shareError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"shares": shares,
+	})
+}
+
+// RevokeShare disables one of the user's share links
+// DELETE /api/shares/:id
+func (h *ShareHandler) RevokeShare(c *fiber.Ctx) error {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		return authRequired(c)
+	}
+
+	shareID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return shareError(c, services.ErrShareNotFound)
+	}
+
+	if err := h.container.ShareService.Revoke(c.Context(), userID, shareID); err != nil {
+		return shareError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// GetSharedConversation returns a conversation through its share link (public)
+// GET /api/shares/:token
+func (h *ShareHandler) GetSharedConversation(c *fiber.Ctx) error {
+	conversation, err := h.container.ShareService.GetShared(c.Context(), c.Params("token"))
+	if err != nil {
+		return shareError(c, err)
+	}
+
+	return c.JSON(conversation)
+}
+
+// shareError maps a ShareService error to a response
+func shareError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, services.ErrShareNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Error:   "SHARE_NOT_FOUND",
+			Message: "Share link not found",
+		})
+	case errors.Is(err, services.ErrShareGone):
+		return c.Status(fiber.StatusGone).JSON(models.ErrorResponse{
+			Error:   "SHARE_GONE",
+			Message: "This share link was revoked or has expired",
+		})
+	case errors.Is(err, services.ErrSharedSessionNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Error:   "SESSION_NOT_FOUND",
+			Message: "Conversation not found",
+		})
+	case errors.Is(err, services.ErrShareLimit):
+		return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
+			Error:   "SHARE_LIMIT",
+			Message: err.Error(),
+		})
+	case errors.Is(err, services.ErrInvalidShare):
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	default:
+		log.Printf("Error handling share request: %v", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Error:   "SHARE_ERROR",
+			Message: "Failed to process share request",
+		})
+	}
+}
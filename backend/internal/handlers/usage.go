@@ -0,0 +1,172 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+	"time"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"mylittleprice/internal/container"
+	"mylittleprice/internal/models"
+	"mylittleprice/internal/services"
+)
+
+type UsageHandler struct {
+	container *container.Container
+}
+
+func NewUsageHandler(container *container.Container) *UsageHandler {
+	return &UsageHandler{
+		container: container,
+	}
+}
+
+// GetSessionCost returns the tokens and cost spent on a chat session
+// GET /api/chat/cost?session_id=xxx
+func (h *UsageHandler) GetSessionCost(c *fiber.Ctx) error {
+	if h.container.UsageService == nil {
+		return usageDisabled(c)
+	}
+
+	sessionID := c.Query("session_id")
+	if sessionID == "" {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "session_id is required",
+		})
+	}
+	// Signed session IDs are resolved by the ownership middleware
+	if rawSessionID, ok := c.Locals("session_id").(string); ok && rawSessionID != "" {
+		sessionID = rawSessionID
+	}
+
+	cost, err := h.container.UsageService.GetSessionCost(c.Context(), sessionID)
+	if err != nil {
+		log.Printf("Error getting session cost: %v", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Error:   "USAGE_FETCH_ERROR",
+			Message: "Failed to retrieve session cost",
+		})
+	}
+
+	return c.JSON(cost)
+}
+
+// GetUserUsage returns the authenticated user's usage per day
+// GET /api/user/usage?from=2025-01-01&to=2025-01-31
+func (h *UsageHandler) GetUserUsage(c *fiber.Ctx) error {
+	if h.container.UsageService == nil {
+		return usageDisabled(c)
+	}
+
+	userID, ok := c.Locals("user_id").(uuid.UUID)
+	if !ok {
+		return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
+			Error:   "unauthorized",
+			Message: "Authentication required",
+		})
+	}
+
+	filter, err := parseUsageFilter(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+	}
+	filter.UserID = &userID
+
+	return h.respondAggregate(c, filter, services.UsageGroupByDay)
+}
+
+// GetUsageStats aggregates usage across all replicas (admin)
+// GET /api/admin/usage?group_by=day|model|user&from=&to=&model=&user_id=
+func (h *UsageHandler) GetUsageStats(c *fiber.Ctx) error {
+	if h.container.UsageService == nil {
+		return usageDisabled(c)
+	}
+
+	filter, err := parseUsageFilter(c)
+	if err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: err.Error(),
+		})
+	}
+	if rawUserID := c.Query("user_id"); rawUserID != "" {
+		userID, err := uuid.Parse(rawUserID)
+		if err != nil {
+			return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+				Error:   "invalid_request",
+				Message: "Invalid user_id",
+			})
+		}
+		filter.UserID = &userID
+	}
+
+	return h.respondAggregate(c, filter, c.Query("group_by", services.UsageGroupByDay))
+}
+
+func (h *UsageHandler) respondAggregate(c *fiber.Ctx, filter models.UsageFilter, groupBy string) error {
+	switch groupBy {
+	case services.UsageGroupByDay, services.UsageGroupByModel, services.UsageGroupByUser:
+	default:
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "group_by must be one of: day, model, user",
+		})
+	}
+
+	buckets, err := h.container.UsageService.Aggregate(c.Context(), filter, groupBy)
+	if err != nil {
+		log.Printf("Error aggregating usage: %v", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Error:   "USAGE_FETCH_ERROR",
+			Message: "Failed to aggregate usage",
+		})
+	}
+
+	totals, err := h.container.UsageService.GetTotals(c.Context(), filter)
+	if err != nil {
+		log.Printf("Error aggregating usage totals: %v", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Error:   "USAGE_FETCH_ERROR",
+			Message: "Failed to aggregate usage",
+		})
+	}
+
+	return c.JSON(fiber.Map{
+		"group_by": groupBy,
+		"from":     filter.From,
+		"to":       filter.To,
+		"totals":   totals,
+		"items":    buckets,
+	})
+}
+
+// parseUsageFilter reads the from/to days (YYYY-MM-DD) and model query parameters
+func parseUsageFilter(c *fiber.Ctx) (models.UsageFilter, error) {
+	filter := models.UsageFilter{
+		From:  c.Query("from"),
+		To:    c.Query("to"),
+		Model: c.Query("model"),
+	}
+	for _, day := range []string{filter.From, filter.To} {
+		if day == "" {
+			continue
+		}
+		if _, err := time.Parse("2006-01-02", day); err != nil {
+			return filter, errors.New("from/to must be dates in YYYY-MM-DD format")
+		}
+	}
+	return filter, nil
+}
+
+func usageDisabled(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusServiceUnavailable).JSON(models.ErrorResponse{
+		Error:   "USAGE_TRACKING_DISABLED",
+		Message: "Usage tracking is disabled",
+	})
+}
@@ -0,0 +1,169 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"mylittleprice/internal/container"
+	"mylittleprice/internal/middleware"
+	"mylittleprice/internal/models"
+	"mylittleprice/internal/services"
+)
+
+type WatchlistHandler struct {
+	container *container.Container
+}
+
+func NewWatchlistHandler(container *container.Container) *WatchlistHandler {
+	return &WatchlistHandler{
+		container: container,
+	}
+}
+
+// GetWatchlist returns the authenticated user's watched products
+// GET /api/watchlist
+func (h *WatchlistHandler) GetWatchlist(c *fiber.Ctx) error {
+	if h.container.WatchlistService == nil {
+		return watchlistDisabled(c)
+	}
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		return authRequired(c)
+	}
+
+	items, err := h.container.WatchlistService.List(c.Context(), userID)
+	if err != nil {
+		return watchlistError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"items": items,
+	})
+}
+
+// AddToWatchlist watches a product card for a drop to a target price
+// POST /api/watchlist
+func (h *WatchlistHandler) AddToWatchlist(c *fiber.Ctx) error {
+	if h.container.WatchlistService == nil {
+		return watchlistDisabled(c)
+	}
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		return authRequired(c)
+	}
+
+	var req models.CreateWatchlistItemRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "Failed to parse request body",
+		})
+	}
+
+	item, err := h.container.WatchlistService.Add(c.Context(), userID, req)
+	if err != nil {
+		return watchlistError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(item)
+}
+
+// UpdateWatchlistItem changes the target price of a watched product
+// PUT /api/watchlist/:id
+func (h *WatchlistHandler) UpdateWatchlistItem(c *fiber.Ctx) error {
+	if h.container.WatchlistService == nil {
+		return watchlistDisabled(c)
+	}
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		return authRequired(c)
+	}
+
+	itemID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return watchlistError(c, services.ErrWatchlistItemNotFound)
+	}
+
+	var req models.UpdateWatchlistItemRequest
+	if err := c.BodyParser(&req); err != nil {
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "invalid_request",
+			Message: "Failed to parse request body",
+		})
+	}
+
+	item, err := h.container.WatchlistService.UpdateTarget(c.Context(), userID, itemID, req)
+	if err != nil {
+		return watchlistError(c, err)
+	}
+
+	return c.JSON(item)
+}
+
+// RemoveFromWatchlist stops watching a product
+// DELETE /api/watchlist/:id
+func (h *WatchlistHandler) RemoveFromWatchlist(c *fiber.Ctx) error {
+	if h.container.WatchlistService == nil {
+		return watchlistDisabled(c)
+	}
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		return authRequired(c)
+	}
+
+	itemID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		return watchlistError(c, services.ErrWatchlistItemNotFound)
+	}
+
+	if err := h.container.WatchlistService.Remove(c.Context(), userID, itemID); err != nil {
+		return watchlistError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// watchlistError maps a WatchlistService error to a response
+func watchlistError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, services.ErrWatchlistItemNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Error:   "WATCHLIST_ITEM_NOT_FOUND",
+			Message: "Watchlist item not found",
+		})
+	case errors.Is(err, services.ErrWatchlistFull):
+		return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
+			Error:   "WATCHLIST_FULL",
+			Message: "Your watchlist is full, remove a product first",
+		})
+	case errors.Is(err, services.ErrInvalidWatchlistItem):
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	default:
+		log.Printf("Error handling watchlist request: %v", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Error:   "WATCHLIST_ERROR",
+			Message: "Failed to process watchlist request",
+		})
+	}
+}
+
+// authRequired responds that the route needs a signed-in user
+func authRequired(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusUnauthorized).JSON(models.ErrorResponse{
+		Error:   "unauthorized",
+		Message: "Authentication required",
+	})
+}
+
+func watchlistDisabled(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusServiceUnavailable).JSON(models.ErrorResponse{
+		Error:   "WATCHLIST_DISABLED",
+		Message: "The watchlist is disabled",
+	})
+}
@@ -3,6 +3,7 @@ package handlers
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net"
@@ -13,6 +14,7 @@ import (
 	"github.com/google/uuid"
 
 	"mylittleprice/internal/container"
+	apperrors "mylittleprice/internal/errors"
 	"mylittleprice/internal/models"
 	"mylittleprice/internal/services"
 	"mylittleprice/internal/utils"
@@ -21,13 +23,33 @@ import (
 type Client struct {
 	Conn   *websocket.Conn
 	UserID *uuid.UUID // nil for anonymous users
+
+	writeMu sync.Mutex     // websocket connections support only one concurrent writer
+	turnMu  sync.Mutex     // chat turns of one connection are processed one at a time
+	turns   sync.WaitGroup // running chat turns; the conn must outlive them
+}
+
+// writeJSON serializes writes to the client's connection
+func (cl *Client) writeJSON(v interface{}) error {
+	cl.writeMu.Lock()
+	defer cl.writeMu.Unlock()
+	return cl.Conn.WriteJSON(v)
+}
+
+// chatTurn is a chat message being processed, cancellable via a "cancel" message
+type chatTurn struct {
+	clientID string
+	userID   *uuid.UUID
+	cancel   context.CancelFunc
 }
 
 type WSHandler struct {
 	container   *container.Container
 	processor   *ChatProcessor
 	clients     map[string]*Client            // clientID -> Client
+	connClients map[*websocket.Conn]*Client   // conn -> Client (for write locking)
 	userConns   map[uuid.UUID]map[string]bool // userID -> set of clientIDs
+	turns       map[string]*chatTurn          // user message ID -> in-flight chat turn
 	mu          sync.RWMutex
 	pubsub      *services.PubSubService // Redis Pub/Sub for cross-server communication
 	rateLimiter *utils.WSRateLimiter    // WebSocket message rate limiter
@@ -44,7 +66,9 @@ func NewWSHandler(c *container.Container) *WSHandler {
 		container:   c,
 		processor:   NewChatProcessor(c),
 		clients:     make(map[string]*Client),
+		connClients: make(map[*websocket.Conn]*Client),
 		userConns:   make(map[uuid.UUID]map[string]bool),
+		turns:       make(map[string]*chatTurn),
 		pubsub:      pubsub,
 		rateLimiter: rateLimiter,
 	}
@@ -53,6 +77,9 @@ func NewWSHandler(c *container.Container) *WSHandler {
 	// This allows this server to receive messages from other servers
 	pubsub.SubscribeToAllUsers(handler.handleBroadcastMessage)
 
+	// Per-user channels carry messages from background jobs (price alerts)
+	pubsub.SubscribeToUsers(handler.handleBroadcastMessage)
+
 	log.Printf("🚀 WebSocket handler initialized with Pub/Sub and Rate Limiting (ServerID: %s)", pubsub.GetServerID()[:8])
 
 	return handler
@@ -72,10 +99,19 @@ type WSMessage struct {
 	AccessToken     string                 `json:"access_token,omitempty"` // Optional JWT token for authentication
 	Preferences     map[string]interface{} `json:"preferences,omitempty"`  // For preferences sync
 	SavedSearch     *models.SavedSearch    `json:"saved_search,omitempty"` // For saved search sync
+	Stream          bool                   `json:"stream,omitempty"`       // Stream the reply as chat_chunk frames + chat_complete
+	Progress        bool                   `json:"progress,omitempty"`     // Send status frames while the reply is being processed
+	MessageID       string                 `json:"message_id,omitempty"`   // Client-generated user message ID (chat), or the message to abort (cancel)
+	SearchID        string                 `json:"search_id,omitempty"`    // Search to page through (more_results)
+	Cursor          string                 `json:"cursor,omitempty"`       // Next page cursor from the previous response (more_results)
+	WishlistID      string                 `json:"wishlist_id,omitempty"`  // Wishlist changed over the REST API (sync_wishlists)
+
+	Refinement *models.SearchRefinement `json:"refinement,omitempty"` // Sort and filters to apply (refine_results)
 }
 
 type WSResponse struct {
 	Type               string                         `json:"type"`
+	ResponseType       string                         `json:"response_type,omitempty"` // Final reply type ("dialogue", "search"...) on chat_complete
 	MessageID          string                         `json:"message_id,omitempty"` // Unique message ID for deduplication
 	Output             string                         `json:"output,omitempty"`
 	QuickReplies       []string                       `json:"quick_replies,omitempty"`
@@ -86,6 +122,14 @@ type WSResponse struct {
 	MessageCount       int                            `json:"message_count,omitempty"`
 	SearchState        *models.SearchStateResponse    `json:"search_state,omitempty"`
 	ProductDetails     *models.ProductDetailsResponse `json:"product_details,omitempty"`
+	Stage              string                         `json:"stage,omitempty"` // Processing stage on status frames
+	Count              int                            `json:"count,omitempty"` // Stage detail, e.g. number of products found
+	SearchID           string                         `json:"search_id,omitempty"`     // Stored result set of a search, paged with more_results
+	NextCursor         string                         `json:"next_cursor,omitempty"`   // Cursor for the next more_results request, empty on the last page
+	TotalResults       int                            `json:"total_results,omitempty"` // Products in the full result set
+	PriceAlert         *models.PriceAlert             `json:"price_alert,omitempty"`   // Watched product reached its target price (price_alert)
+	WishlistID         string                         `json:"wishlist_id,omitempty"`   // Changed wishlist (wishlists_updated)
+	Wishlist           *models.Wishlist               `json:"wishlist,omitempty"`      // Its new state, nil if it was deleted
 	Error              string                         `json:"error,omitempty"`
 	Message            string                         `json:"message,omitempty"`
 }
@@ -155,6 +199,11 @@ func (h *WSHandler) HandleWebSocket(c *websocket.Conn) {
 		h.handleMessage(c, &msg, clientID)
 	}
 
+	// The connection is recycled once this handler returns, so abort running
+	// chat turns and wait for them before leaving
+	h.cancelClientTurns(clientID)
+	client.turns.Wait()
+
 	log.Printf("🔌 Client disconnected: %s", clientID)
 }
 
@@ -185,9 +234,15 @@ func (h *WSHandler) handleMessage(c *websocket.Conn, msg *WSMessage, clientID st
 
 	switch msg.Type {
 	case "chat":
-		h.handleChat(c, msg, clientID)
+		h.startChat(c, msg, clientID)
+	case "cancel":
+		h.handleCancel(c, msg, clientID)
 	case "product_details":
 		h.handleProductDetails(c, msg)
+	case "more_results":
+		h.handleMoreResults(c, msg)
+	case "refine_results":
+		h.handleRefineResults(c, msg)
 	case "ping":
 		h.sendResponse(c, &WSResponse{Type: "pong"})
 	case "sync_preferences":
@@ -196,12 +251,85 @@ func (h *WSHandler) handleMessage(c *websocket.Conn, msg *WSMessage, clientID st
 		h.handleSyncSavedSearch(c, msg, clientID)
 	case "sync_session":
 		h.handleSyncSession(c, msg, clientID)
+	case "sync_wishlists":
+		h.handleSyncWishlists(c, msg, clientID)
 	default:
 		h.sendError(c, "unknown_message_type", "Unknown message type")
 	}
 }
 
-func (h *WSHandler) handleChat(c *websocket.Conn, msg *WSMessage, clientID string) {
+// startChat registers the chat turn and processes it in the background, so the
+// read loop stays free to receive pings and "cancel" messages meanwhile
+func (h *WSHandler) startChat(c *websocket.Conn, msg *WSMessage, clientID string) {
+	// Use the client's message ID if it sent a valid one, so it can cancel the turn later
+	userMessageID := uuid.New().String()
+	if msg.MessageID != "" {
+		if _, err := uuid.Parse(msg.MessageID); err == nil {
+			userMessageID = msg.MessageID
+		}
+	}
+
+	h.mu.Lock()
+	client, exists := h.clients[clientID]
+	_, duplicate := h.turns[userMessageID]
+	if !exists || duplicate {
+		h.mu.Unlock()
+		if duplicate {
+			h.sendError(c, "duplicate_message", "Message is already being processed")
+		}
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	h.turns[userMessageID] = &chatTurn{
+		clientID: clientID,
+		userID:   client.UserID,
+		cancel:   cancel,
+	}
+	client.turns.Add(1)
+	h.mu.Unlock()
+
+	go func() {
+		defer client.turns.Done()
+		defer func() {
+			h.mu.Lock()
+			delete(h.turns, userMessageID)
+			h.mu.Unlock()
+			cancel()
+		}()
+
+		client.turnMu.Lock()
+		defer client.turnMu.Unlock()
+
+		h.handleChat(ctx, c, msg, clientID, userMessageID)
+	}()
+}
+
+// handleCancel aborts an in-flight chat turn started by this connection or
+// another connection of the same user
+func (h *WSHandler) handleCancel(c *websocket.Conn, msg *WSMessage, clientID string) {
+	if msg.MessageID == "" {
+		h.sendError(c, "validation_error", "message_id is required")
+		return
+	}
+
+	h.mu.RLock()
+	turn, exists := h.turns[msg.MessageID]
+	client := h.clients[clientID]
+	h.mu.RUnlock()
+
+	allowed := exists && (turn.clientID == clientID ||
+		(turn.userID != nil && client != nil && client.UserID != nil && *turn.userID == *client.UserID))
+	if !allowed {
+		h.sendError(c, "not_found", "No message in progress with this ID")
+		return
+	}
+
+	log.Printf("🛑 Chat turn %s cancelled by client %s", msg.MessageID, clientID)
+	turn.cancel()
+}
+
+func (h *WSHandler) handleChat(ctx context.Context, c *websocket.Conn, msg *WSMessage, clientID, userMessageID string) {
 	// Extract user ID from access token if provided
 	var userID *uuid.UUID
 	if msg.AccessToken != "" {
@@ -233,7 +361,6 @@ func (h *WSHandler) handleChat(c *websocket.Conn, msg *WSMessage, clientID strin
 	}
 
 	// Generate message IDs upfront for consistent deduplication across devices
-	userMessageID := uuid.New().String()
 	assistantMessageID := uuid.New().String()
 
 	// Broadcast user message to other devices BEFORE processing
@@ -262,7 +389,31 @@ func (h *WSHandler) handleChat(c *websocket.Conn, msg *WSMessage, clientID strin
 		AssistantMessageID: assistantMessageID, // Pass pre-generated assistant message ID
 	}
 
-	result := h.processor.ProcessChat(processorReq)
+	// Streaming: forward output text as it is generated, keyed by the assistant message ID
+	if msg.Stream {
+		processorReq.OnOutputChunk = func(delta string) {
+			h.sendResponse(c, &WSResponse{
+				Type:      "chat_chunk",
+				MessageID: assistantMessageID,
+				Output:    delta,
+				SessionID: sessionID,
+			})
+		}
+	}
+
+	if msg.Progress {
+		processorReq.OnProgress = func(event services.ProgressEvent) {
+			h.sendResponse(c, &WSResponse{
+				Type:      "status",
+				MessageID: assistantMessageID,
+				SessionID: sessionID,
+				Stage:     string(event.Stage),
+				Count:     event.Count,
+			})
+		}
+	}
+
+	result := h.processor.ProcessChat(ctx, processorReq)
 
 	// Handle errors
 	if result.Error != nil {
@@ -286,6 +437,15 @@ func (h *WSHandler) handleChat(c *websocket.Conn, msg *WSMessage, clientID strin
 		SessionID:          result.SessionID,
 		MessageCount:       result.MessageCount,
 		SearchState:        result.SearchState,
+		SearchID:           result.SearchID,
+		NextCursor:         result.NextCursor,
+		TotalResults:       result.TotalResults,
+	}
+
+	// Streaming clients get the full reply as chat_complete, replacing the streamed text
+	if msg.Stream {
+		response.ResponseType = response.Type
+		response.Type = "chat_complete"
 	}
 
 	// Send response to the sender
@@ -305,6 +465,9 @@ func (h *WSHandler) handleChat(c *websocket.Conn, msg *WSMessage, clientID strin
 			SessionID:          result.SessionID,
 			MessageCount:       result.MessageCount,
 			SearchState:        result.SearchState,
+			SearchID:           result.SearchID,
+			NextCursor:         result.NextCursor,
+			TotalResults:       result.TotalResults,
 		}
 		h.broadcastToUser(*userID, syncMsg, clientID)
 	}
@@ -331,20 +494,35 @@ func (h *WSHandler) handleProductDetails(c *websocket.Conn, msg *WSMessage) {
 		sessionID = baseSessionID
 	}
 
-	cachedProduct, err := h.container.CacheService.GetProductByToken(msg.PageToken)
-	if err == nil && cachedProduct != nil {
-		h.sendProductDetailsResponse(c, cachedProduct, sessionID)
-		return
+	var userID *uuid.UUID
+	if msg.AccessToken != "" {
+		claims, err := h.container.JWTService.ValidateAccessToken(msg.AccessToken)
+		if err == nil {
+			userID = &claims.UserID
+		}
 	}
 
-	startTime := time.Now()
 	ctx := context.Background()
-	productDetails, keyIndex, err := h.container.SerpService.GetProductDetailsByToken(ctx, msg.PageToken)
-	responseTime := time.Since(startTime)
+	quotas := h.container.QuotaService
+	quotaSubject, _, quotaErr := checkProductDetailsQuota(ctx, quotas, userID, msg.BrowserID, sessionID)
+	if quotaErr != nil {
+		h.sendError(c, quotaErr.Code, quotaErr.Message)
+		return
+	}
 
-	h.container.SerpRotator.RecordUsage(keyIndex, err == nil, responseTime)
+	cachedProduct, err := h.container.CacheService.GetProductByToken(msg.PageToken)
+	if err == nil && cachedProduct != nil {
+		consumeProductDetailsQuota(ctx, quotas, quotaSubject)
+		h.sendProductDetailsResponse(c, cachedProduct, sessionID, msg.Currency)
+		return
+	}
 
+	productDetails, err := h.container.ProductSearch.GetDetails(ctx, msg.PageToken, msg.Country)
 	if err != nil {
+		if appErr := apperrors.FromUpstream(err); appErr != nil {
+			h.sendError(c, appErr.Code, appErr.Message)
+			return
+		}
 		h.sendError(c, "fetch_error", "Failed to fetch product details")
 		return
 	}
@@ -353,15 +531,83 @@ func (h *WSHandler) handleProductDetails(c *websocket.Conn, msg *WSMessage) {
 		fmt.Printf("⚠️ Failed to cache product details: %v\n", err)
 	}
 
-	h.sendProductDetailsResponse(c, productDetails, sessionID)
+	consumeProductDetailsQuota(ctx, quotas, quotaSubject)
+	h.sendProductDetailsResponse(c, productDetails, sessionID, msg.Currency)
+}
+
+// handleMoreResults sends the next page of a search's results. It doesn't run
+// a new product search or count against search limits.
+func (h *WSHandler) handleMoreResults(c *websocket.Conn, msg *WSMessage) {
+	if msg.SearchID == "" {
+		h.sendError(c, "validation_error", "Search ID is required")
+		return
+	}
+
+	page, err := h.container.SearchResults.Page(context.Background(), msg.SearchID, h.messageUserID(msg), msg.Cursor, 0)
+	if err != nil {
+		code, message, _ := searchResultsError(err)
+		h.sendError(c, code, message)
+		return
+	}
+
+	h.sendSearchResultPage(c, "more_results", msg, page)
 }
 
-func (h *WSHandler) sendProductDetailsResponse(c *websocket.Conn, productData map[string]interface{}, sessionID string) {
+// handleRefineResults sorts and filters a search's results into a new search
+// and sends its first page; further pages come from more_results with the
+// new search ID
+func (h *WSHandler) handleRefineResults(c *websocket.Conn, msg *WSMessage) {
+	if msg.SearchID == "" {
+		h.sendError(c, "validation_error", "Search ID is required")
+		return
+	}
+	if msg.Refinement == nil {
+		h.sendError(c, "validation_error", "Refinement is required")
+		return
+	}
+
+	page, err := h.container.SearchResults.Refine(context.Background(), msg.SearchID, h.messageUserID(msg), *msg.Refinement)
+	if err != nil {
+		code, message, _ := searchResultsError(err)
+		h.sendError(c, code, message)
+		return
+	}
+
+	h.sendSearchResultPage(c, "refine_results", msg, page)
+}
+
+// sendSearchResultPage sends a page with prices in the message's currency
+func (h *WSHandler) sendSearchResultPage(c *websocket.Conn, responseType string, msg *WSMessage, page *models.SearchResultPage) {
+	h.sendResponse(c, &WSResponse{
+		Type:         responseType,
+		Products:     h.container.FXService.ConvertCards(page.Products, msg.Currency),
+		SessionID:    msg.SessionID,
+		SearchID:     page.SearchID,
+		NextCursor:   page.NextCursor,
+		TotalResults: page.Total,
+	})
+}
+
+// messageUserID returns the user of the message's access token, if valid
+func (h *WSHandler) messageUserID(msg *WSMessage) *uuid.UUID {
+	if msg.AccessToken == "" {
+		return nil
+	}
+	claims, err := h.container.JWTService.ValidateAccessToken(msg.AccessToken)
+	if err != nil {
+		return nil
+	}
+	return &claims.UserID
+}
+
+func (h *WSHandler) sendProductDetailsResponse(c *websocket.Conn, productData map[string]interface{}, sessionID, currency string) {
 	details, err := FormatProductDetails(productData)
 	if err != nil {
 		h.sendError(c, "parse_error", err.Error())
 		return
 	}
+	details.Offers = h.container.FXService.ConvertOffers(details.Offers, currency)
+	withBestOffer(details)
 
 	h.sendResponse(c, &WSResponse{
 		Type:           "product_details",
@@ -374,6 +620,7 @@ func (h *WSHandler) addClient(id string, client *Client) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
 	h.clients[id] = client
+	h.connClients[client.Conn] = client
 }
 
 func (h *WSHandler) removeClient(id string) {
@@ -399,9 +646,22 @@ func (h *WSHandler) removeClient(id string) {
 	// Remove rate limit data for this connection
 	h.rateLimiter.RemoveConnection(id)
 
+	delete(h.connClients, client.Conn)
 	delete(h.clients, id)
 }
 
+// cancelClientTurns aborts all chat turns started by a connection
+func (h *WSHandler) cancelClientTurns(clientID string) {
+	h.mu.RLock()
+	defer h.mu.RUnlock()
+
+	for _, turn := range h.turns {
+		if turn.clientID == clientID {
+			turn.cancel()
+		}
+	}
+}
+
 func (h *WSHandler) updateClientUser(clientID string, userID *uuid.UUID) {
 	h.mu.Lock()
 	defer h.mu.Unlock()
@@ -454,7 +714,7 @@ func (h *WSHandler) broadcastToUser(userID uuid.UUID, response *WSResponse, excl
 				continue
 			}
 
-			if err := client.Conn.WriteJSON(response); err != nil {
+			if err := client.writeJSON(response); err != nil {
 				log.Printf("❌ Failed to broadcast to client %s: %v", cid, err)
 			}
 		}
@@ -512,7 +772,7 @@ func (h *WSHandler) handleBroadcastMessage(msg *services.BroadcastMessage) {
 			continue
 		}
 
-		if err := client.Conn.WriteJSON(payload); err != nil {
+		if err := client.writeJSON(payload); err != nil {
 			log.Printf("❌ Failed to send broadcast message to client %s: %v", cid, err)
 		} else {
 			log.Printf("📨 Broadcast from server %s delivered to client %s", msg.ServerID[:8], cid[:8])
@@ -578,6 +838,45 @@ func (h *WSHandler) handleSyncSavedSearch(c *websocket.Conn, msg *WSMessage, cli
 	h.broadcastToUser(claims.UserID, syncMsg, clientID)
 }
 
+// handleSyncWishlists sends a wishlist the client changed over the REST API
+// to the user's other devices
+func (h *WSHandler) handleSyncWishlists(c *websocket.Conn, msg *WSMessage, clientID string) {
+	if msg.AccessToken == "" {
+		h.sendError(c, "auth_required", "Authentication required for wishlist sync")
+		return
+	}
+
+	claims, err := h.container.JWTService.ValidateAccessToken(msg.AccessToken)
+	if err != nil {
+		h.sendError(c, "invalid_token", "Invalid access token")
+		return
+	}
+
+	wishlistID, err := uuid.Parse(msg.WishlistID)
+	if err != nil {
+		h.sendError(c, "invalid_request", "wishlist_id is required")
+		return
+	}
+
+	// A wishlist that no longer exists was deleted
+	wishlist, err := h.container.WishlistService.Get(context.Background(), claims.UserID, wishlistID)
+	if err != nil && !errors.Is(err, services.ErrWishlistNotFound) {
+		log.Printf("❌ Failed to load wishlist %s for sync: %v", wishlistID, err)
+		h.sendError(c, "sync_failed", "Failed to sync wishlist")
+		return
+	}
+
+	syncMsg := &WSResponse{
+		Type:       "wishlists_updated",
+		SessionID:  msg.SessionID,
+		WishlistID: wishlistID.String(),
+		Wishlist:   wishlist,
+	}
+
+	h.sendResponse(c, &WSResponse{Type: "sync_ack"})
+	h.broadcastToUser(claims.UserID, syncMsg, clientID)
+}
+
 // handleSyncSession handles session change synchronization across devices
 func (h *WSHandler) handleSyncSession(c *websocket.Conn, msg *WSMessage, clientID string) {
 	// Extract user ID from access token
@@ -601,7 +900,7 @@ func (h *WSHandler) handleSyncSession(c *websocket.Conn, msg *WSMessage, clientI
 }
 
 func (h *WSHandler) sendResponse(c *websocket.Conn, response *WSResponse) {
-	if err := c.WriteJSON(response); err != nil {
+	if err := h.writeJSON(c, response); err != nil {
 		log.Printf("❌ Failed to send response: %v", err)
 		h.recordMessageSendFailed(response.Type, "write_error")
 	} else {
@@ -609,6 +908,19 @@ func (h *WSHandler) sendResponse(c *websocket.Conn, response *WSResponse) {
 	}
 }
 
+// writeJSON writes to c under its client's write lock; chat turns, pings and
+// Pub/Sub broadcasts may write to the same connection concurrently
+func (h *WSHandler) writeJSON(c *websocket.Conn, v interface{}) error {
+	h.mu.RLock()
+	client, exists := h.connClients[c]
+	h.mu.RUnlock()
+
+	if !exists {
+		return c.WriteJSON(v)
+	}
+	return client.writeJSON(v)
+}
+
 func (h *WSHandler) sendError(c *websocket.Conn, errorCode, message string) {
 	h.sendResponse(c, &WSResponse{
 		Type:    "error",
@@ -0,0 +1,255 @@
+package handlers
+
+import (
+	"errors"
+	"log"
+
+	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
+
+	"mylittleprice/internal/container"
+	"mylittleprice/internal/middleware"
+	"mylittleprice/internal/models"
+	"mylittleprice/internal/services"
+)
+
+type WishlistHandler struct {
+	container *container.Container
+}
+
+func NewWishlistHandler(container *container.Container) *WishlistHandler {
+	return &WishlistHandler{
+		container: container,
+	}
+}
+
+// GetWishlists returns the authenticated user's wishlists with their items
+// GET /api/user/wishlists
+func (h *WishlistHandler) GetWishlists(c *fiber.Ctx) error {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		return authRequired(c)
+	}
+
+	wishlists, err := h.container.WishlistService.List(c.Context(), userID)
+	if err != nil {
+		return wishlistError(c, err)
+	}
+
+	return c.JSON(fiber.Map{
+		"wishlists": wishlists,
+	})
+}
+
+// CreateWishlist creates an empty wishlist
+// POST /api/user/wishlists
+func (h *WishlistHandler) CreateWishlist(c *fiber.Ctx) error {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		return authRequired(c)
+	}
+
+	var req models.WishlistRequest
+	if err := c.BodyParser(&req); err != nil {
+		return invalidWishlistBody(c)
+	}
+
+	wishlist, err := h.container.WishlistService.Create(c.Context(), userID, req.Name)
+	if err != nil {
+		return wishlistError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(wishlist)
+}
+
+// GetWishlist returns one wishlist with its items
+// GET /api/user/wishlists/:id
+func (h *WishlistHandler) GetWishlist(c *fiber.Ctx) error {
+	userID, wishlistID, ok := wishlistParams(c)
+	if !ok {
+		return nil
+	}
+
+	wishlist, err := h.container.WishlistService.Get(c.Context(), userID, wishlistID)
+	if err != nil {
+		return wishlistError(c, err)
+	}
+
+	return c.JSON(wishlist)
+}
+
+// RenameWishlist renames a wishlist
+// PUT /api/user/wishlists/:id
+func (h *WishlistHandler) RenameWishlist(c *fiber.Ctx) error {
+	userID, wishlistID, ok := wishlistParams(c)
+	if !ok {
+		return nil
+	}
+
+	var req models.WishlistRequest
+	if err := c.BodyParser(&req); err != nil {
+		return invalidWishlistBody(c)
+	}
+
+	wishlist, err := h.container.WishlistService.Rename(c.Context(), userID, wishlistID, req.Name)
+	if err != nil {
+		return wishlistError(c, err)
+	}
+
+	return c.JSON(wishlist)
+}
+
+// DeleteWishlist deletes a wishlist and its items
+// DELETE /api/user/wishlists/:id
+func (h *WishlistHandler) DeleteWishlist(c *fiber.Ctx) error {
+	userID, wishlistID, ok := wishlistParams(c)
+	if !ok {
+		return nil
+	}
+
+	if err := h.container.WishlistService.Delete(c.Context(), userID, wishlistID); err != nil {
+		return wishlistError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// AddWishlistItem saves a product card to a wishlist
+// POST /api/user/wishlists/:id/items
+func (h *WishlistHandler) AddWishlistItem(c *fiber.Ctx) error {
+	userID, wishlistID, ok := wishlistParams(c)
+	if !ok {
+		return nil
+	}
+
+	var req models.AddWishlistItemRequest
+	if err := c.BodyParser(&req); err != nil {
+		return invalidWishlistBody(c)
+	}
+
+	item, err := h.container.WishlistService.AddItem(c.Context(), userID, wishlistID, req)
+	if err != nil {
+		return wishlistError(c, err)
+	}
+
+	return c.Status(fiber.StatusCreated).JSON(item)
+}
+
+// RemoveWishlistItem removes a product from a wishlist
+// DELETE /api/user/wishlists/:id/items/:itemId
+func (h *WishlistHandler) RemoveWishlistItem(c *fiber.Ctx) error {
+	userID, wishlistID, ok := wishlistParams(c)
+	if !ok {
+		return nil
+	}
+
+	itemID, err := uuid.Parse(c.Params("itemId"))
+	if err != nil {
+		return wishlistError(c, services.ErrWishlistItemNotFound)
+	}
+
+	if err := h.container.WishlistService.RemoveItem(c.Context(), userID, wishlistID, itemID); err != nil {
+		return wishlistError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// ShareWishlist creates a public read-only link to a wishlist, revoking
+// the previous one
+// POST /api/user/wishlists/:id/share
+func (h *WishlistHandler) ShareWishlist(c *fiber.Ctx) error {
+	userID, wishlistID, ok := wishlistParams(c)
+	if !ok {
+		return nil
+	}
+
+	wishlist, err := h.container.WishlistService.Share(c.Context(), userID, wishlistID)
+	if err != nil {
+		return wishlistError(c, err)
+	}
+
+	return c.JSON(wishlist)
+}
+
+// UnshareWishlist revokes a wishlist's share link
+// DELETE /api/user/wishlists/:id/share
+func (h *WishlistHandler) UnshareWishlist(c *fiber.Ctx) error {
+	userID, wishlistID, ok := wishlistParams(c)
+	if !ok {
+		return nil
+	}
+
+	if err := h.container.WishlistService.Unshare(c.Context(), userID, wishlistID); err != nil {
+		return wishlistError(c, err)
+	}
+
+	return c.SendStatus(fiber.StatusNoContent)
+}
+
+// GetSharedWishlist returns a wishlist through its share link (public)
+// GET /api/wishlists/shared/:token
+func (h *WishlistHandler) GetSharedWishlist(c *fiber.Ctx) error {
+	wishlist, err := h.container.WishlistService.GetShared(c.Context(), c.Params("token"))
+	if err != nil {
+		return wishlistError(c, err)
+	}
+
+	return c.JSON(wishlist)
+}
+
+// wishlistParams returns the authenticated user and the :id wishlist. If
+// it reports false, the error response has been sent.
+func wishlistParams(c *fiber.Ctx) (uuid.UUID, uuid.UUID, bool) {
+	userID, ok := middleware.GetUserID(c)
+	if !ok {
+		_ = authRequired(c)
+		return uuid.Nil, uuid.Nil, false
+	}
+
+	wishlistID, err := uuid.Parse(c.Params("id"))
+	if err != nil {
+		_ = wishlistError(c, services.ErrWishlistNotFound)
+		return uuid.Nil, uuid.Nil, false
+	}
+	return userID, wishlistID, true
+}
+
+// wishlistError maps a WishlistService error to a response
+func wishlistError(c *fiber.Ctx, err error) error {
+	switch {
+	case errors.Is(err, services.ErrWishlistNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Error:   "WISHLIST_NOT_FOUND",
+			Message: "Wishlist not found",
+		})
+	case errors.Is(err, services.ErrWishlistItemNotFound):
+		return c.Status(fiber.StatusNotFound).JSON(models.ErrorResponse{
+			Error:   "WISHLIST_ITEM_NOT_FOUND",
+			Message: "Wishlist item not found",
+		})
+	case errors.Is(err, services.ErrWishlistLimit):
+		return c.Status(fiber.StatusConflict).JSON(models.ErrorResponse{
+			Error:   "WISHLIST_LIMIT",
+			Message: err.Error(),
+		})
+	case errors.Is(err, services.ErrInvalidWishlist):
+		return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+			Error:   "validation_error",
+			Message: err.Error(),
+		})
+	default:
+		log.Printf("Error handling wishlist request: %v", err)
+		return c.Status(fiber.StatusInternalServerError).JSON(models.ErrorResponse{
+			Error:   "WISHLIST_ERROR",
+			Message: "Failed to process wishlist request",
+		})
+	}
+}
+
+func invalidWishlistBody(c *fiber.Ctx) error {
+	return c.Status(fiber.StatusBadRequest).JSON(models.ErrorResponse{
+		Error:   "invalid_request",
+		Message: "Failed to parse request body",
+	})
+}
@@ -0,0 +1,74 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"mylittleprice/internal/services"
+	"mylittleprice/internal/utils"
+)
+
+// FXRefreshJob periodically reloads exchange rates from their sources
+type FXRefreshJob struct {
+	fxService *services.FXService
+	interval  time.Duration
+	ctx       context.Context
+	cancel    context.CancelFunc
+}
+
+// NewFXRefreshJob creates a new exchange rate refresh job instance
+func NewFXRefreshJob(fx *services.FXService, interval time.Duration) *FXRefreshJob {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &FXRefreshJob{
+		fxService: fx,
+		interval:  interval,
+		ctx:       ctx,
+		cancel:    cancel,
+	}
+}
+
+// Start begins the refresh job ticker
+func (j *FXRefreshJob) Start() {
+	ticker := time.NewTicker(j.interval)
+	go func() {
+		// Refresh immediately on start
+		j.runRefresh()
+
+		for {
+			select {
+			case <-ticker.C:
+				j.runRefresh()
+			case <-j.ctx.Done():
+				ticker.Stop()
+				utils.LogInfo(j.ctx, "exchange rate refresh job ticker stopped")
+				return
+			}
+		}
+	}()
+	utils.LogInfo(j.ctx, "exchange rate refresh job started", slog.Duration("interval", j.interval))
+}
+
+// runRefresh reloads the rates. On failure the previous rates stay in use.
+func (j *FXRefreshJob) runRefresh() {
+	startTime := time.Now()
+
+	ctx, cancel := context.WithTimeout(j.ctx, time.Minute)
+	defer cancel()
+
+	if err := j.fxService.Refresh(ctx); err != nil {
+		utils.LogError(j.ctx, "exchange rate refresh failed", err,
+			slog.Duration("duration", time.Since(startTime)),
+			slog.Time("rates_updated_at", j.fxService.UpdatedAt()),
+		)
+		return
+	}
+
+	utils.LogInfo(j.ctx, "exchange rates refreshed", slog.Duration("duration", time.Since(startTime)))
+}
+
+// Stop gracefully stops the refresh job
+func (j *FXRefreshJob) Stop() {
+	j.cancel()
+	utils.LogInfo(j.ctx, "exchange rate refresh job stopped")
+}
@@ -0,0 +1,78 @@
+package jobs
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"mylittleprice/internal/services"
+	"mylittleprice/internal/utils"
+)
+
+// watchlistRecheckTick is how often the job looks for watched products due
+// for a re-check; how often each one is re-checked is up to the service
+const watchlistRecheckTick = 15 * time.Minute
+
+// WatchlistRecheckJob periodically re-checks the prices of watched products
+type WatchlistRecheckJob struct {
+	watchlistService *services.WatchlistService
+	interval         time.Duration
+	ctx              context.Context
+	cancel           context.CancelFunc
+}
+
+// NewWatchlistRecheckJob creates a new watchlist re-check job instance
+func NewWatchlistRecheckJob(watchlist *services.WatchlistService) *WatchlistRecheckJob {
+	ctx, cancel := context.WithCancel(context.Background())
+	return &WatchlistRecheckJob{
+		watchlistService: watchlist,
+		interval:         watchlistRecheckTick,
+		ctx:              ctx,
+		cancel:           cancel,
+	}
+}
+
+// Start begins the re-check job ticker
+func (j *WatchlistRecheckJob) Start() {
+	ticker := time.NewTicker(j.interval)
+	go func() {
+		for {
+			select {
+			case <-ticker.C:
+				j.runRecheck()
+			case <-j.ctx.Done():
+				ticker.Stop()
+				utils.LogInfo(j.ctx, "watchlist re-check job ticker stopped")
+				return
+			}
+		}
+	}()
+	utils.LogInfo(j.ctx, "watchlist re-check job started", slog.Duration("interval", j.interval))
+}
+
+// runRecheck re-checks the items that are due
+func (j *WatchlistRecheckJob) runRecheck() {
+	startTime := time.Now()
+
+	checked, alerted, err := j.watchlistService.RecheckDue(j.ctx)
+	if err != nil {
+		utils.LogError(j.ctx, "watchlist re-check failed", err,
+			slog.Duration("duration", time.Since(startTime)),
+		)
+		return
+	}
+
+	if checked > 0 {
+		utils.LogInfo(j.ctx, "watchlist re-check completed",
+			slog.Duration("duration", time.Since(startTime)),
+			slog.Int("items_checked", checked),
+			slog.Int("alerts_sent", alerted),
+		)
+	}
+}
+
+// Stop gracefully stops the re-check job
+func (j *WatchlistRecheckJob) Stop() {
+	j.cancel()
+	utils.LogInfo(j.ctx, "watchlist re-check job stopped")
+}
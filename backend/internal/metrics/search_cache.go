@@ -0,0 +1,53 @@
+package metrics
+
+import (
+	"log"
+	"sync"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	// Search results cache metrics
+	SearchCacheLookups      *prometheus.CounterVec
+	SemanticCacheEntries    prometheus.Gauge
+	SemanticCacheSimilarity prometheus.Histogram
+
+	// Ensure metrics are registered only once
+	searchCacheMetricsOnce sync.Once
+)
+
+// RegisterSearchCacheMetrics registers all search cache metrics to default registry
+func RegisterSearchCacheMetrics() {
+	searchCacheMetricsOnce.Do(func() {
+		log.Printf("🔧 Registering Search cache metrics")
+
+		SearchCacheLookups = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Name: "search_cache_lookups_total",
+				Help: "Total number of search results cache lookups by result (hit, semantic_hit, miss)",
+			},
+			[]string{"result"},
+		)
+		prometheus.MustRegister(SearchCacheLookups)
+
+		SemanticCacheEntries = prometheus.NewGauge(
+			prometheus.GaugeOpts{
+				Name: "semantic_cache_entries",
+				Help: "Current number of queries in the semantic cache index",
+			},
+		)
+		prometheus.MustRegister(SemanticCacheEntries)
+
+		SemanticCacheSimilarity = prometheus.NewHistogram(
+			prometheus.HistogramOpts{
+				Name:    "semantic_cache_hit_similarity",
+				Help:    "Similarity between the query and the cached query of semantic cache hits",
+				Buckets: []float64{0.9, 0.92, 0.94, 0.96, 0.98, 1},
+			},
+		)
+		prometheus.MustRegister(SemanticCacheSimilarity)
+
+		log.Printf("✅ Search cache metrics registered successfully")
+	})
+}
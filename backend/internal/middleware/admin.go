@@ -0,0 +1,33 @@
+package middleware
+
+import (
+	"crypto/subtle"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// AdminMiddleware lets requests through only if their X-Admin-Token header
+// matches token. With an empty token, admin endpoints are disabled.
+func AdminMiddleware(token string) fiber.Handler {
+	return func(c *fiber.Ctx) error {
+		if token == "" {
+			return c.Status(fiber.StatusNotFound).JSON(fiber.Map{
+				"error": "admin API is disabled",
+			})
+		}
+
+		provided := c.Get("X-Admin-Token")
+		if provided == "" {
+			return c.Status(fiber.StatusUnauthorized).JSON(fiber.Map{
+				"error": "missing admin token",
+			})
+		}
+		if subtle.ConstantTimeCompare([]byte(provided), []byte(token)) != 1 {
+			return c.Status(fiber.StatusForbidden).JSON(fiber.Map{
+				"error": "invalid admin token",
+			})
+		}
+
+		return c.Next()
+	}
+}
@@ -25,18 +25,22 @@ type ChatResponse struct {
 	SessionID          string               `json:"session_id"`
 	MessageCount       int                  `json:"message_count"`
 	SearchState        *SearchStateResponse `json:"search_state,omitempty"`
+	SearchID           string               `json:"search_id,omitempty"`     // Pages through the full result set
+	NextCursor         string               `json:"next_cursor,omitempty"`   // Cursor of the next page, empty when all products are shown
+	TotalResults       int                  `json:"total_results,omitempty"` // Products in the full result set
 }
 
 type SearchStateResponse struct {
-	Status                 string `json:"status"`
-	Category               string `json:"category,omitempty"`
-	CanContinue            bool   `json:"can_continue"`
-	SearchCount            int    `json:"search_count"`
-	MaxSearches            int    `json:"max_searches"`
-	Message                string `json:"message,omitempty"`
-	AnonymousSearchUsed    int    `json:"anonymous_search_used"`     // Number of searches used without auth
-	AnonymousSearchLimit   int    `json:"anonymous_search_limit"`    // Maximum allowed anonymous searches
-	RequiresAuthentication bool   `json:"requires_authentication"`   // True if user needs to login/signup
+	Status                 string       `json:"status"`
+	Category               string       `json:"category,omitempty"`
+	CanContinue            bool         `json:"can_continue"`
+	SearchCount            int          `json:"search_count"`
+	MaxSearches            int          `json:"max_searches"`
+	Message                string       `json:"message,omitempty"`
+	AnonymousSearchUsed    int          `json:"anonymous_search_used"`   // Number of searches used without auth
+	AnonymousSearchLimit   int          `json:"anonymous_search_limit"`  // Maximum allowed anonymous searches
+	RequiresAuthentication bool         `json:"requires_authentication"` // True if user needs to login/signup
+	Quota                  *QuotaStatus `json:"quota,omitempty"`         // Remaining plan budgets (when quotas are enabled)
 }
 
 // ═══════════════════════════════════════════════════════════
@@ -44,7 +48,7 @@ type SearchStateResponse struct {
 // ═══════════════════════════════════════════════════════════
 
 type GeminiResponse struct {
-	ResponseType       string   `json:"response_type"` // "dialogue", "search", or "api_request"
+	ResponseType       string   `json:"response_type"` // "dialogue" or "search"
 	Output             string   `json:"output"`
 	QuickReplies       []string `json:"quick_replies"`
 	SearchPhrase       string   `json:"search_phrase"` // For response_type="search"
@@ -58,9 +62,6 @@ type GeminiResponse struct {
 	Confidence         float32  `json:"confidence"`
 	RequiresInput      bool     `json:"requires_input"`
 	ProductDescription string   `json:"product_description,omitempty"` // AI-generated description about the products
-	// New fields for api_request response type
-	API    string                 `json:"api,omitempty"`    // API name (e.g., "google_shopping")
-	Params map[string]interface{} `json:"params,omitempty"` // API parameters
 }
 
 type SerpConfig struct {
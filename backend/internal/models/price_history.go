@@ -0,0 +1,39 @@
+package models
+
+import (
+	"time"
+
+	"mylittleprice/internal/domain"
+)
+
+// ═══════════════════════════════════════════════════════════
+// PRICE HISTORY MODELS
+// ═══════════════════════════════════════════════════════════
+
+// PriceStats summarizes a set of observed prices
+type PriceStats struct {
+	Min    domain.Money `json:"min"`
+	Max    domain.Money `json:"max"`
+	Median domain.Money `json:"median"`
+}
+
+// PricePoint summarizes the prices observed on one UTC day (YYYY-MM-DD)
+type PricePoint struct {
+	Date         string `json:"date"`
+	Observations int    `json:"observations"`
+	PriceStats
+}
+
+// PriceHistory is the price of a product over time, in one currency.
+// Observations is 0 (and the stats are zero) when none could be converted
+// to Currency.
+type PriceHistory struct {
+	ProductKey   string        `json:"product_key"`
+	Title        string        `json:"title"`
+	Currency     string        `json:"currency"`
+	Observations int           `json:"observations"`
+	Latest       *domain.Money `json:"latest,omitempty"`
+	LatestAt     *time.Time    `json:"latest_at,omitempty"`
+	PriceStats
+	Series []PricePoint `json:"series"` // Oldest day first
+}
@@ -1,12 +1,14 @@
 package models
 
+import "mylittleprice/internal/domain"
+
 // ═══════════════════════════════════════════════════════════
 // PRODUCT MODELS
 // ═══════════════════════════════════════════════════════════
 
 type ProductInfo struct {
-	Name  string  `json:"name"`
-	Price float64 `json:"price"`
+	Name  string       `json:"name"`
+	Price domain.Money `json:"price"`
 }
 
 type ProductCard struct {
@@ -18,11 +20,56 @@ type ProductCard struct {
 	Description string `json:"description,omitempty"`
 	Badge       string `json:"badge,omitempty"`
 	PageToken   string `json:"page_token"`
+
+	// Identity of the product across searches, see services.PriceProductKey
+	ProductID  string `json:"product_id,omitempty"`  // Provider's product ID, if it has one
+	ProductKey string `json:"product_key,omitempty"` // Key of the product's price history
+
+	// Attributes for sorting and filtering result sets
+	Merchant  string        `json:"merchant,omitempty"`
+	Amount    *domain.Money `json:"amount,omitempty"` // Parsed Price, nil if it couldn't be parsed
+	Rating    float32       `json:"rating,omitempty"`
+	Reviews   int           `json:"reviews,omitempty"`
+	Condition string        `json:"condition,omitempty"` // "used", "refurbished"...; empty for new products
+
+	// Price as listed by the merchant, when Price was converted to the user's currency
+	OriginalPrice  string        `json:"original_price,omitempty"`
+	OriginalAmount *domain.Money `json:"original_amount,omitempty"`
+
+	Ranking *RankingScore `json:"ranking,omitempty"` // Only set when RANKING_DEBUG is on
+}
+
+// RankingScore is how the search re-ranker scored a product: the weighted
+// total and the score of each scorer (0-1) by name
+type RankingScore struct {
+	Total  float64            `json:"total"`
+	Scores map[string]float64 `json:"scores"`
+}
+
+// SearchResultPage is one page of the stored result set of a search
+type SearchResultPage struct {
+	SearchID   string        `json:"search_id"`
+	Products   []ProductCard `json:"products"`
+	NextCursor string        `json:"next_cursor,omitempty"` // Empty on the last page
+	Total      int           `json:"total"`
+}
+
+// SearchRefinement sorts and filters the result set of a search. Zero
+// values don't filter.
+type SearchRefinement struct {
+	Sort      string   `json:"sort,omitempty"` // "price_asc", "price_desc", "rating" or "reviews"; empty keeps the ranking
+	MinPrice  *float64 `json:"min_price,omitempty"`
+	MaxPrice  *float64 `json:"max_price,omitempty"`
+	Merchants []string `json:"merchants,omitempty"` // Keep products of these merchants (case-insensitive substring)
+	MinRating float32  `json:"min_rating,omitempty"`
+	Condition string   `json:"condition,omitempty"` // "new", "used" or "refurbished"
 }
 
 type ProductDetailsRequest struct {
 	PageToken string `json:"page_token"`
 	Country   string `json:"country"`
+	BrowserID string `json:"browser_id"` // Persistent browser identifier for anonymous quotas
+	Currency  string `json:"currency"`   // Offer prices are converted to it (optional)
 }
 
 type ProductDetailsResponse struct {
@@ -36,9 +83,12 @@ type ProductDetailsResponse struct {
 	Specifications  []Specification       `json:"specifications,omitempty"`
 	Variants        []Variant             `json:"variants,omitempty"`
 	Offers          []Offer               `json:"offers"`
-	Videos          []interface{}         `json:"videos,omitempty"`
-	MoreOptions     []interface{}         `json:"more_options,omitempty"`
+	Videos          []domain.Video        `json:"videos,omitempty"`
+	MoreOptions     []domain.MoreOption   `json:"more_options,omitempty"`
 	RatingBreakdown []RatingBreakdownItem `json:"rating_breakdown,omitempty"`
+
+	BestOffer    *Offer      `json:"best_offer,omitempty"`    // First of OfferRanking
+	OfferRanking []OfferRank `json:"offer_ranking,omitempty"` // Offers best first
 }
 
 type Specification struct {
@@ -47,8 +97,8 @@ type Specification struct {
 }
 
 type Variant struct {
-	Title string        `json:"title"`
-	Items []interface{} `json:"items"`
+	Title string               `json:"title"`
+	Items []domain.VariantItem `json:"items"`
 }
 
 type Offer struct {
@@ -71,6 +121,22 @@ type Offer struct {
 	DetailsAndOffers  []string `json:"details_and_offers,omitempty"`
 	MonthlyPaymentDur int      `json:"monthly_payment_duration,omitempty"`
 	DownPayment       string   `json:"down_payment,omitempty"`
+
+	Amount *domain.Money `json:"amount,omitempty"` // Parsed Price, nil if it couldn't be parsed
+
+	// Price as listed by the merchant, when Price was converted to the user's currency
+	OriginalPrice  string        `json:"original_price,omitempty"`
+	OriginalAmount *domain.Money `json:"original_amount,omitempty"`
+}
+
+// OfferRank is an offer's place in the best offer ranking, which weighs
+// the landed price against the merchant's rating and leaves out of stock
+// offers for last
+type OfferRank struct {
+	Offer        int           `json:"offer"`                  // Index into Offers
+	LandedPrice  *domain.Money `json:"landed_price,omitempty"` // Price plus shipping, nil if unknown
+	Availability string        `json:"availability"`           // "in_stock", "limited", "out_of_stock" or "unknown"
+	Score        float64       `json:"score"`                  // 0-1, 0 when out of stock
 }
 
 type RatingBreakdownItem struct {
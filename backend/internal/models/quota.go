@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// ═══════════════════════════════════════════════════════════
+// QUOTA MODELS
+// ═══════════════════════════════════════════════════════════
+
+// QuotaStatus is what remains of a user's (or anonymous browser's) budgets
+type QuotaStatus struct {
+	Plan      string                         `json:"plan"`
+	Resources map[string]QuotaResourceStatus `json:"resources"` // "searches", "llm_tokens", "product_details"
+}
+
+// QuotaResourceStatus is the usage of one resource. Limits of 0 are unlimited.
+type QuotaResourceStatus struct {
+	DailyUsed    int64      `json:"daily_used"`
+	DailyLimit   int64      `json:"daily_limit"`
+	MonthlyUsed  int64      `json:"monthly_used"`
+	MonthlyLimit int64      `json:"monthly_limit"`
+	Remaining    int64      `json:"remaining"`          // Left in the tighter budget, -1 if unlimited
+	ResetAt      *time.Time `json:"reset_at,omitempty"` // When the tighter budget renews (UTC), nil if unlimited
+}
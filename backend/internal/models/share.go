@@ -0,0 +1,51 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ═══════════════════════════════════════════════════════════
+// CONVERSATION SHARE MODELS
+// ═══════════════════════════════════════════════════════════
+
+// ConversationShare is a share link to a conversation, as its owner sees it
+type ConversationShare struct {
+	ID           uuid.UUID  `json:"id"`
+	URL          string     `json:"url"`
+	Title        string     `json:"title,omitempty"`
+	MessageCount int        `json:"message_count"`
+	ViewCount    int        `json:"view_count"`
+	ExpiresAt    *time.Time `json:"expires_at,omitempty"` // Nil for links that don't expire
+	Revoked      bool       `json:"revoked"`
+	CreatedAt    time.Time  `json:"created_at"`
+}
+
+// SharedConversation is a conversation as shown through its share link:
+// read-only, without anything identifying its owner
+type SharedConversation struct {
+	Title     string          `json:"title,omitempty"`
+	Messages  []SharedMessage `json:"messages"`
+	Currency  string          `json:"currency,omitempty"`
+	ViewCount int             `json:"view_count"`
+	ExpiresAt *time.Time      `json:"expires_at,omitempty"`
+	CreatedAt time.Time       `json:"created_at"`
+}
+
+// SharedMessage is a message of a shared conversation
+type SharedMessage struct {
+	Role               string        `json:"role"`
+	Content            string        `json:"content"`
+	ResponseType       string        `json:"response_type,omitempty"`
+	Products           []ProductCard `json:"products,omitempty"`
+	ProductDescription string        `json:"product_description,omitempty"`
+	CreatedAt          time.Time     `json:"created_at"`
+}
+
+// CreateShareRequest shares a chat session
+type CreateShareRequest struct {
+	SessionID     string `json:"session_id"`
+	Title         string `json:"title,omitempty"`
+	ExpiresInDays int    `json:"expires_in_days,omitempty"` // 0 for a link that doesn't expire
+}
@@ -0,0 +1,47 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ═══════════════════════════════════════════════════════════
+// TOKEN USAGE MODELS
+// ═══════════════════════════════════════════════════════════
+
+// UsageTotals sums the LLM and embedding calls of a group
+type UsageTotals struct {
+	Calls         int     `json:"calls"`
+	InputTokens   int64   `json:"input_tokens"`
+	CachedTokens  int64   `json:"cached_tokens"`
+	OutputTokens  int64   `json:"output_tokens"`
+	GroundedCalls int     `json:"grounded_calls"`
+	CostUSD       float64 `json:"cost_usd"`
+}
+
+// UsageBucket is one row of an aggregation: Key is the model, the day
+// (YYYY-MM-DD) or the user ID, depending on the grouping
+type UsageBucket struct {
+	Key string `json:"key"`
+	UsageTotals
+}
+
+// UsageFilter narrows usage aggregation. Zero values match everything;
+// From/To are inclusive UTC days (YYYY-MM-DD).
+type UsageFilter struct {
+	UserID    *uuid.UUID
+	SessionID string
+	Model     string
+	From      string
+	To        string
+}
+
+// SessionCost is the accumulated cost of one chat session
+type SessionCost struct {
+	SessionID string        `json:"session_id"`
+	Totals    UsageTotals   `json:"totals"`
+	ByModel   []UsageBucket `json:"by_model"`
+	FirstCall *time.Time    `json:"first_call,omitempty"`
+	LastCall  *time.Time    `json:"last_call,omitempty"`
+}
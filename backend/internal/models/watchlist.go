@@ -0,0 +1,63 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+
+	"mylittleprice/internal/domain"
+)
+
+// ═══════════════════════════════════════════════════════════
+// WATCHLIST MODELS
+// ═══════════════════════════════════════════════════════════
+
+// WatchlistItem is a product the user is notified about when its price
+// drops to TargetPrice
+type WatchlistItem struct {
+	ID            uuid.UUID     `json:"id"`
+	ProductKey    string        `json:"product_key,omitempty"`
+	PageToken     string        `json:"page_token"`
+	Title         string        `json:"title"`
+	Link          string        `json:"link,omitempty"`
+	Image         string        `json:"image,omitempty"`
+	TargetPrice   domain.Money  `json:"target_price"`
+	Country       string        `json:"country,omitempty"`
+	LastPrice     *domain.Money `json:"last_price,omitempty"` // Lowest price at the last check
+	LastCheckedAt *time.Time    `json:"last_checked_at,omitempty"`
+	NotifiedAt    *time.Time    `json:"notified_at,omitempty"`
+	CreatedAt     time.Time     `json:"created_at"`
+}
+
+// CreateWatchlistItemRequest adds a product card to the watchlist.
+// TargetPrice is in major units of Currency.
+type CreateWatchlistItemRequest struct {
+	PageToken   string  `json:"page_token"`
+	ProductKey  string  `json:"product_key,omitempty"`
+	Title       string  `json:"title"`
+	Link        string  `json:"link,omitempty"`
+	Image       string  `json:"image,omitempty"`
+	TargetPrice float64 `json:"target_price"`
+	Currency    string  `json:"currency"`
+	Country     string  `json:"country,omitempty"`
+}
+
+// UpdateWatchlistItemRequest changes the target price of a watchlist item
+type UpdateWatchlistItemRequest struct {
+	TargetPrice float64 `json:"target_price"`
+	Currency    string  `json:"currency"`
+}
+
+// PriceAlert tells a user that a watched product reached its target price.
+// It is sent as a "price_alert" WebSocket frame and by email.
+type PriceAlert struct {
+	ItemID      uuid.UUID    `json:"item_id"`
+	ProductKey  string       `json:"product_key,omitempty"`
+	PageToken   string       `json:"page_token"`
+	Title       string       `json:"title"`
+	Link        string       `json:"link,omitempty"`
+	Image       string       `json:"image,omitempty"`
+	Price       domain.Money `json:"price"`
+	TargetPrice domain.Money `json:"target_price"`
+	Merchant    string       `json:"merchant,omitempty"` // Store with the lowest price
+}
@@ -0,0 +1,49 @@
+package models
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// ═══════════════════════════════════════════════════════════
+// WISHLIST MODELS
+// ═══════════════════════════════════════════════════════════
+
+// Wishlist is a named list of products the user saved
+type Wishlist struct {
+	ID        uuid.UUID      `json:"id"`
+	Name      string         `json:"name"`
+	Items     []WishlistItem `json:"items"` // Oldest first
+	Shared    bool           `json:"shared"`
+	ShareURL  string         `json:"share_url,omitempty"` // Public read-only link, while shared
+	CreatedAt time.Time      `json:"created_at"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// WishlistItem is a saved product, as its card looked when it was saved
+type WishlistItem struct {
+	ID        uuid.UUID   `json:"id"`
+	Product   ProductCard `json:"product"`
+	Note      string      `json:"note,omitempty"`
+	CreatedAt time.Time   `json:"created_at"`
+}
+
+// SharedWishlist is a wishlist as shown through its share link: read-only,
+// without anything identifying its owner
+type SharedWishlist struct {
+	Name      string         `json:"name"`
+	Items     []WishlistItem `json:"items"`
+	UpdatedAt time.Time      `json:"updated_at"`
+}
+
+// WishlistRequest creates or renames a wishlist
+type WishlistRequest struct {
+	Name string `json:"name"`
+}
+
+// AddWishlistItemRequest saves a product card to a wishlist
+type AddWishlistItemRequest struct {
+	Product ProductCard `json:"product"`
+	Note    string      `json:"note,omitempty"`
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/redis/go-redis/v9"
 
 	"mylittleprice/internal/config"
+	"mylittleprice/internal/metrics"
 	"mylittleprice/internal/models"
 )
 
@@ -17,56 +18,110 @@ type CacheService struct {
 	redis     *redis.Client
 	config    *config.Config
 	embedding *EmbeddingService
+	semantic  *SemanticCache // nil when SEMANTIC_CACHE_ENABLED is off
 	ctx       context.Context
 }
 
 // NewCacheService creates a new CacheService with injected dependencies
 // Following the Dependency Injection pattern used throughout the application
-func NewCacheService(redisClient *redis.Client, cfg *config.Config, embedding *EmbeddingService) *CacheService {
+func NewCacheService(redisClient *redis.Client, cfg *config.Config, embedding *EmbeddingService, semantic *SemanticCache) *CacheService {
 	return &CacheService{
 		redis:     redisClient,
 		config:    cfg,
 		embedding: embedding,
+		semantic:  semantic,
 		ctx:       context.Background(),
 	}
 }
 
-func (c *CacheService) GetSearchResults(cacheKey string) ([]models.ProductCard, error) {
-	data, err := c.redis.Get(c.ctx, cacheKey).Bytes()
+// Close stops the background work of the semantic cache
+func (c *CacheService) Close() {
+	if c.semantic != nil {
+		c.semantic.Close()
+	}
+}
+
+// GetSearchResults returns the cached results of the search, or else of the
+// most similar cached query in its partition (see SemanticCache)
+func (c *CacheService) GetSearchResults(ctx context.Context, key SearchCacheKey) ([]models.ProductCard, error) {
+	data, err := c.redis.Get(ctx, key.String()).Bytes()
 	if err == redis.Nil {
-		similarKey := c.embedding.FindSimilarCachedQuery(cacheKey, 0.92)
-		if similarKey != "" {
-			data, err = c.redis.Get(c.ctx, similarKey).Bytes()
-			if err == nil {
-				var cards []models.ProductCard
-				if err := json.Unmarshal(data, &cards); err == nil {
-					return cards, nil
+		if c.semantic != nil {
+			embedding, model := c.semantic.Embed(ctx, key.Query)
+			similarKey, similarity, ok := c.semantic.Lookup(ctx, key, embedding, model)
+			if ok {
+				data, err = c.redis.Get(ctx, similarKey).Bytes()
+				if err == nil {
+					if cards, err := decodeCachedSearch(data); err == nil {
+						metrics.SearchCacheLookups.WithLabelValues("semantic_hit").Inc()
+						metrics.SemanticCacheSimilarity.Observe(float64(similarity))
+						return cards, nil
+					}
 				}
 			}
 		}
+		metrics.SearchCacheLookups.WithLabelValues("miss").Inc()
 		return nil, fmt.Errorf("cache miss")
 	}
 	if err != nil {
 		return nil, fmt.Errorf("redis error: %w", err)
 	}
 
-	var cards []models.ProductCard
-	if err := json.Unmarshal(data, &cards); err != nil {
-		return nil, fmt.Errorf("unmarshal error: %w", err)
+	cards, err := decodeCachedSearch(data)
+	if err != nil {
+		return nil, err
 	}
 
+	metrics.SearchCacheLookups.WithLabelValues("hit").Inc()
 	return cards, nil
 }
 
-func (c *CacheService) SetSearchResults(cacheKey string, cards []models.ProductCard, ttl time.Duration) error {
+func (c *CacheService) SetSearchResults(ctx context.Context, key SearchCacheKey, cards []models.ProductCard, ttl time.Duration) error {
 	dedupedCards := c.deduplicateProducts(cards)
 
-	data, err := json.Marshal(dedupedCards)
+	var embedding []float32
+	var model string
+	if c.semantic != nil {
+		embedding, model = c.semantic.Embed(ctx, key.Query)
+	}
+
+	data, err := json.Marshal(cachedSearch{
+		Partition:      key.Partition,
+		Query:          key.Query,
+		Embedding:      embedding,
+		EmbeddingModel: model,
+		Products:       dedupedCards,
+	})
 	if err != nil {
 		return fmt.Errorf("marshal error: %w", err)
 	}
 
-	return c.redis.Set(c.ctx, cacheKey, data, ttl).Err()
+	if err := c.redis.Set(ctx, key.String(), data, ttl).Err(); err != nil {
+		return err
+	}
+
+	if c.semantic != nil {
+		c.semantic.Add(key, embedding, model, ttl)
+	}
+	return nil
+}
+
+// decodeCachedSearch reads cached search results, including the bare
+// product arrays cached before cachedSearch
+func decodeCachedSearch(data []byte) ([]models.ProductCard, error) {
+	if len(data) > 0 && data[0] == '[' {
+		var cards []models.ProductCard
+		if err := json.Unmarshal(data, &cards); err != nil {
+			return nil, fmt.Errorf("unmarshal error: %w", err)
+		}
+		return cards, nil
+	}
+
+	var cached cachedSearch
+	if err := json.Unmarshal(data, &cached); err != nil {
+		return nil, fmt.Errorf("unmarshal error: %w", err)
+	}
+	return cached.Products, nil
 }
 
 func (c *CacheService) deduplicateProducts(cards []models.ProductCard) []models.ProductCard {
@@ -81,14 +136,19 @@ func (c *CacheService) deduplicateProducts(cards []models.ProductCard) []models.
 		embedding []float32
 	}
 
-	// Pre-compute embeddings for all products (O(n) API calls)
+	// Pre-compute embeddings for all products (batched API calls)
+	names := make([]string, len(cards))
+	for i, card := range cards {
+		names[i] = card.Name
+	}
+	embeddings, _ := c.embedding.Embed(c.ctx, names)
+
 	cardsWithEmbeddings := make([]cardWithEmbedding, 0, len(cards))
-	for _, card := range cards {
-		emb := c.embedding.GetQueryEmbedding(card.Name)
-		if emb != nil {
+	for i, card := range cards {
+		if embeddings[i] != nil {
 			cardsWithEmbeddings = append(cardsWithEmbeddings, cardWithEmbedding{
 				card:      card,
-				embedding: emb,
+				embedding: embeddings[i],
 			})
 		}
 	}
@@ -8,23 +8,21 @@ import (
 	"strings"
 	"time"
 
-	"google.golang.org/genai"
-
 	"mylittleprice/internal/models"
 )
 
 // ContextExtractorService extracts structured information from conversation history
 // Uses AI to intelligently identify user preferences, requirements, and context
 type ContextExtractorService struct {
-	client    *genai.Client
+	llm       LLMProvider
 	ctx       context.Context
 	modelName string
 }
 
 // NewContextExtractorService creates a new context extractor
-func NewContextExtractorService(client *genai.Client, modelName string) *ContextExtractorService {
+func NewContextExtractorService(llm LLMProvider, modelName string) *ContextExtractorService {
 	return &ContextExtractorService{
-		client:    client,
+		llm:       llm,
 		ctx:       context.Background(),
 		modelName: modelName,
 	}
@@ -75,37 +73,24 @@ Rules:
 - Return ONLY valid JSON, no explanations`, conversationText, currentPrefJSON, currency, currency)
 
 	// Use fast model for extraction (token efficiency)
-	temp := float32(0.2) // Low temperature for more deterministic extraction
-	resp, err := c.client.Models.GenerateContent(
-		c.ctx,
-		c.modelName, // Fast model for extraction
-		genai.Text(prompt),
-		&genai.GenerateContentConfig{
-			Temperature:      &temp,
-			ResponseMIMEType: "application/json",
-			MaxOutputTokens:  500, // Small response
-		},
-	)
+	resp, err := c.llm.GenerateContent(c.ctx, &LLMRequest{
+		Model:           c.modelName, // Fast model for extraction
+		Prompt:          prompt,
+		Temperature:     0.2, // Low temperature for more deterministic extraction
+		MaxOutputTokens: 500, // Small response
+		JSONMode:        true,
+	})
 
 	if err != nil {
 		fmt.Printf("⚠️ Failed to extract preferences: %v\n", err)
 		return currentPreferences, err
 	}
 
-	if resp == nil || len(resp.Candidates) == 0 || resp.Candidates[0].Content == nil {
+	responseText := strings.TrimSpace(resp.Text)
+	if responseText == "" {
 		return currentPreferences, fmt.Errorf("empty response from preference extraction")
 	}
 
-	// Extract text from response
-	responseText := ""
-	for _, part := range resp.Candidates[0].Content.Parts {
-		if part.Text != "" {
-			responseText += part.Text
-		}
-	}
-
-	responseText = strings.TrimSpace(responseText)
-
 	// Parse JSON response
 	var extracted models.ConversationPreferences
 	if err := json.Unmarshal([]byte(responseText), &extracted); err != nil {
@@ -157,35 +142,19 @@ Recent conversation:
 
 Return a clear, concise summary in %s language. Maximum 3 sentences.`, previousSummaryText, conversationText, language)
 
-	temp := float32(0.3) // Low temperature for consistent summaries
-	resp, err := c.client.Models.GenerateContent(
-		c.ctx,
-		c.modelName,
-		genai.Text(prompt),
-		&genai.GenerateContentConfig{
-			Temperature:     &temp,
-			MaxOutputTokens: 200, // Short summary
-		},
-	)
+	resp, err := c.llm.GenerateContent(c.ctx, &LLMRequest{
+		Model:           c.modelName,
+		Prompt:          prompt,
+		Temperature:     0.3, // Low temperature for consistent summaries
+		MaxOutputTokens: 200, // Short summary
+	})
 
 	if err != nil {
 		fmt.Printf("⚠️ Failed to generate summary: %v\n", err)
 		return previousSummary, err
 	}
 
-	if resp == nil || len(resp.Candidates) == 0 || resp.Candidates[0].Content == nil {
-		return previousSummary, fmt.Errorf("empty response from summary generation")
-	}
-
-	// Extract summary text
-	summary := ""
-	for _, part := range resp.Candidates[0].Content.Parts {
-		if part.Text != "" {
-			summary += part.Text
-		}
-	}
-
-	summary = strings.TrimSpace(summary)
+	summary := strings.TrimSpace(resp.Text)
 
 	if summary == "" {
 		return previousSummary, nil
@@ -27,12 +27,14 @@ const (
 // ContextOptimizerService determines optimal context depth for each request
 type ContextOptimizerService struct {
 	embedding *EmbeddingService
+	taxonomy  *TaxonomyService
 }
 
 // NewContextOptimizerService creates a new context optimizer
-func NewContextOptimizerService(embedding *EmbeddingService) *ContextOptimizerService {
+func NewContextOptimizerService(embedding *EmbeddingService, taxonomy *TaxonomyService) *ContextOptimizerService {
 	return &ContextOptimizerService{
 		embedding: embedding,
+		taxonomy:  taxonomy,
 	}
 }
 
@@ -262,32 +264,15 @@ func (c *ContextOptimizerService) isClarification(msgLower string) bool {
 	return false
 }
 
-// isRelatedCategory checks if two categories are related (e.g., "smartphones" and "brand_specific:apple_iphone")
+// isRelatedCategory checks if two categories are related: in the same
+// top-level category of the taxonomy ("smartphones" and "headphones"), or,
+// for categories the taxonomy doesn't know, one containing the other
+// ("smartphones" and "brand_specific:apple_smartphones")
 func (c *ContextOptimizerService) isRelatedCategory(cat1, cat2 string) bool {
-	// If one contains the other, they're related
 	if strings.Contains(cat1, cat2) || strings.Contains(cat2, cat1) {
 		return true
 	}
-
-	// Both are electronics-related
-	electronicsCategories := []string{"smartphones", "laptops", "tablets", "headphones", "smartwatches"}
-	isElectronics1 := false
-	isElectronics2 := false
-
-	for _, eCat := range electronicsCategories {
-		if strings.Contains(strings.ToLower(cat1), eCat) {
-			isElectronics1 = true
-		}
-		if strings.Contains(strings.ToLower(cat2), eCat) {
-			isElectronics2 = true
-		}
-	}
-
-	if isElectronics1 && isElectronics2 {
-		return true
-	}
-
-	return false
+	return c.taxonomy.Current().IsRelated(cat1, cat2)
 }
 
 // ShouldUpdateContext determines if conversation context should be updated
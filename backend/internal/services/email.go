@@ -7,6 +7,7 @@ import (
 	"net/smtp"
 
 	"mylittleprice/internal/config"
+	"mylittleprice/internal/models"
 )
 
 type EmailService struct {
@@ -154,6 +155,129 @@ func (s *EmailService) SendPasswordResetEmail(toEmail, resetToken string) error
 	return s.sendEmail(toEmail, subject, body.String())
 }
 
+// SendPriceAlertEmail tells the user that a watched product reached its target price
+func (s *EmailService) SendPriceAlertEmail(toEmail string, alert models.PriceAlert) error {
+	subject := fmt.Sprintf("Price drop: %s - MyLittlePrice", alert.Title)
+
+	htmlTemplate := `
+<!DOCTYPE html>
+<html>
+<head>
+    <meta charset="UTF-8">
+    <meta name="viewport" content="width=device-width, initial-scale=1.0">
+    <style>
+        body {
+            font-family: -apple-system, BlinkMacSystemFont, 'Segoe UI', Roboto, 'Helvetica Neue', Arial, sans-serif;
+            line-height: 1.6;
+            color: #333;
+            max-width: 600px;
+            margin: 0 auto;
+            padding: 20px;
+        }
+        .container {
+            background-color: #ffffff;
+            border-radius: 8px;
+            padding: 40px;
+            box-shadow: 0 2px 4px rgba(0,0,0,0.1);
+        }
+        .logo {
+            text-align: center;
+            margin-bottom: 30px;
+        }
+        .logo h1 {
+            color: #6366f1;
+            margin: 0;
+            font-size: 28px;
+        }
+        .product img {
+            max-width: 160px;
+            display: block;
+            margin: 0 auto 20px;
+        }
+        .price {
+            font-size: 24px;
+            font-weight: 600;
+            color: #16a34a;
+        }
+        .button {
+            display: inline-block;
+            background-color: #6366f1;
+            color: white;
+            text-decoration: none;
+            padding: 14px 28px;
+            border-radius: 6px;
+            font-weight: 600;
+            margin: 20px 0;
+        }
+        .footer {
+            margin-top: 40px;
+            padding-top: 20px;
+            border-top: 1px solid #e5e7eb;
+            font-size: 14px;
+            color: #6b7280;
+            text-align: center;
+        }
+    </style>
+</head>
+<body>
+    <div class="container">
+        <div class="logo">
+            <h1>🛍️ MyLittlePrice</h1>
+        </div>
+
+        <div class="product">
+            {{if .Image}}<img src="{{.Image}}" alt="">{{end}}
+            <h2>{{.Title}}</h2>
+            <p>The price dropped to your target of {{.TargetPrice}}:</p>
+            <p class="price">{{.Price}}{{if .Merchant}} at {{.Merchant}}{{end}}</p>
+
+            {{if .Link}}
+            <div style="text-align: center;">
+                <a href="{{.Link}}" class="button">View Product</a>
+            </div>
+            {{end}}
+        </div>
+
+        <div class="footer">
+            <p>You receive this email because the product is on your MyLittlePrice watchlist.</p>
+            <p><a href="{{.WatchlistLink}}">Manage your watchlist</a></p>
+        </div>
+    </div>
+</body>
+</html>
+`
+
+	tmpl, err := template.New("price_alert").Parse(htmlTemplate)
+	if err != nil {
+		return fmt.Errorf("failed to parse email template: %w", err)
+	}
+
+	var body bytes.Buffer
+	data := struct {
+		Title         string
+		Image         string
+		Link          string
+		Merchant      string
+		Price         string
+		TargetPrice   string
+		WatchlistLink string
+	}{
+		Title:         alert.Title,
+		Image:         alert.Image,
+		Link:          alert.Link,
+		Merchant:      alert.Merchant,
+		Price:         alert.Price.String(),
+		TargetPrice:   alert.TargetPrice.String(),
+		WatchlistLink: s.config.FrontendURL + "/watchlist",
+	}
+
+	if err := tmpl.Execute(&body, data); err != nil {
+		return fmt.Errorf("failed to execute email template: %w", err)
+	}
+
+	return s.sendEmail(toEmail, subject, body.String())
+}
+
 // sendEmail sends an email using SMTP
 func (s *EmailService) sendEmail(to, subject, htmlBody string) error {
 	// Check if SMTP is configured
@@ -188,6 +312,6 @@ func (s *EmailService) sendEmail(to, subject, htmlBody string) error {
 		return fmt.Errorf("failed to send email: %w", err)
 	}
 
-	fmt.Printf("✅ Password reset email sent to %s\n", to)
+	fmt.Printf("✅ Email %q sent to %s\n", subject, to)
 	return nil
 }
@@ -3,6 +3,8 @@ package services
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
 	"math"
@@ -10,186 +12,372 @@ import (
 	"time"
 
 	"github.com/redis/go-redis/v9"
-	"google.golang.org/genai"
 
 	"mylittleprice/internal/config"
+	"mylittleprice/internal/upstream"
+	"mylittleprice/internal/utils"
 )
 
 type EmbeddingService struct {
-	client             *genai.Client
+	embedder           Embedder
+	fallback           Embedder // Local embedder used when embedder fails, nil if disabled
 	redis              *redis.Client
+	taxonomy           *TaxonomyService
 	config             *config.Config
 	ctx                context.Context
-	categoryEmbeddings map[string][]float32
+	categoryTaxonomy   *Taxonomy                       // Taxonomy categoryEmbeddings were made for
+	categoryEmbeddings map[string]map[string][]float32 // By embedder name, then category ID
+	categoryRetryAt    time.Time                       // Set when the provider failed to embed the categories
 	mu                 sync.RWMutex
+	generateMu         sync.Mutex // One category embedding generation at a time
 }
 
-func NewEmbeddingService(client *genai.Client, redis *redis.Client, cfg *config.Config) *EmbeddingService {
+// categoryEmbeddingRetryBackoff is how long category detection waits before
+// embedding the categories again after the provider failed to
+const categoryEmbeddingRetryBackoff = 5 * time.Minute
+
+func NewEmbeddingService(embedder Embedder, redis *redis.Client, taxonomy *TaxonomyService, cfg *config.Config) *EmbeddingService {
 	s := &EmbeddingService{
-		client:             client,
+		embedder:           embedder,
 		redis:              redis,
+		taxonomy:           taxonomy,
 		config:             cfg,
 		ctx:                context.Background(),
-		categoryEmbeddings: make(map[string][]float32),
+		categoryEmbeddings: make(map[string]map[string][]float32),
+	}
+	if cfg.EmbeddingFallback && embedder.Name() != EmbeddingProviderLocal {
+		s.fallback = NewLocalEmbedder(cfg.LocalEmbeddingDimensions)
 	}
-	s.loadCategoryEmbeddings()
+	s.generateCategoryEmbeddings(taxonomy.Current())
 	return s
 }
 
-func (e *EmbeddingService) loadCategoryEmbeddings() {
-	key := "embeddings:categories:v1"
-	data, err := e.redis.Get(e.ctx, key).Bytes()
+// ensureCategoryEmbeddings generates the category embeddings once per
+// taxonomy, and again after categoryEmbeddingRetryBackoff if the provider
+// failed to embed them. Concurrent callers wait for a single generation.
+func (e *EmbeddingService) ensureCategoryEmbeddings(taxonomy *Taxonomy) {
+	if !e.needCategoryEmbeddings(taxonomy) {
+		return
+	}
 
-	if err == redis.Nil {
-		e.generateCategoryEmbeddings()
-		jsonData, err := json.Marshal(e.categoryEmbeddings)
-		if err != nil {
-			fmt.Printf("⚠️ Failed to marshal category embeddings: %v\n", err)
-			return
+	e.generateMu.Lock()
+	defer e.generateMu.Unlock()
+	if e.needCategoryEmbeddings(taxonomy) {
+		e.generateCategoryEmbeddings(taxonomy)
+	}
+}
+
+func (e *EmbeddingService) needCategoryEmbeddings(taxonomy *Taxonomy) bool {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
+
+	if e.categoryTaxonomy != taxonomy {
+		return true
+	}
+	return !e.categoryRetryAt.IsZero() && !time.Now().Before(e.categoryRetryAt)
+}
+
+// generateCategoryEmbeddings embeds the categories of taxonomy with the
+// provider and, so that detection works while it is down, the fallback.
+// Embeddings of an older taxonomy are dropped.
+func (e *EmbeddingService) generateCategoryEmbeddings(taxonomy *Taxonomy) {
+	categories := make([]string, 0, taxonomy.Len())
+	offsets := make([]int, 0, taxonomy.Len()+1) // Of each category's texts
+	texts := make([]string, 0)
+	for id := range taxonomy.nodes {
+		categories = append(categories, id)
+		offsets = append(offsets, len(texts))
+		texts = append(texts, taxonomy.embeddingTexts(id)...)
+	}
+	offsets = append(offsets, len(texts))
+
+	store := func(vectors [][]float32, name string) {
+		embeddings := make(map[string][]float32, len(categories))
+		for i, category := range categories {
+			if mean := meanVector(vectors[offsets[i]:offsets[i+1]]); mean != nil {
+				embeddings[category] = mean
+			}
 		}
-		if err := e.redis.Set(e.ctx, key, jsonData, 0).Err(); err != nil {
-			fmt.Printf("⚠️ Failed to save category embeddings to Redis: %v\n", err)
+		e.mu.Lock()
+		if e.categoryTaxonomy != taxonomy {
+			e.categoryTaxonomy = taxonomy
+			e.categoryEmbeddings = make(map[string]map[string][]float32)
 		}
-	} else if err != nil {
-		fmt.Printf("⚠️ Failed to load category embeddings from Redis: %v\n", err)
-		e.generateCategoryEmbeddings()
+		e.categoryEmbeddings[name] = embeddings
+		e.mu.Unlock()
+	}
+
+	vectors, name := e.Embed(e.ctx, texts)
+	store(vectors, name)
+	if e.fallback != nil && name != e.fallback.Name() {
+		vectors, _ := e.fallback.EmbedTexts(e.ctx, texts)
+		store(vectors, e.fallback.Name())
+	}
+
+	// Without provider embeddings for every text, try again after a backoff
+	failed := name != e.embedder.Name()
+	for _, vector := range vectors {
+		failed = failed || vector == nil
+	}
+	e.mu.Lock()
+	if failed {
+		e.categoryRetryAt = time.Now().Add(categoryEmbeddingRetryBackoff)
 	} else {
-		if err := json.Unmarshal(data, &e.categoryEmbeddings); err != nil {
-			fmt.Printf("⚠️ Failed to unmarshal category embeddings, regenerating: %v\n", err)
-			e.generateCategoryEmbeddings()
+		e.categoryRetryAt = time.Time{}
+	}
+	e.mu.Unlock()
+}
+
+// meanVector returns the normalized mean of the normalized vectors, skipping
+// nil ones, or nil if there are none
+func meanVector(vectors [][]float32) []float32 {
+	var sum []float32
+	for _, vector := range vectors {
+		vector = normalize(vector)
+		if vector == nil || (sum != nil && len(vector) != len(sum)) {
+			continue
+		}
+		if sum == nil {
+			sum = make([]float32, len(vector))
+		}
+		for i, x := range vector {
+			sum[i] += x
 		}
 	}
+	return normalize(sum)
 }
 
-func (e *EmbeddingService) generateCategoryEmbeddings() {
-	categories := map[string]string{
-		"electronics": "laptop computer phone tablet tv monitor camera headphones speaker gadget electronics device",
-		"clothing":    "shirt pants dress shoes jacket coat sweater jeans clothing fashion apparel wear",
-		"furniture":   "chair table bed sofa desk cabinet shelf bookcase furniture home decor",
-		"kitchen":     "pan pot knife plate cup dish spoon fork cookware kitchen utensil appliance",
-		"sports":      "bicycle ball racket fitness gym equipment sports workout training exercise",
-		"tools":       "drill hammer screwdriver wrench saw power tool hand tool equipment",
-		"decor":       "lamp vase picture frame mirror decoration ornament home decor",
-		"textiles":    "pillow blanket towel sheet curtain textile fabric bedding linen",
+// Embed returns the embeddings of texts and the name of the embedder that
+// made them. Vectors of different embedders can't be compared, so when the
+// provider fails the whole call is answered by the local fallback (if
+// enabled; otherwise the texts that weren't cached get nil). Provider
+// vectors are cached by content hash, and texts that aren't cached are sent
+// in batches of EMBEDDING_BATCH_SIZE over up to EMBEDDING_WORKERS requests
+// at a time.
+func (e *EmbeddingService) Embed(ctx context.Context, texts []string) ([][]float32, string) {
+	vectors := e.loadCached(ctx, texts)
+
+	missing := make([]int, 0)
+	for i, vector := range vectors {
+		if vector == nil {
+			missing = append(missing, i)
+		}
+	}
+	if len(missing) == 0 {
+		return vectors, e.embedder.Name()
+	}
+
+	missingTexts := make([]string, len(missing))
+	for i, idx := range missing {
+		missingTexts[i] = texts[idx]
 	}
 
-	for category, text := range categories {
-		embedding := e.getEmbedding(text)
-		if embedding != nil {
-			e.categoryEmbeddings[category] = embedding
+	embedded, err := e.embedBatches(ctx, missingTexts)
+	if err != nil {
+		if e.fallback == nil {
+			fmt.Printf("⚠️ Failed to embed %d texts: %v\n", len(missingTexts), err)
+			return vectors, e.embedder.Name()
 		}
+		fmt.Printf("⚠️ Failed to embed %d texts, using local embeddings: %v\n", len(missingTexts), err)
+		fallbackVectors, _ := e.fallback.EmbedTexts(ctx, texts)
+		return fallbackVectors, e.fallback.Name()
 	}
+
+	for i, idx := range missing {
+		vectors[idx] = embedded[i]
+	}
+	e.storeCached(ctx, missingTexts, embedded)
+	return vectors, e.embedder.Name()
 }
 
-func (e *EmbeddingService) getEmbedding(text string) []float32 {
-	resp, err := e.client.Models.EmbedContent(
-		e.ctx,
-		e.config.GeminiEmbeddingModel,
-		genai.Text(text),
-		nil,
+// embedBatches embeds texts with a bounded pool of concurrent batch
+// requests. It fails if any batch fails.
+func (e *EmbeddingService) embedBatches(ctx context.Context, texts []string) ([][]float32, error) {
+	batchSize := e.config.EmbeddingBatchSize
+	batches := make(chan int) // Offsets into texts
+	vectors := make([][]float32, len(texts))
+
+	var (
+		wg       sync.WaitGroup
+		errMu    sync.Mutex
+		firstErr error
 	)
-	if err != nil || resp == nil || len(resp.Embeddings) == 0 {
-		return nil
+	workers := min(e.config.EmbeddingWorkers, (len(texts)+batchSize-1)/batchSize)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for offset := range batches {
+				batch := texts[offset:min(offset+batchSize, len(texts))]
+				embedded, err := e.embedBatch(ctx, batch)
+				if err != nil {
+					errMu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					errMu.Unlock()
+					continue
+				}
+				copy(vectors[offset:], embedded)
+			}
+		}()
 	}
-	return resp.Embeddings[0].Values
-}
 
-func (e *EmbeddingService) GetQueryEmbedding(query string) []float32 {
-	cacheKey := fmt.Sprintf("embeddings:query:%s", query)
-	cached, err := e.redis.Get(e.ctx, cacheKey).Bytes()
-
-	if err == nil {
-		var embedding []float32
-		if err := json.Unmarshal(cached, &embedding); err != nil {
-			fmt.Printf("⚠️ Failed to unmarshal cached embedding for query '%s': %v\n", query, err)
-		} else {
-			return embedding
-		}
+	for offset := 0; offset < len(texts); offset += batchSize {
+		batches <- offset
 	}
+	close(batches)
+	wg.Wait()
 
-	embedding := e.getEmbedding(query)
-	if embedding != nil {
-		jsonData, err := json.Marshal(embedding)
-		if err != nil {
-			fmt.Printf("⚠️ Failed to marshal embedding for query '%s': %v\n", query, err)
-			return embedding
-		}
-		ttl := time.Duration(e.config.CacheQueryEmbeddingTTL) * time.Second
-		if err := e.redis.Set(e.ctx, cacheKey, jsonData, ttl).Err(); err != nil {
-			fmt.Printf("⚠️ Failed to cache embedding for query '%s': %v\n", query, err)
-		}
+	if firstErr != nil {
+		return nil, firstErr
 	}
-	return embedding
+	return vectors, nil
 }
 
-func (e *EmbeddingService) DetectCategory(userMessage string) string {
-	queryEmbedding := e.GetQueryEmbedding(userMessage)
-	if queryEmbedding == nil {
-		return ""
+func (e *EmbeddingService) embedBatch(ctx context.Context, texts []string) ([][]float32, error) {
+	var vectors [][]float32
+
+	retryConfig := utils.DefaultRetryConfig()
+	retryConfig.MaxRetries = 2
+
+	// Key errors are retried too: the provider has already switched keys
+	err := utils.RetryWithBackoffSelective(ctx, func() error {
+		var err error
+		vectors, err = e.embedder.EmbedTexts(ctx, texts)
+		return err
+	}, retryConfig, func(err error) bool {
+		return upstream.IsRetriable(err) || upstream.ShouldRotateKey(err)
+	})
+	if err != nil {
+		return nil, err
 	}
+	return vectors, nil
+}
 
-	e.mu.RLock()
-	defer e.mu.RUnlock()
+// cacheKey namespaces cached vectors by provider, since vectors from
+// different embedding models are not comparable, and keys them by a hash of
+// the text so that long texts make short keys
+func (e *EmbeddingService) cacheKey(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return fmt.Sprintf("embeddings:%s:%s", e.embedder.Name(), hex.EncodeToString(sum[:]))
+}
 
-	maxSimilarity := float32(-1)
-	bestCategory := ""
+// loadCached returns the cached vectors of texts, nil for those not cached
+func (e *EmbeddingService) loadCached(ctx context.Context, texts []string) [][]float32 {
+	vectors := make([][]float32, len(texts))
+	if len(texts) == 0 || e.embedder.Name() == EmbeddingProviderLocal {
+		return vectors // Local vectors are cheaper to compute than to fetch
+	}
 
-	for category, categoryEmbedding := range e.categoryEmbeddings {
-		similarity := cosineSimilarity(queryEmbedding, categoryEmbedding)
-		if similarity > maxSimilarity {
-			maxSimilarity = similarity
-			bestCategory = category
+	keys := make([]string, len(texts))
+	for i, text := range texts {
+		keys[i] = e.cacheKey(text)
+	}
+	cached, err := e.redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		fmt.Printf("⚠️ Failed to load cached embeddings: %v\n", err)
+		return vectors
+	}
+
+	for i, value := range cached {
+		data, ok := value.(string)
+		if !ok {
+			continue
+		}
+		if err := json.Unmarshal([]byte(data), &vectors[i]); err != nil {
+			vectors[i] = nil
 		}
 	}
+	return vectors
+}
 
-	if maxSimilarity > float32(e.config.EmbeddingCategoryDetectionThresh) {
-		return bestCategory
+func (e *EmbeddingService) storeCached(ctx context.Context, texts []string, vectors [][]float32) {
+	if e.embedder.Name() == EmbeddingProviderLocal {
+		return
+	}
+
+	ttl := time.Duration(e.config.CacheQueryEmbeddingTTL) * time.Second
+	pipe := e.redis.Pipeline()
+	for i, vector := range vectors {
+		data, err := json.Marshal(vector)
+		if err != nil {
+			continue
+		}
+		pipe.Set(ctx, e.cacheKey(texts[i]), data, ttl)
+	}
+	if _, err := pipe.Exec(ctx); err != nil {
+		fmt.Printf("⚠️ Failed to cache embeddings: %v\n", err)
 	}
-	return ""
 }
 
-func (e *EmbeddingService) FindSimilarCachedQuery(query string, threshold float32) string {
-	queryEmbedding := e.GetQueryEmbedding(query)
+// GetQueryEmbedding returns the embedding of query, nil if it couldn't be
+// embedded. Texts that will be compared with each other should be embedded
+// together with Embed instead, so that they come from the same embedder.
+func (e *EmbeddingService) GetQueryEmbedding(query string) []float32 {
+	vectors, _ := e.Embed(e.ctx, []string{query})
+	return vectors[0]
+}
+
+// DetectCategory returns the deepest category of the taxonomy the message
+// confidently belongs to, "" if none. It picks the most similar top-level
+// category, then the most similar of its subcategories and so on, for as
+// long as the best one is above its relevance threshold.
+func (e *EmbeddingService) DetectCategory(userMessage string) string {
+	vectors, name := e.Embed(e.ctx, []string{userMessage})
+	queryEmbedding := vectors[0]
 	if queryEmbedding == nil {
 		return ""
 	}
 
-	pattern := "cache:search:*"
-	iter := e.redis.Scan(e.ctx, 0, pattern, 100).Iterator()
-
-	// Limit scan to prevent performance issues with large key sets
-	const maxKeysToCheck = 100
-	keysChecked := 0
-
-	for iter.Next(e.ctx) {
-		if keysChecked >= maxKeysToCheck {
-			fmt.Printf("⚠️ FindSimilarCachedQuery: Reached max keys limit (%d), stopping scan\n", maxKeysToCheck)
-			break
+	// The taxonomy may have been reloaded, or the provider was down before
+	taxonomy := e.taxonomy.Current()
+	e.ensureCategoryEmbeddings(taxonomy)
+	e.mu.RLock()
+	categoryEmbeddings := e.categoryEmbeddings[name]
+	e.mu.RUnlock()
+
+	detected := ""
+	for {
+		maxSimilarity := float32(-1)
+		bestCategory := ""
+		for _, category := range taxonomy.Children(detected) {
+			categoryEmbedding, ok := categoryEmbeddings[category]
+			if !ok {
+				continue
+			}
+			similarity := cosineSimilarity(queryEmbedding, categoryEmbedding)
+			if similarity > maxSimilarity {
+				maxSimilarity = similarity
+				bestCategory = category
+			}
 		}
 
-		cacheKey := iter.Val()
-		cachedQuery := cacheKey[len("cache:search:"):]
-		cachedEmbedding := e.GetQueryEmbedding(cachedQuery)
-
-		if cachedEmbedding != nil {
-			similarity := cosineSimilarity(queryEmbedding, cachedEmbedding)
-			if similarity >= threshold {
-				return cacheKey
-			}
+		threshold := taxonomy.RelevanceThreshold(bestCategory, e.config.EmbeddingCategoryDetectionThresh)
+		if bestCategory == "" || maxSimilarity <= float32(threshold) {
+			return detected
 		}
-		keysChecked++
+		detected = bestCategory
 	}
+}
 
-	if err := iter.Err(); err != nil {
-		fmt.Printf("⚠️ FindSimilarCachedQuery: Redis scan error: %v\n", err)
-	}
+// categoryEmbedding returns the embedding of category that can be compared
+// with vector, i.e. made by the same embedder (going by its size)
+func (e *EmbeddingService) categoryEmbedding(category string, vector []float32) []float32 {
+	e.mu.RLock()
+	defer e.mu.RUnlock()
 
-	return ""
+	for _, name := range []string{e.embedder.Name(), EmbeddingProviderLocal} {
+		if embedding := e.categoryEmbeddings[name][category]; embedding != nil && len(embedding) == len(vector) {
+			return embedding
+		}
+	}
+	return nil
 }
 
 func (e *EmbeddingService) AreDuplicateProducts(name1, name2 string, threshold float32) bool {
-	emb1 := e.GetQueryEmbedding(name1)
-	emb2 := e.GetQueryEmbedding(name2)
+	vectors, _ := e.Embed(e.ctx, []string{name1, name2})
+	emb1, emb2 := vectors[0], vectors[1]
 
 	if emb1 == nil || emb2 == nil {
 		return false
@@ -0,0 +1,65 @@
+package services
+
+import (
+	"context"
+	"hash/fnv"
+)
+
+// EmbeddingProviderLocal is the EMBEDDING_PROVIDER value of LocalEmbedder
+const EmbeddingProviderLocal = "local"
+
+// LocalEmbedder is an offline, deterministic embedder: the words of a text
+// and their character trigrams are hashed into a fixed-size vector (the
+// "hashing trick"). It knows nothing about meaning, but texts sharing words
+// or spellings get similar vectors, which is enough for duplicate detection
+// and rough category matching without an embedding API.
+type LocalEmbedder struct {
+	dimensions int
+}
+
+// NewLocalEmbedder creates a local embedder producing vectors of the given size
+func NewLocalEmbedder(dimensions int) *LocalEmbedder {
+	return &LocalEmbedder{dimensions: dimensions}
+}
+
+func (l *LocalEmbedder) Name() string {
+	return EmbeddingProviderLocal
+}
+
+func (l *LocalEmbedder) EmbedText(ctx context.Context, text string) ([]float32, error) {
+	vector := make([]float32, l.dimensions)
+	for _, word := range rankingTokens(text) {
+		l.add(vector, "w:"+word, 1)
+
+		padded := []rune("^" + word + "$")
+		for i := 0; i+3 <= len(padded); i++ {
+			l.add(vector, "t:"+string(padded[i:i+3]), 0.5)
+		}
+	}
+
+	if normalized := normalize(vector); normalized != nil {
+		return normalized, nil
+	}
+	return vector, nil // No words: a zero vector, similar to nothing
+}
+
+func (l *LocalEmbedder) EmbedTexts(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		vectors[i], _ = l.EmbedText(ctx, text)
+	}
+	return vectors, nil
+}
+
+// add adds weight to the dimension feature hashes to, with a sign taken from
+// the hash so that collisions tend to cancel out
+func (l *LocalEmbedder) add(vector []float32, feature string, weight float32) {
+	h := fnv.New64a()
+	h.Write([]byte(feature))
+	sum := h.Sum64()
+
+	if sum>>63 == 1 {
+		weight = -weight
+	}
+	vector[sum%uint64(len(vector))] += weight
+}
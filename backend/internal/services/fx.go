@@ -0,0 +1,280 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"mylittleprice/ent"
+	"mylittleprice/ent/exchangerate"
+	"mylittleprice/internal/config"
+	"mylittleprice/internal/domain"
+	"mylittleprice/internal/models"
+)
+
+// ErrNoExchangeRate is returned when a currency has no known rate
+var ErrNoExchangeRate = errors.New("no exchange rate")
+
+// FXService converts prices between currencies. Rates are loaded from the
+// configured RateSources, persisted in Postgres so that a restart doesn't
+// depend on the sources being up, and held in memory for conversion.
+type FXService struct {
+	client  *ent.Client
+	sources []RateSource
+
+	mu        sync.RWMutex
+	rates     map[domain.Currency]float64 // Units per 1 EUR
+	updatedAt time.Time
+}
+
+// NewFXService creates the rate sources listed in cfg.FXSources
+func NewFXService(client *ent.Client, cfg *config.Config) (*FXService, error) {
+	sources := make([]RateSource, 0, len(cfg.FXSources))
+	for _, name := range cfg.FXSources {
+		switch name {
+		case FXSourceFile:
+			sources = append(sources, NewFileRateSource(cfg.FXRatesFile))
+		case FXSourceECB:
+			sources = append(sources, NewECBRateSource(cfg.FXECBURL))
+		default:
+			return nil, fmt.Errorf("unknown exchange rate source: %s", name)
+		}
+	}
+
+	return &FXService{
+		client:  client,
+		sources: sources,
+		rates:   map[domain.Currency]float64{domain.CurrencyEUR: 1},
+	}, nil
+}
+
+// LoadStored loads the rates persisted by the last refresh
+func (s *FXService) LoadStored(ctx context.Context) error {
+	stored, err := s.client.ExchangeRate.Query().All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to load exchange rates: %w", err)
+	}
+
+	rates := map[domain.Currency]float64{domain.CurrencyEUR: 1}
+	var updatedAt time.Time
+	for _, rate := range stored {
+		rates[domain.Currency(rate.Currency)] = rate.Rate
+		if rate.UpdatedAt.After(updatedAt) {
+			updatedAt = rate.UpdatedAt
+		}
+	}
+
+	s.mu.Lock()
+	s.rates = rates
+	s.updatedAt = updatedAt
+	s.mu.Unlock()
+	return nil
+}
+
+// Refresh loads rates from every source, persists them and makes them
+// current. A currency offered by several sources takes the rate of the
+// first one listed. Refresh fails only if no source could be loaded.
+func (s *FXService) Refresh(ctx context.Context) error {
+	rates := map[domain.Currency]float64{domain.CurrencyEUR: 1}
+	sourceOf := make(map[domain.Currency]string)
+	var errs []error
+
+	for _, source := range s.sources {
+		loaded, err := source.Load(ctx)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", source.Name(), err))
+			continue
+		}
+		for currency, rate := range loaded {
+			if _, ok := sourceOf[currency]; ok || rate <= 0 || currency == domain.CurrencyEUR {
+				continue
+			}
+			rates[currency] = rate
+			sourceOf[currency] = source.Name()
+		}
+	}
+
+	if len(sourceOf) == 0 {
+		if len(errs) > 0 {
+			return errors.Join(errs...)
+		}
+		return fmt.Errorf("exchange rate sources returned no rates")
+	}
+
+	if err := s.store(ctx, rates, sourceOf); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	s.rates = rates
+	s.updatedAt = time.Now()
+	s.mu.Unlock()
+
+	if len(errs) > 0 {
+		fmt.Printf("⚠️ Some exchange rate sources failed: %v\n", errors.Join(errs...))
+	}
+	return nil
+}
+
+// store upserts the rates in one transaction
+func (s *FXService) store(ctx context.Context, rates map[domain.Currency]float64, sourceOf map[domain.Currency]string) error {
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	for currency, source := range sourceOf {
+		updated, err := tx.ExchangeRate.Update().
+			Where(exchangerate.CurrencyEQ(string(currency))).
+			SetRate(rates[currency]).
+			SetSource(source).
+			Save(ctx)
+		if err == nil && updated == 0 {
+			err = tx.ExchangeRate.Create().
+				SetCurrency(string(currency)).
+				SetRate(rates[currency]).
+				SetSource(source).
+				Exec(ctx)
+		}
+		if err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to store %s exchange rate: %w", currency, err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit exchange rates: %w", err)
+	}
+	return nil
+}
+
+// UpdatedAt returns when the current rates were loaded (zero if never)
+func (s *FXService) UpdatedAt() time.Time {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.updatedAt
+}
+
+// Convert converts money to the currency to
+func (s *FXService) Convert(money domain.Money, to domain.Currency) (domain.Money, error) {
+	if money.Currency == to {
+		return money, nil
+	}
+
+	s.mu.RLock()
+	fromRate, fromOK := s.rates[money.Currency]
+	toRate, toOK := s.rates[to]
+	s.mu.RUnlock()
+
+	if !fromOK {
+		return domain.Money{}, fmt.Errorf("%w for %s", ErrNoExchangeRate, money.Currency)
+	}
+	if !toOK {
+		return domain.Money{}, fmt.Errorf("%w for %s", ErrNoExchangeRate, to)
+	}
+	return domain.NewMoney(money.Major()/fromRate*toRate, to), nil
+}
+
+// convertMoney converts money to the currency to, reporting false if there
+// is no rate for either or fx is nil (conversion disabled)
+func convertMoney(fx *FXService, money domain.Money, to domain.Currency) (domain.Money, bool) {
+	if money.Currency == to {
+		return money, true
+	}
+	if fx == nil {
+		return domain.Money{}, false
+	}
+	converted, err := fx.Convert(money, to)
+	return converted, err == nil
+}
+
+// ConvertCards returns copies of cards priced in currency, with the
+// original price kept in OriginalPrice/OriginalAmount. Cards without a
+// parsed price or a known rate are left as they are, as are all cards when
+// s is nil (conversion disabled) or currency is empty.
+func (s *FXService) ConvertCards(cards []models.ProductCard, currency string) []models.ProductCard {
+	if s == nil || currency == "" || len(cards) == 0 {
+		return cards
+	}
+
+	to := domain.Currency(currency)
+	converted := make([]models.ProductCard, len(cards))
+	for i, card := range cards {
+		converted[i] = card
+		if card.Amount == nil || card.Amount.Currency == "" || card.Amount.Currency == to {
+			continue
+		}
+
+		amount, err := s.Convert(*card.Amount, to)
+		if err != nil {
+			continue
+		}
+		converted[i].OriginalPrice = card.Price
+		converted[i].OriginalAmount = card.Amount
+		converted[i].Amount = &amount
+		converted[i].Price = amount.String()
+		if card.OldPrice != "" {
+			converted[i].OldPrice = s.convertDisplayPrice(card.OldPrice, card.Amount.Currency, to)
+		}
+	}
+	return converted
+}
+
+// ConvertOffers returns copies of offers priced in currency, like
+// ConvertCards. Shipping and totals are converted too.
+func (s *FXService) ConvertOffers(offers []models.Offer, currency string) []models.Offer {
+	if s == nil || currency == "" || len(offers) == 0 {
+		return offers
+	}
+
+	to := domain.Currency(currency)
+	converted := make([]models.Offer, len(offers))
+	for i, offer := range offers {
+		converted[i] = offer
+		if offer.Amount == nil || offer.Amount.Currency == "" || offer.Amount.Currency == to {
+			continue
+		}
+
+		amount, err := s.Convert(*offer.Amount, to)
+		if err != nil {
+			continue
+		}
+		from := offer.Amount.Currency
+		converted[i].OriginalPrice = offer.Price
+		converted[i].OriginalAmount = offer.Amount
+		converted[i].Amount = &amount
+		converted[i].Price = amount.String()
+		converted[i].ExtractedPrice = amount.Major()
+		converted[i].Currency = currency
+
+		if offer.ExtractedTotal > 0 {
+			if total, err := s.Convert(domain.NewMoney(offer.ExtractedTotal, from), to); err == nil {
+				converted[i].ExtractedTotal = total.Major()
+				converted[i].Total = total.String()
+			}
+		}
+		if offer.ShippingExtracted > 0 {
+			if shipping, err := s.Convert(domain.NewMoney(offer.ShippingExtracted, from), to); err == nil {
+				converted[i].ShippingExtracted = shipping.Major()
+				converted[i].Shipping = s.convertDisplayPrice(offer.Shipping, from, to)
+			}
+		}
+	}
+	return converted
+}
+
+// convertDisplayPrice converts a display price in currency from, or returns
+// it unchanged if it can't be parsed or converted
+func (s *FXService) convertDisplayPrice(price string, from, to domain.Currency) string {
+	money, err := domain.ParseMoney(price, from)
+	if err != nil {
+		return price
+	}
+	converted, err := s.Convert(money, to)
+	if err != nil {
+		return price
+	}
+	return converted.String()
+}
@@ -0,0 +1,185 @@
+package services
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"mylittleprice/internal/domain"
+)
+
+// Supported values for FX_SOURCES
+const (
+	FXSourceFile = "file"
+	FXSourceECB  = "ecb"
+)
+
+// RateSource loads exchange rates as units of each currency per 1 EUR
+type RateSource interface {
+	Name() string
+	Load(ctx context.Context) (map[domain.Currency]float64, error)
+}
+
+// FileRateSource reads rates from a local file, either JSON
+//
+//	{"base": "EUR", "rates": {"USD": 1.0812, "CHF": 0.9431}}
+//
+// or CSV with "currency,rate" rows against EUR (a header row is skipped).
+// JSON rates against another base are rebased to EUR, which must be listed.
+type FileRateSource struct {
+	path string
+}
+
+// NewFileRateSource creates a rate source for the file at path
+func NewFileRateSource(path string) *FileRateSource {
+	return &FileRateSource{path: path}
+}
+
+func (s *FileRateSource) Name() string {
+	return FXSourceFile
+}
+
+func (s *FileRateSource) Load(ctx context.Context) (map[domain.Currency]float64, error) {
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read rates file: %w", err)
+	}
+
+	if strings.EqualFold(filepath.Ext(s.path), ".csv") {
+		return parseCSVRates(data)
+	}
+	return parseJSONRates(data)
+}
+
+func parseJSONRates(data []byte) (map[domain.Currency]float64, error) {
+	var table struct {
+		Base  string             `json:"base"`
+		Rates map[string]float64 `json:"rates"`
+	}
+	if err := json.Unmarshal(data, &table); err != nil {
+		return nil, fmt.Errorf("failed to parse rates file: %w", err)
+	}
+
+	base := domain.Currency(strings.ToUpper(table.Base))
+	if base == "" {
+		base = domain.CurrencyEUR
+	}
+
+	rates := make(map[domain.Currency]float64, len(table.Rates)+1)
+	for code, rate := range table.Rates {
+		rates[domain.Currency(strings.ToUpper(code))] = rate
+	}
+	rates[base] = 1
+
+	if base != domain.CurrencyEUR {
+		eur := rates[domain.CurrencyEUR]
+		if eur <= 0 {
+			return nil, fmt.Errorf("rates file with base %s must list EUR", base)
+		}
+		for code, rate := range rates {
+			rates[code] = rate / eur
+		}
+	}
+	return rates, nil
+}
+
+func parseCSVRates(data []byte) (map[domain.Currency]float64, error) {
+	records, err := csv.NewReader(strings.NewReader(string(data))).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse rates file: %w", err)
+	}
+
+	rates := map[domain.Currency]float64{domain.CurrencyEUR: 1}
+	for i, record := range records {
+		if len(record) < 2 {
+			return nil, fmt.Errorf("rates file line %d: expected currency,rate", i+1)
+		}
+		rate, err := strconv.ParseFloat(strings.TrimSpace(record[1]), 64)
+		if err != nil {
+			if i == 0 {
+				continue // Header
+			}
+			return nil, fmt.Errorf("rates file line %d: invalid rate %q", i+1, record[1])
+		}
+		rates[domain.Currency(strings.ToUpper(strings.TrimSpace(record[0])))] = rate
+	}
+	return rates, nil
+}
+
+// ECBRateSource reads the ECB euro reference rates XML
+// (https://www.ecb.europa.eu/stats/eurofxref/eurofxref-daily.xml) or a
+// feed in the same format
+type ECBRateSource struct {
+	url        string
+	httpClient *http.Client
+}
+
+// NewECBRateSource creates a rate source for the feed at url
+func NewECBRateSource(url string) *ECBRateSource {
+	return &ECBRateSource{
+		url:        url,
+		httpClient: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (s *ECBRateSource) Name() string {
+	return FXSourceECB
+}
+
+func (s *ECBRateSource) Load(ctx context.Context) (map[domain.Currency]float64, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create ECB rates request: %w", err)
+	}
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("ECB rates request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("ECB rates feed returned HTTP %d", resp.StatusCode)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, 1<<20))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read ECB rates: %w", err)
+	}
+	return parseECBRates(body)
+}
+
+// parseECBRates reads <Cube><Cube time="..."><Cube currency="USD" rate="1.08"/>...
+func parseECBRates(data []byte) (map[domain.Currency]float64, error) {
+	var envelope struct {
+		Rates []struct {
+			Currency string `xml:"currency,attr"`
+			Rate     string `xml:"rate,attr"`
+		} `xml:"Cube>Cube>Cube"`
+	}
+	if err := xml.Unmarshal(data, &envelope); err != nil {
+		return nil, fmt.Errorf("failed to parse ECB rates: %w", err)
+	}
+	if len(envelope.Rates) == 0 {
+		return nil, fmt.Errorf("ECB rates feed has no rates")
+	}
+
+	rates := map[domain.Currency]float64{domain.CurrencyEUR: 1}
+	for _, cube := range envelope.Rates {
+		rate, err := strconv.ParseFloat(cube.Rate, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid ECB rate for %s: %q", cube.Currency, cube.Rate)
+		}
+		rates[domain.Currency(cube.Currency)] = rate
+	}
+	return rates, nil
+}
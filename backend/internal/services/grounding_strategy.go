@@ -173,7 +173,7 @@ func (gs *GroundingStrategy) calculateDialogueDrift(queryEmbedding []float32, hi
 }
 
 func (gs *GroundingStrategy) calculateCategorySimilarity(queryEmbedding []float32, category string) float32 {
-	categoryEmbedding := gs.embedding.categoryEmbeddings[category]
+	categoryEmbedding := gs.embedding.categoryEmbedding(category, queryEmbedding)
 	if categoryEmbedding == nil {
 		return 0.0
 	}
@@ -0,0 +1,280 @@
+package services
+
+import (
+	"container/heap"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// hnswIndex is an in-memory approximate nearest neighbour index over
+// embeddings (Hierarchical Navigable Small World graph, Malkov & Yashunin).
+// Vectors are normalised on insert so that similarity is a dot product.
+// Removed nodes stay in the graph for navigation but are never returned;
+// the graph is rebuilt once they make up half of it. Not safe for
+// concurrent use.
+type hnswIndex struct {
+	m              int // Neighbours per node on upper layers (2m on layer 0)
+	efConstruction int
+	efSearch       int
+	levelMult      float64
+
+	nodes    []*hnswNode
+	byID     map[string]int
+	entry    int // -1 when empty
+	maxLevel int
+	removed  int
+	rng      *rand.Rand
+}
+
+type hnswNode struct {
+	id        string
+	vector    []float32
+	neighbors [][]int // Per layer
+	removed   bool
+}
+
+// hnswMatch is a search result
+type hnswMatch struct {
+	ID         string
+	Similarity float32
+}
+
+func newHNSWIndex(m, efConstruction, efSearch int) *hnswIndex {
+	return &hnswIndex{
+		m:              m,
+		efConstruction: efConstruction,
+		efSearch:       efSearch,
+		levelMult:      1 / math.Log(float64(m)),
+		byID:           make(map[string]int),
+		entry:          -1,
+		rng:            rand.New(rand.NewSource(1)),
+	}
+}
+
+// Len returns the number of searchable vectors
+func (h *hnswIndex) Len() int {
+	return len(h.byID)
+}
+
+// Add inserts a vector. Re-adding an ID keeps its original vector.
+func (h *hnswIndex) Add(id string, vector []float32) {
+	if _, ok := h.byID[id]; ok {
+		return
+	}
+	vector = normalize(vector)
+	if vector == nil {
+		return
+	}
+	if h.entry >= 0 && len(vector) != len(h.nodes[h.entry].vector) {
+		return // Embedding from another model
+	}
+
+	level := int(math.Floor(-math.Log(1-h.rng.Float64()) * h.levelMult))
+	node := &hnswNode{id: id, vector: vector, neighbors: make([][]int, level+1)}
+	idx := len(h.nodes)
+	h.nodes = append(h.nodes, node)
+	h.byID[id] = idx
+
+	if h.entry < 0 {
+		h.entry = idx
+		h.maxLevel = level
+		return
+	}
+
+	entryPoints := []int{h.entry}
+	for l := h.maxLevel; l > level; l-- {
+		entryPoints = h.searchLayer(vector, entryPoints, 1, l)[:1]
+	}
+
+	for l := min(level, h.maxLevel); l >= 0; l-- {
+		candidates := h.searchLayer(vector, entryPoints, h.efConstruction, l)
+		node.neighbors[l] = append([]int(nil), candidates[:min(h.m, len(candidates))]...)
+		for _, neighbor := range node.neighbors[l] {
+			h.link(neighbor, idx, l)
+		}
+		entryPoints = candidates
+	}
+
+	if level > h.maxLevel {
+		h.maxLevel = level
+		h.entry = idx
+	}
+}
+
+// link adds to as a neighbour of from on layer l, keeping only the
+// closest neighbours when from has too many
+func (h *hnswIndex) link(from, to, l int) {
+	node := h.nodes[from]
+	node.neighbors[l] = append(node.neighbors[l], to)
+
+	maxNeighbors := h.m
+	if l == 0 {
+		maxNeighbors = 2 * h.m
+	}
+	if len(node.neighbors[l]) <= maxNeighbors {
+		return
+	}
+
+	neighbors := node.neighbors[l]
+	sort.Slice(neighbors, func(i, j int) bool {
+		return dot(node.vector, h.nodes[neighbors[i]].vector) > dot(node.vector, h.nodes[neighbors[j]].vector)
+	})
+	node.neighbors[l] = neighbors[:maxNeighbors]
+}
+
+// Remove makes an ID unsearchable
+func (h *hnswIndex) Remove(id string) {
+	idx, ok := h.byID[id]
+	if !ok {
+		return
+	}
+	delete(h.byID, id)
+	h.nodes[idx].removed = true
+	h.removed++
+
+	if h.removed*2 >= len(h.nodes) {
+		h.rebuild()
+	}
+}
+
+// rebuild recreates the graph from the nodes that weren't removed
+func (h *hnswIndex) rebuild() {
+	nodes := h.nodes
+	h.nodes = nil
+	h.byID = make(map[string]int)
+	h.entry = -1
+	h.maxLevel = 0
+	h.removed = 0
+	for _, node := range nodes {
+		if !node.removed {
+			h.Add(node.id, node.vector)
+		}
+	}
+}
+
+// Search returns up to k nearest vectors, most similar first
+func (h *hnswIndex) Search(vector []float32, k int) []hnswMatch {
+	vector = normalize(vector)
+	if h.entry < 0 || vector == nil || len(vector) != len(h.nodes[h.entry].vector) {
+		return nil
+	}
+
+	entryPoints := []int{h.entry}
+	for l := h.maxLevel; l > 0; l-- {
+		entryPoints = h.searchLayer(vector, entryPoints, 1, l)[:1]
+	}
+
+	matches := make([]hnswMatch, 0, k)
+	for _, idx := range h.searchLayer(vector, entryPoints, max(h.efSearch, k), 0) {
+		node := h.nodes[idx]
+		if node.removed {
+			continue
+		}
+		matches = append(matches, hnswMatch{ID: node.id, Similarity: dot(vector, node.vector)})
+		if len(matches) == k {
+			break
+		}
+	}
+	return matches
+}
+
+// searchLayer returns up to ef nodes of layer l closest to vector, closest first
+func (h *hnswIndex) searchLayer(vector []float32, entryPoints []int, ef, l int) []int {
+	visited := make(map[int]bool, ef*4)
+	candidates := &hnswHeap{}       // Closest first
+	results := &hnswHeap{min: true} // Farthest first
+
+	for _, idx := range entryPoints {
+		visited[idx] = true
+		item := hnswItem{idx: idx, similarity: dot(vector, h.nodes[idx].vector)}
+		heap.Push(candidates, item)
+		heap.Push(results, item)
+		if results.Len() > ef {
+			heap.Pop(results)
+		}
+	}
+
+	for candidates.Len() > 0 {
+		current := heap.Pop(candidates).(hnswItem)
+		if results.Len() >= ef && current.similarity < results.items[0].similarity {
+			break
+		}
+		node := h.nodes[current.idx]
+		if l >= len(node.neighbors) {
+			continue
+		}
+		for _, neighbor := range node.neighbors[l] {
+			if visited[neighbor] {
+				continue
+			}
+			visited[neighbor] = true
+			item := hnswItem{idx: neighbor, similarity: dot(vector, h.nodes[neighbor].vector)}
+			if results.Len() < ef || item.similarity > results.items[0].similarity {
+				heap.Push(candidates, item)
+				heap.Push(results, item)
+				if results.Len() > ef {
+					heap.Pop(results)
+				}
+			}
+		}
+	}
+
+	closest := make([]int, results.Len())
+	for i := len(closest) - 1; i >= 0; i-- {
+		closest[i] = heap.Pop(results).(hnswItem).idx
+	}
+	return closest
+}
+
+type hnswItem struct {
+	idx        int
+	similarity float32
+}
+
+// hnswHeap is a max-heap on similarity, or a min-heap when min is set
+type hnswHeap struct {
+	items []hnswItem
+	min   bool
+}
+
+func (q hnswHeap) Len() int { return len(q.items) }
+func (q hnswHeap) Less(i, j int) bool {
+	if q.min {
+		return q.items[i].similarity < q.items[j].similarity
+	}
+	return q.items[i].similarity > q.items[j].similarity
+}
+func (q hnswHeap) Swap(i, j int)       { q.items[i], q.items[j] = q.items[j], q.items[i] }
+func (q *hnswHeap) Push(x interface{}) { q.items = append(q.items, x.(hnswItem)) }
+func (q *hnswHeap) Pop() interface{} {
+	item := q.items[len(q.items)-1]
+	q.items = q.items[:len(q.items)-1]
+	return item
+}
+
+// normalize returns a unit-length copy of v, or nil for a zero vector
+func normalize(v []float32) []float32 {
+	var norm float64
+	for _, x := range v {
+		norm += float64(x) * float64(x)
+	}
+	if norm == 0 {
+		return nil
+	}
+	norm = math.Sqrt(norm)
+
+	normalized := make([]float32, len(v))
+	for i, x := range v {
+		normalized[i] = float32(float64(x) / norm)
+	}
+	return normalized
+}
+
+func dot(a, b []float32) float32 {
+	var sum float32
+	for i := range a {
+		sum += a[i] * b[i]
+	}
+	return sum
+}
@@ -0,0 +1,457 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"google.golang.org/genai"
+
+	"mylittleprice/internal/config"
+	"mylittleprice/internal/upstream"
+	"mylittleprice/internal/utils"
+)
+
+// GeminiProvider implements LLMProvider and Embedder on top of the Gemini API
+// with API key rotation
+type GeminiProvider struct {
+	client          *genai.Client
+	keyRotator      *utils.KeyRotator
+	config          *config.Config
+	ctx             context.Context
+	currentKeyIndex int                // Track current API key index
+	promptCache     *GeminiPromptCache // nil when GEMINI_PROMPT_CACHE is off
+	mu              sync.RWMutex
+}
+
+// NewGeminiProvider creates a Gemini provider using the next available API key
+func NewGeminiProvider(keyRotator *utils.KeyRotator, cfg *config.Config) (*GeminiProvider, error) {
+	ctx := context.Background()
+
+	apiKey, keyIndex, err := keyRotator.GetNextKey()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get initial API key: %w", err)
+	}
+
+	client, err := genai.NewClient(ctx, &genai.ClientConfig{
+		APIKey:  apiKey,
+		Backend: genai.BackendGeminiAPI,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to create Gemini client: %w", err)
+	}
+
+	provider := &GeminiProvider{
+		client:          client,
+		keyRotator:      keyRotator,
+		config:          cfg,
+		ctx:             ctx,
+		currentKeyIndex: keyIndex,
+	}
+	if cfg.GeminiPromptCache {
+		provider.promptCache = NewGeminiPromptCache(cfg.GeminiPromptCacheTTL)
+	}
+	return provider, nil
+}
+
+func (p *GeminiProvider) Name() string {
+	return LLMProviderGemini
+}
+
+func (p *GeminiProvider) Model() string {
+	return p.config.GeminiModel
+}
+
+func (p *GeminiProvider) FallbackModel() string {
+	return p.config.GeminiFallbackModel
+}
+
+func (p *GeminiProvider) SupportsGrounding() bool {
+	return true
+}
+
+// GenerateContent calls Gemini once. Errors are classified (see upstream);
+// on rate limit, quota and auth errors the current key is taken out of
+// rotation and the client is rotated before the error is returned, so the
+// caller's next attempt uses a fresh key.
+func (p *GeminiProvider) GenerateContent(ctx context.Context, req *LLMRequest) (*LLMResponse, error) {
+	client, keyIndex := p.getClientWithKey()
+	contents, generateConfig, cacheName := p.prepareRequest(ctx, client, keyIndex, req)
+
+	resp, err := client.Models.GenerateContent(ctx, req.Model, contents, generateConfig)
+	if err != nil && cacheName != "" && isPromptCacheError(err) {
+		fmt.Printf("⚠️ Prompt cache %s rejected, retrying without cache: %v\n", cacheName, err)
+		p.promptCache.Invalidate(cacheName)
+		resp, err = client.Models.GenerateContent(ctx, req.Model, buildGeminiContents(req), buildGeminiConfig(req))
+	}
+	if err != nil {
+		return nil, p.handleError(err)
+	}
+
+	if resp == nil {
+		return nil, fmt.Errorf("Gemini returned nil response")
+	}
+
+	if len(resp.Candidates) == 0 {
+		if resp.PromptFeedback != nil && resp.PromptFeedback.BlockReason != "" {
+			return nil, upstream.NewSafetyBlocked(upstream.ProviderGemini, string(resp.PromptFeedback.BlockReason))
+		}
+		return nil, fmt.Errorf("no candidates in Gemini response")
+	}
+
+	result := &LLMResponse{}
+	mergeGeminiResponse(result, resp)
+	return result, nil
+}
+
+// GenerateContentStream streams a Gemini response, merging chunks into a
+// single LLMResponse. Errors are handled as in GenerateContent.
+func (p *GeminiProvider) GenerateContentStream(ctx context.Context, req *LLMRequest, onText func(text string)) (*LLMResponse, error) {
+	client, keyIndex := p.getClientWithKey()
+	contents, generateConfig, cacheName := p.prepareRequest(ctx, client, keyIndex, req)
+
+	result, err := streamGeminiContent(ctx, client, req.Model, contents, generateConfig, onText)
+	// A rejected cache fails the first chunk, so nothing was emitted yet
+	if err != nil && cacheName != "" && isPromptCacheError(err) && result.Text == "" {
+		fmt.Printf("⚠️ Prompt cache %s rejected, retrying without cache: %v\n", cacheName, err)
+		p.promptCache.Invalidate(cacheName)
+		result, err = streamGeminiContent(ctx, client, req.Model, buildGeminiContents(req), buildGeminiConfig(req), onText)
+	}
+	if err != nil {
+		return nil, p.handleError(err)
+	}
+	return result, nil
+}
+
+// streamGeminiContent runs one streaming call. On error the partial result
+// is returned along with it.
+func streamGeminiContent(
+	ctx context.Context,
+	client *genai.Client,
+	model string,
+	contents []*genai.Content,
+	generateConfig *genai.GenerateContentConfig,
+	onText func(text string),
+) (*LLMResponse, error) {
+	result := &LLMResponse{}
+	hasCandidates := false
+	var blockReason genai.BlockedReason
+
+	for chunk, err := range client.Models.GenerateContentStream(ctx, model, contents, generateConfig) {
+		if err != nil {
+			return result, err
+		}
+		if chunk == nil {
+			continue
+		}
+		if chunk.PromptFeedback != nil && chunk.PromptFeedback.BlockReason != "" {
+			blockReason = chunk.PromptFeedback.BlockReason
+		}
+
+		textBefore := len(result.Text)
+		mergeGeminiResponse(result, chunk)
+		if len(chunk.Candidates) > 0 {
+			hasCandidates = true
+		}
+
+		if delta := result.Text[textBefore:]; delta != "" && onText != nil {
+			onText(delta)
+		}
+	}
+
+	if !hasCandidates {
+		if blockReason != "" {
+			return result, upstream.NewSafetyBlocked(upstream.ProviderGemini, string(blockReason))
+		}
+		return result, fmt.Errorf("no candidates in Gemini response")
+	}
+
+	return result, nil
+}
+
+// prepareRequest builds the contents and config for req. When the system
+// prompt is served from a cached content, the config references it instead
+// of carrying the system instruction and tools, and the per-turn
+// SystemContext moves into the user turn. cacheName is empty otherwise.
+func (p *GeminiProvider) prepareRequest(
+	ctx context.Context,
+	client *genai.Client,
+	keyIndex int,
+	req *LLMRequest,
+) (contents []*genai.Content, generateConfig *genai.GenerateContentConfig, cacheName string) {
+	generateConfig = buildGeminiConfig(req)
+	if p.promptCache == nil {
+		return buildGeminiContents(req), generateConfig, ""
+	}
+
+	cacheName = p.promptCache.Get(ctx, client, keyIndex, req, generateConfig.Tools)
+	if cacheName == "" {
+		return buildGeminiContents(req), generateConfig, ""
+	}
+
+	generateConfig.CachedContent = cacheName
+	generateConfig.SystemInstruction = nil
+	generateConfig.Tools = nil
+	generateConfig.ToolConfig = nil
+
+	cachedReq := *req
+	if req.SystemContext != "" {
+		cachedReq.Prompt = req.SystemContext + "\n\n" + req.Prompt
+	}
+	return buildGeminiContents(&cachedReq), generateConfig, cacheName
+}
+
+// EmbedText returns the embedding for text using GEMINI_EMBEDDING_MODEL
+func (p *GeminiProvider) EmbedText(ctx context.Context, text string) ([]float32, error) {
+	resp, err := p.getClient().Models.EmbedContent(
+		ctx,
+		p.config.GeminiEmbeddingModel,
+		genai.Text(text),
+		nil,
+	)
+	if err != nil {
+		return nil, p.handleError(err)
+	}
+	if resp == nil || len(resp.Embeddings) == 0 {
+		return nil, fmt.Errorf("empty embedding response")
+	}
+	return resp.Embeddings[0].Values, nil
+}
+
+// EmbedTexts embeds texts in one batch request
+func (p *GeminiProvider) EmbedTexts(ctx context.Context, texts []string) ([][]float32, error) {
+	contents := make([]*genai.Content, 0, len(texts))
+	for _, text := range texts {
+		contents = append(contents, genai.Text(text)...)
+	}
+
+	resp, err := p.getClient().Models.EmbedContent(ctx, p.config.GeminiEmbeddingModel, contents, nil)
+	if err != nil {
+		return nil, p.handleError(err)
+	}
+	if resp == nil || len(resp.Embeddings) != len(texts) {
+		return nil, fmt.Errorf("embedding response doesn't match the %d texts", len(texts))
+	}
+
+	vectors := make([][]float32, len(texts))
+	for i, embedding := range resp.Embeddings {
+		vectors[i] = embedding.Values
+	}
+	return vectors, nil
+}
+
+func (p *GeminiProvider) getClient() *genai.Client {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.client
+}
+
+// getClientWithKey returns the client and the index of its API key
+func (p *GeminiProvider) getClientWithKey() (*genai.Client, int) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	return p.client, p.currentKeyIndex
+}
+
+// handleError classifies err and rotates the API key when the key itself is
+// the problem (rate limit, quota, invalid key). The classified error is returned.
+func (p *GeminiProvider) handleError(err error) error {
+	err = upstream.FromGemini(err)
+
+	p.mu.RLock()
+	keyIndex := p.currentKeyIndex
+	p.mu.RUnlock()
+
+	if p.keyRotator.ReportFailure(keyIndex, err) {
+		fmt.Printf("⚠️ Gemini key %d unusable (%s), rotating API key...\n", keyIndex, upstream.KindOf(err))
+		if rotateErr := p.rotateClient(); rotateErr != nil {
+			fmt.Printf("❌ Key rotation failed: %v\n", rotateErr)
+		}
+	}
+	return err
+}
+
+func (p *GeminiProvider) rotateClient() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	apiKey, keyIndex, err := p.keyRotator.GetNextKey()
+	if err != nil {
+		return fmt.Errorf("failed to get API key: %w", err)
+	}
+
+	client, err := genai.NewClient(p.ctx, &genai.ClientConfig{
+		APIKey:  apiKey,
+		Backend: genai.BackendGeminiAPI,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create Gemini client: %w", err)
+	}
+
+	p.client = client
+	p.currentKeyIndex = keyIndex
+	fmt.Printf("   🔄 Gemini API key rotated to key %d\n", keyIndex)
+	return nil
+}
+
+// buildGeminiContents returns the history turns, the prompt as the current
+// user turn and the model/user content pairs of previous tool turns
+func buildGeminiContents(req *LLMRequest) []*genai.Content {
+	var contents []*genai.Content
+
+	for _, msg := range req.History {
+		role := genai.Role(genai.RoleUser)
+		if msg.Role == LLMRoleAssistant {
+			role = genai.RoleModel
+		}
+		// Consecutive messages of one role are merged into a single turn
+		if last := len(contents) - 1; last >= 0 && contents[last].Role == string(role) {
+			contents[last].Parts = append(contents[last].Parts, &genai.Part{Text: msg.Text})
+			continue
+		}
+		contents = append(contents, genai.NewContentFromText(msg.Text, role))
+	}
+
+	if last := len(contents) - 1; last >= 0 && contents[last].Role == genai.RoleUser {
+		contents[last].Parts = append(contents[last].Parts, &genai.Part{Text: req.Prompt})
+	} else {
+		contents = append(contents, genai.NewContentFromText(req.Prompt, genai.RoleUser))
+	}
+
+	for _, turn := range req.ToolTurns {
+		modelContent := &genai.Content{Role: genai.RoleModel}
+		for _, call := range turn.Calls {
+			modelContent.Parts = append(modelContent.Parts, &genai.Part{
+				FunctionCall: &genai.FunctionCall{
+					ID:   call.ID,
+					Name: call.Name,
+					Args: call.Args,
+				},
+				ThoughtSignature: call.Signature,
+			})
+		}
+
+		userContent := &genai.Content{Role: genai.RoleUser}
+		for _, result := range turn.Results {
+			userContent.Parts = append(userContent.Parts, &genai.Part{
+				FunctionResponse: &genai.FunctionResponse{
+					ID:       result.CallID,
+					Name:     result.Name,
+					Response: result.Response,
+				},
+			})
+		}
+
+		contents = append(contents, modelContent, userContent)
+	}
+
+	return contents
+}
+
+func buildGeminiConfig(req *LLMRequest) *genai.GenerateContentConfig {
+	temp := req.Temperature
+	generateConfig := &genai.GenerateContentConfig{
+		Temperature:     &temp,
+		MaxOutputTokens: int32(req.MaxOutputTokens),
+	}
+
+	if system := req.fullSystemInstruction(); system != "" {
+		generateConfig.SystemInstruction = genai.NewContentFromText(system, genai.RoleUser)
+	}
+
+	if len(req.Tools) > 0 {
+		// Function calling can't be combined with Google Search or a JSON
+		// response MIME type, so the prompt carries the JSON format here too
+		generateConfig.Tools = []*genai.Tool{
+			{FunctionDeclarations: req.Tools},
+		}
+	} else if req.UseGrounding {
+		generateConfig.Tools = []*genai.Tool{
+			{GoogleSearch: &genai.GoogleSearch{}},
+		}
+		// When using grounding/tools, we CANNOT use ResponseSchema or ResponseMIMEType
+		// The API returns error: "Unsupported response mime type when response schema is set"
+		// The prompt is responsible for the JSON format instead
+	} else if req.JSONMode {
+		generateConfig.ResponseMIMEType = "application/json"
+		generateConfig.ResponseSchema = req.ResponseSchema
+	}
+
+	return generateConfig
+}
+
+// mergeGeminiResponse folds a (possibly partial, streamed) Gemini response
+// into result: text is appended, usage/finish reason take the latest values
+func mergeGeminiResponse(result *LLMResponse, resp *genai.GenerateContentResponse) {
+	if resp.UsageMetadata != nil {
+		usage := &LLMUsage{
+			PromptTokens: int(resp.UsageMetadata.PromptTokenCount),
+			CachedTokens: int(resp.UsageMetadata.CachedContentTokenCount),
+			TotalTokens:  int(resp.UsageMetadata.TotalTokenCount),
+		}
+		// Total includes thinking tokens, so output is derived from it
+		if usage.TotalTokens > 0 && usage.PromptTokens > 0 {
+			usage.OutputTokens = usage.TotalTokens - usage.PromptTokens
+		}
+		result.Usage = usage
+	}
+
+	if len(resp.Candidates) == 0 {
+		return
+	}
+
+	candidate := resp.Candidates[0]
+	if candidate.FinishReason != "" {
+		result.FinishReason = convertGeminiFinishReason(candidate.FinishReason)
+	} else if result.FinishReason == "" {
+		result.FinishReason = LLMFinishStop
+	}
+
+	if candidate.Content != nil {
+		var sb strings.Builder
+		sb.WriteString(result.Text)
+		for _, part := range candidate.Content.Parts {
+			if part.FunctionCall != nil {
+				result.ToolCalls = append(result.ToolCalls, LLMToolCall{
+					ID:        part.FunctionCall.ID,
+					Name:      part.FunctionCall.Name,
+					Args:      part.FunctionCall.Args,
+					Signature: part.ThoughtSignature,
+				})
+				continue
+			}
+			if part.Text != "" {
+				sb.WriteString(part.Text)
+			}
+		}
+		result.Text = sb.String()
+	}
+
+	if candidate.GroundingMetadata != nil {
+		// Streams repeat the metadata, so the latest one replaces earlier sources
+		result.Grounded = true
+		result.GroundingSources = nil
+		for _, chunk := range candidate.GroundingMetadata.GroundingChunks {
+			if chunk.Web != nil {
+				result.GroundingSources = append(result.GroundingSources, GroundingSource{
+					Title: chunk.Web.Title,
+					URI:   chunk.Web.URI,
+				})
+			}
+		}
+	}
+}
+
+func convertGeminiFinishReason(reason genai.FinishReason) LLMFinishReason {
+	switch reason {
+	case genai.FinishReasonStop, genai.FinishReasonUnspecified, "":
+		return LLMFinishStop
+	case genai.FinishReasonMaxTokens:
+		return LLMFinishMaxTokens
+	case genai.FinishReasonSafety, genai.FinishReasonProhibitedContent, genai.FinishReasonBlocklist, genai.FinishReasonSPII:
+		return LLMFinishSafety
+	default:
+		return LLMFinishOther
+	}
+}
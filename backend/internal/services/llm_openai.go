@@ -0,0 +1,531 @@
+package services
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/genai"
+
+	"mylittleprice/internal/config"
+	"mylittleprice/internal/upstream"
+)
+
+// OpenAIProvider implements LLMProvider and Embedder against any
+// OpenAI-compatible HTTP API (OpenAI, llama.cpp server, Ollama, vLLM)
+type OpenAIProvider struct {
+	httpClient *http.Client
+	config     *config.Config
+	baseURL    string
+}
+
+// NewOpenAIProvider creates an OpenAI-compatible provider from OPENAI_* settings
+func NewOpenAIProvider(cfg *config.Config) *OpenAIProvider {
+	return &OpenAIProvider{
+		httpClient: &http.Client{Timeout: cfg.OpenAITimeout},
+		config:     cfg,
+		baseURL:    strings.TrimSuffix(cfg.OpenAIBaseURL, "/"),
+	}
+}
+
+type openAIChatMessage struct {
+	Role       string           `json:"role"`
+	Content    string           `json:"content"`
+	ToolCalls  []openAIToolCall `json:"tool_calls,omitempty"`
+	ToolCallID string           `json:"tool_call_id,omitempty"`
+}
+
+type openAIToolCall struct {
+	Index    *int   `json:"index,omitempty"` // Only set on streamed deltas
+	ID       string `json:"id,omitempty"`
+	Type     string `json:"type,omitempty"`
+	Function struct {
+		Name      string `json:"name,omitempty"`
+		Arguments string `json:"arguments"`
+	} `json:"function"`
+}
+
+type openAITool struct {
+	Type     string             `json:"type"`
+	Function openAIToolFunction `json:"function"`
+}
+
+type openAIToolFunction struct {
+	Name        string                 `json:"name"`
+	Description string                 `json:"description,omitempty"`
+	Parameters  map[string]interface{} `json:"parameters,omitempty"`
+}
+
+type openAIResponseFormat struct {
+	Type       string            `json:"type"`
+	JSONSchema *openAIJSONSchema `json:"json_schema,omitempty"`
+}
+
+type openAIJSONSchema struct {
+	Name   string                 `json:"name"`
+	Schema map[string]interface{} `json:"schema"`
+}
+
+type openAIStreamOptions struct {
+	IncludeUsage bool `json:"include_usage"`
+}
+
+type openAIChatRequest struct {
+	Model          string                `json:"model"`
+	Messages       []openAIChatMessage   `json:"messages"`
+	Temperature    float32               `json:"temperature"`
+	MaxTokens      int                   `json:"max_tokens,omitempty"`
+	ResponseFormat *openAIResponseFormat `json:"response_format,omitempty"`
+	Tools          []openAITool          `json:"tools,omitempty"`
+	Stream         bool                  `json:"stream,omitempty"`
+	StreamOptions  *openAIStreamOptions  `json:"stream_options,omitempty"`
+}
+
+type openAIUsage struct {
+	PromptTokens        int `json:"prompt_tokens"`
+	CompletionTokens    int `json:"completion_tokens"`
+	TotalTokens         int `json:"total_tokens"`
+	PromptTokensDetails *struct {
+		CachedTokens int `json:"cached_tokens"`
+	} `json:"prompt_tokens_details,omitempty"`
+}
+
+type openAIChatResponse struct {
+	Choices []struct {
+		Message      openAIChatMessage `json:"message"`
+		FinishReason string            `json:"finish_reason"`
+	} `json:"choices"`
+	Usage *openAIUsage `json:"usage"`
+}
+
+// openAIChatStreamChunk is one "data:" event of a streamed chat completion
+type openAIChatStreamChunk struct {
+	Choices []struct {
+		Delta        openAIChatMessage `json:"delta"`
+		FinishReason *string           `json:"finish_reason"`
+	} `json:"choices"`
+	Usage *openAIUsage `json:"usage"`
+}
+
+type openAIEmbeddingRequest struct {
+	Model string      `json:"model"`
+	Input interface{} `json:"input"` // A string or a batch of strings
+}
+
+type openAIEmbeddingResponse struct {
+	Data []struct {
+		Index     int       `json:"index"`
+		Embedding []float32 `json:"embedding"`
+	} `json:"data"`
+}
+
+func (p *OpenAIProvider) Name() string {
+	return LLMProviderOpenAI
+}
+
+func (p *OpenAIProvider) Model() string {
+	return p.config.OpenAIModel
+}
+
+func (p *OpenAIProvider) FallbackModel() string {
+	return p.config.OpenAIFallbackModel
+}
+
+func (p *OpenAIProvider) SupportsGrounding() bool {
+	return false
+}
+
+// GenerateContent calls POST {base}/chat/completions once
+func (p *OpenAIProvider) GenerateContent(ctx context.Context, req *LLMRequest) (*LLMResponse, error) {
+	var chatResp openAIChatResponse
+	if err := p.post(ctx, "/chat/completions", buildOpenAIChatRequest(req), &chatResp); err != nil {
+		return nil, err
+	}
+
+	if len(chatResp.Choices) == 0 {
+		return nil, fmt.Errorf("no choices in OpenAI-compatible response")
+	}
+
+	choice := chatResp.Choices[0]
+	toolCalls, err := convertOpenAIToolCalls(choice.Message.ToolCalls)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &LLMResponse{
+		Text:         choice.Message.Content,
+		FinishReason: convertOpenAIFinishReason(choice.FinishReason),
+		Usage:        convertOpenAIUsage(chatResp.Usage),
+		ToolCalls:    toolCalls,
+	}
+
+	return result, nil
+}
+
+// GenerateContentStream calls POST {base}/chat/completions with stream=true
+// and reads the server-sent events until [DONE]
+func (p *OpenAIProvider) GenerateContentStream(ctx context.Context, req *LLMRequest, onText func(text string)) (*LLMResponse, error) {
+	chatReq := buildOpenAIChatRequest(req)
+	chatReq.Stream = true
+	chatReq.StreamOptions = &openAIStreamOptions{IncludeUsage: true}
+
+	resp, err := p.do(ctx, "/chat/completions", chatReq)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	result := &LLMResponse{FinishReason: LLMFinishStop}
+	var text strings.Builder
+	var toolCalls []openAIToolCall // Assembled from deltas by index
+
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+		data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		if data == "[DONE]" {
+			break
+		}
+
+		var chunk openAIChatStreamChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return nil, fmt.Errorf("failed to parse stream chunk: %w", err)
+		}
+
+		if chunk.Usage != nil {
+			result.Usage = convertOpenAIUsage(chunk.Usage)
+		}
+		if len(chunk.Choices) == 0 {
+			continue
+		}
+
+		choice := chunk.Choices[0]
+		if choice.FinishReason != nil {
+			result.FinishReason = convertOpenAIFinishReason(*choice.FinishReason)
+		}
+		if choice.Delta.Content != "" {
+			text.WriteString(choice.Delta.Content)
+			if onText != nil {
+				onText(choice.Delta.Content)
+			}
+		}
+		for _, delta := range choice.Delta.ToolCalls {
+			toolCalls = mergeOpenAIToolCallDelta(toolCalls, delta)
+		}
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("failed to read stream: %w", err)
+	}
+
+	calls, err := convertOpenAIToolCalls(toolCalls)
+	if err != nil {
+		return nil, err
+	}
+
+	result.Text = text.String()
+	result.ToolCalls = calls
+	return result, nil
+}
+
+// mergeOpenAIToolCallDelta appends a streamed tool call fragment: the first
+// fragment of a call carries its ID and name, later ones more argument text
+func mergeOpenAIToolCallDelta(calls []openAIToolCall, delta openAIToolCall) []openAIToolCall {
+	index := len(calls)
+	if delta.Index != nil {
+		index = *delta.Index
+	}
+	for len(calls) <= index {
+		calls = append(calls, openAIToolCall{Type: "function"})
+	}
+
+	call := &calls[index]
+	if delta.ID != "" {
+		call.ID = delta.ID
+	}
+	if delta.Function.Name != "" {
+		call.Function.Name = delta.Function.Name
+	}
+	call.Function.Arguments += delta.Function.Arguments
+	return calls
+}
+
+func buildOpenAIChatRequest(req *LLMRequest) openAIChatRequest {
+	chatReq := openAIChatRequest{
+		Model:       req.Model,
+		Temperature: req.Temperature,
+		MaxTokens:   req.MaxOutputTokens,
+	}
+
+	if system := req.fullSystemInstruction(); system != "" {
+		chatReq.Messages = append(chatReq.Messages, openAIChatMessage{Role: "system", Content: system})
+	}
+	for _, msg := range req.History {
+		role := "user"
+		if msg.Role == LLMRoleAssistant {
+			role = "assistant"
+		}
+		chatReq.Messages = append(chatReq.Messages, openAIChatMessage{Role: role, Content: msg.Text})
+	}
+	chatReq.Messages = append(chatReq.Messages, openAIChatMessage{Role: "user", Content: req.Prompt})
+
+	for _, decl := range req.Tools {
+		chatReq.Tools = append(chatReq.Tools, openAITool{
+			Type: "function",
+			Function: openAIToolFunction{
+				Name:        decl.Name,
+				Description: decl.Description,
+				Parameters:  convertSchemaToJSONSchema(decl.Parameters),
+			},
+		})
+	}
+
+	for _, turn := range req.ToolTurns {
+		assistant := openAIChatMessage{Role: "assistant"}
+		for _, call := range turn.Calls {
+			args, _ := json.Marshal(call.Args)
+			toolCall := openAIToolCall{ID: call.ID, Type: "function"}
+			toolCall.Function.Name = call.Name
+			toolCall.Function.Arguments = string(args)
+			assistant.ToolCalls = append(assistant.ToolCalls, toolCall)
+		}
+		chatReq.Messages = append(chatReq.Messages, assistant)
+
+		for _, result := range turn.Results {
+			content, _ := json.Marshal(result.Response)
+			chatReq.Messages = append(chatReq.Messages, openAIChatMessage{
+				Role:       "tool",
+				Content:    string(content),
+				ToolCallID: result.CallID,
+			})
+		}
+	}
+
+	if req.JSONMode {
+		if req.ResponseSchema != nil {
+			chatReq.ResponseFormat = &openAIResponseFormat{
+				Type: "json_schema",
+				JSONSchema: &openAIJSONSchema{
+					Name:   "response",
+					Schema: convertSchemaToJSONSchema(req.ResponseSchema),
+				},
+			}
+		} else {
+			chatReq.ResponseFormat = &openAIResponseFormat{Type: "json_object"}
+		}
+	}
+
+	return chatReq
+}
+
+// EmbedText calls POST {base}/embeddings with OPENAI_EMBEDDING_MODEL
+func (p *OpenAIProvider) EmbedText(ctx context.Context, text string) ([]float32, error) {
+	var embResp openAIEmbeddingResponse
+	err := p.post(ctx, "/embeddings", openAIEmbeddingRequest{
+		Model: p.config.OpenAIEmbeddingModel,
+		Input: text,
+	}, &embResp)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(embResp.Data) == 0 || len(embResp.Data[0].Embedding) == 0 {
+		return nil, fmt.Errorf("empty embedding response")
+	}
+	return embResp.Data[0].Embedding, nil
+}
+
+// EmbedTexts embeds texts in one request
+func (p *OpenAIProvider) EmbedTexts(ctx context.Context, texts []string) ([][]float32, error) {
+	var embResp openAIEmbeddingResponse
+	err := p.post(ctx, "/embeddings", openAIEmbeddingRequest{
+		Model: p.config.OpenAIEmbeddingModel,
+		Input: texts,
+	}, &embResp)
+	if err != nil {
+		return nil, err
+	}
+
+	vectors := make([][]float32, len(texts))
+	for _, data := range embResp.Data {
+		if data.Index < 0 || data.Index >= len(texts) {
+			return nil, fmt.Errorf("embedding response index %d out of range", data.Index)
+		}
+		vectors[data.Index] = data.Embedding
+	}
+	for i, vector := range vectors {
+		if len(vector) == 0 {
+			return nil, fmt.Errorf("embedding response is missing text %d", i)
+		}
+	}
+	return vectors, nil
+}
+
+func (p *OpenAIProvider) post(ctx context.Context, path string, body interface{}, out interface{}) error {
+	resp, err := p.do(ctx, path, body)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response: %w", err)
+	}
+
+	if err := json.Unmarshal(respBody, out); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+	return nil
+}
+
+// do sends a JSON POST and returns the response if the status is 2xx.
+// The caller must close the body.
+func (p *OpenAIProvider) do(ctx context.Context, path string, body interface{}) (*http.Response, error) {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal request: %w", err)
+	}
+
+	httpReq, err := http.NewRequestWithContext(ctx, http.MethodPost, p.baseURL+path, bytes.NewReader(payload))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create request: %w", err)
+	}
+	httpReq.Header.Set("Content-Type", "application/json")
+	if p.config.OpenAIAPIKey != "" {
+		httpReq.Header.Set("Authorization", "Bearer "+p.config.OpenAIAPIKey)
+	}
+
+	resp, err := p.httpClient.Do(httpReq)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, fmt.Errorf("OpenAI-compatible request failed: %w", err)
+		}
+		return nil, upstream.New(LLMProviderOpenAI, upstream.KindTransient, 0, fmt.Errorf("OpenAI-compatible request failed: %w", err))
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		respBody, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		resp.Body.Close()
+		body := strings.TrimSpace(string(respBody))
+		upstreamErr := upstream.FromHTTPStatus(LLMProviderOpenAI, resp.StatusCode, body,
+			fmt.Errorf("OpenAI-compatible API error %d: %s", resp.StatusCode, body))
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			upstreamErr.RetryAfter = time.Duration(seconds) * time.Second
+		}
+		return nil, upstreamErr
+	}
+
+	return resp, nil
+}
+
+func convertOpenAIUsage(usage *openAIUsage) *LLMUsage {
+	if usage == nil {
+		return nil
+	}
+	result := &LLMUsage{
+		PromptTokens: usage.PromptTokens,
+		OutputTokens: usage.CompletionTokens,
+		TotalTokens:  usage.TotalTokens,
+	}
+	if usage.PromptTokensDetails != nil {
+		result.CachedTokens = usage.PromptTokensDetails.CachedTokens
+	}
+	return result
+}
+
+func convertOpenAIToolCalls(calls []openAIToolCall) ([]LLMToolCall, error) {
+	if len(calls) == 0 {
+		return nil, nil
+	}
+
+	result := make([]LLMToolCall, 0, len(calls))
+	for _, call := range calls {
+		args := map[string]interface{}{}
+		if strings.TrimSpace(call.Function.Arguments) != "" {
+			if err := json.Unmarshal([]byte(call.Function.Arguments), &args); err != nil {
+				return nil, fmt.Errorf("invalid arguments for tool %s: %w", call.Function.Name, err)
+			}
+		}
+		result = append(result, LLMToolCall{
+			ID:   call.ID,
+			Name: call.Function.Name,
+			Args: args,
+		})
+	}
+	return result, nil
+}
+
+func convertOpenAIFinishReason(reason string) LLMFinishReason {
+	switch reason {
+	case "stop", "tool_calls", "":
+		return LLMFinishStop
+	case "length":
+		return LLMFinishMaxTokens
+	case "content_filter":
+		return LLMFinishSafety
+	default:
+		return LLMFinishOther
+	}
+}
+
+// convertSchemaToJSONSchema converts a genai schema (OpenAPI subset) into a
+// plain JSON Schema object accepted by OpenAI-style structured outputs
+func convertSchemaToJSONSchema(schema *genai.Schema) map[string]interface{} {
+	if schema == nil {
+		return nil
+	}
+
+	result := map[string]interface{}{}
+
+	nullable := schema.Nullable != nil && *schema.Nullable
+
+	if schema.Type != "" {
+		jsonType := strings.ToLower(string(schema.Type))
+		if nullable {
+			result["type"] = []string{jsonType, "null"}
+		} else {
+			result["type"] = jsonType
+		}
+	}
+	if schema.Description != "" {
+		result["description"] = schema.Description
+	}
+	if len(schema.Enum) > 0 {
+		enum := make([]interface{}, 0, len(schema.Enum)+1)
+		for _, v := range schema.Enum {
+			enum = append(enum, v)
+		}
+		if nullable {
+			enum = append(enum, nil)
+		}
+		result["enum"] = enum
+	}
+	if len(schema.Required) > 0 {
+		result["required"] = schema.Required
+	}
+	if schema.Items != nil {
+		result["items"] = convertSchemaToJSONSchema(schema.Items)
+	}
+	if len(schema.Properties) > 0 {
+		props := make(map[string]interface{}, len(schema.Properties))
+		for name, prop := range schema.Properties {
+			props[name] = convertSchemaToJSONSchema(prop)
+		}
+		result["properties"] = props
+	}
+
+	return result
+}
@@ -0,0 +1,104 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ModelPrice is the list price of a model in USD
+type ModelPrice struct {
+	InputPerMillion       float64 `json:"input"`                 // Per 1M uncached input tokens
+	CachedInputPerMillion float64 `json:"cached_input"`          // Per 1M input tokens served from the prompt cache
+	OutputPerMillion      float64 `json:"output"`                // Per 1M output tokens (including thinking)
+	GroundingPerRequest   float64 `json:"grounding_per_request"` // Per grounded (Google Search) request
+}
+
+// defaultModelPrices are the paid-tier list prices, keyed by model name or
+// prefix (the longest matching prefix wins, so preview versions like
+// "gemini-2.5-flash-preview-09-2025" use the "gemini-2.5-flash" price).
+// Models without a price (local OpenAI-compatible servers) cost nothing.
+var defaultModelPrices = map[string]ModelPrice{
+	"gemini-2.5-pro":           {InputPerMillion: 1.25, CachedInputPerMillion: 0.125, OutputPerMillion: 10.00, GroundingPerRequest: 0.035},
+	"gemini-2.5-flash":         {InputPerMillion: 0.30, CachedInputPerMillion: 0.03, OutputPerMillion: 2.50, GroundingPerRequest: 0.035},
+	"gemini-2.5-flash-lite":    {InputPerMillion: 0.10, CachedInputPerMillion: 0.01, OutputPerMillion: 0.40, GroundingPerRequest: 0.035},
+	"gemini-2.0-flash":         {InputPerMillion: 0.10, CachedInputPerMillion: 0.025, OutputPerMillion: 0.40, GroundingPerRequest: 0.035},
+	"gemini-2.0-flash-lite":    {InputPerMillion: 0.075, CachedInputPerMillion: 0.075, OutputPerMillion: 0.30},
+	"gemini-flash-latest":      {InputPerMillion: 0.30, CachedInputPerMillion: 0.03, OutputPerMillion: 2.50, GroundingPerRequest: 0.035},
+	"gemini-flash-lite-latest": {InputPerMillion: 0.10, CachedInputPerMillion: 0.01, OutputPerMillion: 0.40, GroundingPerRequest: 0.035},
+	"gemini-embedding-001":     {InputPerMillion: 0.15},
+	"text-embedding-004":       {}, // Free
+	"gpt-4o-mini":              {InputPerMillion: 0.15, CachedInputPerMillion: 0.075, OutputPerMillion: 0.60},
+	"gpt-4o":                   {InputPerMillion: 2.50, CachedInputPerMillion: 1.25, OutputPerMillion: 10.00},
+	"text-embedding-3-small":   {InputPerMillion: 0.02},
+	"text-embedding-3-large":   {InputPerMillion: 0.13},
+}
+
+// PriceTable resolves model prices for cost accounting
+type PriceTable struct {
+	prices map[string]ModelPrice
+}
+
+// NewPriceTable returns the default prices, overridden by the JSON file at
+// path if set (an object of model name -> ModelPrice)
+func NewPriceTable(path string) (*PriceTable, error) {
+	prices := make(map[string]ModelPrice, len(defaultModelPrices))
+	for model, price := range defaultModelPrices {
+		prices[model] = price
+	}
+
+	if path != "" {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read price table: %w", err)
+		}
+		var overrides map[string]ModelPrice
+		if err := json.Unmarshal(data, &overrides); err != nil {
+			return nil, fmt.Errorf("failed to parse price table: %w", err)
+		}
+		for model, price := range overrides {
+			prices[strings.ToLower(model)] = price
+		}
+	}
+
+	return &PriceTable{prices: prices}, nil
+}
+
+// Lookup returns the price of model by exact name, then by longest prefix
+func (t *PriceTable) Lookup(model string) (ModelPrice, bool) {
+	model = strings.TrimPrefix(strings.ToLower(model), "models/")
+	if price, ok := t.prices[model]; ok {
+		return price, true
+	}
+
+	bestLen := 0
+	var best ModelPrice
+	for name, price := range t.prices {
+		if len(name) > bestLen && strings.HasPrefix(model, name) {
+			best, bestLen = price, len(name)
+		}
+	}
+	return best, bestLen > 0
+}
+
+// Cost returns the USD cost of one call. inputTokens includes cachedTokens.
+func (t *PriceTable) Cost(model string, inputTokens, cachedTokens, outputTokens int, grounded bool) float64 {
+	price, ok := t.Lookup(model)
+	if !ok {
+		return 0
+	}
+
+	uncached := inputTokens - cachedTokens
+	if uncached < 0 {
+		uncached = 0
+	}
+
+	cost := float64(uncached)*price.InputPerMillion/1e6 +
+		float64(cachedTokens)*price.CachedInputPerMillion/1e6 +
+		float64(outputTokens)*price.OutputPerMillion/1e6
+	if grounded {
+		cost += price.GroundingPerRequest
+	}
+	return cost
+}
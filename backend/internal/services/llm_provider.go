@@ -0,0 +1,200 @@
+package services
+
+import (
+	"context"
+	"fmt"
+
+	"google.golang.org/genai"
+
+	"mylittleprice/internal/config"
+	"mylittleprice/internal/utils"
+)
+
+// Supported values for LLM_PROVIDER / EMBEDDING_PROVIDER
+const (
+	LLMProviderGemini = "gemini"
+	LLMProviderOpenAI = "openai"
+)
+
+// LLMProvider is the text generation backend behind GeminiService and
+// ContextExtractorService
+type LLMProvider interface {
+	// Name returns the provider identifier ("gemini", "openai")
+	Name() string
+	// Model returns the primary model name
+	Model() string
+	// FallbackModel returns the lighter model used for retries and side tasks
+	// (empty if not configured)
+	FallbackModel() string
+	// SupportsGrounding reports whether UseGrounding has any effect
+	SupportsGrounding() bool
+	// GenerateContent performs a single generation call (no retries)
+	GenerateContent(ctx context.Context, req *LLMRequest) (*LLMResponse, error)
+	// GenerateContentStream performs a single streaming generation call,
+	// invoking onText with each raw text chunk as it arrives. The returned
+	// response holds the full accumulated text.
+	GenerateContentStream(ctx context.Context, req *LLMRequest, onText func(text string)) (*LLMResponse, error)
+}
+
+// Embedder produces vector embeddings for EmbeddingService
+type Embedder interface {
+	// Name returns the provider identifier, used to namespace cached vectors
+	Name() string
+	// EmbedText returns the embedding vector for text
+	EmbedText(ctx context.Context, text string) ([]float32, error)
+	// EmbedTexts returns the embedding vectors for texts, in order, in one request
+	EmbedTexts(ctx context.Context, texts []string) ([][]float32, error)
+}
+
+// LLMRole is the author of a conversation turn
+type LLMRole string
+
+const (
+	LLMRoleUser      LLMRole = "user"
+	LLMRoleAssistant LLMRole = "assistant"
+)
+
+// LLMMessage is one earlier turn of the conversation
+type LLMMessage struct {
+	Role LLMRole
+	Text string
+}
+
+// LLMRequest is a provider-neutral generation request. The conversation is
+// sent as SystemInstruction + SystemContext, then History, then Prompt as the
+// current user turn (followed by ToolTurns, if any).
+type LLMRequest struct {
+	Model             string
+	SystemInstruction string // Static part of the system prompt, cacheable
+	SystemContext     string // Per-turn part of the system prompt, never cached
+	History           []LLMMessage
+	Prompt            string
+	Temperature       float32
+	MaxOutputTokens   int
+
+	// CacheKey identifies SystemInstruction for providers with explicit
+	// prompt caching (empty disables caching for the request)
+	CacheKey string
+
+	// JSONMode asks for a JSON-only response; ResponseSchema (optional)
+	// constrains its structure on providers that support structured output
+	JSONMode       bool
+	ResponseSchema *genai.Schema
+
+	// UseGrounding enables web search grounding where supported
+	UseGrounding bool
+
+	// Tools are functions the model may call instead of answering. ToolTurns
+	// replays earlier calls and their results after the prompt. Providers
+	// that cannot combine tools with grounding or JSON mode drop the latter.
+	Tools     []*genai.FunctionDeclaration
+	ToolTurns []LLMToolTurn
+}
+
+// fullSystemInstruction joins the static and per-turn system prompt parts
+func (r *LLMRequest) fullSystemInstruction() string {
+	switch {
+	case r.SystemContext == "":
+		return r.SystemInstruction
+	case r.SystemInstruction == "":
+		return r.SystemContext
+	default:
+		return r.SystemInstruction + "\n\n" + r.SystemContext
+	}
+}
+
+// LLMToolCall is a function call requested by the model
+type LLMToolCall struct {
+	ID        string // Provider call ID (required by OpenAI, usually empty for Gemini)
+	Name      string
+	Args      map[string]interface{}
+	Signature []byte // Opaque Gemini thought signature, sent back with the call
+}
+
+// LLMToolResult is the outcome of an executed tool call
+type LLMToolResult struct {
+	CallID   string
+	Name     string
+	Response map[string]interface{}
+}
+
+// LLMToolTurn is one round of tool use: the model's calls and their results
+type LLMToolTurn struct {
+	Calls   []LLMToolCall
+	Results []LLMToolResult
+}
+
+// LLMFinishReason is a provider-neutral reason why generation stopped
+type LLMFinishReason string
+
+const (
+	LLMFinishStop      LLMFinishReason = "stop"
+	LLMFinishMaxTokens LLMFinishReason = "max_tokens"
+	LLMFinishSafety    LLMFinishReason = "safety"
+	LLMFinishOther     LLMFinishReason = "other"
+)
+
+// LLMUsage holds token counts reported by the provider
+type LLMUsage struct {
+	PromptTokens int
+	CachedTokens int // Part of PromptTokens served from the provider's prompt cache
+	OutputTokens int
+	TotalTokens  int
+}
+
+// GroundingSource is a web source the model used while grounding
+type GroundingSource struct {
+	Title string
+	URI   string
+}
+
+// LLMResponse is a provider-neutral generation result
+type LLMResponse struct {
+	Text             string
+	FinishReason     LLMFinishReason
+	Usage            *LLMUsage
+	Grounded         bool
+	GroundingSources []GroundingSource
+	ToolCalls        []LLMToolCall // Non-empty when the model wants tools executed
+}
+
+// NewLLMProvider creates the generation provider selected by cfg.LLMProvider
+func NewLLMProvider(cfg *config.Config, geminiRotator *utils.KeyRotator) (LLMProvider, error) {
+	switch cfg.LLMProvider {
+	case LLMProviderGemini:
+		provider, err := NewGeminiProvider(geminiRotator, cfg)
+		if err != nil {
+			return nil, err
+		}
+		return provider, nil
+	case LLMProviderOpenAI:
+		return NewOpenAIProvider(cfg), nil
+	default:
+		return nil, fmt.Errorf("unknown LLM provider: %s", cfg.LLMProvider)
+	}
+}
+
+// NewEmbedder creates the embedding provider selected by cfg.EmbeddingProvider.
+// If it matches the generation provider, the same instance is reused.
+func NewEmbedder(cfg *config.Config, geminiRotator *utils.KeyRotator, llm LLMProvider) (Embedder, error) {
+	if llm != nil && cfg.EmbeddingProvider == llm.Name() {
+		if embedder, ok := llm.(Embedder); ok {
+			return embedder, nil
+		}
+	}
+
+	switch cfg.EmbeddingProvider {
+	case LLMProviderGemini:
+		provider, err := NewGeminiProvider(geminiRotator, cfg)
+		if err != nil {
+			return nil, err
+		}
+		return provider, nil
+	case LLMProviderOpenAI:
+		return NewOpenAIProvider(cfg), nil
+	case EmbeddingProviderLocal:
+		return NewLocalEmbedder(cfg.LocalEmbeddingDimensions), nil
+	default:
+		return nil, fmt.Errorf("unknown embedding provider: %s", cfg.EmbeddingProvider)
+	}
+}
@@ -0,0 +1,128 @@
+package services
+
+import (
+	"context"
+	"time"
+	"unicode/utf8"
+
+	"github.com/google/uuid"
+
+	"mylittleprice/internal/config"
+	"mylittleprice/internal/utils"
+)
+
+// MeteredLLMProvider records the usage of every successful call of the
+// wrapped provider. The session and user are taken from the request context
+// (utils.WithSessionID / utils.WithUserID). Tokens are also added to the
+// context's TokenMeter for quota accounting.
+type MeteredLLMProvider struct {
+	LLMProvider
+	usage *UsageService
+}
+
+// NewMeteredLLMProvider wraps llm with usage recording (usage may be nil to
+// only meter tokens)
+func NewMeteredLLMProvider(llm LLMProvider, usage *UsageService) *MeteredLLMProvider {
+	return &MeteredLLMProvider{LLMProvider: llm, usage: usage}
+}
+
+func (m *MeteredLLMProvider) GenerateContent(ctx context.Context, req *LLMRequest) (*LLMResponse, error) {
+	start := time.Now()
+	resp, err := m.LLMProvider.GenerateContent(ctx, req)
+	if err == nil {
+		m.record(ctx, req, resp, time.Since(start))
+	}
+	return resp, err
+}
+
+func (m *MeteredLLMProvider) GenerateContentStream(ctx context.Context, req *LLMRequest, onText func(text string)) (*LLMResponse, error) {
+	start := time.Now()
+	resp, err := m.LLMProvider.GenerateContentStream(ctx, req, onText)
+	if err == nil {
+		m.record(ctx, req, resp, time.Since(start))
+	}
+	return resp, err
+}
+
+func (m *MeteredLLMProvider) record(ctx context.Context, req *LLMRequest, resp *LLMResponse, latency time.Duration) {
+	if resp.Usage != nil {
+		meterTokens(ctx, resp.Usage.PromptTokens+resp.Usage.OutputTokens)
+	}
+	if m.usage == nil {
+		return
+	}
+
+	record := newUsageRecord(ctx, m.Name(), req.Model, UsageOperationGenerate, latency)
+	record.Grounded = req.UseGrounding && resp.Grounded
+	if resp.Usage != nil {
+		record.InputTokens = resp.Usage.PromptTokens
+		record.CachedTokens = resp.Usage.CachedTokens
+		record.OutputTokens = resp.Usage.OutputTokens
+	}
+	m.usage.Record(record)
+}
+
+// MeteredEmbedder records every successful embedding call of the wrapped
+// embedder. Embedding responses carry no token counts, so input tokens are
+// estimated from the text length.
+type MeteredEmbedder struct {
+	Embedder
+	model string
+	usage *UsageService
+}
+
+// NewMeteredEmbedder wraps embedder with usage recording
+func NewMeteredEmbedder(embedder Embedder, cfg *config.Config, usage *UsageService) *MeteredEmbedder {
+	model := cfg.GeminiEmbeddingModel
+	if embedder.Name() == LLMProviderOpenAI {
+		model = cfg.OpenAIEmbeddingModel
+	}
+	return &MeteredEmbedder{Embedder: embedder, model: model, usage: usage}
+}
+
+func (m *MeteredEmbedder) EmbedText(ctx context.Context, text string) ([]float32, error) {
+	start := time.Now()
+	values, err := m.Embedder.EmbedText(ctx, text)
+	if err == nil {
+		record := newUsageRecord(ctx, m.Name(), m.model, UsageOperationEmbed, time.Since(start))
+		record.InputTokens = estimateTokens(text)
+		m.usage.Record(record)
+	}
+	return values, err
+}
+
+func (m *MeteredEmbedder) EmbedTexts(ctx context.Context, texts []string) ([][]float32, error) {
+	start := time.Now()
+	vectors, err := m.Embedder.EmbedTexts(ctx, texts)
+	if err == nil {
+		record := newUsageRecord(ctx, m.Name(), m.model, UsageOperationEmbed, time.Since(start))
+		for _, text := range texts {
+			record.InputTokens += estimateTokens(text)
+		}
+		m.usage.Record(record)
+	}
+	return vectors, err
+}
+
+func newUsageRecord(ctx context.Context, provider, model, operation string, latency time.Duration) UsageRecord {
+	record := UsageRecord{
+		Provider:  provider,
+		Model:     model,
+		Operation: operation,
+		Latency:   latency,
+	}
+	if sessionID, ok := ctx.Value(utils.SessionIDKey).(string); ok {
+		record.SessionID = sessionID
+	}
+	if userID, ok := ctx.Value(utils.UserIDKey).(string); ok {
+		if parsed, err := uuid.Parse(userID); err == nil {
+			record.UserID = &parsed
+		}
+	}
+	return record
+}
+
+// estimateTokens approximates the token count of text (~4 characters per token)
+func estimateTokens(text string) int {
+	return (utf8.RuneCountInString(text) + 3) / 4
+}
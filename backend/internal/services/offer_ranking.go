@@ -0,0 +1,137 @@
+package services
+
+import (
+	"sort"
+	"strings"
+
+	"mylittleprice/internal/domain"
+	"mylittleprice/internal/models"
+)
+
+// Offer availabilities, as reported in models.OfferRank
+const (
+	AvailabilityInStock    = "in_stock"
+	AvailabilityLimited    = "limited"
+	AvailabilityOutOfStock = "out_of_stock"
+	AvailabilityUnknown    = "unknown"
+)
+
+// Weights of the best offer score
+const (
+	offerPriceWeight  = 0.7
+	offerRatingWeight = 0.3
+)
+
+// offerAvailabilityFactor scales the score of an offer by how sure it is
+// that it can be bought
+var offerAvailabilityFactor = map[string]float64{
+	AvailabilityInStock:    1,
+	AvailabilityUnknown:    0.95,
+	AvailabilityLimited:    0.9,
+	AvailabilityOutOfStock: 0,
+}
+
+// Availability phrases of the stores' languages. Out of stock is matched
+// first, as "unavailable" contains "available".
+var (
+	outOfStockPhrases = []string{"out of stock", "sold out", "unavailable", "нет в наличии", "немає в наявності", "ausverkauft", "nicht verfügbar"}
+	limitedPhrases    = []string{"limited", "low stock", " left", "заканчивается", "закінчується"}
+	inStockPhrases    = []string{"in stock", "available", "в наличии", "в наявності", "auf lager", "verfügbar"}
+)
+
+// RankOffers ranks offers best first. An offer's score is the cheapest
+// landed price (price plus shipping) over its own, weighed against the
+// merchant's rating (shrunk like RatingScorer's), times a factor for its
+// availability. Prices are only compared within the currency most offers
+// are in: offers in another currency, or without a price, get no price
+// score. Ties keep the store order.
+func RankOffers(offers []models.Offer) []models.OfferRank {
+	if len(offers) == 0 {
+		return nil
+	}
+
+	ranks := make([]models.OfferRank, len(offers))
+	currencyCount := make(map[domain.Currency]int)
+	var currency domain.Currency
+	for i, offer := range offers {
+		ranks[i] = models.OfferRank{
+			Offer:        i,
+			LandedPrice:  landedPrice(offer),
+			Availability: offerAvailability(offer.Availability),
+		}
+		if landed := ranks[i].LandedPrice; landed != nil && landed.Amount > 0 {
+			currencyCount[landed.Currency]++
+			if currencyCount[landed.Currency] > currencyCount[currency] {
+				currency = landed.Currency
+			}
+		}
+	}
+
+	var cheapest int64 // Out of stock offers aren't a price to beat
+	for _, rank := range ranks {
+		if rank.Availability == AvailabilityOutOfStock || !comparablePrice(rank, currency) {
+			continue
+		}
+		if cheapest == 0 || rank.LandedPrice.Amount < cheapest {
+			cheapest = rank.LandedPrice.Amount
+		}
+	}
+
+	ratings := NewRatingScorer()
+	for i := range ranks {
+		var priceScore float64
+		if comparablePrice(ranks[i], currency) {
+			priceScore = float64(cheapest) / float64(ranks[i].LandedPrice.Amount)
+		}
+		offer := offers[i]
+		score := offerPriceWeight*priceScore + offerRatingWeight*ratings.shrunkRating(offer.Rating, offer.Reviews)
+		ranks[i].Score = score * offerAvailabilityFactor[ranks[i].Availability]
+	}
+
+	sort.SliceStable(ranks, func(i, j int) bool {
+		return ranks[i].Score > ranks[j].Score
+	})
+	return ranks
+}
+
+// landedPrice returns the offer's price plus shipping, nil if its price is unknown
+func landedPrice(offer models.Offer) *domain.Money {
+	if offer.Amount == nil {
+		return nil
+	}
+	landed := *offer.Amount
+	if offer.ShippingExtracted > 0 {
+		landed.Amount += domain.NewMoney(offer.ShippingExtracted, landed.Currency).Amount
+	}
+	return &landed
+}
+
+func comparablePrice(rank models.OfferRank, currency domain.Currency) bool {
+	return rank.LandedPrice != nil && rank.LandedPrice.Amount > 0 && rank.LandedPrice.Currency == currency
+}
+
+// offerAvailability classifies a store's availability text
+func offerAvailability(text string) string {
+	text = strings.ToLower(strings.TrimSpace(text))
+	switch {
+	case text == "":
+		return AvailabilityUnknown
+	case containsAny(text, outOfStockPhrases):
+		return AvailabilityOutOfStock
+	case containsAny(text, limitedPhrases):
+		return AvailabilityLimited
+	case containsAny(text, inStockPhrases):
+		return AvailabilityInStock
+	default:
+		return AvailabilityUnknown
+	}
+}
+
+func containsAny(text string, phrases []string) bool {
+	for _, phrase := range phrases {
+		if strings.Contains(text, phrase) {
+			return true
+		}
+	}
+	return false
+}
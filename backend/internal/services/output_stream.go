@@ -0,0 +1,126 @@
+package services
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+	"unicode/utf8"
+)
+
+var outputFieldPattern = regexp.MustCompile(`"output"\s*:\s*"`)
+
+// outputStreamExtractor pulls the "output" string field out of a JSON
+// response while it is still being generated, so the user-facing text can be
+// streamed before the whole object (products, quick replies...) is complete
+type outputStreamExtractor struct {
+	buf      strings.Builder
+	pos      int  // next unread byte of buf
+	started  bool // opening quote of "output" found
+	finished bool // closing quote of "output" found
+}
+
+// Feed appends a raw chunk of model text and returns the newly decoded part
+// of the output string (empty if nothing new is available yet)
+func (e *outputStreamExtractor) Feed(chunk string) string {
+	if e.finished {
+		return ""
+	}
+	e.buf.WriteString(chunk)
+	raw := e.buf.String()
+
+	if !e.started {
+		loc := outputFieldPattern.FindStringIndex(raw)
+		if loc == nil {
+			return ""
+		}
+		e.started = true
+		e.pos = loc[1]
+	}
+
+	var out strings.Builder
+	for e.pos < len(raw) {
+		c := raw[e.pos]
+
+		if c == '"' {
+			e.finished = true
+			e.pos++
+			break
+		}
+
+		if c != '\\' {
+			r, size := utf8.DecodeRuneInString(raw[e.pos:])
+			if r == utf8.RuneError && !utf8.FullRuneInString(raw[e.pos:]) {
+				break // wait for the rest of a multi-byte character
+			}
+			out.WriteString(raw[e.pos : e.pos+size])
+			e.pos += size
+			continue
+		}
+
+		// Escape sequence - stop if it's not complete yet
+		if e.pos+1 >= len(raw) {
+			break
+		}
+		esc := raw[e.pos+1]
+		if esc != 'u' {
+			out.WriteString(decodeSimpleEscape(esc))
+			e.pos += 2
+			continue
+		}
+
+		r, size, ok := decodeUnicodeEscape(raw[e.pos:])
+		if !ok {
+			break
+		}
+		out.WriteRune(r)
+		e.pos += size
+	}
+
+	return out.String()
+}
+
+func decodeSimpleEscape(esc byte) string {
+	switch esc {
+	case 'n':
+		return "\n"
+	case 't':
+		return "\t"
+	case 'r':
+		return "\r"
+	case 'b':
+		return "\b"
+	case 'f':
+		return "\f"
+	default: // '"', '\\', '/'
+		return string(esc)
+	}
+}
+
+// decodeUnicodeEscape decodes \uXXXX (and a following low surrogate if
+// needed). ok is false when more input is required.
+func decodeUnicodeEscape(s string) (r rune, size int, ok bool) {
+	if len(s) < 6 {
+		return 0, 0, false
+	}
+	code, err := strconv.ParseUint(s[2:6], 16, 16)
+	if err != nil {
+		return utf8.RuneError, 6, true
+	}
+	r = rune(code)
+
+	if !utf16.IsSurrogate(r) {
+		return r, 6, true
+	}
+	if len(s) < 12 {
+		return 0, 0, false
+	}
+	if s[6] != '\\' || s[7] != 'u' {
+		return utf8.RuneError, 6, true
+	}
+	low, err := strconv.ParseUint(s[8:12], 16, 16)
+	if err != nil {
+		return utf8.RuneError, 6, true
+	}
+	return utf16.DecodeRune(r, rune(low)), 12, true
+}
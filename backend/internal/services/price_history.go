@@ -0,0 +1,347 @@
+package services
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"mylittleprice/ent"
+	"mylittleprice/ent/priceobservation"
+	"mylittleprice/internal/domain"
+	"mylittleprice/internal/models"
+)
+
+// Sources of price observations
+const (
+	PriceSourceSearch  = "search"
+	PriceSourceDetails = "details"
+)
+
+const (
+	priceQueueSize        = 1000
+	priceBatchSize        = 200
+	priceFlushInterval    = 5 * time.Second
+	priceHistoryMaxPoints = 5000 // Observations read for one product's history
+)
+
+// PriceProductKey returns the stable identity of a product across searches:
+// the provider's product ID if it has one, else the page token, else the
+// normalized title and merchant. Long values are hashed to keep keys short.
+func PriceProductKey(provider, productID, pageToken, title, merchant string) string {
+	switch {
+	case productID != "":
+		return "id:" + provider + ":" + productID
+	case pageToken != "":
+		return "token:" + shortHash(pageToken)
+	default:
+		return "title:" + shortHash(strings.Join(rankingTokens(title), " ")+"|"+strings.Join(rankingTokens(merchant), " "))
+	}
+}
+
+func shortHash(text string) string {
+	sum := sha256.Sum256([]byte(text))
+	return hex.EncodeToString(sum[:12])
+}
+
+// cardProvider returns the provider a card comes from, going by its page token
+func cardProvider(card models.ProductCard) string {
+	if provider, _, ok := parseProviderPageToken(card.PageToken); ok {
+		return provider
+	}
+	return SearchProviderSerpAPI
+}
+
+// PriceObservation is one price a provider returned for a product
+type PriceObservation struct {
+	ProductKey string
+	Provider   string
+	Source     string
+	ProductID  string
+	PageToken  string
+	Title      string
+	Merchant   string
+	Country    string
+	Price      domain.Money
+	ObservedAt time.Time
+}
+
+// PriceHistoryService records the prices of products returned by searches
+// and product details, and summarizes a product's price over time. Like
+// usage records, observations are written in batches by a background worker.
+type PriceHistoryService struct {
+	client       *ent.Client
+	fx           *FXService // Converts observations to one currency, nil if disabled
+	observations chan PriceObservation
+	done         chan struct{}
+	once         sync.Once
+}
+
+// NewPriceHistoryService creates the service and starts its writer. fx may be nil.
+func NewPriceHistoryService(client *ent.Client, fx *FXService) *PriceHistoryService {
+	s := &PriceHistoryService{
+		client:       client,
+		fx:           fx,
+		observations: make(chan PriceObservation, priceQueueSize),
+		done:         make(chan struct{}),
+	}
+	go s.writeLoop()
+	return s
+}
+
+// Record queues an observation. It never blocks: when the queue is full the
+// observation is dropped. Observations without a price are ignored.
+func (s *PriceHistoryService) Record(observation PriceObservation) {
+	if observation.Price.Amount <= 0 || observation.Price.Currency == "" || observation.Title == "" {
+		return
+	}
+	if observation.ObservedAt.IsZero() {
+		observation.ObservedAt = time.Now()
+	}
+	select {
+	case s.observations <- observation:
+	default:
+		fmt.Printf("⚠️ Price history queue full, dropping observation of %s\n", observation.ProductKey)
+	}
+}
+
+// RecordSearch records the prices of search results, setting each card's
+// ProductKey
+func (s *PriceHistoryService) RecordSearch(cards []models.ProductCard, country string) {
+	for i := range cards {
+		card := &cards[i]
+		provider := cardProvider(*card)
+		card.ProductKey = PriceProductKey(provider, card.ProductID, card.PageToken, card.Name, card.Merchant)
+		if card.Amount == nil {
+			continue
+		}
+		s.Record(PriceObservation{
+			ProductKey: card.ProductKey,
+			Provider:   provider,
+			Source:     PriceSourceSearch,
+			ProductID:  card.ProductID,
+			PageToken:  card.PageToken,
+			Title:      card.Name,
+			Merchant:   card.Merchant,
+			Country:    country,
+			Price:      *card.Amount,
+		})
+	}
+}
+
+// RecordDetails records the store prices of product details. They are
+// filed under the product the page token was last seen on in a search, so
+// that they join its history.
+func (s *PriceHistoryService) RecordDetails(ctx context.Context, pageToken string, product *domain.GoogleImmersiveProductResponse) {
+	provider := cardProvider(models.ProductCard{PageToken: pageToken})
+	productKey := PriceProductKey(provider, "", pageToken, product.ProductResults.Title, "")
+	seen, err := s.client.PriceObservation.Query().
+		Where(priceobservation.PageTokenEQ(pageToken)).
+		Order(ent.Desc(priceobservation.FieldObservedAt)).
+		First(ctx)
+	if err == nil {
+		productKey = seen.ProductKey
+	} else if !ent.IsNotFound(err) {
+		fmt.Printf("⚠️ Failed to look up product of page token: %v\n", err)
+	}
+
+	for _, store := range product.ProductResults.Offers() {
+		price := domain.ParsePrice(store.Price, store.ExtractedPrice, domain.Currency(store.Currency))
+		if price == nil {
+			continue
+		}
+		s.Record(PriceObservation{
+			ProductKey: productKey,
+			Provider:   provider,
+			Source:     PriceSourceDetails,
+			PageToken:  pageToken,
+			Title:      product.ProductResults.Title,
+			Merchant:   store.Name,
+			Price:      *price,
+		})
+	}
+}
+
+// PriceRecordingProvider records the prices every search and details
+// request of the wrapped provider returns
+type PriceRecordingProvider struct {
+	ProductSearchProvider
+	history *PriceHistoryService
+}
+
+// NewPriceRecordingProvider wraps provider with price recording
+func NewPriceRecordingProvider(provider ProductSearchProvider, history *PriceHistoryService) *PriceRecordingProvider {
+	return &PriceRecordingProvider{ProductSearchProvider: provider, history: history}
+}
+
+func (p *PriceRecordingProvider) Search(ctx context.Context, query ProductSearchQuery) ([]models.ProductCard, error) {
+	cards, err := p.ProductSearchProvider.Search(ctx, query)
+	if err == nil {
+		p.history.RecordSearch(cards, query.Country)
+	}
+	return cards, err
+}
+
+func (p *PriceRecordingProvider) GetDetails(ctx context.Context, pageToken, country string) (map[string]interface{}, error) {
+	data, err := p.ProductSearchProvider.GetDetails(ctx, pageToken, country)
+	if err != nil {
+		return data, err
+	}
+	if product, decodeErr := domain.DecodeImmersiveProduct(data); decodeErr == nil {
+		// The product lookup mustn't hold up the response
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			p.history.RecordDetails(ctx, pageToken, product)
+		}()
+	}
+	return data, nil
+}
+
+// Close flushes queued observations and stops the writer
+func (s *PriceHistoryService) Close() {
+	s.once.Do(func() {
+		close(s.observations)
+		<-s.done
+	})
+}
+
+func (s *PriceHistoryService) writeLoop() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(priceFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]PriceObservation, 0, priceBatchSize)
+	for {
+		select {
+		case observation, ok := <-s.observations:
+			if !ok {
+				s.flush(batch)
+				return
+			}
+			batch = append(batch, observation)
+			if len(batch) >= priceBatchSize {
+				s.flush(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			s.flush(batch)
+			batch = batch[:0]
+		}
+	}
+}
+
+func (s *PriceHistoryService) flush(batch []PriceObservation) {
+	if len(batch) == 0 {
+		return
+	}
+
+	builders := make([]*ent.PriceObservationCreate, 0, len(batch))
+	for _, observation := range batch {
+		builders = append(builders, s.client.PriceObservation.Create().
+			SetProductKey(observation.ProductKey).
+			SetProvider(observation.Provider).
+			SetSource(observation.Source).
+			SetProductID(observation.ProductID).
+			SetPageToken(observation.PageToken).
+			SetTitle(observation.Title).
+			SetMerchant(observation.Merchant).
+			SetCountry(observation.Country).
+			SetAmount(observation.Price.Amount).
+			SetCurrency(string(observation.Price.Currency)).
+			SetObservedAt(observation.ObservedAt))
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if _, err := s.client.PriceObservation.CreateBulk(builders...).Save(ctx); err != nil {
+		fmt.Printf("⚠️ Failed to save %d price observations: %v\n", len(batch), err)
+	}
+}
+
+// GetHistory summarizes the prices of a product observed since since: the
+// overall min/max/median and one point per UTC day. Prices are in currency,
+// or, if empty, in the currency of the latest observation; observations that
+// can't be converted to it are left out. nil means the product was never
+// observed.
+func (s *PriceHistoryService) GetHistory(ctx context.Context, productKey, currency string, since time.Time) (*models.PriceHistory, error) {
+	observations, err := s.client.PriceObservation.Query().
+		Where(
+			priceobservation.ProductKeyEQ(productKey),
+			priceobservation.ObservedAtGTE(since),
+		).
+		Order(ent.Desc(priceobservation.FieldObservedAt)).
+		Limit(priceHistoryMaxPoints).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query price history: %w", err)
+	}
+	if len(observations) == 0 {
+		return nil, nil
+	}
+
+	target := domain.Currency(currency)
+	if target == "" {
+		target = domain.Currency(observations[0].Currency)
+	}
+
+	history := &models.PriceHistory{
+		ProductKey: productKey,
+		Title:      observations[0].Title,
+		Currency:   string(target),
+		Series:     []models.PricePoint{},
+	}
+
+	var all []int64
+	byDay := make(map[string][]int64)
+	for _, observation := range observations {
+		price, ok := convertMoney(s.fx, domain.Money{Amount: observation.Amount, Currency: domain.Currency(observation.Currency)}, target)
+		if !ok {
+			continue
+		}
+		if history.Latest == nil {
+			history.Latest = &price
+			history.LatestAt = &observation.ObservedAt
+		}
+		day := observation.ObservedAt.UTC().Format("2006-01-02")
+		byDay[day] = append(byDay[day], price.Amount)
+		all = append(all, price.Amount)
+	}
+	if len(all) == 0 {
+		return history, nil
+	}
+
+	history.Observations = len(all)
+	history.PriceStats = priceStats(all, target)
+	for day, amounts := range byDay {
+		history.Series = append(history.Series, models.PricePoint{
+			Date:         day,
+			Observations: len(amounts),
+			PriceStats:   priceStats(amounts, target),
+		})
+	}
+	sort.Slice(history.Series, func(i, j int) bool { return history.Series[i].Date < history.Series[j].Date })
+	return history, nil
+}
+
+// priceStats returns the min, max and median of amounts (not empty)
+func priceStats(amounts []int64, currency domain.Currency) models.PriceStats {
+	sorted := append([]int64(nil), amounts...)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	median := sorted[len(sorted)/2]
+	if len(sorted)%2 == 0 {
+		median = (sorted[len(sorted)/2-1] + median) / 2
+	}
+	return models.PriceStats{
+		Min:    domain.Money{Amount: sorted[0], Currency: currency},
+		Max:    domain.Money{Amount: sorted[len(sorted)-1], Currency: currency},
+		Median: domain.Money{Amount: median, Currency: currency},
+	}
+}
@@ -0,0 +1,393 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"google.golang.org/genai"
+
+	"mylittleprice/internal/config"
+	"mylittleprice/internal/models"
+)
+
+// Product tool names offered to the model when function calling is enabled
+const (
+	ToolSearchProducts    = "search_products"
+	ToolGetProductDetails = "get_product_details"
+	ToolCompareProducts   = "compare_products"
+)
+
+const (
+	maxToolSearchResults  = 10 // Products described to the model per search
+	maxToolCompareTokens  = 4  // Products per compare_products call
+	maxToolOffersPerItem  = 5
+	maxToolSpecsPerItem   = 15
+	maxToolDescriptionLen = 400
+)
+
+// ToolExecutor runs the function calls a model makes during one chat turn
+type ToolExecutor interface {
+	// Declarations returns the tools offered to the model
+	Declarations() []*genai.FunctionDeclaration
+	// Execute runs one call and returns the result sent back to the model.
+	// Failures are reported inside the result so the model can react.
+	Execute(ctx context.Context, call LLMToolCall) map[string]interface{}
+}
+
+// ToolSearch is a search_products call made during a turn
+type ToolSearch struct {
+	Query      string
+	SearchType string
+	Products   []models.ProductCard
+}
+
+// ProductToolExecutor implements the product tools on top of a ProductSearchProvider for
+// one chat turn (country is fixed per turn). It remembers the searches made
+// so the caller can show their products with the final reply.
+type ProductToolExecutor struct {
+	search       ProductSearchProvider
+	cache        *CacheService
+	quotas       *QuotaService // nil when quotas are disabled
+	quotaSubject QuotaSubject
+	searchTTL    time.Duration
+	immersiveTTL int
+	country      string
+	searches     []ToolSearch
+	mu           sync.Mutex
+}
+
+// NewProductToolExecutor creates a tool executor for a chat turn in country.
+// Each search and product details lookup is charged to quotaSubject, as
+// through the search and product endpoints.
+func NewProductToolExecutor(search ProductSearchProvider, cache *CacheService, quotas *QuotaService, quotaSubject QuotaSubject, cfg *config.Config, country string) *ProductToolExecutor {
+	return &ProductToolExecutor{
+		search:       search,
+		cache:        cache,
+		quotas:       quotas,
+		quotaSubject: quotaSubject,
+		searchTTL:    time.Duration(cfg.CacheSerpTTL) * time.Second,
+		immersiveTTL: cfg.CacheImmersiveTTL,
+		country:      country,
+	}
+}
+
+// Declarations returns search_products, get_product_details and compare_products
+func (e *ProductToolExecutor) Declarations() []*genai.FunctionDeclaration {
+	return GetProductToolDeclarations()
+}
+
+// LastSearch returns the most recent search_products call with results, or nil
+func (e *ProductToolExecutor) LastSearch() *ToolSearch {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+
+	for i := len(e.searches) - 1; i >= 0; i-- {
+		if len(e.searches[i].Products) > 0 {
+			search := e.searches[i]
+			return &search
+		}
+	}
+	return nil
+}
+
+// Execute dispatches a tool call by name
+func (e *ProductToolExecutor) Execute(ctx context.Context, call LLMToolCall) map[string]interface{} {
+	fmt.Printf("🛠️ Tool call: %s %v\n", call.Name, call.Args)
+
+	switch call.Name {
+	case ToolSearchProducts:
+		return e.searchProducts(ctx, call.Args)
+	case ToolGetProductDetails:
+		return e.getProductDetails(ctx, call.Args)
+	case ToolCompareProducts:
+		return e.compareProducts(ctx, call.Args)
+	default:
+		return toolError(fmt.Sprintf("unknown tool: %s", call.Name))
+	}
+}
+
+func (e *ProductToolExecutor) searchProducts(ctx context.Context, args map[string]interface{}) map[string]interface{} {
+	query := getStringFromInterface(args["query"])
+	if query == "" {
+		return toolError("query is required")
+	}
+	searchType := getStringFromInterface(args["search_type"])
+	if searchType == "" {
+		searchType = "exact"
+	}
+
+	if err := e.checkQuota(ctx, QuotaSearches); err != nil {
+		return toolError(err.Error())
+	}
+
+	ReportProgress(ctx, ProgressEvent{Stage: ProgressSearching})
+
+	products, err := SearchProductsWithCache(ctx, e.search, e.cache, e.searchTTL, ProductSearchQuery{
+		Query:      query,
+		SearchType: searchType,
+		Country:    e.country,
+	})
+	if err != nil {
+		fmt.Printf("⚠️ Tool search failed: %v\n", err)
+		return toolError("no products found")
+	}
+	e.consumeQuota(ctx, QuotaSearches)
+
+	e.mu.Lock()
+	e.searches = append(e.searches, ToolSearch{Query: query, SearchType: searchType, Products: products})
+	e.mu.Unlock()
+
+	results := make([]map[string]interface{}, 0, min(len(products), maxToolSearchResults))
+	for i, product := range products {
+		if i >= maxToolSearchResults {
+			break
+		}
+		results = append(results, map[string]interface{}{
+			"name":       product.Name,
+			"price":      product.Price,
+			"merchant":   product.Description,
+			"page_token": product.PageToken,
+		})
+	}
+
+	return map[string]interface{}{
+		"query":    query,
+		"count":    len(products),
+		"products": results,
+	}
+}
+
+func (e *ProductToolExecutor) getProductDetails(ctx context.Context, args map[string]interface{}) map[string]interface{} {
+	pageToken := getStringFromInterface(args["page_token"])
+	if pageToken == "" {
+		return toolError("page_token is required")
+	}
+
+	details, err := e.fetchDetails(ctx, pageToken)
+	if errors.Is(err, ErrQuotaExceeded) {
+		return toolError(err.Error())
+	}
+	if err != nil {
+		fmt.Printf("⚠️ Tool product details failed: %v\n", err)
+		return toolError("failed to fetch product details")
+	}
+	return details
+}
+
+func (e *ProductToolExecutor) compareProducts(ctx context.Context, args map[string]interface{}) map[string]interface{} {
+	rawTokens, _ := args["page_tokens"].([]interface{})
+	if len(rawTokens) < 2 {
+		return toolError("at least two page_tokens are required")
+	}
+	if len(rawTokens) > maxToolCompareTokens {
+		rawTokens = rawTokens[:maxToolCompareTokens]
+	}
+
+	products := make([]map[string]interface{}, 0, len(rawTokens))
+	for _, raw := range rawTokens {
+		pageToken := getStringFromInterface(raw)
+		if pageToken == "" {
+			continue
+		}
+		details, err := e.fetchDetails(ctx, pageToken)
+		if errors.Is(err, ErrQuotaExceeded) {
+			products = append(products, map[string]interface{}{
+				"page_token": pageToken,
+				"error":      err.Error(),
+			})
+			break
+		}
+		if err != nil {
+			products = append(products, map[string]interface{}{
+				"page_token": pageToken,
+				"error":      "failed to fetch product details",
+			})
+			continue
+		}
+		products = append(products, details)
+	}
+
+	return map[string]interface{}{"products": products}
+}
+
+// fetchDetails returns a compact summary of an immersive product page,
+// using the same cache and quota as the product details endpoint
+func (e *ProductToolExecutor) fetchDetails(ctx context.Context, pageToken string) (map[string]interface{}, error) {
+	if err := e.checkQuota(ctx, QuotaProductDetails); err != nil {
+		return nil, err
+	}
+
+	var data map[string]interface{}
+	if e.cache != nil {
+		if cached, err := e.cache.GetProductByToken(pageToken); err == nil && cached != nil {
+			data = cached
+		}
+	}
+
+	if data == nil {
+		fetched, err := e.search.GetDetails(ctx, pageToken, e.country)
+		if err != nil {
+			return nil, err
+		}
+		data = fetched
+		if e.cache != nil {
+			if err := e.cache.SetProductByToken(pageToken, data, e.immersiveTTL); err != nil {
+				fmt.Printf("⚠️ Failed to cache product details: %v\n", err)
+			}
+		}
+	}
+
+	e.consumeQuota(ctx, QuotaProductDetails)
+
+	summary := summarizeProductDetails(data)
+	summary["page_token"] = pageToken
+	return summary, nil
+}
+
+// checkQuota returns a *QuotaExceededError if the turn's requester has no
+// resource left
+func (e *ProductToolExecutor) checkQuota(ctx context.Context, resource QuotaResource) error {
+	if e.quotas == nil || e.quotaSubject.Key == "" {
+		return nil
+	}
+	_, err := e.quotas.Check(ctx, e.quotaSubject, resource)
+	return err
+}
+
+// consumeQuota charges one unit of resource after a successful call
+func (e *ProductToolExecutor) consumeQuota(ctx context.Context, resource QuotaResource) {
+	if e.quotas == nil || e.quotaSubject.Key == "" {
+		return
+	}
+	if err := e.quotas.Consume(context.WithoutCancel(ctx), e.quotaSubject, resource, 1); err != nil {
+		fmt.Printf("⚠️ Failed to consume %s quota: %v\n", resource, err)
+	}
+}
+
+// summarizeProductDetails keeps the fields of an immersive product response
+// that matter for answering questions, to save tokens
+func summarizeProductDetails(data map[string]interface{}) map[string]interface{} {
+	summary := map[string]interface{}{}
+
+	product, ok := data["product_results"].(map[string]interface{})
+	if !ok {
+		return summary
+	}
+
+	summary["title"] = getStringFromInterface(product["title"])
+	if rating := getFloat32FromInterface(product["rating"]); rating > 0 {
+		summary["rating"] = rating
+		summary["reviews"] = getIntFromInterface(product["reviews"])
+	}
+
+	if about, ok := product["about_the_product"].(map[string]interface{}); ok {
+		description := getStringFromInterface(about["description"])
+		if len(description) > maxToolDescriptionLen {
+			description = description[:maxToolDescriptionLen] + "..."
+		}
+		if description != "" {
+			summary["description"] = description
+		}
+	}
+
+	if specs, ok := product["specifications"].([]interface{}); ok {
+		specMap := map[string]string{}
+		for i, spec := range specs {
+			if i >= maxToolSpecsPerItem {
+				break
+			}
+			if s, ok := spec.(map[string]interface{}); ok {
+				specMap[getStringFromInterface(s["title"])] = getStringFromInterface(s["value"])
+			}
+		}
+		if len(specMap) > 0 {
+			summary["specifications"] = specMap
+		}
+	}
+
+	stores, ok := product["stores"].([]interface{})
+	if !ok {
+		stores, _ = product["sellers"].([]interface{})
+	}
+	offers := make([]map[string]interface{}, 0, min(len(stores), maxToolOffersPerItem))
+	for i, store := range stores {
+		if i >= maxToolOffersPerItem {
+			break
+		}
+		if s, ok := store.(map[string]interface{}); ok {
+			offers = append(offers, map[string]interface{}{
+				"merchant": getStringFromInterface(s["name"]),
+				"price":    getStringFromInterface(s["price"]),
+				"total":    getStringFromInterface(s["total"]),
+			})
+		}
+	}
+	if len(offers) > 0 {
+		summary["offers"] = offers
+	}
+
+	return summary
+}
+
+func toolError(message string) map[string]interface{} {
+	return map[string]interface{}{"error": message}
+}
+
+// GetProductToolDeclarations returns the function declarations for the product tools
+func GetProductToolDeclarations() []*genai.FunctionDeclaration {
+	return []*genai.FunctionDeclaration{
+		{
+			Name:        ToolSearchProducts,
+			Description: "Search Google Shopping for products in the user's country. Use it once the user has chosen a specific product, or to verify that a product exists. Returns up to 10 products with prices and page tokens; the results are shown to the user automatically.",
+			Parameters: &genai.Schema{
+				Type: genai.TypeObject,
+				Properties: map[string]*genai.Schema{
+					"query": {
+						Type:        genai.TypeString,
+						Description: "Product name with specifications in ENGLISH. No country, currency or words like 'price'. Example: 'Samsung Galaxy S25 Ultra 256GB Titanium Black'",
+					},
+					"search_type": {
+						Type:        genai.TypeString,
+						Enum:        []string{"exact", "parameters", "category"},
+						Description: "exact: a specific model; parameters: products matching specs; category: broad category",
+					},
+				},
+				Required: []string{"query"},
+			},
+		},
+		{
+			Name:        ToolGetProductDetails,
+			Description: "Get details of one product from a previous search: description, specifications, rating and store offers.",
+			Parameters: &genai.Schema{
+				Type: genai.TypeObject,
+				Properties: map[string]*genai.Schema{
+					"page_token": {
+						Type:        genai.TypeString,
+						Description: "page_token of a product returned by search_products",
+					},
+				},
+				Required: []string{"page_token"},
+			},
+		},
+		{
+			Name:        ToolCompareProducts,
+			Description: "Get details of 2-4 products from previous searches side by side, to compare specifications and prices.",
+			Parameters: &genai.Schema{
+				Type: genai.TypeObject,
+				Properties: map[string]*genai.Schema{
+					"page_tokens": {
+						Type:        genai.TypeArray,
+						Items:       &genai.Schema{Type: genai.TypeString},
+						MinItems:    int64Ptr(2),
+						MaxItems:    int64Ptr(maxToolCompareTokens),
+						Description: "page_token values of the products to compare",
+					},
+				},
+				Required: []string{"page_tokens"},
+			},
+		},
+	}
+}
@@ -0,0 +1,48 @@
+package services
+
+import (
+	"context"
+)
+
+// ProgressStage identifies a step of chat processing reported to the client
+type ProgressStage string
+
+const (
+	ProgressThinking      ProgressStage = "thinking"         // LLM is generating the reply
+	ProgressTranslating   ProgressStage = "translating"      // Search phrase is translated to English
+	ProgressSearching     ProgressStage = "searching"        // Shopping search request in flight
+	ProgressFoundProducts ProgressStage = "found_n_products" // Raw results received (Count is set)
+	ProgressRanking       ProgressStage = "ranking"          // Results are filtered by relevance
+	ProgressSaving        ProgressStage = "saving"           // Session and messages are persisted
+)
+
+// ProgressEvent is a single stage notification
+type ProgressEvent struct {
+	Stage ProgressStage `json:"stage"`
+	Count int           `json:"count,omitempty"`
+}
+
+// ProgressObserver receives progress events. It is called synchronously from
+// the processing goroutine, so it must not block for long.
+type ProgressObserver func(event ProgressEvent)
+
+type progressObserverKey struct{}
+
+// WithProgressObserver attaches an observer to ctx so services deeper in the
+// call chain can report stages without extra parameters
+func WithProgressObserver(ctx context.Context, observer ProgressObserver) context.Context {
+	if observer == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, progressObserverKey{}, observer)
+}
+
+// ReportProgress sends event to the observer attached to ctx, if any
+func ReportProgress(ctx context.Context, event ProgressEvent) {
+	if ctx == nil {
+		return
+	}
+	if observer, ok := ctx.Value(progressObserverKey{}).(ProgressObserver); ok {
+		observer(event)
+	}
+}
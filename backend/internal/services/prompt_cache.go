@@ -0,0 +1,185 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/genai"
+)
+
+const (
+	promptCacheRefreshWindow    = 5 * time.Minute  // Extend the TTL when less than this is left
+	promptCacheMinTTL           = 10 * time.Minute // Shorter TTLs leave no room for refreshing
+	promptCacheRetryAfter       = time.Minute      // Pause after a failed create (rate limit, network)
+	promptCacheUnsupportedPause = 6 * time.Hour    // Pause after the API rejected caching for a model/key
+)
+
+// promptCacheEntry is a cached content holding one rendered system prompt
+type promptCacheEntry struct {
+	name       string
+	expireTime time.Time
+}
+
+// GeminiPromptCache keeps Gemini cached contents for the static system prompt
+// (SystemInstruction + tools), so repeated turns don't pay for it in full.
+//
+// Cached contents belong to the API key's project and to one model, and a
+// request using one can't set its own system instruction or tools, so entries
+// are keyed by LLMRequest.CacheKey, model, key index and tool set. Any
+// failure (model without caching support, prompt under the minimum size,
+// free tier limits) disables caching for that model and key for a while and
+// the request is sent without the cache.
+type GeminiPromptCache struct {
+	ttl         time.Duration
+	entries     map[string]promptCacheEntry
+	unsupported map[string]time.Time // model/key -> caching paused until
+	mu          sync.Mutex
+}
+
+// NewGeminiPromptCache creates a prompt cache whose contents live for ttl
+func NewGeminiPromptCache(ttl time.Duration) *GeminiPromptCache {
+	if ttl < promptCacheMinTTL {
+		ttl = promptCacheMinTTL
+	}
+	return &GeminiPromptCache{
+		ttl:         ttl,
+		entries:     make(map[string]promptCacheEntry),
+		unsupported: make(map[string]time.Time),
+	}
+}
+
+// Get returns the name of the cached content for req, creating or refreshing
+// it when needed. An empty name means the request must be sent uncached.
+func (c *GeminiPromptCache) Get(ctx context.Context, client *genai.Client, keyIndex int, req *LLMRequest, tools []*genai.Tool) string {
+	if req.CacheKey == "" || req.SystemInstruction == "" {
+		return ""
+	}
+
+	modelKey := fmt.Sprintf("%s:%d", req.Model, keyIndex)
+	entryKey := fmt.Sprintf("%s:%s:%s", req.CacheKey, modelKey, toolFingerprint(tools))
+	now := time.Now()
+
+	c.mu.Lock()
+	if until, ok := c.unsupported[modelKey]; ok {
+		if now.Before(until) {
+			c.mu.Unlock()
+			return ""
+		}
+		delete(c.unsupported, modelKey)
+	}
+	entry, ok := c.entries[entryKey]
+	c.pruneExpired(now)
+	c.mu.Unlock()
+
+	if ok && entry.expireTime.Sub(now) > promptCacheRefreshWindow {
+		return entry.name
+	}
+
+	if ok && entry.expireTime.After(now) {
+		updated, err := client.Caches.Update(ctx, entry.name, &genai.UpdateCachedContentConfig{TTL: c.ttl})
+		if err == nil {
+			c.store(entryKey, updated.Name, updated.ExpireTime)
+			return updated.Name
+		}
+		fmt.Printf("⚠️ Prompt cache refresh failed, recreating: %v\n", err)
+	}
+
+	created, err := client.Caches.Create(ctx, req.Model, &genai.CreateCachedContentConfig{
+		TTL:               c.ttl,
+		DisplayName:       "mlp-system-prompt",
+		SystemInstruction: genai.NewContentFromText(req.SystemInstruction, genai.RoleUser),
+		Tools:             tools,
+	})
+	if err != nil {
+		if ctx.Err() == nil {
+			c.pause(modelKey, err)
+		}
+		return ""
+	}
+
+	fmt.Printf("🗄️ Prompt cache created for %s (key %d): %s\n", req.Model, keyIndex, created.Name)
+	c.store(entryKey, created.Name, created.ExpireTime)
+	return created.Name
+}
+
+// Invalidate forgets a cached content the API no longer accepts
+func (c *GeminiPromptCache) Invalidate(name string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for key, entry := range c.entries {
+		if entry.name == name {
+			delete(c.entries, key)
+		}
+	}
+}
+
+func (c *GeminiPromptCache) store(entryKey, name string, expireTime time.Time) {
+	if expireTime.IsZero() {
+		expireTime = time.Now().Add(c.ttl)
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[entryKey] = promptCacheEntry{name: name, expireTime: expireTime}
+}
+
+// pause disables caching for a model/key after a failed create. Rejections
+// by the API (4xx) last longer than temporary failures.
+func (c *GeminiPromptCache) pause(modelKey string, err error) {
+	pause := promptCacheRetryAfter
+	var apiErr genai.APIError
+	if errors.As(err, &apiErr) && apiErr.Code >= 400 && apiErr.Code < 500 && apiErr.Code != 429 {
+		pause = promptCacheUnsupportedPause
+	}
+	fmt.Printf("⚠️ Prompt cache unavailable for %s, paused for %v: %v\n", modelKey, pause, err)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.unsupported[modelKey] = time.Now().Add(pause)
+}
+
+// pruneExpired drops expired entries (caller holds c.mu)
+func (c *GeminiPromptCache) pruneExpired(now time.Time) {
+	for key, entry := range c.entries {
+		if !entry.expireTime.After(now) {
+			delete(c.entries, key)
+		}
+	}
+}
+
+// toolFingerprint identifies the tool set stored with a cached content
+func toolFingerprint(tools []*genai.Tool) string {
+	var parts []string
+	for _, tool := range tools {
+		if tool.GoogleSearch != nil {
+			parts = append(parts, "google_search")
+		}
+		for _, fn := range tool.FunctionDeclarations {
+			parts = append(parts, fn.Name)
+		}
+	}
+	if len(parts) == 0 {
+		return "none"
+	}
+	return strings.Join(parts, ",")
+}
+
+// isPromptCacheError reports whether a generation failed because of the
+// cached content it referenced (expired, deleted or not accessible)
+func isPromptCacheError(err error) bool {
+	var apiErr genai.APIError
+	if !errors.As(err, &apiErr) {
+		return false
+	}
+	if apiErr.Code == 404 {
+		return true
+	}
+	message := strings.ToLower(apiErr.Message)
+	return (apiErr.Code == 400 || apiErr.Code == 403) &&
+		(strings.Contains(message, "cachedcontent") || strings.Contains(message, "cached content"))
+}
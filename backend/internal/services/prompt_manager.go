@@ -8,13 +8,15 @@ import (
 )
 
 type PromptManager struct {
-	prompts map[string]string
-	mu      sync.RWMutex
+	prompts  map[string]string
+	taxonomy *TaxonomyService
+	mu       sync.RWMutex
 }
 
-func NewPromptManager() *PromptManager {
+func NewPromptManager(taxonomy *TaxonomyService) *PromptManager {
 	pm := &PromptManager{
-		prompts: make(map[string]string),
+		prompts:  make(map[string]string),
+		taxonomy: taxonomy,
 	}
 	pm.loadPrompts()
 	return pm
@@ -62,15 +64,14 @@ func (pm *PromptManager) GetPrompt(key, country, language, category string) stri
 	return prompt
 }
 
+// GetPromptKey returns the prompt of a category: the one set on it or its
+// closest ancestor in the taxonomy, "master" if none
 func (pm *PromptManager) GetPromptKey(category string) string {
-	switch category {
-	case "electronics":
-		return "electronics"
-	case "generic_model":
+	if category == "generic_model" {
 		return "generic_model"
-	case "clothing", "furniture", "kitchen", "sports", "tools", "decor", "textiles":
-		return "parametric"
-	default:
-		return "master"
 	}
+	if key := pm.taxonomy.Current().Prompt(category); key != "" {
+		return key
+	}
+	return "master"
 }
@@ -165,6 +165,23 @@ func (s *PubSubService) SubscribeToUser(userID uuid.UUID, handler BroadcastHandl
 	return s.Subscribe(channel, handler)
 }
 
+// SubscribeToUsers subscribes to the channels of every user, so that
+// BroadcastToUser messages reach the server the user is connected to
+func (s *PubSubService) SubscribeToUsers(handler BroadcastHandler) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	pattern := "user:*"
+	s.handlers[pattern] = handler
+
+	// Messages are looked up by the pattern they were received with
+	pubsub := s.redis.PSubscribe(s.ctx, pattern)
+	go s.listen(pattern, pubsub)
+
+	log.Printf("🔔 Server %s subscribed to pattern: %s", s.serverID[:8], pattern)
+	return nil
+}
+
 // SubscribeToSession subscribes to all messages for a specific session
 func (s *PubSubService) SubscribeToSession(sessionID string, handler BroadcastHandler) error {
 	channel := fmt.Sprintf("session:%s", sessionID)
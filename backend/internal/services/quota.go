@@ -0,0 +1,332 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"mylittleprice/ent"
+	"mylittleprice/ent/user"
+	"mylittleprice/internal/config"
+	"mylittleprice/internal/models"
+)
+
+// QuotaResource is a budgeted resource
+type QuotaResource string
+
+const (
+	QuotaSearches       QuotaResource = "searches"        // Product searches
+	QuotaLLMTokens      QuotaResource = "llm_tokens"      // LLM input + output tokens
+	QuotaProductDetails QuotaResource = "product_details" // Product details lookups
+)
+
+var quotaResources = []QuotaResource{QuotaSearches, QuotaLLMTokens, QuotaProductDetails}
+
+// Quota plan names. Users have "free" or "pro" (User.plan); requests without
+// a user get "anonymous".
+const (
+	QuotaPlanAnonymous = "anonymous"
+	QuotaPlanFree      = "free"
+	QuotaPlanPro       = "pro"
+)
+
+const quotaPlanCacheTTL = time.Minute
+
+// ErrQuotaExceeded matches every *QuotaExceededError
+var ErrQuotaExceeded = errors.New("quota exceeded")
+
+// QuotaExceededError is returned by QuotaService.Check when a budget is used up
+type QuotaExceededError struct {
+	Plan     string
+	Resource QuotaResource
+	ResetAt  *time.Time
+}
+
+func (e *QuotaExceededError) Error() string {
+	return fmt.Sprintf("%s quota of the %s plan exceeded", e.Resource, e.Plan)
+}
+
+func (e *QuotaExceededError) Is(target error) bool {
+	return target == ErrQuotaExceeded
+}
+
+// QuotaBudget limits one resource per UTC day and month (0 = unlimited)
+type QuotaBudget struct {
+	Daily   int64 `json:"daily"`
+	Monthly int64 `json:"monthly"`
+}
+
+// QuotaPlan is the set of budgets of a plan
+type QuotaPlan map[QuotaResource]QuotaBudget
+
+// DefaultQuotaPlans returns the built-in plans. The anonymous search budget
+// is ANONYMOUS_SEARCH_LIMIT.
+func DefaultQuotaPlans(anonymousSearchLimit int) map[string]QuotaPlan {
+	return map[string]QuotaPlan{
+		QuotaPlanAnonymous: {
+			QuotaSearches:       {Daily: int64(anonymousSearchLimit)},
+			QuotaLLMTokens:      {Daily: 100_000},
+			QuotaProductDetails: {Daily: 10},
+		},
+		QuotaPlanFree: {
+			QuotaSearches:       {Daily: 20, Monthly: 300},
+			QuotaLLMTokens:      {Daily: 500_000, Monthly: 5_000_000},
+			QuotaProductDetails: {Daily: 50, Monthly: 1000},
+		},
+		QuotaPlanPro: {
+			QuotaSearches:       {Daily: 200},
+			QuotaLLMTokens:      {Daily: 5_000_000, Monthly: 100_000_000},
+			QuotaProductDetails: {Daily: 500},
+		},
+	}
+}
+
+// QuotaSubject is who a budget belongs to
+type QuotaSubject struct {
+	Plan string
+	Key  string // "user:<id>", "browser:<id>" or "session:<id>"
+}
+
+// IsAnonymous reports whether the subject is not a signed-in user
+func (s QuotaSubject) IsAnonymous() bool {
+	return s.Plan == QuotaPlanAnonymous
+}
+
+type cachedQuotaPlan struct {
+	plan      string
+	expiresAt time.Time
+}
+
+// QuotaService enforces per-plan daily and monthly budgets. Counters live in
+// Redis so limits hold across replicas.
+type QuotaService struct {
+	redis     *redis.Client
+	client    *ent.Client
+	plans     map[string]QuotaPlan
+	planCache map[uuid.UUID]cachedQuotaPlan
+	mu        sync.Mutex
+}
+
+// NewQuotaService creates the quota service with the built-in plans,
+// overridden by QUOTA_PLANS_FILE if set (plan name -> resource -> budget)
+func NewQuotaService(redisClient *redis.Client, client *ent.Client, cfg *config.Config) (*QuotaService, error) {
+	plans := DefaultQuotaPlans(cfg.AnonymousSearchLimit)
+
+	if cfg.QuotaPlansFile != "" {
+		data, err := os.ReadFile(cfg.QuotaPlansFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read quota plans: %w", err)
+		}
+		var overrides map[string]QuotaPlan
+		if err := json.Unmarshal(data, &overrides); err != nil {
+			return nil, fmt.Errorf("failed to parse quota plans: %w", err)
+		}
+		for name, plan := range overrides {
+			if plans[name] == nil {
+				plans[name] = QuotaPlan{}
+			}
+			for resource, budget := range plan {
+				plans[name][resource] = budget
+			}
+		}
+	}
+
+	return &QuotaService{
+		redis:     redisClient,
+		client:    client,
+		plans:     plans,
+		planCache: make(map[uuid.UUID]cachedQuotaPlan),
+	}, nil
+}
+
+// Subject identifies the budget owner of a request: the user if signed in,
+// otherwise the browser (or, without a browser ID, the session)
+func (q *QuotaService) Subject(ctx context.Context, userID *uuid.UUID, browserID, sessionID string) QuotaSubject {
+	switch {
+	case userID != nil:
+		return QuotaSubject{Plan: q.userPlan(ctx, *userID), Key: "user:" + userID.String()}
+	case browserID != "":
+		return QuotaSubject{Plan: QuotaPlanAnonymous, Key: "browser:" + browserID}
+	default:
+		return QuotaSubject{Plan: QuotaPlanAnonymous, Key: "session:" + sessionID}
+	}
+}
+
+// userPlan returns the user's plan, cached briefly so plan changes apply
+// within a minute without a query per request
+func (q *QuotaService) userPlan(ctx context.Context, userID uuid.UUID) string {
+	q.mu.Lock()
+	cached, ok := q.planCache[userID]
+	q.mu.Unlock()
+	if ok && time.Now().Before(cached.expiresAt) {
+		return cached.plan
+	}
+
+	plan := QuotaPlanFree
+	entUser, err := q.client.User.Query().
+		Where(user.IDEQ(userID)).
+		Select(user.FieldPlan).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			fmt.Printf("⚠️ Failed to load quota plan for user %s: %v\n", userID, err)
+		}
+	} else if _, known := q.plans[entUser.Plan]; known {
+		plan = entUser.Plan
+	}
+
+	q.mu.Lock()
+	q.planCache[userID] = cachedQuotaPlan{plan: plan, expiresAt: time.Now().Add(quotaPlanCacheTTL)}
+	q.mu.Unlock()
+	return plan
+}
+
+// Status returns the usage and remaining budget of every resource
+func (q *QuotaService) Status(ctx context.Context, subject QuotaSubject) (*models.QuotaStatus, error) {
+	now := time.Now().UTC()
+
+	keys := make([]string, 0, len(quotaResources)*2)
+	for _, resource := range quotaResources {
+		dailyKey, monthlyKey := quotaKeys(subject, resource, now)
+		keys = append(keys, dailyKey, monthlyKey)
+	}
+
+	values, err := q.redis.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, fmt.Errorf("failed to read quota counters: %w", err)
+	}
+
+	plan := q.plans[subject.Plan]
+	status := &models.QuotaStatus{
+		Plan:      subject.Plan,
+		Resources: make(map[string]models.QuotaResourceStatus, len(quotaResources)),
+	}
+	for i, resource := range quotaResources {
+		budget := plan[resource]
+		status.Resources[string(resource)] = quotaResourceStatus(
+			budget,
+			parseQuotaCounter(values[i*2]),
+			parseQuotaCounter(values[i*2+1]),
+			now,
+		)
+	}
+	return status, nil
+}
+
+// Check returns the current status, and a *QuotaExceededError if any of
+// resources has nothing left. Redis failures don't block requests.
+func (q *QuotaService) Check(ctx context.Context, subject QuotaSubject, resources ...QuotaResource) (*models.QuotaStatus, error) {
+	status, err := q.Status(ctx, subject)
+	if err != nil {
+		fmt.Printf("⚠️ Quota check skipped: %v\n", err)
+		return nil, nil
+	}
+
+	for _, resource := range resources {
+		if resourceStatus := status.Resources[string(resource)]; resourceStatus.Remaining == 0 {
+			return status, &QuotaExceededError{
+				Plan:     subject.Plan,
+				Resource: resource,
+				ResetAt:  resourceStatus.ResetAt,
+			}
+		}
+	}
+	return status, nil
+}
+
+// Consume adds amount to the daily and monthly counters of resource
+func (q *QuotaService) Consume(ctx context.Context, subject QuotaSubject, resource QuotaResource, amount int64) error {
+	if amount <= 0 {
+		return nil
+	}
+
+	now := time.Now().UTC()
+	dailyKey, monthlyKey := quotaKeys(subject, resource, now)
+
+	pipe := q.redis.Pipeline()
+	pipe.IncrBy(ctx, dailyKey, amount)
+	pipe.Expire(ctx, dailyKey, 48*time.Hour)
+	pipe.IncrBy(ctx, monthlyKey, amount)
+	pipe.Expire(ctx, monthlyKey, 32*24*time.Hour)
+	if _, err := pipe.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to update quota counters: %w", err)
+	}
+	return nil
+}
+
+func quotaKeys(subject QuotaSubject, resource QuotaResource, now time.Time) (daily, monthly string) {
+	daily = fmt.Sprintf("quota:%s:%s:d:%s", subject.Key, resource, now.Format("2006-01-02"))
+	monthly = fmt.Sprintf("quota:%s:%s:m:%s", subject.Key, resource, now.Format("2006-01"))
+	return daily, monthly
+}
+
+func parseQuotaCounter(value interface{}) int64 {
+	str, ok := value.(string)
+	if !ok {
+		return 0
+	}
+	var n int64
+	fmt.Sscan(str, &n)
+	return n
+}
+
+// quotaResourceStatus computes what remains of budget; Remaining and ResetAt
+// follow whichever of the daily and monthly budgets is tighter
+func quotaResourceStatus(budget QuotaBudget, dailyUsed, monthlyUsed int64, now time.Time) models.QuotaResourceStatus {
+	status := models.QuotaResourceStatus{
+		DailyUsed:    dailyUsed,
+		DailyLimit:   budget.Daily,
+		MonthlyUsed:  monthlyUsed,
+		MonthlyLimit: budget.Monthly,
+		Remaining:    -1,
+	}
+
+	if budget.Daily > 0 {
+		status.Remaining = max(budget.Daily-dailyUsed, 0)
+		resetAt := time.Date(now.Year(), now.Month(), now.Day()+1, 0, 0, 0, 0, time.UTC)
+		status.ResetAt = &resetAt
+	}
+	if budget.Monthly > 0 {
+		monthlyRemaining := max(budget.Monthly-monthlyUsed, 0)
+		if status.Remaining < 0 || monthlyRemaining < status.Remaining {
+			status.Remaining = monthlyRemaining
+			resetAt := time.Date(now.Year(), now.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			status.ResetAt = &resetAt
+		}
+	}
+	return status
+}
+
+// TokenMeter sums the LLM tokens used while handling one request
+type TokenMeter struct {
+	total atomic.Int64
+}
+
+type tokenMeterKey struct{}
+
+// WithTokenMeter attaches a new meter to ctx; MeteredLLMProvider adds the
+// tokens of every call made with the returned context
+func WithTokenMeter(ctx context.Context) (context.Context, *TokenMeter) {
+	meter := &TokenMeter{}
+	return context.WithValue(ctx, tokenMeterKey{}, meter), meter
+}
+
+// Take returns the tokens counted since the last Take
+func (m *TokenMeter) Take() int64 {
+	return m.total.Swap(0)
+}
+
+// meterTokens adds tokens to the meter attached to ctx, if any
+func meterTokens(ctx context.Context, tokens int) {
+	if meter, ok := ctx.Value(tokenMeterKey{}).(*TokenMeter); ok && tokens > 0 {
+		meter.total.Add(int64(tokens))
+	}
+}
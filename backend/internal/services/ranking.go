@@ -0,0 +1,363 @@
+package services
+
+import (
+	"context"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"mylittleprice/internal/config"
+	"mylittleprice/internal/domain"
+	"mylittleprice/internal/models"
+)
+
+// Scorer names, the keys of models.RankingScore.Scores
+const (
+	ScorerBM25        = "bm25"
+	ScorerEmbedding   = "embedding"
+	ScorerModelNumber = "model_number"
+	ScorerRating      = "rating"
+	ScorerPosition    = "position"
+)
+
+// RankingScorer scores search results against the query, one score in
+// [0, 1] per item. A nil result means the scorer doesn't apply to this
+// query (no model number in it, embeddings unavailable...) and its weight is
+// left out of the total.
+type RankingScorer interface {
+	Name() string
+	Score(ctx context.Context, query string, items []domain.ShoppingItem) []float64
+}
+
+type weightedScorer struct {
+	scorer RankingScorer
+	weight float64
+}
+
+// Ranker re-ranks search results by the weighted mean of its scorers
+type Ranker struct {
+	scorers []weightedScorer
+}
+
+// RankedItem is a search result with its ranking score
+type RankedItem struct {
+	Item  domain.ShoppingItem
+	Score models.RankingScore
+}
+
+// NewRanker creates a ranker with the built-in scorers weighted by the
+// RANKING_WEIGHT_* settings. embeddings may be nil.
+func NewRanker(cfg *config.Config, embeddings *EmbeddingService) *Ranker {
+	r := &Ranker{}
+	r.Add(NewBM25Scorer(), cfg.RankingWeightBM25)
+	if embeddings != nil {
+		r.Add(NewEmbeddingScorer(embeddings), cfg.RankingWeightEmbedding)
+	}
+	r.Add(NewModelNumberScorer(cfg.SerpModelNumberMinLength), cfg.RankingWeightModelNumber)
+	r.Add(NewRatingScorer(), cfg.RankingWeightRating)
+	r.Add(NewPositionScorer(), cfg.RankingWeightPosition)
+	return r
+}
+
+// Add adds a scorer. Scorers with a weight of 0 are skipped.
+func (r *Ranker) Add(scorer RankingScorer, weight float64) {
+	if weight <= 0 {
+		return
+	}
+	r.scorers = append(r.scorers, weightedScorer{scorer: scorer, weight: weight})
+}
+
+// Rank returns the items best first. Ties keep the provider's order.
+func (r *Ranker) Rank(ctx context.Context, query string, items []domain.ShoppingItem) []RankedItem {
+	ranked := make([]RankedItem, len(items))
+	for i, item := range items {
+		ranked[i] = RankedItem{Item: item, Score: models.RankingScore{Scores: make(map[string]float64)}}
+	}
+	if len(items) == 0 {
+		return ranked
+	}
+
+	var totalWeight float64
+	for _, ws := range r.scorers {
+		scores := ws.scorer.Score(ctx, query, items)
+		if len(scores) != len(items) {
+			continue
+		}
+		totalWeight += ws.weight
+		for i, score := range scores {
+			ranked[i].Score.Scores[ws.scorer.Name()] = score
+			ranked[i].Score.Total += ws.weight * score
+		}
+	}
+
+	if totalWeight > 0 {
+		for i := range ranked {
+			ranked[i].Score.Total /= totalWeight
+		}
+	}
+
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].Score.Total > ranked[j].Score.Total
+	})
+	return ranked
+}
+
+// rankingTokens splits text into lowercase words and numbers, in any script
+func rankingTokens(text string) []string {
+	return strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
+
+// queryTerms returns the distinct tokens of the query, without stop words
+func queryTerms(query string) []string {
+	seen := make(map[string]bool)
+	terms := make([]string, 0)
+	for _, token := range rankingTokens(query) {
+		if seen[token] || isCommonWord(token) {
+			continue
+		}
+		seen[token] = true
+		terms = append(terms, token)
+	}
+	return terms
+}
+
+// BM25Scorer scores the title and merchant of each item with Okapi BM25,
+// using the result set as the corpus. Scores are scaled so the best item
+// gets 1.
+type BM25Scorer struct {
+	k1 float64
+	b  float64
+}
+
+// NewBM25Scorer creates a BM25 scorer with the usual k1 = 1.2, b = 0.75
+func NewBM25Scorer() *BM25Scorer {
+	return &BM25Scorer{k1: 1.2, b: 0.75}
+}
+
+func (s *BM25Scorer) Name() string {
+	return ScorerBM25
+}
+
+func (s *BM25Scorer) Score(ctx context.Context, query string, items []domain.ShoppingItem) []float64 {
+	terms := queryTerms(query)
+	if len(terms) == 0 {
+		return nil
+	}
+
+	docs := make([]map[string]int, len(items))
+	lengths := make([]int, len(items))
+	docFreq := make(map[string]int)
+	var totalLength int
+	for i, item := range items {
+		tokens := rankingTokens(item.Title + " " + item.Merchant)
+		docs[i] = make(map[string]int)
+		for _, token := range tokens {
+			docs[i][token]++
+		}
+		for token := range docs[i] {
+			docFreq[token]++
+		}
+		lengths[i] = len(tokens)
+		totalLength += len(tokens)
+	}
+	avgLength := float64(totalLength) / float64(len(items))
+	if avgLength == 0 {
+		avgLength = 1
+	}
+
+	n := float64(len(items))
+	scores := make([]float64, len(items))
+	var best float64
+	for i, doc := range docs {
+		for _, term := range terms {
+			tf := float64(doc[term])
+			if tf == 0 {
+				continue
+			}
+			df := float64(docFreq[term])
+			idf := math.Log(1 + (n-df+0.5)/(df+0.5))
+			norm := s.k1 * (1 - s.b + s.b*float64(lengths[i])/avgLength)
+			scores[i] += idf * tf * (s.k1 + 1) / (tf + norm)
+		}
+		best = math.Max(best, scores[i])
+	}
+
+	if best > 0 {
+		for i := range scores {
+			scores[i] /= best
+		}
+	}
+	return scores
+}
+
+// EmbeddingScorer scores items by the cosine similarity of their title's
+// embedding to the query's, which catches synonyms and titles in another
+// language than the query
+type EmbeddingScorer struct {
+	embeddings *EmbeddingService
+}
+
+// NewEmbeddingScorer creates an embedding scorer
+func NewEmbeddingScorer(embeddings *EmbeddingService) *EmbeddingScorer {
+	return &EmbeddingScorer{embeddings: embeddings}
+}
+
+func (s *EmbeddingScorer) Name() string {
+	return ScorerEmbedding
+}
+
+// Score gives items whose title couldn't be embedded the mean score of the others
+func (s *EmbeddingScorer) Score(ctx context.Context, query string, items []domain.ShoppingItem) []float64 {
+	// One call, so that the query and titles come from the same embedder
+	texts := make([]string, 0, len(items)+1)
+	texts = append(texts, query)
+	for _, item := range items {
+		texts = append(texts, item.Title)
+	}
+	vectors, _ := s.embeddings.Embed(ctx, texts)
+
+	queryEmbedding, embeddings := vectors[0], vectors[1:]
+	if queryEmbedding == nil {
+		return nil
+	}
+
+	scores := make([]float64, len(items))
+	var sum float64
+	embedded := 0
+	for i, embedding := range embeddings {
+		if embedding == nil {
+			scores[i] = -1
+			continue
+		}
+		scores[i] = math.Max(0, float64(cosineSimilarity(queryEmbedding, embedding)))
+		sum += scores[i]
+		embedded++
+	}
+	if embedded == 0 {
+		return nil
+	}
+
+	mean := sum / float64(embedded)
+	for i := range scores {
+		if scores[i] < 0 {
+			scores[i] = mean
+		}
+	}
+	return scores
+}
+
+// ModelNumberScorer scores the share of the query's model numbers (words
+// with a digit such as "wh-1000xm5" or "s24") found in each title. Spaces
+// and hyphens are ignored, so "WH1000XM5" matches "wh-1000xm5".
+type ModelNumberScorer struct {
+	minLength int
+}
+
+// NewModelNumberScorer creates a model number scorer for model numbers of
+// at least minLength characters
+func NewModelNumberScorer(minLength int) *ModelNumberScorer {
+	return &ModelNumberScorer{minLength: minLength}
+}
+
+func (s *ModelNumberScorer) Name() string {
+	return ScorerModelNumber
+}
+
+func (s *ModelNumberScorer) Score(ctx context.Context, query string, items []domain.ShoppingItem) []float64 {
+	modelNumbers := make([]string, 0)
+	for _, word := range strings.Fields(query) {
+		model := compactModelNumber(word)
+		if len(model) >= s.minLength && strings.IndexFunc(model, unicode.IsDigit) >= 0 {
+			modelNumbers = append(modelNumbers, model)
+		}
+	}
+	if len(modelNumbers) == 0 {
+		return nil
+	}
+
+	scores := make([]float64, len(items))
+	for i, item := range items {
+		title := compactModelNumber(item.Title)
+		matched := 0
+		for _, model := range modelNumbers {
+			if strings.Contains(title, model) {
+				matched++
+			}
+		}
+		scores[i] = float64(matched) / float64(len(modelNumbers))
+	}
+	return scores
+}
+
+// compactModelNumber lowercases text and drops everything but letters and digits
+func compactModelNumber(text string) string {
+	return strings.Join(rankingTokens(text), "")
+}
+
+// RatingScorer scores items by their rating, shrunk towards a prior so that
+// a 5.0 from two reviews doesn't beat a 4.7 from two thousand. Unrated items
+// get the prior.
+type RatingScorer struct {
+	priorRating  float64
+	priorReviews float64
+}
+
+// NewRatingScorer creates a rating scorer with a prior of 3.5 stars worth
+// 10 reviews
+func NewRatingScorer() *RatingScorer {
+	return &RatingScorer{priorRating: 3.5, priorReviews: 10}
+}
+
+func (s *RatingScorer) Name() string {
+	return ScorerRating
+}
+
+func (s *RatingScorer) Score(ctx context.Context, query string, items []domain.ShoppingItem) []float64 {
+	rated := false
+	scores := make([]float64, len(items))
+	for i, item := range items {
+		rated = rated || item.Rating > 0
+		scores[i] = s.shrunkRating(item.Rating, item.Reviews)
+	}
+	if !rated {
+		return nil
+	}
+	return scores
+}
+
+// shrunkRating returns a rating out of 5 from the given number of reviews,
+// shrunk towards the prior, scaled to [0, 1]. A rating without a review
+// count counts as one review.
+func (s *RatingScorer) shrunkRating(rating float32, reviews int) float64 {
+	r, n := float64(rating), float64(reviews)
+	if r <= 0 {
+		n = 0
+	} else if n <= 0 {
+		n = 1
+	}
+	return (r*n + s.priorRating*s.priorReviews) / (n + s.priorReviews) / 5
+}
+
+// PositionScorer keeps some of the provider's own ranking: the first item
+// scores 1, decreasing linearly to the last
+type PositionScorer struct{}
+
+// NewPositionScorer creates a position scorer
+func NewPositionScorer() *PositionScorer {
+	return &PositionScorer{}
+}
+
+func (s *PositionScorer) Name() string {
+	return ScorerPosition
+}
+
+func (s *PositionScorer) Score(ctx context.Context, query string, items []domain.ShoppingItem) []float64 {
+	scores := make([]float64, len(items))
+	for i := range items {
+		scores[i] = 1 - float64(i)/float64(len(items))
+	}
+	return scores
+}
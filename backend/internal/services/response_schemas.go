@@ -90,65 +90,7 @@ func GetSearchResponseSchema() *genai.Schema {
 	}
 }
 
-// GetAPIRequestResponseSchema returns the schema for API request responses (final product search)
-func GetAPIRequestResponseSchema() *genai.Schema {
-	return &genai.Schema{
-		Type: genai.TypeObject,
-		Properties: map[string]*genai.Schema{
-			"response_type": {
-				Type: genai.TypeString,
-				Enum: []string{"api_request"},
-			},
-			"api": {
-				Type:        genai.TypeString,
-				Enum:        []string{"google_shopping"},
-				Description: "API to call (REQUIRED for api_request)",
-			},
-			"params": {
-				Type:        genai.TypeObject,
-				Description: "API parameters (REQUIRED for api_request)",
-				Properties: map[string]*genai.Schema{
-					"q": {
-						Type:        genai.TypeString,
-						Description: "Final product name (full official or fully specified name)",
-					},
-					"gl": {
-						Type:        genai.TypeString,
-						Description: "Geographic location code",
-					},
-					"hl": {
-						Type:        genai.TypeString,
-						Description: "Host language code",
-					},
-					"currency": {
-						Type:        genai.TypeString,
-						Description: "Currency code",
-					},
-				},
-				Required:         []string{"q", "gl", "hl", "currency"},
-				PropertyOrdering: []string{"q", "gl", "hl", "currency"},
-			},
-			"category": {
-				Type:        genai.TypeString,
-				Enum:        []string{"brand_specific", "parametric", "generic_model"},
-				Description: "Product category classification",
-			},
-			"output": {
-				Type:        genai.TypeString,
-				Description: "Optional message to accompany the results",
-				Nullable:    boolPtr(true),
-			},
-			"product_description": {
-				Type:        genai.TypeString,
-				Description: "REQUIRED: Brief description about the product category or search results (1-2 sentences, max 200 chars)",
-			},
-		},
-		Required:         []string{"response_type", "api", "params", "category", "product_description"},
-		PropertyOrdering: []string{"response_type", "api", "params", "category", "output", "product_description"},
-	}
-}
-
-// GetUniversalResponseSchema returns a union schema that accepts any of the three response types
+// GetUniversalResponseSchema returns a union schema that accepts both response types
 // This uses anyOf to allow multiple possible response structures
 func GetUniversalResponseSchema() *genai.Schema {
 	return &genai.Schema{
@@ -156,7 +98,7 @@ func GetUniversalResponseSchema() *genai.Schema {
 		Properties: map[string]*genai.Schema{
 			"response_type": {
 				Type:        genai.TypeString,
-				Enum:        []string{"dialogue", "search", "api_request"},
+				Enum:        []string{"dialogue", "search"},
 				Description: "Type of response",
 			},
 			// Common fields
@@ -207,27 +149,9 @@ func GetUniversalResponseSchema() *genai.Schema {
 				Nullable:    boolPtr(true),
 				Description: "Maximum price in user's currency",
 			},
-			// API request-specific (REQUIRED when response_type is "api_request")
-			"api": {
-				Type:        genai.TypeString,
-				Enum:        []string{"google_shopping"},
-				Description: "API name - REQUIRED when response_type is api_request",
-			},
-			"params": {
-				Type:        genai.TypeObject,
-				Description: "API parameters - REQUIRED when response_type is api_request",
-				Properties: map[string]*genai.Schema{
-					"q":        {Type: genai.TypeString, Description: "Product query"},
-					"gl":       {Type: genai.TypeString, Description: "Geographic location"},
-					"hl":       {Type: genai.TypeString, Description: "Host language"},
-					"currency": {Type: genai.TypeString, Description: "Currency code"},
-				},
-				Required:         []string{"q"},
-				PropertyOrdering: []string{"q", "gl", "hl", "currency"},
-			},
 			"product_description": {
 				Type:        genai.TypeString,
-				Description: "REQUIRED: Brief description about the product category or search results (1-2 sentences, max 200 chars). MUST be provided for api_request responses.",
+				Description: "REQUIRED: Brief description about the product category or search results (1-2 sentences, max 200 chars). MUST be provided for search responses.",
 			},
 		},
 		Required: []string{"response_type", "category"},
@@ -242,8 +166,6 @@ func GetUniversalResponseSchema() *genai.Schema {
 			"price_filter",
 			"min_price",
 			"max_price",
-			"api",
-			"params",
 		},
 	}
 }
@@ -0,0 +1,160 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"mylittleprice/internal/models"
+)
+
+// ProductSearchAggregator fans a search out to several providers at once
+// and merges their results. Each provider's ranking is kept by taking the
+// results round-robin; products listed by more than one provider (same link,
+// or same name and price) are shown once.
+type ProductSearchAggregator struct {
+	providers []ProductSearchProvider
+	timeout   time.Duration // Per-provider search timeout
+}
+
+// NewProductSearchAggregator creates an aggregator over providers. Details
+// requests for tokens not namespaced by a provider go to the first one.
+func NewProductSearchAggregator(providers []ProductSearchProvider, timeout time.Duration) *ProductSearchAggregator {
+	return &ProductSearchAggregator{
+		providers: providers,
+		timeout:   timeout,
+	}
+}
+
+// Name returns the provider names joined with "+", so cached results are
+// invalidated when the provider set changes
+func (a *ProductSearchAggregator) Name() string {
+	names := make([]string, 0, len(a.providers))
+	for _, provider := range a.providers {
+		names = append(names, provider.Name())
+	}
+	return strings.Join(names, "+")
+}
+
+func (a *ProductSearchAggregator) Search(ctx context.Context, query ProductSearchQuery) ([]models.ProductCard, error) {
+	type providerResult struct {
+		cards []models.ProductCard
+		err   error
+	}
+	results := make([]providerResult, len(a.providers))
+
+	var wg sync.WaitGroup
+	for i, provider := range a.providers {
+		wg.Add(1)
+		go func(i int, provider ProductSearchProvider) {
+			defer wg.Done()
+
+			searchCtx := ctx
+			if a.timeout > 0 {
+				var cancel context.CancelFunc
+				searchCtx, cancel = context.WithTimeout(ctx, a.timeout)
+				defer cancel()
+			}
+
+			cards, err := provider.Search(searchCtx, query)
+			if err != nil && !errors.Is(err, ErrNoProductsFound) {
+				fmt.Printf("⚠️ Product search provider %s failed: %v\n", provider.Name(), err)
+			}
+			results[i] = providerResult{cards: cards, err: err}
+		}(i, provider)
+	}
+	wg.Wait()
+
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("product search aborted: %w", ctx.Err())
+	}
+
+	lists := make([][]models.ProductCard, 0, len(results))
+	var errs []error
+	for _, result := range results {
+		if result.err != nil {
+			if !errors.Is(result.err, ErrNoProductsFound) {
+				errs = append(errs, result.err)
+			}
+			continue
+		}
+		lists = append(lists, result.cards)
+	}
+
+	cards := mergeProductCards(lists, maxSearchResults)
+	if len(cards) == 0 {
+		// Only report provider failures if nobody answered
+		if len(errs) > 0 {
+			return nil, errors.Join(errs...)
+		}
+		return nil, ErrNoProductsFound
+	}
+	return cards, nil
+}
+
+func (a *ProductSearchAggregator) GetDetails(ctx context.Context, pageToken, country string) (map[string]interface{}, error) {
+	if name, _, ok := parseProviderPageToken(pageToken); ok {
+		for _, provider := range a.providers {
+			if provider.Name() == name {
+				return provider.GetDetails(ctx, pageToken, country)
+			}
+		}
+	}
+
+	// Not namespaced: a SerpAPI token
+	for _, provider := range a.providers {
+		if provider.Name() == SearchProviderSerpAPI {
+			return provider.GetDetails(ctx, pageToken, country)
+		}
+	}
+	return a.providers[0].GetDetails(ctx, pageToken, country)
+}
+
+// mergeProductCards interleaves lists round-robin, dropping duplicates,
+// until limit cards are taken
+func mergeProductCards(lists [][]models.ProductCard, limit int) []models.ProductCard {
+	merged := make([]models.ProductCard, 0, limit)
+	seen := make(map[string]bool)
+
+	for rank := 0; len(merged) < limit; rank++ {
+		taken := false
+		for _, list := range lists {
+			if rank >= len(list) {
+				continue
+			}
+			taken = true
+
+			card := list[rank]
+			key := productDedupKey(card)
+			if seen[key] {
+				continue
+			}
+			seen[key] = true
+
+			merged = append(merged, card)
+			if len(merged) == limit {
+				break
+			}
+		}
+		if !taken {
+			break
+		}
+	}
+	return merged
+}
+
+// productDedupKey identifies a product across providers: its link without
+// query string (tracking parameters differ), or else its name and price
+func productDedupKey(card models.ProductCard) string {
+	if card.Link != "" {
+		if parsed, err := url.Parse(card.Link); err == nil && parsed.Host != "" {
+			return "link:" + strings.ToLower(parsed.Host+strings.TrimRight(parsed.Path, "/"))
+		}
+	}
+	name := strings.Join(strings.Fields(strings.ToLower(card.Name)), " ")
+	return "name:" + name + "|" + strings.ReplaceAll(card.Price, " ", "")
+}
@@ -0,0 +1,211 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"mylittleprice/internal/domain"
+	"mylittleprice/internal/models"
+)
+
+// FeedProduct is a product of a merchant feed or fixture file
+type FeedProduct struct {
+	ID             string              `json:"id"`
+	Title          string              `json:"title"`
+	Price          string              `json:"price"`                     // Display price, e.g. "CHF 1,099.00"
+	ExtractedPrice float64             `json:"extracted_price,omitempty"` // Numeric price, used for price filters
+	Currency       string              `json:"currency,omitempty"`        // ISO code, when Price doesn't show it
+	OldPrice       string              `json:"old_price,omitempty"`
+	Link           string              `json:"link"`
+	Image          string              `json:"image"`
+	Images         []string            `json:"images,omitempty"`
+	Merchant       string              `json:"merchant"`
+	Rating         float64             `json:"rating,omitempty"`
+	Reviews        int                 `json:"reviews,omitempty"`
+	Description    string              `json:"description,omitempty"`
+	Condition      string              `json:"condition,omitempty"` // "used", "refurbished"...; empty for new products
+	Keywords       []string            `json:"keywords,omitempty"`  // Extra search terms (fixtures only)
+	Specifications []FeedSpecification `json:"specifications,omitempty"`
+}
+
+// FeedSpecification is one specification row of a FeedProduct
+type FeedSpecification struct {
+	Title string `json:"title"`
+	Value string `json:"value"`
+}
+
+// toProductCard converts the product to a card whose page token routes back
+// to provider
+func (p FeedProduct) toProductCard(provider string) models.ProductCard {
+	badge := ""
+	if p.Rating > 0 {
+		badge = fmt.Sprintf("⭐ %.1f", p.Rating)
+	}
+	return models.ProductCard{
+		Name:        p.Title,
+		Price:       p.Price,
+		OldPrice:    p.OldPrice,
+		Link:        p.Link,
+		Image:       p.Image,
+		Description: p.Merchant,
+		Badge:       badge,
+		PageToken:   providerPageToken(provider, p.ID),
+		ProductID:   p.ID,
+
+		Merchant:  p.Merchant,
+		Amount:    domain.ParsePrice(p.Price, p.ExtractedPrice, domain.Currency(p.Currency)),
+		Rating:    float32(p.Rating),
+		Reviews:   p.Reviews,
+		Condition: p.Condition,
+	}
+}
+
+// toProductDetails converts the product to the google_immersive_product
+// format, with the merchant as the only store
+func (p FeedProduct) toProductDetails() map[string]interface{} {
+	thumbnails := []interface{}{}
+	if p.Image != "" {
+		thumbnails = append(thumbnails, p.Image)
+	}
+	for _, image := range p.Images {
+		if image != p.Image {
+			thumbnails = append(thumbnails, image)
+		}
+	}
+
+	specifications := make([]interface{}, 0, len(p.Specifications))
+	for _, spec := range p.Specifications {
+		specifications = append(specifications, map[string]interface{}{
+			"title": spec.Title,
+			"value": spec.Value,
+		})
+	}
+
+	return map[string]interface{}{
+		"product_results": map[string]interface{}{
+			"title":             p.Title,
+			"price":             p.Price,
+			"rating":            p.Rating,
+			"reviews":           float64(p.Reviews),
+			"thumbnails":        thumbnails,
+			"about_the_product": map[string]interface{}{"description": p.Description},
+			"specifications":    specifications,
+			"stores": []interface{}{
+				map[string]interface{}{
+					"name":            p.Merchant,
+					"price":           p.Price,
+					"extracted_price": p.ExtractedPrice,
+					"currency":        p.Currency,
+					"link":            p.Link,
+					"title":           p.Title,
+				},
+			},
+		},
+	}
+}
+
+// FeedSearchProvider queries a merchant feed over JSON/HTTP:
+//
+//	GET {base}/search?q=...&country=CH&type=exact[&min_price=&max_price=]&limit=10 -> {"products": [FeedProduct...]}
+//	GET {base}/products/{id}                                                   -> FeedProduct
+//
+// A non-empty API key is sent as "Authorization: Bearer <key>".
+type FeedSearchProvider struct {
+	baseURL    string
+	apiKey     string
+	httpClient *http.Client
+}
+
+// NewFeedSearchProvider creates a merchant feed provider for baseURL
+func NewFeedSearchProvider(baseURL, apiKey string, timeout time.Duration) *FeedSearchProvider {
+	return &FeedSearchProvider{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		apiKey:     apiKey,
+		httpClient: &http.Client{Timeout: timeout},
+	}
+}
+
+func (p *FeedSearchProvider) Name() string {
+	return SearchProviderFeed
+}
+
+func (p *FeedSearchProvider) Search(ctx context.Context, query ProductSearchQuery) ([]models.ProductCard, error) {
+	params := url.Values{}
+	params.Set("q", query.Query)
+	params.Set("country", query.Country)
+	params.Set("type", query.SearchType)
+	params.Set("limit", fmt.Sprint(maxSearchResults))
+	if query.MinPrice != nil {
+		params.Set("min_price", fmt.Sprintf("%.0f", *query.MinPrice))
+	}
+	if query.MaxPrice != nil {
+		params.Set("max_price", fmt.Sprintf("%.0f", *query.MaxPrice))
+	}
+
+	var result struct {
+		Products []FeedProduct `json:"products"`
+	}
+	if err := p.get(ctx, "/search?"+params.Encode(), &result); err != nil {
+		return nil, err
+	}
+	if len(result.Products) == 0 {
+		return nil, ErrNoProductsFound
+	}
+
+	cards := make([]models.ProductCard, 0, min(len(result.Products), maxSearchResults))
+	for _, product := range result.Products[:min(len(result.Products), maxSearchResults)] {
+		cards = append(cards, product.toProductCard(p.Name()))
+	}
+
+	ReportProgress(ctx, ProgressEvent{Stage: ProgressFoundProducts, Count: len(cards)})
+	return cards, nil
+}
+
+func (p *FeedSearchProvider) GetDetails(ctx context.Context, pageToken, _ string) (map[string]interface{}, error) {
+	provider, id, ok := parseProviderPageToken(pageToken)
+	if !ok || provider != p.Name() {
+		return nil, fmt.Errorf("not a %s page token: %s", p.Name(), pageToken)
+	}
+
+	var product FeedProduct
+	if err := p.get(ctx, "/products/"+url.PathEscape(id), &product); err != nil {
+		return nil, err
+	}
+	return product.toProductDetails(), nil
+}
+
+func (p *FeedSearchProvider) get(ctx context.Context, path string, out interface{}) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, p.baseURL+path, nil)
+	if err != nil {
+		return fmt.Errorf("failed to create feed request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if p.apiKey != "" {
+		req.Header.Set("Authorization", "Bearer "+p.apiKey)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("feed request failed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotFound {
+		return ErrNoProductsFound
+	}
+	if resp.StatusCode != http.StatusOK {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("feed returned HTTP %d: %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(out); err != nil {
+		return fmt.Errorf("failed to decode feed response: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,126 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"unicode"
+
+	"mylittleprice/internal/models"
+)
+
+// FixtureSearchProvider serves products from a local JSON file
+// ({"products": [FeedProduct...]}) for development without API keys.
+// Products match when words of their title, merchant, description or
+// keywords start with words of the query.
+type FixtureSearchProvider struct {
+	products []FeedProduct
+	byID     map[string]FeedProduct
+}
+
+// NewFixtureSearchProvider loads the fixture file at path
+func NewFixtureSearchProvider(path string) (*FixtureSearchProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read search fixtures: %w", err)
+	}
+
+	var fixtures struct {
+		Products []FeedProduct `json:"products"`
+	}
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		return nil, fmt.Errorf("failed to parse search fixtures: %w", err)
+	}
+
+	byID := make(map[string]FeedProduct, len(fixtures.Products))
+	for i, product := range fixtures.Products {
+		if product.ID == "" {
+			product.ID = fmt.Sprint(i + 1)
+			fixtures.Products[i] = product
+		}
+		byID[product.ID] = product
+	}
+
+	fmt.Printf("✅ Loaded %d search fixtures from %s\n", len(fixtures.Products), path)
+	return &FixtureSearchProvider{products: fixtures.Products, byID: byID}, nil
+}
+
+func (p *FixtureSearchProvider) Name() string {
+	return SearchProviderFixture
+}
+
+func (p *FixtureSearchProvider) Search(ctx context.Context, query ProductSearchQuery) ([]models.ProductCard, error) {
+	words := make([]string, 0)
+	for _, word := range strings.Fields(strings.ToLower(query.Query)) {
+		if len(word) > 1 && !isCommonWord(word) {
+			words = append(words, word)
+		}
+	}
+
+	type match struct {
+		product FeedProduct
+		score   int
+	}
+	matches := make([]match, 0)
+	for _, product := range p.products {
+		if query.MinPrice != nil && product.ExtractedPrice < *query.MinPrice {
+			continue
+		}
+		if query.MaxPrice != nil && product.ExtractedPrice > *query.MaxPrice {
+			continue
+		}
+
+		text := strings.Join(append([]string{
+			product.Title, product.Merchant, product.Description,
+		}, product.Keywords...), " ")
+		productWords := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+			return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+		})
+
+		score := 0
+		for _, word := range words {
+			for _, productWord := range productWords {
+				if strings.HasPrefix(productWord, word) {
+					score++
+					break
+				}
+			}
+		}
+		if score > 0 {
+			matches = append(matches, match{product: product, score: score})
+		}
+	}
+
+	if len(matches) == 0 {
+		return nil, ErrNoProductsFound
+	}
+
+	// Best match first, file order among equals
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+
+	cards := make([]models.ProductCard, 0, min(len(matches), maxSearchResults))
+	for _, m := range matches[:min(len(matches), maxSearchResults)] {
+		cards = append(cards, m.product.toProductCard(p.Name()))
+	}
+
+	ReportProgress(ctx, ProgressEvent{Stage: ProgressFoundProducts, Count: len(cards)})
+	return cards, nil
+}
+
+func (p *FixtureSearchProvider) GetDetails(ctx context.Context, pageToken, _ string) (map[string]interface{}, error) {
+	provider, id, ok := parseProviderPageToken(pageToken)
+	if !ok || provider != p.Name() {
+		return nil, fmt.Errorf("not a %s page token: %s", p.Name(), pageToken)
+	}
+
+	product, ok := p.byID[id]
+	if !ok {
+		return nil, fmt.Errorf("fixture product not found: %s", id)
+	}
+	return product.toProductDetails(), nil
+}
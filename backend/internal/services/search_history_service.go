@@ -8,6 +8,7 @@ import (
 	"github.com/google/uuid"
 	"mylittleprice/ent"
 	"mylittleprice/ent/searchhistory"
+	"mylittleprice/internal/domain"
 	"mylittleprice/internal/models"
 )
 
@@ -79,6 +80,12 @@ func (s *SearchHistoryService) SaveSearchHistory(ctx context.Context, history *m
 				"badge":       p.Badge,
 				"page_token":  p.PageToken,
 			}
+			if p.Amount != nil {
+				products[i]["amount"] = map[string]interface{}{
+					"amount":   p.Amount.Amount,
+					"currency": string(p.Amount.Currency),
+				}
+			}
 		}
 		builder.SetProductsFound(products)
 	}
@@ -210,6 +217,7 @@ func (s *SearchHistoryService) GetUserSearchHistory(ctx context.Context, userID
 					Description: getStringFromMap(p, "description"),
 					Badge:       getStringFromMap(p, "badge"),
 					PageToken:   getStringFromMap(p, "page_token"),
+					Amount:      getMoneyFromMap(p, "amount"),
 				}
 			}
 			responseItems[i].ProductsFound = products
@@ -235,6 +243,19 @@ func getStringFromMap(m map[string]interface{}, key string) string {
 	return ""
 }
 
+// getMoneyFromMap reads a Money stored as {"amount": minor units, "currency": code}
+func getMoneyFromMap(m map[string]interface{}, key string) *domain.Money {
+	v, ok := m[key].(map[string]interface{})
+	if !ok {
+		return nil
+	}
+	amount, ok := v["amount"].(float64)
+	if !ok {
+		return nil
+	}
+	return &domain.Money{Amount: int64(amount), Currency: domain.Currency(getStringFromMap(v, "currency"))}
+}
+
 // DeleteSearchHistory deletes a search history entry
 func (s *SearchHistoryService) DeleteSearchHistory(ctx context.Context, id uuid.UUID, userID *uuid.UUID) error {
 	query := s.client.SearchHistory.Delete().Where(searchhistory.IDEQ(id))
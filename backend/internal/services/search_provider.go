@@ -0,0 +1,119 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	"mylittleprice/internal/config"
+	"mylittleprice/internal/models"
+	"mylittleprice/internal/utils"
+)
+
+// Supported values for PRODUCT_SEARCH_PROVIDERS
+const (
+	SearchProviderSerpAPI = "serpapi"
+	SearchProviderFixture = "fixture"
+	SearchProviderFeed    = "feed"
+)
+
+// maxSearchResults is the number of product cards kept per search. They're
+// shown a page at a time (SEARCH_PAGE_SIZE), see SearchResultsService.
+const maxSearchResults = 40
+
+// ErrNoProductsFound is returned by ProductSearchProvider.Search when nothing matches
+var ErrNoProductsFound = errors.New("no relevant products found")
+
+// ProductSearchQuery is a provider-neutral product search
+type ProductSearchQuery struct {
+	Query      string
+	SearchType string // "exact", "parameters" or "category"
+	Country    string
+	MinPrice   *float64
+	MaxPrice   *float64
+}
+
+// ProductSearchProvider is a product catalogue backend (Google Shopping via
+// SerpAPI, a fixture file, a merchant feed...)
+type ProductSearchProvider interface {
+	// Name returns the provider identifier, used to namespace cached results
+	Name() string
+	// Search returns up to maxSearchResults products, best first
+	Search(ctx context.Context, query ProductSearchQuery) ([]models.ProductCard, error)
+	// GetDetails returns the product behind a card's page token in the
+	// google_immersive_product format (a "product_results" object), which
+	// FormatProductDetails and the product tools understand. The stores are
+	// those of country, if set.
+	GetDetails(ctx context.Context, pageToken, country string) (map[string]interface{}, error)
+}
+
+// NewProductSearchProvider creates the providers listed in
+// cfg.ProductSearchProviders. Several providers are queried concurrently
+// through a ProductSearchAggregator.
+func NewProductSearchProvider(cfg *config.Config, serp *SerpService) (ProductSearchProvider, error) {
+	providers := make([]ProductSearchProvider, 0, len(cfg.ProductSearchProviders))
+	for _, name := range cfg.ProductSearchProviders {
+		switch strings.ToLower(strings.TrimSpace(name)) {
+		case SearchProviderSerpAPI:
+			providers = append(providers, NewSerpAPIProvider(serp))
+		case SearchProviderFixture:
+			provider, err := NewFixtureSearchProvider(cfg.SearchFixturesFile)
+			if err != nil {
+				return nil, err
+			}
+			providers = append(providers, provider)
+		case SearchProviderFeed:
+			providers = append(providers, NewFeedSearchProvider(cfg.MerchantFeedURL, cfg.MerchantFeedAPIKey, cfg.ProductSearchTimeout))
+		default:
+			return nil, fmt.Errorf("unknown product search provider: %s", name)
+		}
+	}
+
+	switch len(providers) {
+	case 0:
+		return nil, fmt.Errorf("no product search provider configured")
+	case 1:
+		return providers[0], nil
+	default:
+		return NewProductSearchAggregator(providers, cfg.ProductSearchTimeout), nil
+	}
+}
+
+// SearchProductsWithCache runs provider.Search through the search results cache
+func SearchProductsWithCache(ctx context.Context, provider ProductSearchProvider, cacheService *CacheService, ttl time.Duration, query ProductSearchQuery) ([]models.ProductCard, error) {
+	cacheKey := NewSearchCacheKey(provider.Name(), query)
+
+	if cacheService != nil {
+		if cached, err := cacheService.GetSearchResults(ctx, cacheKey); err == nil && cached != nil {
+			utils.LogInfo(ctx, "📦 Using cached search results", slog.String("cache_key", cacheKey.String()))
+			ReportProgress(ctx, ProgressEvent{Stage: ProgressFoundProducts, Count: len(cached)})
+			return cached, nil
+		}
+	}
+
+	cards, err := provider.Search(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	if cacheService != nil {
+		_ = cacheService.SetSearchResults(ctx, cacheKey, cards, ttl)
+	}
+
+	return cards, nil
+}
+
+// providerPageToken namespaces a provider's own product ID so that
+// ProductSearchAggregator can route details requests back to it
+func providerPageToken(provider, id string) string {
+	return provider + ":" + id
+}
+
+// parseProviderPageToken splits a token made by providerPageToken. SerpAPI
+// tokens are base64 and never contain ':'.
+func parseProviderPageToken(pageToken string) (provider, id string, ok bool) {
+	return strings.Cut(pageToken, ":")
+}
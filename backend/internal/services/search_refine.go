@@ -0,0 +1,148 @@
+package services
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"mylittleprice/internal/domain"
+	"mylittleprice/internal/models"
+)
+
+// Sort orders of models.SearchRefinement
+const (
+	SortPriceAsc  = "price_asc"
+	SortPriceDesc = "price_desc"
+	SortRating    = "rating"
+	SortReviews   = "reviews"
+)
+
+// Conditions of models.SearchRefinement
+const (
+	ConditionNew         = "new"
+	ConditionUsed        = "used"
+	ConditionRefurbished = "refurbished"
+)
+
+// ErrInvalidRefinement is returned by SearchResultsService.Refine for
+// unknown sort orders or conditions and inverted price ranges
+var ErrInvalidRefinement = errors.New("invalid refinement")
+
+func validateRefinement(r models.SearchRefinement) error {
+	switch r.Sort {
+	case "", SortPriceAsc, SortPriceDesc, SortRating, SortReviews:
+	default:
+		return fmt.Errorf("%w: unknown sort %q", ErrInvalidRefinement, r.Sort)
+	}
+	switch r.Condition {
+	case "", ConditionNew, ConditionUsed, ConditionRefurbished:
+	default:
+		return fmt.Errorf("%w: unknown condition %q", ErrInvalidRefinement, r.Condition)
+	}
+	if r.MinPrice != nil && r.MaxPrice != nil && *r.MinPrice > *r.MaxPrice {
+		return fmt.Errorf("%w: min_price is above max_price", ErrInvalidRefinement)
+	}
+	if r.MinRating < 0 || r.MinRating > 5 {
+		return fmt.Errorf("%w: min_rating must be between 0 and 5", ErrInvalidRefinement)
+	}
+	return nil
+}
+
+// refineProducts returns the products matching r, in r.Sort order. Products
+// without a known price are dropped by price filters and sorted last by
+// price; ties keep the search ranking.
+func refineProducts(products []models.ProductCard, r models.SearchRefinement) []models.ProductCard {
+	merchants := make([]string, 0, len(r.Merchants))
+	for _, merchant := range r.Merchants {
+		if merchant = strings.ToLower(strings.TrimSpace(merchant)); merchant != "" {
+			merchants = append(merchants, merchant)
+		}
+	}
+
+	refined := make([]models.ProductCard, 0, len(products))
+	for _, product := range products {
+		price := productPrice(product)
+		if r.MinPrice != nil && (price == 0 || price < *r.MinPrice) {
+			continue
+		}
+		if r.MaxPrice != nil && (price == 0 || price > *r.MaxPrice) {
+			continue
+		}
+		if r.MinRating > 0 && product.Rating < r.MinRating {
+			continue
+		}
+		if len(merchants) > 0 && !matchesMerchant(product, merchants) {
+			continue
+		}
+		if r.Condition != "" && !matchesCondition(product, r.Condition) {
+			continue
+		}
+		refined = append(refined, product)
+	}
+
+	switch r.Sort {
+	case SortPriceAsc, SortPriceDesc:
+		descending := r.Sort == SortPriceDesc
+		sort.SliceStable(refined, func(i, j int) bool {
+			pi, pj := productPrice(refined[i]), productPrice(refined[j])
+			if pi == 0 || pj == 0 {
+				return pj == 0 && pi != 0
+			}
+			if descending {
+				return pi > pj
+			}
+			return pi < pj
+		})
+	case SortRating:
+		sort.SliceStable(refined, func(i, j int) bool {
+			if refined[i].Rating != refined[j].Rating {
+				return refined[i].Rating > refined[j].Rating
+			}
+			return refined[i].Reviews > refined[j].Reviews
+		})
+	case SortReviews:
+		sort.SliceStable(refined, func(i, j int) bool {
+			return refined[i].Reviews > refined[j].Reviews
+		})
+	}
+
+	return refined
+}
+
+func matchesMerchant(product models.ProductCard, merchants []string) bool {
+	merchant := product.Merchant
+	if merchant == "" {
+		merchant = product.Description // Cards cached before Merchant was set
+	}
+	merchant = strings.ToLower(merchant)
+
+	for _, m := range merchants {
+		if strings.Contains(merchant, m) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesCondition treats products without a condition as new
+func matchesCondition(product models.ProductCard, condition string) bool {
+	productCondition := strings.ToLower(product.Condition)
+	if condition == ConditionNew {
+		return productCondition == "" || productCondition == ConditionNew
+	}
+	// SerpAPI reports e.g. "refurbished" or "used - like new"
+	return strings.Contains(productCondition, condition)
+}
+
+// productPrice returns the price of a product in major units, 0 if unknown
+func productPrice(product models.ProductCard) float64 {
+	if product.Amount != nil {
+		return product.Amount.Major()
+	}
+	// Cards cached before Amount was set
+	if money, err := domain.ParseMoney(product.Price, ""); err == nil {
+		return money.Major()
+	}
+	return 0
+}
@@ -0,0 +1,186 @@
+package services
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"mylittleprice/internal/config"
+	"mylittleprice/internal/models"
+)
+
+var (
+	// ErrSearchNotFound is returned for unknown or expired search IDs, and for
+	// searches of another user
+	ErrSearchNotFound = errors.New("search not found")
+	// ErrInvalidCursor is returned for cursors not made by SearchResultsService
+	ErrInvalidCursor = errors.New("invalid cursor")
+)
+
+// searchResultSet is the full result set of a search as stored in Redis
+type searchResultSet struct {
+	ID         string               `json:"id"`
+	SessionID  string               `json:"session_id"`
+	UserID     *uuid.UUID           `json:"user_id,omitempty"`
+	Query      string               `json:"query"`
+	SearchType string               `json:"search_type"`
+	Products   []models.ProductCard `json:"products"`
+	CreatedAt  time.Time            `json:"created_at"`
+}
+
+// SearchResultsService keeps the full ranked result set of each search so
+// that further pages ("show more") are served without a new product search
+// or search count.
+type SearchResultsService struct {
+	redis    *redis.Client
+	pageSize int
+	ttl      time.Duration
+}
+
+// NewSearchResultsService creates a SearchResultsService storing result
+// sets for cfg.SearchResultsTTL
+func NewSearchResultsService(redisClient *redis.Client, cfg *config.Config) *SearchResultsService {
+	return &SearchResultsService{
+		redis:    redisClient,
+		pageSize: cfg.SearchPageSize,
+		ttl:      cfg.SearchResultsTTL,
+	}
+}
+
+// PageSize returns the default number of products per page
+func (s *SearchResultsService) PageSize() int {
+	return s.pageSize
+}
+
+// Save stores the products of a search under a new search ID and returns
+// its first page of firstPageSize products (0 = the default page size)
+func (s *SearchResultsService) Save(ctx context.Context, sessionID string, userID *uuid.UUID, query, searchType string, products []models.ProductCard, firstPageSize int) (*models.SearchResultPage, error) {
+	set := searchResultSet{
+		ID:         uuid.New().String(),
+		SessionID:  sessionID,
+		UserID:     userID,
+		Query:      query,
+		SearchType: searchType,
+		Products:   products,
+		CreatedAt:  time.Now(),
+	}
+
+	data, err := json.Marshal(set)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal search results: %w", err)
+	}
+	if err := s.redis.Set(ctx, searchResultsKey(set.ID), data, s.ttl).Err(); err != nil {
+		return nil, fmt.Errorf("failed to save search results: %w", err)
+	}
+
+	if firstPageSize <= 0 {
+		firstPageSize = s.pageSize
+	}
+	return set.page(0, firstPageSize), nil
+}
+
+// Page returns up to limit products (0 = the default page size) of a stored
+// search, starting at cursor (empty = the first page). Searches made by a
+// signed-in user are only visible to that user.
+func (s *SearchResultsService) Page(ctx context.Context, searchID string, userID *uuid.UUID, cursor string, limit int) (*models.SearchResultPage, error) {
+	offset, err := decodeSearchCursor(cursor)
+	if err != nil {
+		return nil, err
+	}
+	if limit <= 0 || limit > maxSearchResults {
+		limit = s.pageSize
+	}
+
+	set, err := s.load(ctx, searchID, userID)
+	if err != nil {
+		return nil, err
+	}
+	if offset > len(set.Products) {
+		return nil, ErrInvalidCursor
+	}
+
+	return set.page(offset, limit), nil
+}
+
+// Refine sorts and filters the products of a stored search into a new
+// search and returns its first page. Refining a refined search narrows it
+// further; the original search is left as it was.
+func (s *SearchResultsService) Refine(ctx context.Context, searchID string, userID *uuid.UUID, refinement models.SearchRefinement) (*models.SearchResultPage, error) {
+	if err := validateRefinement(refinement); err != nil {
+		return nil, err
+	}
+
+	set, err := s.load(ctx, searchID, userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return s.Save(ctx, set.SessionID, set.UserID, set.Query, set.SearchType, refineProducts(set.Products, refinement), 0)
+}
+
+// load reads a stored search, hiding searches of other users
+func (s *SearchResultsService) load(ctx context.Context, searchID string, userID *uuid.UUID) (*searchResultSet, error) {
+	data, err := s.redis.Get(ctx, searchResultsKey(searchID)).Bytes()
+	if err == redis.Nil {
+		return nil, ErrSearchNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to load search results: %w", err)
+	}
+
+	var set searchResultSet
+	if err := json.Unmarshal(data, &set); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal search results: %w", err)
+	}
+
+	if set.UserID != nil && (userID == nil || *userID != *set.UserID) {
+		return nil, ErrSearchNotFound
+	}
+	return &set, nil
+}
+
+// page slices limit products starting at offset
+func (set *searchResultSet) page(offset, limit int) *models.SearchResultPage {
+	end := min(offset+limit, len(set.Products))
+
+	page := &models.SearchResultPage{
+		SearchID: set.ID,
+		Products: set.Products[offset:end],
+		Total:    len(set.Products),
+	}
+	if end < len(set.Products) {
+		page.NextCursor = encodeSearchCursor(end)
+	}
+	return page
+}
+
+func searchResultsKey(searchID string) string {
+	return "search_results:" + searchID
+}
+
+// Cursors are opaque to clients; they encode the offset of the page
+func encodeSearchCursor(offset int) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(strconv.Itoa(offset)))
+}
+
+func decodeSearchCursor(cursor string) (int, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+	raw, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return 0, ErrInvalidCursor
+	}
+	offset, err := strconv.Atoi(string(raw))
+	if err != nil || offset < 0 {
+		return 0, ErrInvalidCursor
+	}
+	return offset, nil
+}
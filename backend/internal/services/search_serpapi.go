@@ -0,0 +1,46 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"mylittleprice/internal/models"
+)
+
+// SerpAPIProvider searches Google Shopping through SerpService and records
+// the outcome of every call against the SerpAPI key it used
+type SerpAPIProvider struct {
+	serp *SerpService
+}
+
+// NewSerpAPIProvider wraps serp as a ProductSearchProvider
+func NewSerpAPIProvider(serp *SerpService) *SerpAPIProvider {
+	return &SerpAPIProvider{serp: serp}
+}
+
+func (p *SerpAPIProvider) Name() string {
+	return SearchProviderSerpAPI
+}
+
+func (p *SerpAPIProvider) Search(ctx context.Context, query ProductSearchQuery) ([]models.ProductCard, error) {
+	start := time.Now()
+	cards, keyIndex, err := p.serp.SearchProducts(ctx, query.Query, query.SearchType, query.Country, query.MinPrice, query.MaxPrice)
+	p.recordUsage(keyIndex, err, time.Since(start))
+	return cards, err
+}
+
+func (p *SerpAPIProvider) GetDetails(ctx context.Context, pageToken, country string) (map[string]interface{}, error) {
+	start := time.Now()
+	data, keyIndex, err := p.serp.GetProductDetailsByToken(ctx, pageToken, country)
+	p.recordUsage(keyIndex, err, time.Since(start))
+	return data, err
+}
+
+// recordUsage updates the key statistics. "No products" is a successful call.
+func (p *SerpAPIProvider) recordUsage(keyIndex int, err error, responseTime time.Duration) {
+	if keyIndex < 0 {
+		return
+	}
+	p.serp.keyRotator.RecordUsage(keyIndex, err == nil || errors.Is(err, ErrNoProductsFound), responseTime)
+}
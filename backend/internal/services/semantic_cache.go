@@ -0,0 +1,288 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	"mylittleprice/internal/config"
+	"mylittleprice/internal/constants"
+	"mylittleprice/internal/metrics"
+	"mylittleprice/internal/models"
+)
+
+// HNSW parameters of the semantic cache indexes
+const (
+	semanticCacheM              = 16
+	semanticCacheEfConstruction = 100
+	semanticCacheEfSearch       = 50
+	semanticCacheCandidates     = 3 // Nearest queries tried in case some have expired
+
+	semanticCacheSweepInterval = 10 * time.Minute // How often expired searches are dropped from the indexes
+)
+
+// SearchCacheKey identifies cached search results: a query within a
+// partition of searches whose results are interchangeable (same provider,
+// country, search type and price range)
+type SearchCacheKey struct {
+	Partition string
+	Query     string
+}
+
+// NewSearchCacheKey returns the cache key of a search on provider
+func NewSearchCacheKey(provider string, query ProductSearchQuery) SearchCacheKey {
+	partition := fmt.Sprintf("%s:%s:%s", provider, query.Country, query.SearchType)
+	if query.MinPrice != nil {
+		partition += fmt.Sprintf(":min%.0f", *query.MinPrice)
+	}
+	if query.MaxPrice != nil {
+		partition += fmt.Sprintf(":max%.0f", *query.MaxPrice)
+	}
+	return SearchCacheKey{Partition: partition, Query: query.Query}
+}
+
+// String returns the Redis key of the cached results
+func (k SearchCacheKey) String() string {
+	return constants.CachePrefixSearch + k.Partition + ":" + k.Query
+}
+
+// cachedSearch is how search results are stored in Redis. The query
+// embedding is kept with them so that the semantic index can be rebuilt
+// without embedding every query again.
+type cachedSearch struct {
+	Partition      string               `json:"partition"`
+	Query          string               `json:"query"`
+	Embedding      []float32            `json:"embedding,omitempty"`
+	EmbeddingModel string               `json:"embedding_model,omitempty"` // Embedder name
+	Products       []models.ProductCard `json:"products"`
+}
+
+// SemanticCache finds cached searches for queries that are worded
+// differently but mean the same ("iphone 15 case" / "case for iPhone 15").
+// Each partition has its own in-memory HNSW index of query embeddings (one
+// per embedder, as the local fallback's vectors can't be compared with the
+// provider's), rebuilt from the cached searches in Redis on boot. The
+// results themselves stay in Redis under their SearchCacheKey, and expire
+// there; a periodic sweep drops them from the indexes once they have.
+type SemanticCache struct {
+	redis     *redis.Client
+	embedding *EmbeddingService
+	threshold float32
+
+	mu       sync.RWMutex
+	indexes  map[string]*hnswIndex           // By indexName
+	expiries map[string]map[string]time.Time // By indexName, then Redis key; only for keys with a TTL
+
+	stop chan struct{}
+	done chan struct{}
+	once sync.Once
+}
+
+// indexName is the index of a partition's queries embedded by model
+func indexName(partition, model string) string {
+	return model + "|" + partition
+}
+
+// NewSemanticCache creates an empty semantic cache, see Rebuild, and
+// starts sweeping expired searches until Close
+func NewSemanticCache(redisClient *redis.Client, embedding *EmbeddingService, cfg *config.Config) *SemanticCache {
+	s := &SemanticCache{
+		redis:     redisClient,
+		embedding: embedding,
+		threshold: float32(cfg.SemanticCacheThreshold),
+		indexes:   make(map[string]*hnswIndex),
+		expiries:  make(map[string]map[string]time.Time),
+		stop:      make(chan struct{}),
+		done:      make(chan struct{}),
+	}
+	go s.sweepLoop()
+	return s
+}
+
+// Close stops the sweep
+func (s *SemanticCache) Close() {
+	s.once.Do(func() {
+		close(s.stop)
+		<-s.done
+	})
+}
+
+// Rebuild indexes the cached searches in Redis
+func (s *SemanticCache) Rebuild(ctx context.Context) error {
+	indexes := make(map[string]*hnswIndex)
+	expiries := make(map[string]map[string]time.Time)
+
+	iter := s.redis.Scan(ctx, 0, constants.CachePrefixSearch+"*", 500).Iterator()
+	for iter.Next(ctx) {
+		data, err := s.redis.Get(ctx, iter.Val()).Bytes()
+		if err != nil {
+			continue // Expired since the scan
+		}
+		var cached cachedSearch
+		if err := json.Unmarshal(data, &cached); err != nil || len(cached.Embedding) == 0 {
+			continue // Cached before the semantic cache existed
+		}
+
+		name := indexName(cached.Partition, cached.EmbeddingModel)
+		index, ok := indexes[name]
+		if !ok {
+			index = newHNSWIndex(semanticCacheM, semanticCacheEfConstruction, semanticCacheEfSearch)
+			indexes[name] = index
+		}
+		index.Add(iter.Val(), cached.Embedding)
+
+		if ttl, err := s.redis.PTTL(ctx, iter.Val()).Result(); err == nil && ttl > 0 {
+			if expiries[name] == nil {
+				expiries[name] = make(map[string]time.Time)
+			}
+			expiries[name][iter.Val()] = time.Now().Add(ttl)
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return fmt.Errorf("failed to scan cached searches: %w", err)
+	}
+
+	entries := 0
+	for _, index := range indexes {
+		entries += index.Len()
+	}
+
+	s.mu.Lock()
+	s.indexes = indexes
+	s.expiries = expiries
+	s.mu.Unlock()
+
+	metrics.SemanticCacheEntries.Set(float64(entries))
+	return nil
+}
+
+// Embed returns the embedding of a query (nil if it couldn't be embedded)
+// and the name of the embedder that made it
+func (s *SemanticCache) Embed(ctx context.Context, query string) ([]float32, string) {
+	vectors, model := s.embedding.Embed(ctx, []string{query})
+	return vectors[0], model
+}
+
+// Add indexes the query of a search cached for ttl (0 = without expiry)
+func (s *SemanticCache) Add(key SearchCacheKey, embedding []float32, model string, ttl time.Duration) {
+	if embedding == nil {
+		return
+	}
+
+	s.mu.Lock()
+	name := indexName(key.Partition, model)
+	index, ok := s.indexes[name]
+	if !ok {
+		index = newHNSWIndex(semanticCacheM, semanticCacheEfConstruction, semanticCacheEfSearch)
+		s.indexes[name] = index
+	}
+	before := index.Len()
+	index.Add(key.String(), embedding)
+	added := index.Len() - before
+	if ttl > 0 {
+		if s.expiries[name] == nil {
+			s.expiries[name] = make(map[string]time.Time)
+		}
+		s.expiries[name][key.String()] = time.Now().Add(ttl)
+	}
+	s.mu.Unlock()
+
+	metrics.SemanticCacheEntries.Add(float64(added))
+}
+
+// Lookup returns the Redis key of the cached search in key's partition
+// whose query is most similar to embedding, if it's at least as similar as
+// SEMANTIC_CACHE_THRESHOLD. Searches that have expired from Redis are
+// dropped from the index on the way.
+func (s *SemanticCache) Lookup(ctx context.Context, key SearchCacheKey, embedding []float32, model string) (string, float32, bool) {
+	if embedding == nil {
+		return "", 0, false
+	}
+
+	name := indexName(key.Partition, model)
+	s.mu.RLock()
+	var matches []hnswMatch
+	if index, ok := s.indexes[name]; ok {
+		matches = index.Search(embedding, semanticCacheCandidates)
+	}
+	s.mu.RUnlock()
+
+	for _, match := range matches {
+		if match.Similarity < s.threshold {
+			break
+		}
+		exists, err := s.redis.Exists(ctx, match.ID).Result()
+		if err != nil {
+			return "", 0, false
+		}
+		if exists == 1 {
+			return match.ID, match.Similarity, true
+		}
+		s.remove(name, match.ID)
+	}
+	return "", 0, false
+}
+
+func (s *SemanticCache) remove(name, redisKey string) {
+	s.mu.Lock()
+	removed := 0
+	if index, ok := s.indexes[name]; ok {
+		before := index.Len()
+		index.Remove(redisKey)
+		removed = before - index.Len()
+	}
+	delete(s.expiries[name], redisKey)
+	s.mu.Unlock()
+
+	metrics.SemanticCacheEntries.Sub(float64(removed))
+}
+
+func (s *SemanticCache) sweepLoop() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(semanticCacheSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case now := <-ticker.C:
+			s.sweep(now)
+		}
+	}
+}
+
+// sweep drops the searches that have expired from Redis by now from the
+// indexes, so that queries nobody looks up again don't pile up in memory
+func (s *SemanticCache) sweep(now time.Time) {
+	s.mu.Lock()
+	removed := 0
+	for name, keys := range s.expiries {
+		index := s.indexes[name]
+		for redisKey, expiresAt := range keys {
+			if expiresAt.After(now) {
+				continue
+			}
+			if index != nil {
+				before := index.Len()
+				index.Remove(redisKey)
+				removed += before - index.Len()
+			}
+			delete(keys, redisKey)
+		}
+		if len(keys) == 0 {
+			delete(s.expiries, name)
+		}
+		if index != nil && index.Len() == 0 {
+			delete(s.indexes, name)
+		}
+	}
+	s.mu.Unlock()
+
+	metrics.SemanticCacheEntries.Sub(float64(removed))
+}
@@ -5,32 +5,35 @@ import (
 	"context"
 	"fmt"
 	"log/slog"
-	"strings"
 	"time"
 
-	g "github.com/serpapi/google-search-results-golang"
-
 	"mylittleprice/internal/config"
 	"mylittleprice/internal/domain"
 	"mylittleprice/internal/models"
+	"mylittleprice/internal/upstream"
 	"mylittleprice/internal/utils"
 )
 
 type SerpService struct {
+	client     *SerpAPIClient
 	keyRotator *utils.KeyRotator
+	ranker     *Ranker
 	config     *config.Config
 }
 
 type SearchResult struct {
 	Products        []domain.ShoppingItem
+	Scores          []models.RankingScore // Ranking score of each product
 	RelevanceScore  float32
 	IsRelevant      bool
 	AlternativeHint string
 }
 
-func NewSerpService(keyRotator *utils.KeyRotator, cfg *config.Config) *SerpService {
+func NewSerpService(keyRotator *utils.KeyRotator, ranker *Ranker, cfg *config.Config) *SerpService {
 	return &SerpService{
+		client:     NewSerpAPIClient(cfg),
 		keyRotator: keyRotator,
+		ranker:     ranker,
 		config:     cfg,
 	}
 }
@@ -79,7 +82,10 @@ func (s *SerpService) SearchProducts(ctx context.Context, query, searchType, cou
 				slog.Int("max_attempts", maxRetries+1),
 				slog.Duration("backoff", backoffDuration),
 			)
-			time.Sleep(backoffDuration)
+			select {
+			case <-time.After(backoffDuration):
+			case <-ctx.Done():
+			}
 		} else if attempt > 0 && lastWasQuotaError {
 			utils.LogInfo(ctx, "🔄 SERP retry with next key",
 				slog.Int("attempt", attempt+1),
@@ -87,6 +93,10 @@ func (s *SerpService) SearchProducts(ctx context.Context, query, searchType, cou
 			)
 		}
 
+		if ctx.Err() != nil {
+			return nil, lastKeyIndex, fmt.Errorf("SERP request aborted: %w", ctx.Err())
+		}
+
 		apiKey, keyIndex, err := s.keyRotator.GetNextKey()
 		if err != nil {
 			return nil, -1, fmt.Errorf("failed to get API key: %w", err)
@@ -113,12 +123,14 @@ func (s *SerpService) SearchProducts(ctx context.Context, query, searchType, cou
 			parameter["max_price"] = fmt.Sprintf("%.0f", *maxPrice)
 		}
 
-		search := g.NewGoogleSearch(parameter, apiKey)
-
 		startTime := time.Now()
-		data, err := search.GetJSON()
+		data, err := s.client.Search(ctx, apiKey, parameter, s.config.SerpAPISearchTimeout)
 		elapsed := time.Since(startTime)
 
+		if ctx.Err() != nil {
+			return nil, keyIndex, fmt.Errorf("SERP request aborted: %w", ctx.Err())
+		}
+
 		if err != nil {
 			lastErr = err
 			utils.LogError(ctx, "❌ SERP API error", err,
@@ -129,31 +141,23 @@ func (s *SerpService) SearchProducts(ctx context.Context, query, searchType, cou
 			)
 
 			// Check if error is retryable
-			errMsg := err.Error()
-			isQuotaError := strings.Contains(errMsg, "run out of searches") ||
-				strings.Contains(errMsg, "quota exceeded") ||
-				strings.Contains(errMsg, "limit exceeded") ||
-				strings.Contains(errMsg, "rate limit")
-
-			isNetworkError := strings.Contains(errMsg, "timeout") ||
-				strings.Contains(errMsg, "503") ||
-				strings.Contains(errMsg, "502") ||
-				strings.Contains(errMsg, "500")
-
-			if isQuotaError {
-				// Mark this key as exhausted
-				utils.LogWarn(ctx, "⚠️ Quota error detected, marking key as exhausted", slog.Int("key_index", keyIndex))
-				if markErr := s.keyRotator.MarkKeyAsExhausted(keyIndex); markErr != nil {
-					utils.LogError(ctx, "Failed to mark key as exhausted", markErr, slog.Int("key_index", keyIndex))
-				}
+			err = upstream.FromSerpAPI(err)
+			lastErr = err
+
+			if s.keyRotator.ReportFailure(keyIndex, err) {
+				// Key is out of rotation (quota, rate limit or invalid)
+				utils.LogWarn(ctx, "⚠️ Key error detected, switching key",
+					slog.Int("key_index", keyIndex),
+					slog.String("error_type", string(upstream.KindOf(err))),
+				)
 				// Try next key immediately (don't wait for backoff)
 				lastWasQuotaError = true
 				if attempt < maxRetries {
 					continue
 				}
-			} else if isNetworkError {
+			} else if upstream.IsRetriable(err) {
 				// Retryable network error - continue to next attempt
-				utils.LogWarn(ctx, "Network error, retrying", slog.String("error_type", "network"))
+				utils.LogWarn(ctx, "Network error, retrying", slog.String("error_type", string(upstream.KindOf(err))))
 				if attempt < maxRetries {
 					continue
 				}
@@ -192,6 +196,9 @@ func (s *SerpService) SearchProducts(ctx context.Context, query, searchType, cou
 						Reviews:     getIntFromInterface(itemMap["reviews"]),
 						SerpAPILink: getStringFromInterface(itemMap["serpapi_product_api"]),
 						PageToken:   getStringFromInterface(itemMap["immersive_product_page_token"]),
+
+						ExtractedPrice: getFloat64FromInterface(itemMap["extracted_price"]),
+						Condition:      getStringFromInterface(itemMap["second_hand_condition"]),
 					}
 					shoppingItems = append(shoppingItems, shoppingItem)
 				}
@@ -200,17 +207,26 @@ func (s *SerpService) SearchProducts(ctx context.Context, query, searchType, cou
 			utils.LogWarn(ctx, "⚠️ No shopping_results in SERP response")
 		}
 
-		result := s.validateRelevance(query, shoppingItems, searchType)
+		ReportProgress(ctx, ProgressEvent{Stage: ProgressFoundProducts, Count: len(shoppingItems)})
+		ReportProgress(ctx, ProgressEvent{Stage: ProgressRanking})
+
+		result := s.validateRelevance(ctx, query, shoppingItems, searchType)
 
 		if !result.IsRelevant {
 			utils.LogWarn(ctx, "⚠️ No relevant products found",
 				slog.String("query", query),
 				slog.Float64("relevance_score", float64(result.RelevanceScore)),
 			)
-			return nil, keyIndex, fmt.Errorf("no relevant products found")
+			return nil, keyIndex, ErrNoProductsFound
 		}
 
-		cards := s.convertToProductCards(result.Products, searchType)
+		cards := s.convertToProductCards(result.Products, searchType, country)
+		if s.config.RankingDebug {
+			for i := range cards {
+				cards[i].Ranking = &result.Scores[i]
+			}
+			s.logRanking(ctx, cards)
+		}
 
 		// Log final results with product details
 		productNames := make([]string, 0, min(3, len(cards)))
@@ -234,7 +250,9 @@ func (s *SerpService) SearchProducts(ctx context.Context, query, searchType, cou
 	return nil, lastKeyIndex, fmt.Errorf("SERP API failed after %d retries", maxRetries+1)
 }
 
-func (s *SerpService) validateRelevance(query string, items []domain.ShoppingItem, searchType string) SearchResult {
+// validateRelevance re-ranks the items with the ranker and keeps the best
+// maxSearchResults of them
+func (s *SerpService) validateRelevance(ctx context.Context, query string, items []domain.ShoppingItem, searchType string) SearchResult {
 	if len(items) == 0 {
 		return SearchResult{
 			Products:        []domain.ShoppingItem{},
@@ -244,187 +262,33 @@ func (s *SerpService) validateRelevance(query string, items []domain.ShoppingIte
 		}
 	}
 
-	// ✅ НОВАЯ ЛОГИКА: Берем товары по позициям 1-10 от SerpAPI (уже отсортированы)
-	// Вместо фильтрации по score, используем оригинальный порядок от Google Shopping
-
-	maxProducts := 10 // Всегда берем до 10 товаров
-	relevantProducts := []domain.ShoppingItem{}
-
-	// Берем товары в оригинальном порядке (позиции 1-10)
-	productCount := min(maxProducts, len(items))
-	for i := 0; i < productCount; i++ {
-		relevantProducts = append(relevantProducts, items[i])
-	}
-
-	// Считаем релевантным если есть хоть какие-то продукты
-	isRelevant := len(relevantProducts) > 0
+	ranked := s.ranker.Rank(ctx, query, items)
+	ranked = ranked[:min(maxSearchResults, len(ranked))] // Весь набор, страницы отдаёт SearchResultsService
 
 	result := SearchResult{
-		Products:       relevantProducts,
-		RelevanceScore: 1.0, // Не используется при новой логике
-		IsRelevant:     isRelevant,
+		Products:       make([]domain.ShoppingItem, len(ranked)),
+		Scores:         make([]models.RankingScore, len(ranked)),
+		RelevanceScore: float32(ranked[0].Score.Total),
+		IsRelevant:     true,
 	}
-
-	if !isRelevant && len(items) > 0 {
-		result.AlternativeHint = fmt.Sprintf("Found similar products but exact match not available. Best alternative: %s", items[0].Title)
+	for i, r := range ranked {
+		result.Products[i] = r.Item
+		result.Scores[i] = r.Score
 	}
 
 	return result
 }
-func (s *SerpService) calculateRelevanceScore(queryWords []string, item domain.ShoppingItem) float32 {
-	titleLower := strings.ToLower(item.Title)
-	var score float32 = 0.0
-
-	// ✅ 1. Полное совпадение всей фразы (бонус)
-	queryStr := strings.Join(queryWords, " ")
-	if strings.Contains(titleLower, queryStr) {
-		score += float32(s.config.SerpScorePhraseMatch)
-	}
-
-	// ✅ 2. Все слова присутствуют (хороший сигнал)
-	allWordsPresent := true
-	for _, word := range queryWords {
-		if len(word) <= s.config.SerpMinWordLength {
-			continue // Игнорируем короткие слова
-		}
-		if !strings.Contains(titleLower, word) {
-			allWordsPresent = false
-			break
-		}
-	}
-	if allWordsPresent {
-		score += float32(s.config.SerpScoreAllWords)
-	}
-
-	// ✅ 3. Частичное совпадение слов (даже если не все слова есть)
-	matchedWords := 0
-	importantMatchedWords := 0
-	for _, word := range queryWords {
-		if len(word) <= 2 || isCommonWord(word) {
-			continue
-		}
-		if strings.Contains(titleLower, word) {
-			matchedWords++
-			// Дополнительный бонус за важные слова (бренды, типы продуктов)
-			if !isCommonWord(word) {
-				importantMatchedWords++
-			}
-		}
-	}
-
-	significantWords := 0
-	for _, word := range queryWords {
-		if len(word) > s.config.SerpMinWordLength && !isCommonWord(word) {
-			significantWords++
-		}
-	}
-
-	if significantWords > 0 {
-		matchRatio := float32(matchedWords) / float32(significantWords)
-		score += matchRatio * float32(s.config.SerpScorePartialWords)
-	}
-
-	// ✅ 4. Порядок слов (менее важно)
-	if len(queryWords) >= 2 {
-		titleWords := strings.Fields(titleLower)
-		orderScore := s.calculateWordOrderScore(queryWords, titleWords)
-		score += orderScore * float32(s.config.SerpScoreWordOrderWeight)
-	}
-
-	// ✅ 5. Бренды (важно для точности)
-	brands := []string{
-		"apple", "iphone", "ipad", "macbook", "samsung", "galaxy",
-		"google", "pixel", "xiaomi", "oneplus", "sony", "dell",
-		"hp", "lenovo", "asus", "acer", "msi", "lg", "huawei",
-		"nike", "adidas", "puma", "reebok", "under", "armour",
-	}
-	for _, brand := range brands {
-		for _, word := range queryWords {
-			if word == brand && strings.Contains(titleLower, brand) {
-				score += float32(s.config.SerpScoreBrandMatch)
-				break
-			}
-		}
-	}
-
-	// ✅ 6. Номера моделей (если есть в запросе, должны совпадать)
-	modelNumbers := s.extractModelNumbers(queryWords)
-	if len(modelNumbers) > 0 {
-		hasModelMatch := false
-		for _, modelNum := range modelNumbers {
-			if strings.Contains(titleLower, modelNum) {
-				hasModelMatch = true
-				break
-			}
-		}
-		if hasModelMatch {
-			score += float32(s.config.SerpScoreModelMatch)
-		}
-		// Убираем штраф если модель не совпала - может быть похожий продукт
-	}
-
-	// ✅ 7. УБИРАЕМ ЖЕСТКИЙ ШТРАФ за лишние слова
-	// Это слишком строго для гибкого поиска
-
-	// Ограничиваем score в пределах [0, 1]
-	if score < 0 {
-		score = 0
-	}
-	if score > 1 {
-		score = 1
-	}
-
-	return score
-}
-
-func (s *SerpService) calculateWordOrderScore(queryWords, titleWords []string) float32 {
-	if len(queryWords) < 2 || len(titleWords) < 2 {
-		return 0
-	}
 
-	matches := 0
-	for i := 0; i < len(queryWords)-1; i++ {
-		word1 := queryWords[i]
-		word2 := queryWords[i+1]
-
-		pos1 := -1
-		pos2 := -1
-
-		for j, titleWord := range titleWords {
-			if strings.Contains(titleWord, word1) {
-				pos1 = j
-			}
-			if strings.Contains(titleWord, word2) {
-				pos2 = j
-			}
-		}
-
-		if pos1 != -1 && pos2 != -1 && pos1 < pos2 {
-			matches++
-		}
-	}
-
-	return float32(matches) / float32(len(queryWords)-1)
-}
-
-func (s *SerpService) extractModelNumbers(words []string) []string {
-	modelNumbers := []string{}
-
-	for _, word := range words {
-		hasDigit := false
-		for _, char := range word {
-			if char >= '0' && char <= '9' {
-				hasDigit = true
-				break
-			}
-		}
-
-		if hasDigit && len(word) >= s.config.SerpModelNumberMinLength {
-			modelNumbers = append(modelNumbers, word)
-		}
+// logRanking logs the score breakdown of the top results
+func (s *SerpService) logRanking(ctx context.Context, cards []models.ProductCard) {
+	for i := 0; i < min(s.config.SerpLogTopResultsCount, len(cards)); i++ {
+		utils.LogInfo(ctx, "🔢 Ranking score",
+			slog.Int("rank", i+1),
+			slog.String("product", cards[i].Name),
+			slog.Float64("total", cards[i].Ranking.Total),
+			slog.Any("scores", cards[i].Ranking.Scores),
+		)
 	}
-
-	return modelNumbers
 }
 
 func isCommonWord(word string) bool {
@@ -445,7 +309,7 @@ func isCommonWord(word string) bool {
 	return false
 }
 
-func (s *SerpService) GetProductDetailsByToken(ctx context.Context, pageToken string) (map[string]interface{}, int, error) {
+func (s *SerpService) GetProductDetailsByToken(ctx context.Context, pageToken, country string) (map[string]interface{}, int, error) {
 	if ctx == nil {
 		ctx = context.Background()
 	}
@@ -466,13 +330,20 @@ func (s *SerpService) GetProductDetailsByToken(ctx context.Context, pageToken st
 				slog.Int("attempt", attempt+1),
 				slog.Duration("backoff", backoffDuration),
 			)
-			time.Sleep(backoffDuration)
+			select {
+			case <-time.After(backoffDuration):
+			case <-ctx.Done():
+			}
 		} else if attempt > 0 && lastWasQuotaError {
 			utils.LogInfo(ctx, "🔄 Product details retry with next key",
 				slog.Int("attempt", attempt+1),
 			)
 		}
 
+		if ctx.Err() != nil {
+			return nil, lastKeyIndex, fmt.Errorf("SERP request aborted: %w", ctx.Err())
+		}
+
 		apiKey, keyIndex, err := s.keyRotator.GetNextKey()
 		if err != nil {
 			return nil, -1, fmt.Errorf("failed to get API key: %w", err)
@@ -485,12 +356,19 @@ func (s *SerpService) GetProductDetailsByToken(ctx context.Context, pageToken st
 			"page_token":  pageToken,
 			"more_stores": "true",
 		}
+		if country != "" {
+			parameter["gl"] = country
+			parameter["hl"] = getLanguageForCountry(country)
+		}
 
-		search := g.NewGoogleSearch(parameter, apiKey)
 		startTime := time.Now()
-		data, err := search.GetJSON()
+		data, err := s.client.Search(ctx, apiKey, parameter, s.config.SerpAPIDetailsTimeout)
 		elapsed := time.Since(startTime)
 
+		if ctx.Err() != nil {
+			return nil, keyIndex, fmt.Errorf("SERP request aborted: %w", ctx.Err())
+		}
+
 		if err != nil {
 			lastErr = err
 			utils.LogError(ctx, "❌ Product details API error", err,
@@ -499,27 +377,19 @@ func (s *SerpService) GetProductDetailsByToken(ctx context.Context, pageToken st
 				slog.Int("key_index", keyIndex),
 			)
 
-			errMsg := err.Error()
-			isQuotaError := strings.Contains(errMsg, "run out of searches") ||
-				strings.Contains(errMsg, "quota exceeded") ||
-				strings.Contains(errMsg, "limit exceeded") ||
-				strings.Contains(errMsg, "rate limit")
-
-			isNetworkError := strings.Contains(errMsg, "timeout") ||
-				strings.Contains(errMsg, "503") ||
-				strings.Contains(errMsg, "502") ||
-				strings.Contains(errMsg, "500")
-
-			if isQuotaError {
-				utils.LogWarn(ctx, "⚠️ Quota error for product details", slog.Int("key_index", keyIndex))
-				if markErr := s.keyRotator.MarkKeyAsExhausted(keyIndex); markErr != nil {
-					utils.LogError(ctx, "Failed to mark key as exhausted", markErr)
-				}
+			err = upstream.FromSerpAPI(err)
+			lastErr = err
+
+			if s.keyRotator.ReportFailure(keyIndex, err) {
+				utils.LogWarn(ctx, "⚠️ Key error for product details",
+					slog.Int("key_index", keyIndex),
+					slog.String("error_type", string(upstream.KindOf(err))),
+				)
 				lastWasQuotaError = true
 				if attempt < maxRetries {
 					continue
 				}
-			} else if isNetworkError {
+			} else if upstream.IsRetriable(err) {
 				if attempt < maxRetries {
 					continue
 				}
@@ -542,10 +412,11 @@ func (s *SerpService) GetProductDetailsByToken(ctx context.Context, pageToken st
 	return nil, lastKeyIndex, fmt.Errorf("product details failed after %d retries", maxRetries+1)
 }
 
-func (s *SerpService) convertToProductCards(items []domain.ShoppingItem, searchType string) []models.ProductCard {
-	// ✅ НОВАЯ ЛОГИКА: Всегда берем до 10 товаров независимо от типа поиска
-	maxProducts := 10
+func (s *SerpService) convertToProductCards(items []domain.ShoppingItem, searchType, country string) []models.ProductCard {
+	// ✅ НОВАЯ ЛОГИКА: Всегда берем до maxSearchResults товаров независимо от типа поиска
+	maxProducts := maxSearchResults
 	cards := make([]models.ProductCard, 0, maxProducts)
+	currency := domain.GetCurrencyForCountry(domain.CountryCode(country))
 
 	for i, item := range items {
 		if i >= maxProducts {
@@ -571,6 +442,13 @@ func (s *SerpService) convertToProductCards(items []domain.ShoppingItem, searchT
 			Description: item.Merchant,
 			Badge:       badge,
 			PageToken:   pageToken,
+			ProductID:   item.ProductID,
+
+			Merchant:  item.Merchant,
+			Amount:    domain.ParsePrice(item.Price, item.ExtractedPrice, currency),
+			Rating:    item.Rating,
+			Reviews:   item.Reviews,
+			Condition: item.Condition,
 		}
 
 		cards = append(cards, card)
@@ -635,42 +513,8 @@ func getLanguageForCountry(country string) string {
 	return "en"
 }
 
-func (s *SerpService) GetProductByPageToken(ctx context.Context, pageToken string) (map[string]interface{}, int, error) {
-	return s.GetProductDetailsByToken(ctx, pageToken)
-}
-
-func (s *SerpService) SearchWithCache(ctx context.Context, query, searchType, country string, minPrice, maxPrice *float64, cacheService *CacheService) ([]models.ProductCard, int, error) {
-	if ctx == nil {
-		ctx = context.Background()
-	}
-
-	// Build cache key including price range
-	cacheKey := fmt.Sprintf("search:%s:%s:%s", country, searchType, query)
-	if minPrice != nil {
-		cacheKey += fmt.Sprintf(":min%.0f", *minPrice)
-	}
-	if maxPrice != nil {
-		cacheKey += fmt.Sprintf(":max%.0f", *maxPrice)
-	}
-
-	if cacheService != nil {
-		if cached, err := cacheService.GetSearchResults(cacheKey); err == nil && cached != nil {
-			utils.LogInfo(ctx, "📦 Using cached SERP results", slog.String("cache_key", cacheKey))
-			return cached, -1, nil
-		}
-	}
-
-	cards, keyIndex, err := s.SearchProducts(ctx, query, searchType, country, minPrice, maxPrice)
-	if err != nil {
-		return nil, keyIndex, err
-	}
-
-	if cacheService != nil {
-		ttl := time.Duration(s.config.CacheSerpTTL) * time.Second
-		_ = cacheService.SetSearchResults(cacheKey, cards, ttl)
-	}
-
-	return cards, keyIndex, nil
+func (s *SerpService) GetProductByPageToken(ctx context.Context, pageToken, country string) (map[string]interface{}, int, error) {
+	return s.GetProductDetailsByToken(ctx, pageToken, country)
 }
 
 func getStringFromInterface(val interface{}) string {
@@ -705,3 +549,16 @@ func getFloat32FromInterface(val interface{}) float32 {
 		return 0
 	}
 }
+
+func getFloat64FromInterface(val interface{}) float64 {
+	switch v := val.(type) {
+	case float64:
+		return v
+	case float32:
+		return float64(v)
+	case int:
+		return float64(v)
+	default:
+		return 0
+	}
+}
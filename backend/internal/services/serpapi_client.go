@@ -0,0 +1,115 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"mylittleprice/internal/config"
+	"mylittleprice/internal/upstream"
+)
+
+// SerpAPIClient calls the SerpAPI search endpoint. Every call honours its
+// context and a per-call deadline, and connections are pooled across calls.
+type SerpAPIClient struct {
+	baseURL          string
+	httpClient       *http.Client
+	maxResponseBytes int64
+}
+
+// NewSerpAPIClient creates a client for cfg.SerpAPIBaseURL (a local stand-in
+// server can replace serpapi.com)
+func NewSerpAPIClient(cfg *config.Config) *SerpAPIClient {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.MaxIdleConns = cfg.SerpAPIMaxIdleConns
+	transport.MaxIdleConnsPerHost = cfg.SerpAPIMaxIdleConns
+
+	return &SerpAPIClient{
+		baseURL:          strings.TrimRight(cfg.SerpAPIBaseURL, "/"),
+		httpClient:       &http.Client{Transport: transport},
+		maxResponseBytes: cfg.SerpAPIMaxResponseBytes,
+	}
+}
+
+// Search runs one SerpAPI query (params as documented per engine) and
+// returns the decoded JSON response. timeout bounds the whole call,
+// including reading the body; 0 means only ctx applies. Failures are
+// classified with upstream.FromSerpAPI.
+func (c *SerpAPIClient) Search(ctx context.Context, apiKey string, params map[string]string, timeout time.Duration) (map[string]interface{}, error) {
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	query := url.Values{}
+	for key, value := range params {
+		query.Set(key, value)
+	}
+	query.Set("api_key", apiKey)
+	query.Set("output", "json")
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, c.baseURL+"/search?"+query.Encode(), nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create SerpAPI request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		// Keep the API key out of logs: url.Error includes the full URL
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return nil, upstream.FromSerpAPI(fmt.Errorf("SerpAPI request failed: %w", err))
+	}
+	defer resp.Body.Close()
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, c.maxResponseBytes+1))
+	if err != nil {
+		return nil, upstream.FromSerpAPI(fmt.Errorf("failed to read SerpAPI response: %w", err))
+	}
+	if int64(len(body)) > c.maxResponseBytes {
+		return nil, upstream.New(upstream.ProviderSerpAPI, upstream.KindBadRequest, resp.StatusCode,
+			fmt.Errorf("SerpAPI response exceeds %d bytes", c.maxResponseBytes))
+	}
+
+	var data map[string]interface{}
+	decodeErr := json.Unmarshal(body, &data)
+	message := getStringFromInterface(data["error"])
+
+	if resp.StatusCode != http.StatusOK {
+		if message == "" {
+			message = strings.TrimSpace(string(body[:min(len(body), 512)]))
+		}
+		upstreamErr := upstream.FromHTTPStatus(upstream.ProviderSerpAPI, resp.StatusCode, message,
+			fmt.Errorf("SerpAPI error %d: %s", resp.StatusCode, message))
+		if seconds, err := strconv.Atoi(resp.Header.Get("Retry-After")); err == nil {
+			upstreamErr.RetryAfter = time.Duration(seconds) * time.Second
+		}
+		return nil, upstreamErr
+	}
+
+	if decodeErr != nil {
+		return nil, upstream.New(upstream.ProviderSerpAPI, upstream.KindTransient, resp.StatusCode,
+			fmt.Errorf("failed to parse SerpAPI response: %w", decodeErr))
+	}
+
+	if message != "" {
+		// An empty result page is reported as an error, but isn't a failure
+		if strings.Contains(strings.ToLower(message), "hasn't returned any results") {
+			return data, nil
+		}
+		return nil, upstream.FromSerpAPI(errors.New(message))
+	}
+
+	return data, nil
+}
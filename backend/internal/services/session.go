@@ -111,7 +111,7 @@ func (s *SessionService) GetSession(sessionID string) (*models.ChatSession, erro
 
 	// Found in DB - restore to Redis for future requests
 	fmt.Printf("📦 Session %s restored from PostgreSQL to Redis\n", sessionID)
-	if err := s.saveSessionToRedis(session); err != nil {
+	if err := s.saveSessionToRedis(s.ctx, session); err != nil {
 		fmt.Printf("⚠️ Failed to restore session to Redis: %v\n", err)
 	}
 
@@ -135,7 +135,7 @@ func (s *SessionService) getSessionFromDB(sessionID string) (*models.ChatSession
 }
 
 // saveSessionToRedis saves session to Redis only
-func (s *SessionService) saveSessionToRedis(session *models.ChatSession) error {
+func (s *SessionService) saveSessionToRedis(ctx context.Context, session *models.ChatSession) error {
 	key := fmt.Sprintf(constants.CachePrefixSession+"%s", session.SessionID)
 
 	data, err := json.Marshal(session)
@@ -143,7 +143,7 @@ func (s *SessionService) saveSessionToRedis(session *models.ChatSession) error {
 		return fmt.Errorf("failed to marshal session: %w", err)
 	}
 
-	err = s.redis.Set(s.ctx, key, data, s.ttl).Err()
+	err = s.redis.Set(ctx, key, data, s.ttl).Err()
 	if err != nil {
 		return fmt.Errorf("failed to save session to Redis: %w", err)
 	}
@@ -152,7 +152,7 @@ func (s *SessionService) saveSessionToRedis(session *models.ChatSession) error {
 }
 
 // saveSessionToDB saves or updates session in PostgreSQL using Ent
-func (s *SessionService) saveSessionToDB(session *models.ChatSession) error {
+func (s *SessionService) saveSessionToDB(ctx context.Context, session *models.ChatSession) error {
 	// If session has a user_id, ensure user exists in PostgreSQL first
 	if session.UserID != nil {
 		if err := s.ensureUserExistsInPostgres(*session.UserID); err != nil {
@@ -184,7 +184,7 @@ func (s *SessionService) saveSessionToDB(session *models.ChatSession) error {
 	// Check if session exists
 	exists, err := s.client.ChatSession.Query().
 		Where(chatsession.SessionIDEQ(session.SessionID)).
-		Exist(s.ctx)
+		Exist(ctx)
 
 	if err != nil {
 		return fmt.Errorf("failed to check session existence: %w", err)
@@ -217,7 +217,7 @@ func (s *SessionService) saveSessionToDB(session *models.ChatSession) error {
 			updateBuilder.ClearConversationContext()
 		}
 
-		_, err = updateBuilder.Save(s.ctx)
+		_, err = updateBuilder.Save(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to update session: %w", err)
 		}
@@ -246,7 +246,7 @@ func (s *SessionService) saveSessionToDB(session *models.ChatSession) error {
 			createBuilder.SetConversationContext(conversationContextMap)
 		}
 
-		_, err = createBuilder.Save(s.ctx)
+		_, err = createBuilder.Save(ctx)
 		if err != nil {
 			return fmt.Errorf("failed to create session: %w", err)
 		}
@@ -260,22 +260,26 @@ func (s *SessionService) UpdateSession(session *models.ChatSession) error {
 	return s.saveSession(session)
 }
 
-// SaveSession explicitly saves a session (alias for UpdateSession for clarity in ProcessChat)
-func (s *SessionService) SaveSession(session *models.ChatSession) error {
+// SaveSession explicitly saves a session with the caller's context (used by ProcessChat)
+func (s *SessionService) SaveSession(ctx context.Context, session *models.ChatSession) error {
 	session.UpdatedAt = time.Now()
-	return s.saveSession(session)
+	return s.saveSessionWithContext(ctx, session)
 }
 
 func (s *SessionService) saveSession(session *models.ChatSession) error {
+	return s.saveSessionWithContext(s.ctx, session)
+}
+
+func (s *SessionService) saveSessionWithContext(ctx context.Context, session *models.ChatSession) error {
 	// Save to both Redis (cache) and PostgreSQL (persistent storage)
 
 	// Save to PostgreSQL first (persistent)
-	if err := s.saveSessionToDB(session); err != nil {
+	if err := s.saveSessionToDB(ctx, session); err != nil {
 		return fmt.Errorf("failed to save session to database: %w", err)
 	}
 
 	// Save to Redis (cache) - non-critical, log errors but don't fail
-	if err := s.saveSessionToRedis(session); err != nil {
+	if err := s.saveSessionToRedis(ctx, session); err != nil {
 		fmt.Printf("⚠️ Failed to save session to Redis (non-critical): %v\n", err)
 	}
 
@@ -435,7 +439,7 @@ func (s *SessionService) GetActiveSessionForUser(userID uuid.UUID) (*models.Chat
 	}
 
 	// Update Redis cache for faster subsequent access
-	if err := s.saveSessionToRedis(session); err != nil {
+	if err := s.saveSessionToRedis(s.ctx, session); err != nil {
 		fmt.Printf("⚠️ Failed to cache active session to Redis: %v\n", err)
 	}
 
@@ -502,7 +506,7 @@ func (s *SessionService) RefreshSessionCache(sessionID string) error {
 	}
 
 	// Save to Redis
-	if err := s.saveSessionToRedis(session); err != nil {
+	if err := s.saveSessionToRedis(s.ctx, session); err != nil {
 		return fmt.Errorf("failed to save session to Redis: %w", err)
 	}
 
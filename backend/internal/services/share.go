@@ -0,0 +1,291 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"mylittleprice/ent"
+	"mylittleprice/ent/conversationshare"
+	"mylittleprice/internal/config"
+	"mylittleprice/internal/models"
+	"mylittleprice/internal/utils"
+)
+
+var (
+	// ErrShareNotFound is returned for unknown shares, shares of another
+	// user, and invalid share tokens
+	ErrShareNotFound = errors.New("share not found")
+	// ErrShareGone is returned when a share link was revoked or has expired
+	ErrShareGone = errors.New("share link is no longer available")
+	// ErrSharedSessionNotFound is returned when sharing a chat session that
+	// doesn't exist or belongs to another user
+	ErrSharedSessionNotFound = errors.New("conversation not found")
+	// ErrShareLimit is returned when a user has too many active share links
+	ErrShareLimit = errors.New("share limit reached")
+	// ErrInvalidShare is returned for invalid share requests and empty conversations
+	ErrInvalidShare = errors.New("invalid share")
+)
+
+const (
+	maxActiveSharesPerUser = 50
+	maxListedShares        = 100
+	maxShareTitleRunes     = 200
+	maxShareExpiryDays     = 365
+	maxSharedMessages      = 200
+
+	conversationShareKind = "conversation"
+)
+
+// emailInTextRegex finds email addresses typed into a conversation
+var emailInTextRegex = regexp.MustCompile(`[A-Za-z0-9._%+\-]+@[A-Za-z0-9.\-]+\.[A-Za-z]{2,}`)
+
+// ShareService snapshots chat sessions into immutable share records and
+// serves them through public read-only links
+type ShareService struct {
+	client         *ent.Client
+	sessionService *SessionService
+	messageService *MessageService
+	signer         *utils.ShareSignature
+	frontendURL    string
+}
+
+// NewShareService creates a ShareService. Share links are signed with the
+// JWT access secret, like wishlist links.
+func NewShareService(client *ent.Client, sessionService *SessionService, messageService *MessageService, cfg *config.Config) *ShareService {
+	return &ShareService{
+		client:         client,
+		sessionService: sessionService,
+		messageService: messageService,
+		signer:         utils.NewShareSignature(cfg.JWTAccessSecret),
+		frontendURL:    cfg.FrontendURL,
+	}
+}
+
+// Create snapshots one of the user's chat sessions and returns its share link.
+// Later messages of the session are not part of the share.
+func (s *ShareService) Create(ctx context.Context, userID uuid.UUID, req models.CreateShareRequest) (*models.ConversationShare, error) {
+	title := strings.TrimSpace(req.Title)
+	if len([]rune(title)) > maxShareTitleRunes {
+		return nil, fmt.Errorf("%w: title is longer than %d characters", ErrInvalidShare, maxShareTitleRunes)
+	}
+	if req.ExpiresInDays < 0 || req.ExpiresInDays > maxShareExpiryDays {
+		return nil, fmt.Errorf("%w: expires_in_days must be between 0 and %d", ErrInvalidShare, maxShareExpiryDays)
+	}
+	if req.SessionID == "" {
+		return nil, fmt.Errorf("%w: session_id is required", ErrInvalidShare)
+	}
+
+	session, err := s.sessionService.GetSession(req.SessionID)
+	if err != nil || session.UserID == nil || *session.UserID != userID {
+		return nil, ErrSharedSessionNotFound
+	}
+
+	messages, err := s.messageService.GetMessages(session.SessionID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get messages: %w", err)
+	}
+	if len(messages) == 0 {
+		return nil, fmt.Errorf("%w: conversation has no messages", ErrInvalidShare)
+	}
+	if len(messages) > maxSharedMessages {
+		messages = messages[len(messages)-maxSharedMessages:]
+	}
+
+	snapshot, err := shareSnapshot(messages)
+	if err != nil {
+		return nil, err
+	}
+
+	active, err := s.client.ConversationShare.Query().
+		Where(
+			conversationshare.UserIDEQ(userID),
+			conversationshare.RevokedAtIsNil(),
+			conversationshare.Or(
+				conversationshare.ExpiresAtIsNil(),
+				conversationshare.ExpiresAtGT(time.Now()),
+			),
+		).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count shares: %w", err)
+	}
+	if active >= maxActiveSharesPerUser {
+		return nil, fmt.Errorf("%w: at most %d active share links, revoke one first", ErrShareLimit, maxActiveSharesPerUser)
+	}
+
+	create := s.client.ConversationShare.Create().
+		SetUserID(userID).
+		SetSessionID(session.ID).
+		SetTitle(title).
+		SetMessages(snapshot).
+		SetMessageCount(len(messages)).
+		SetCurrency(session.Currency)
+	if req.ExpiresInDays > 0 {
+		create.SetExpiresAt(time.Now().AddDate(0, 0, req.ExpiresInDays))
+	}
+
+	share, err := create.Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create share: %w", err)
+	}
+
+	result := s.toConversationShare(share)
+	return &result, nil
+}
+
+// List returns the user's share links, newest first
+func (s *ShareService) List(ctx context.Context, userID uuid.UUID) ([]models.ConversationShare, error) {
+	shares, err := s.client.ConversationShare.Query().
+		Where(conversationshare.UserIDEQ(userID)).
+		Order(ent.Desc(conversationshare.FieldCreatedAt)).
+		Limit(maxListedShares).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query shares: %w", err)
+	}
+
+	result := make([]models.ConversationShare, 0, len(shares))
+	for _, share := range shares {
+		result = append(result, s.toConversationShare(share))
+	}
+	return result, nil
+}
+
+// Revoke disables one of the user's share links. Revoking a revoked link
+// does nothing.
+func (s *ShareService) Revoke(ctx context.Context, userID, shareID uuid.UUID) error {
+	share, err := s.client.ConversationShare.Query().
+		Where(
+			conversationshare.IDEQ(shareID),
+			conversationshare.UserIDEQ(userID),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return ErrShareNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("failed to get share: %w", err)
+	}
+	if share.RevokedAt != nil {
+		return nil
+	}
+
+	if err := s.client.ConversationShare.UpdateOne(share).SetRevokedAt(time.Now()).Exec(ctx); err != nil {
+		return fmt.Errorf("failed to revoke share: %w", err)
+	}
+	return nil
+}
+
+// GetShared returns a conversation through its share link and counts the view
+func (s *ShareService) GetShared(ctx context.Context, token string) (*models.SharedConversation, error) {
+	// Shares are immutable, so their links are always signed at version 0
+	rawID, _, err := s.signer.Verify(conversationShareKind, token)
+	if err != nil {
+		return nil, ErrShareNotFound
+	}
+	shareID, err := uuid.Parse(rawID)
+	if err != nil {
+		return nil, ErrShareNotFound
+	}
+
+	share, err := s.client.ConversationShare.Get(ctx, shareID)
+	if ent.IsNotFound(err) {
+		return nil, ErrShareNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get share: %w", err)
+	}
+	if share.RevokedAt != nil || (share.ExpiresAt != nil && !share.ExpiresAt.After(time.Now())) {
+		return nil, ErrShareGone
+	}
+
+	messages, err := sharedMessages(share.Messages)
+	if err != nil {
+		return nil, err
+	}
+
+	// Counted in SQL, so concurrent views aren't lost
+	viewed, err := s.client.ConversationShare.UpdateOne(share).AddViewCount(1).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count share view: %w", err)
+	}
+
+	return &models.SharedConversation{
+		Title:     share.Title,
+		Messages:  messages,
+		Currency:  share.Currency,
+		ViewCount: viewed.ViewCount,
+		ExpiresAt: share.ExpiresAt,
+		CreatedAt: share.CreatedAt,
+	}, nil
+}
+
+func (s *ShareService) toConversationShare(share *ent.ConversationShare) models.ConversationShare {
+	token := s.signer.Sign(conversationShareKind, share.ID.String(), 0)
+	return models.ConversationShare{
+		ID:           share.ID,
+		URL:          fmt.Sprintf("%s/shared/%s", s.frontendURL, token),
+		Title:        share.Title,
+		MessageCount: share.MessageCount,
+		ViewCount:    share.ViewCount,
+		ExpiresAt:    share.ExpiresAt,
+		Revoked:      share.RevokedAt != nil,
+		CreatedAt:    share.CreatedAt,
+	}
+}
+
+// shareSnapshot copies what a shared conversation shows of messages. IDs,
+// search state and quick replies are left out, and email addresses in the
+// text are masked.
+func shareSnapshot(messages []*models.Message) ([]map[string]interface{}, error) {
+	shared := make([]models.SharedMessage, 0, len(messages))
+	for _, msg := range messages {
+		products := make([]models.ProductCard, len(msg.Products))
+		copy(products, msg.Products)
+		for i := range products {
+			products[i].Ranking = nil
+		}
+
+		shared = append(shared, models.SharedMessage{
+			Role:               msg.Role,
+			Content:            maskEmails(msg.Content),
+			ResponseType:       msg.ResponseType,
+			Products:           products,
+			ProductDescription: maskEmails(msg.ProductDescription),
+			CreatedAt:          msg.CreatedAt,
+		})
+	}
+
+	raw, err := json.Marshal(shared)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode shared messages: %w", err)
+	}
+	var snapshot []map[string]interface{}
+	if err := json.Unmarshal(raw, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to encode shared messages: %w", err)
+	}
+	return snapshot, nil
+}
+
+func sharedMessages(snapshot []map[string]interface{}) ([]models.SharedMessage, error) {
+	raw, err := json.Marshal(snapshot)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode shared messages: %w", err)
+	}
+	messages := []models.SharedMessage{}
+	if err := json.Unmarshal(raw, &messages); err != nil {
+		return nil, fmt.Errorf("failed to decode shared messages: %w", err)
+	}
+	return messages, nil
+}
+
+func maskEmails(text string) string {
+	return emailInTextRegex.ReplaceAllString(text, "[email hidden]")
+}
@@ -0,0 +1,339 @@
+package services
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+
+	"mylittleprice/internal/config"
+)
+
+// TaxonomyNode is a product category. Settings left at zero are inherited
+// from the parent.
+type TaxonomyNode struct {
+	ID       string            `json:"id"`                  // Stable slug, stored in sessions ("smartphones")
+	GoogleID int               `json:"google_id,omitempty"` // Google product taxonomy ID
+	Parent   string            `json:"parent,omitempty"`    // Empty for top-level categories
+	Names    map[string]string `json:"names"`               // By language code
+	Examples []string          `json:"examples"`            // Phrases embedded with the names to detect the category
+
+	Prompt             string  `json:"prompt,omitempty"`              // PromptManager key of the specialized prompt
+	MaxProducts        int     `json:"max_products,omitempty"`        // Products on the first page of a search in the category
+	RelevanceThreshold float64 `json:"relevance_threshold,omitempty"` // Similarity a message needs to be detected as the category
+}
+
+// TaxonomyFile is the format of TAXONOMY_FILE
+type TaxonomyFile struct {
+	Version string         `json:"version"`
+	Nodes   []TaxonomyNode `json:"nodes"`
+}
+
+// Taxonomy is an immutable category tree
+type Taxonomy struct {
+	version  string
+	nodes    map[string]*TaxonomyNode
+	children map[string][]string // By parent ID, "" for the top level
+}
+
+// NewTaxonomy builds a tree from nodes, checking that IDs are unique, that
+// parents exist and that there are no cycles
+func NewTaxonomy(version string, nodes []TaxonomyNode) (*Taxonomy, error) {
+	t := &Taxonomy{
+		version:  version,
+		nodes:    make(map[string]*TaxonomyNode, len(nodes)),
+		children: make(map[string][]string),
+	}
+
+	for i := range nodes {
+		node := nodes[i]
+		switch {
+		case node.ID == "":
+			return nil, fmt.Errorf("taxonomy node %d has no id", i)
+		case t.nodes[node.ID] != nil:
+			return nil, fmt.Errorf("duplicate taxonomy node %q", node.ID)
+		case len(node.Names) == 0 && len(node.Examples) == 0:
+			return nil, fmt.Errorf("taxonomy node %q has neither names nor examples", node.ID)
+		case node.MaxProducts < 0:
+			return nil, fmt.Errorf("taxonomy node %q: max_products must be non-negative", node.ID)
+		case node.RelevanceThreshold < 0 || node.RelevanceThreshold > 1:
+			return nil, fmt.Errorf("taxonomy node %q: relevance_threshold must be between 0 and 1", node.ID)
+		}
+		t.nodes[node.ID] = &node
+	}
+
+	for _, node := range t.nodes {
+		if node.Parent != "" && t.nodes[node.Parent] == nil {
+			return nil, fmt.Errorf("taxonomy node %q has unknown parent %q", node.ID, node.Parent)
+		}
+		t.children[node.Parent] = append(t.children[node.Parent], node.ID)
+	}
+	for _, node := range t.nodes {
+		depth := 0
+		for parent := node.Parent; parent != ""; parent = t.nodes[parent].Parent {
+			if depth++; parent == node.ID || depth > len(nodes) {
+				return nil, fmt.Errorf("taxonomy node %q is its own ancestor", node.ID)
+			}
+		}
+	}
+	for _, ids := range t.children {
+		sort.Strings(ids)
+	}
+
+	return t, nil
+}
+
+// LoadTaxonomy reads a TaxonomyFile, or returns the built-in taxonomy if path is empty
+func LoadTaxonomy(path string) (*Taxonomy, error) {
+	if path == "" {
+		return DefaultTaxonomy(), nil
+	}
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read taxonomy: %w", err)
+	}
+	var file TaxonomyFile
+	if err := json.Unmarshal(data, &file); err != nil {
+		return nil, fmt.Errorf("failed to parse taxonomy: %w", err)
+	}
+	if len(file.Nodes) == 0 {
+		return nil, fmt.Errorf("taxonomy %s has no nodes", path)
+	}
+	return NewTaxonomy(file.Version, file.Nodes)
+}
+
+// Version returns the version of the file the taxonomy was loaded from
+func (t *Taxonomy) Version() string {
+	return t.version
+}
+
+// Len returns the number of categories
+func (t *Taxonomy) Len() int {
+	return len(t.nodes)
+}
+
+// Node returns a category by ID
+func (t *Taxonomy) Node(id string) (TaxonomyNode, bool) {
+	node, ok := t.nodes[id]
+	if !ok {
+		return TaxonomyNode{}, false
+	}
+	return *node, true
+}
+
+// Children returns the IDs of a category's subcategories, or of the
+// top-level categories for ""
+func (t *Taxonomy) Children(id string) []string {
+	return t.children[id]
+}
+
+// Path returns the IDs from the top-level category down to id, nil if id is unknown
+func (t *Taxonomy) Path(id string) []string {
+	var path []string
+	for node, ok := t.nodes[id]; ok; node, ok = t.nodes[node.Parent] {
+		path = append([]string{node.ID}, path...)
+	}
+	return path
+}
+
+// IsRelated reports whether two categories are in the same top-level
+// category ("smartphones" and "headphones" are both electronics)
+func (t *Taxonomy) IsRelated(a, b string) bool {
+	pathA, pathB := t.Path(a), t.Path(b)
+	return len(pathA) > 0 && len(pathB) > 0 && pathA[0] == pathB[0]
+}
+
+// Name returns the category's name in language, falling back to English
+// and then to its ID
+func (t *Taxonomy) Name(id, language string) string {
+	node, ok := t.nodes[id]
+	if !ok {
+		return id
+	}
+	if name := node.Names[language]; name != "" {
+		return name
+	}
+	if name := node.Names["en"]; name != "" {
+		return name
+	}
+	return id
+}
+
+// Prompt returns the PromptManager key of the category, "" if neither it
+// nor its ancestors have one
+func (t *Taxonomy) Prompt(id string) string {
+	for node, ok := t.nodes[id]; ok; node, ok = t.nodes[node.Parent] {
+		if node.Prompt != "" {
+			return node.Prompt
+		}
+	}
+	return ""
+}
+
+// MaxProducts returns how many products the first page of a search in the
+// category shows, 0 for the default page size
+func (t *Taxonomy) MaxProducts(id string) int {
+	for node, ok := t.nodes[id]; ok; node, ok = t.nodes[node.Parent] {
+		if node.MaxProducts > 0 {
+			return node.MaxProducts
+		}
+	}
+	return 0
+}
+
+// RelevanceThreshold returns the similarity a message needs to be detected
+// as the category, or fallback if neither it nor its ancestors set one
+func (t *Taxonomy) RelevanceThreshold(id string, fallback float64) float64 {
+	for node, ok := t.nodes[id]; ok; node, ok = t.nodes[node.Parent] {
+		if node.RelevanceThreshold > 0 {
+			return node.RelevanceThreshold
+		}
+	}
+	return fallback
+}
+
+// embeddingTexts are embedded to detect the category: its names in every
+// language, then each example phrase. The category's embedding is the mean
+// of theirs.
+func (t *Taxonomy) embeddingTexts(id string) []string {
+	node := t.nodes[id]
+
+	languages := make([]string, 0, len(node.Names))
+	for language := range node.Names {
+		languages = append(languages, language)
+	}
+	sort.Strings(languages)
+
+	names := make([]string, len(languages))
+	for i, language := range languages {
+		names[i] = node.Names[language]
+	}
+
+	texts := make([]string, 0, len(node.Examples)+1)
+	if len(names) > 0 {
+		texts = append(texts, strings.Join(names, " "))
+	}
+	return append(texts, node.Examples...)
+}
+
+// TaxonomyService holds the current taxonomy, which can be reloaded from
+// TAXONOMY_FILE without a restart
+type TaxonomyService struct {
+	path string
+
+	mu      sync.RWMutex
+	current *Taxonomy
+}
+
+// NewTaxonomyService loads TAXONOMY_FILE, or the built-in taxonomy if unset
+func NewTaxonomyService(cfg *config.Config) (*TaxonomyService, error) {
+	taxonomy, err := LoadTaxonomy(cfg.TaxonomyFile)
+	if err != nil {
+		return nil, err
+	}
+	return &TaxonomyService{path: cfg.TaxonomyFile, current: taxonomy}, nil
+}
+
+// Current returns the current taxonomy
+func (s *TaxonomyService) Current() *Taxonomy {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.current
+}
+
+// Reload reads the taxonomy file again. The current taxonomy is kept if
+// the file is invalid.
+func (s *TaxonomyService) Reload() (*Taxonomy, error) {
+	taxonomy, err := LoadTaxonomy(s.path)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.current = taxonomy
+	s.mu.Unlock()
+	return taxonomy, nil
+}
+
+// DefaultTaxonomy returns the built-in taxonomy, used when TAXONOMY_FILE is unset
+func DefaultTaxonomy() *Taxonomy {
+	taxonomy, err := NewTaxonomy(defaultTaxonomy.Version, defaultTaxonomy.Nodes)
+	if err != nil {
+		panic(fmt.Sprintf("invalid built-in taxonomy: %v", err))
+	}
+	return taxonomy
+}
+
+var defaultTaxonomy = TaxonomyFile{
+	Version: "builtin-1",
+	Nodes: []TaxonomyNode{
+		{
+			ID: "electronics", GoogleID: 222, Prompt: "electronics",
+			Names:    map[string]string{"en": "Electronics", "ru": "Электроника", "uk": "Електроніка"},
+			Examples: []string{"laptop computer phone tablet tv monitor camera headphones speaker gadget electronics device"},
+		},
+		{
+			ID: "smartphones", GoogleID: 267, Parent: "electronics", MaxProducts: 6,
+			Names:    map[string]string{"en": "Smartphones", "ru": "Смартфоны", "uk": "Смартфони"},
+			Examples: []string{"smartphone mobile phone cell phone iphone android galaxy pixel"},
+		},
+		{
+			ID: "laptops", GoogleID: 328, Parent: "electronics", MaxProducts: 6,
+			Names:    map[string]string{"en": "Laptops", "ru": "Ноутбуки", "uk": "Ноутбуки"},
+			Examples: []string{"laptop notebook macbook ultrabook gaming laptop chromebook"},
+		},
+		{
+			ID: "tablets", GoogleID: 4745, Parent: "electronics", MaxProducts: 6,
+			Names:    map[string]string{"en": "Tablets", "ru": "Планшеты", "uk": "Планшети"},
+			Examples: []string{"tablet ipad android tablet e-reader kindle"},
+		},
+		{
+			ID: "headphones", Parent: "electronics",
+			Names:    map[string]string{"en": "Headphones", "ru": "Наушники", "uk": "Навушники"},
+			Examples: []string{"headphones earbuds wireless earphones headset airpods noise cancelling"},
+		},
+		{
+			ID: "smartwatches", Parent: "electronics",
+			Names:    map[string]string{"en": "Smartwatches", "ru": "Смарт-часы", "uk": "Смарт-годинники"},
+			Examples: []string{"smartwatch apple watch fitness tracker smart band wearable"},
+		},
+		{
+			ID: "clothing", GoogleID: 166, Prompt: "parametric",
+			Names:    map[string]string{"en": "Clothing", "ru": "Одежда", "uk": "Одяг"},
+			Examples: []string{"shirt pants dress shoes jacket coat sweater jeans clothing fashion apparel wear"},
+		},
+		{
+			ID: "furniture", GoogleID: 436, Prompt: "parametric",
+			Names:    map[string]string{"en": "Furniture", "ru": "Мебель", "uk": "Меблі"},
+			Examples: []string{"chair table bed sofa desk cabinet shelf bookcase furniture home decor"},
+		},
+		{
+			ID: "kitchen", GoogleID: 638, Prompt: "parametric",
+			Names:    map[string]string{"en": "Kitchen", "ru": "Кухня", "uk": "Кухня"},
+			Examples: []string{"pan pot knife plate cup dish spoon fork cookware kitchen utensil appliance"},
+		},
+		{
+			ID: "sports", GoogleID: 988, Prompt: "parametric",
+			Names:    map[string]string{"en": "Sports", "ru": "Спорт", "uk": "Спорт"},
+			Examples: []string{"bicycle ball racket fitness gym equipment sports workout training exercise"},
+		},
+		{
+			ID: "tools", GoogleID: 1167, Prompt: "parametric",
+			Names:    map[string]string{"en": "Tools", "ru": "Инструменты", "uk": "Інструменти"},
+			Examples: []string{"drill hammer screwdriver wrench saw power tool hand tool equipment"},
+		},
+		{
+			ID: "decor", GoogleID: 696, Prompt: "parametric",
+			Names:    map[string]string{"en": "Decor", "ru": "Декор", "uk": "Декор"},
+			Examples: []string{"lamp vase picture frame mirror decoration ornament home decor"},
+		},
+		{
+			ID: "textiles", GoogleID: 4171, Prompt: "parametric",
+			Names:    map[string]string{"en": "Textiles", "ru": "Текстиль", "uk": "Текстиль"},
+			Examples: []string{"pillow blanket towel sheet curtain textile fabric bedding linen"},
+		},
+	},
+}
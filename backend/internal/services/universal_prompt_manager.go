@@ -8,6 +8,7 @@ import (
 	"sync"
 	"time"
 
+	"mylittleprice/internal/domain"
 	"mylittleprice/internal/models"
 	"mylittleprice/internal/utils"
 )
@@ -21,6 +22,7 @@ const (
 type UniversalPromptManager struct {
 	universalPrompt string
 	miniKernel      string
+	toolsKernel     string
 	promptHasher    *utils.PromptHasher
 	promptHash      string
 	mu              sync.RWMutex
@@ -59,6 +61,14 @@ func (upm *UniversalPromptManager) loadPrompts() {
 	}
 	upm.miniKernel = string(kernelContent)
 
+	// Load tools kernel (appended when native function calling is enabled)
+	toolsPath := basePath + "internal/services/prompts/tools_kernel.txt"
+	toolsContent, err := os.ReadFile(toolsPath)
+	if err != nil {
+		panic(fmt.Errorf("CRITICAL: Failed to load tools kernel from %s: %w", toolsPath, err))
+	}
+	upm.toolsKernel = string(toolsContent)
+
 	// Generate hash for drift detection
 	upm.promptHash = upm.promptHasher.HashPrompt(upm.universalPrompt)
 
@@ -90,6 +100,20 @@ func (upm *UniversalPromptManager) GetSystemPrompt(
 	return prompt
 }
 
+// GetPromptCacheKey identifies the rendered system prompt: prompt version,
+// locale and the current date (the prompt embeds it)
+func (upm *UniversalPromptManager) GetPromptCacheKey(
+	feLocation, feLanguage, feCurrency string,
+) string {
+	return fmt.Sprintf("%s:%s:%s:%s:%s",
+		upm.GetPromptHash(),
+		feLocation,
+		feLanguage,
+		feCurrency,
+		time.Now().Format("2006-01-02"),
+	)
+}
+
 // GetMiniKernel returns the mini-kernel for EVERY turn
 // This ensures the rules are always in context
 func (upm *UniversalPromptManager) GetMiniKernel(
@@ -119,8 +143,22 @@ func (upm *UniversalPromptManager) GetMiniKernel(
 	return kernel
 }
 
+// GetToolsKernel returns the function calling rules that replace the
+// search response format of the mini-kernel
+func (upm *UniversalPromptManager) GetToolsKernel(feLocation, feLanguage, feCurrency string) string {
+	upm.mu.RLock()
+	defer upm.mu.RUnlock()
+
+	kernel := upm.toolsKernel
+	kernel = strings.ReplaceAll(kernel, "{fe_location}", feLocation)
+	kernel = strings.ReplaceAll(kernel, "{fe_language}", feLanguage)
+	kernel = strings.ReplaceAll(kernel, "{fe_currency}", feCurrency)
+
+	return kernel
+}
+
 // BuildStateContext builds the state context object sent with each turn
-// This includes cycle_history, last_cycle_context, and last_defined
+// This includes last_cycle_context and last_defined; cycle_history goes as turns
 func (upm *UniversalPromptManager) BuildStateContext(
 	session *models.ChatSession,
 ) string {
@@ -134,30 +172,9 @@ func (upm *UniversalPromptManager) BuildStateContext(
 	sb.WriteString(fmt.Sprintf("CURRENT_CATEGORY: %s\n", getCategory(cycleState)))
 	sb.WriteString("\n")
 
-	// Cycle history (limited to last 6 messages to match MaxIterations)
+	// Cycle history messages are sent as conversation turns (see BuildHistoryTurns)
 	sb.WriteString("=== CYCLE_HISTORY (Current Cycle) ===\n")
-	if len(cycleState.CycleHistory) == 0 {
-		sb.WriteString("(empty - first message in cycle)\n")
-	} else {
-		// Show only last 6 messages to match max iterations per cycle
-		// This ensures full visibility of current cycle while managing token usage
-		maxRecentMessages := MaxIterations
-		startIdx := len(cycleState.CycleHistory) - maxRecentMessages
-		if startIdx < 0 {
-			startIdx = 0
-		}
-
-		// If we're skipping messages, add a summary note
-		if startIdx > 0 {
-			sb.WriteString(fmt.Sprintf("(showing last %d of %d messages)\n",
-				len(cycleState.CycleHistory)-startIdx, len(cycleState.CycleHistory)))
-		}
-
-		for i := startIdx; i < len(cycleState.CycleHistory); i++ {
-			msg := cycleState.CycleHistory[i]
-			sb.WriteString(fmt.Sprintf("%d. %s: %s\n", i+1, msg.Role, msg.Content))
-		}
-	}
+	sb.WriteString(describeHistoryTurns(len(cycleState.CycleHistory), MaxIterations))
 	sb.WriteString("\n")
 
 	// Last cycle context
@@ -172,7 +189,7 @@ func (upm *UniversalPromptManager) BuildStateContext(
 		if len(cycleState.LastCycleContext.Products) > 0 {
 			sb.WriteString("Products from last cycle:\n")
 			for _, p := range cycleState.LastCycleContext.Products {
-				sb.WriteString(fmt.Sprintf("  - %s (%.2f)\n", p.Name, p.Price))
+				sb.WriteString(fmt.Sprintf("  - %s (%s)\n", p.Name, p.Price))
 			}
 		}
 		if cycleState.LastCycleContext.LastRequest != "" {
@@ -313,6 +330,54 @@ func extractSubgroups(history []models.CycleMessage) []string {
 	return result
 }
 
+// BuildHistoryTurns maps the last maxMessages messages of the current cycle
+// to conversation turns. currentMessage is skipped if it is already the last
+// history entry (it is sent as the prompt instead). Assistant turns are
+// replayed in the dialogue JSON format so the model keeps answering in JSON.
+func (upm *UniversalPromptManager) BuildHistoryTurns(
+	session *models.ChatSession,
+	maxMessages int,
+	currentMessage string,
+) []LLMMessage {
+	history := session.CycleState.CycleHistory
+	if n := len(history); n > 0 && history[n-1].Role == "user" && history[n-1].Content == currentMessage {
+		history = history[:n-1]
+	}
+
+	startIdx := len(history) - maxMessages
+	if startIdx < 0 {
+		startIdx = 0
+	}
+
+	turns := make([]LLMMessage, 0, len(history)-startIdx)
+	for _, msg := range history[startIdx:] {
+		if msg.Role == "assistant" {
+			reply, _ := json.Marshal(map[string]string{
+				"response_type": "dialogue",
+				"output":        msg.Content,
+			})
+			turns = append(turns, LLMMessage{Role: LLMRoleAssistant, Text: string(reply)})
+			continue
+		}
+		turns = append(turns, LLMMessage{Role: LLMRoleUser, Text: msg.Content})
+	}
+
+	return turns
+}
+
+// describeHistoryTurns tells the model how much of the cycle history precedes
+// the current message as turns (total includes the current message)
+func describeHistoryTurns(total, maxMessages int) string {
+	previous := total - 1
+	if previous <= 0 {
+		return "(empty - first message in cycle)\n"
+	}
+	if previous > maxMessages {
+		return fmt.Sprintf("(last %d of %d messages are the conversation turns above)\n", maxMessages, previous)
+	}
+	return "(the conversation turns above)\n"
+}
+
 // ==================== Smart Context Management Methods ====================
 
 // BuildMinimalContext builds minimal context for simple queries (e.g., "cheaper?")
@@ -325,31 +390,11 @@ func (upm *UniversalPromptManager) BuildMinimalContext(
 	sb.WriteString("=== MINIMAL CONTEXT ===\n")
 	sb.WriteString(fmt.Sprintf("CYCLE: %d, ITERATION: %d\n", session.CycleState.CycleID, session.CycleState.Iteration))
 
-	// Last 1-2 messages only
-	history := session.CycleState.CycleHistory
-	if len(history) > 0 {
-		sb.WriteString("\nRecent exchange:\n")
-		startIdx := len(history) - 2
-		if startIdx < 0 {
-			startIdx = 0
-		}
-		for i := startIdx; i < len(history); i++ {
-			msg := history[i]
-			// Truncate long messages
-			content := msg.Content
-			if len(content) > 150 {
-				content = content[:147] + "..."
-			}
-			sb.WriteString(fmt.Sprintf("%s: %s\n", msg.Role, content))
-		}
-	}
-
 	// Last product shown
 	if session.SearchState.LastProduct != nil {
-		sb.WriteString(fmt.Sprintf("\nLast product: %s (%.2f %s)\n",
+		sb.WriteString(fmt.Sprintf("\nLast product: %s (%s)\n",
 			session.SearchState.LastProduct.Name,
-			session.SearchState.LastProduct.Price,
-			session.Currency))
+			lastProductPrice(session)))
 	}
 
 	// Conversation context if available
@@ -363,7 +408,7 @@ func (upm *UniversalPromptManager) BuildMinimalContext(
 }
 
 // BuildCompactStateContext builds compact context with configurable depth
-// maxRecentMessages: how many recent messages to include (2-6)
+// maxRecentMessages: how many recent messages are sent as turns (2-6)
 func (upm *UniversalPromptManager) BuildCompactStateContext(
 	session *models.ChatSession,
 	maxRecentMessages int,
@@ -401,38 +446,15 @@ func (upm *UniversalPromptManager) BuildCompactStateContext(
 		}
 	}
 
-	// Recent messages (limited)
+	// Recent messages go as conversation turns (see BuildHistoryTurns)
 	sb.WriteString("\n=== RECENT MESSAGES ===\n")
-	history := session.CycleState.CycleHistory
-	if len(history) == 0 {
-		sb.WriteString("(no messages yet)\n")
-	} else {
-		startIdx := len(history) - maxRecentMessages
-		if startIdx < 0 {
-			startIdx = 0
-		}
-
-		if startIdx > 0 {
-			sb.WriteString(fmt.Sprintf("(showing last %d of %d)\n", len(history)-startIdx, len(history)))
-		}
-
-		for i := startIdx; i < len(history); i++ {
-			msg := history[i]
-			// Truncate very long messages
-			content := msg.Content
-			if len(content) > 300 {
-				content = content[:297] + "..."
-			}
-			sb.WriteString(fmt.Sprintf("%s: %s\n", msg.Role, content))
-		}
-	}
+	sb.WriteString(describeHistoryTurns(len(session.CycleState.CycleHistory), maxRecentMessages))
 
 	// Last product if available
 	if session.SearchState.LastProduct != nil {
-		sb.WriteString(fmt.Sprintf("\nLast product shown: %s (%.2f %s)\n",
+		sb.WriteString(fmt.Sprintf("\nLast product shown: %s (%s)\n",
 			session.SearchState.LastProduct.Name,
-			session.SearchState.LastProduct.Price,
-			session.Currency))
+			lastProductPrice(session)))
 	}
 
 	return sb.String()
@@ -480,3 +502,13 @@ func getPromptBasePath() string {
 	// Default to current directory
 	return ""
 }
+
+// lastProductPrice formats the price of the session's last product. Prices
+// stored before they carried a currency are in the session currency.
+func lastProductPrice(session *models.ChatSession) string {
+	price := session.SearchState.LastProduct.Price
+	if price.Currency == "" {
+		price.Currency = domain.Currency(session.Currency)
+	}
+	return price.String()
+}
@@ -0,0 +1,317 @@
+package services
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+
+	"mylittleprice/ent"
+	"mylittleprice/ent/predicate"
+	"mylittleprice/ent/tokenusage"
+	"mylittleprice/internal/models"
+)
+
+// Usage operations recorded per call
+const (
+	UsageOperationGenerate = "generate"
+	UsageOperationEmbed    = "embed"
+)
+
+// Usage aggregation groupings
+const (
+	UsageGroupByDay   = "day"
+	UsageGroupByModel = "model"
+	UsageGroupByUser  = "user"
+)
+
+const (
+	usageQueueSize     = 1000
+	usageBatchSize     = 100
+	usageFlushInterval = 2 * time.Second
+)
+
+// UsageRecord is one LLM generation or embedding call
+type UsageRecord struct {
+	UserID       *uuid.UUID
+	SessionID    string
+	Provider     string
+	Model        string
+	Operation    string
+	InputTokens  int // Including CachedTokens
+	CachedTokens int
+	OutputTokens int
+	Grounded     bool
+	Latency      time.Duration
+	CreatedAt    time.Time
+}
+
+// UsageService persists token usage and cost per call and aggregates it by
+// session, user, day and model. Records are written in batches by a
+// background worker so calls are never slowed down by accounting.
+type UsageService struct {
+	client  *ent.Client
+	prices  *PriceTable
+	records chan UsageRecord
+	done    chan struct{}
+	once    sync.Once
+}
+
+// NewUsageService creates the service and starts its writer
+func NewUsageService(client *ent.Client, prices *PriceTable) *UsageService {
+	s := &UsageService{
+		client:  client,
+		prices:  prices,
+		records: make(chan UsageRecord, usageQueueSize),
+		done:    make(chan struct{}),
+	}
+	go s.writeLoop()
+	return s
+}
+
+// Record queues a call for persistence. It never blocks: when the queue is
+// full the record is dropped.
+func (s *UsageService) Record(record UsageRecord) {
+	if record.CreatedAt.IsZero() {
+		record.CreatedAt = time.Now()
+	}
+	select {
+	case s.records <- record:
+	default:
+		fmt.Printf("⚠️ Usage queue full, dropping %s record for %s\n", record.Operation, record.Model)
+	}
+}
+
+// Close flushes queued records and stops the writer
+func (s *UsageService) Close() {
+	s.once.Do(func() {
+		close(s.records)
+		<-s.done
+	})
+}
+
+func (s *UsageService) writeLoop() {
+	defer close(s.done)
+
+	ticker := time.NewTicker(usageFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]UsageRecord, 0, usageBatchSize)
+	for {
+		select {
+		case record, ok := <-s.records:
+			if !ok {
+				s.flush(batch)
+				return
+			}
+			batch = append(batch, record)
+			if len(batch) >= usageBatchSize {
+				s.flush(batch)
+				batch = batch[:0]
+			}
+		case <-ticker.C:
+			s.flush(batch)
+			batch = batch[:0]
+		}
+	}
+}
+
+func (s *UsageService) flush(batch []UsageRecord) {
+	if len(batch) == 0 {
+		return
+	}
+
+	builders := make([]*ent.TokenUsageCreate, 0, len(batch))
+	for _, record := range batch {
+		builder := s.client.TokenUsage.Create().
+			SetProvider(record.Provider).
+			SetModel(record.Model).
+			SetOperation(record.Operation).
+			SetInputTokens(record.InputTokens).
+			SetCachedTokens(record.CachedTokens).
+			SetOutputTokens(record.OutputTokens).
+			SetGrounded(record.Grounded).
+			SetLatencyMs(int(record.Latency.Milliseconds())).
+			SetCostUsd(s.prices.Cost(record.Model, record.InputTokens, record.CachedTokens, record.OutputTokens, record.Grounded)).
+			SetDay(record.CreatedAt.UTC().Format("2006-01-02")).
+			SetCreatedAt(record.CreatedAt)
+		if record.UserID != nil {
+			builder.SetUserID(*record.UserID)
+		}
+		if record.SessionID != "" {
+			builder.SetSessionID(record.SessionID)
+		}
+		builders = append(builders, builder)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	if _, err := s.client.TokenUsage.CreateBulk(builders...).Save(ctx); err != nil {
+		fmt.Printf("⚠️ Failed to save %d usage records: %v\n", len(batch), err)
+	}
+}
+
+// usageRow is a scanned aggregation row; only the grouped key column is set
+type usageRow struct {
+	Day           string     `sql:"day"`
+	Model         string     `sql:"model"`
+	UserID        *uuid.UUID `sql:"user_id"`
+	Calls         int        `sql:"calls"`
+	InputTokens   int64      `sql:"input_tokens"`
+	CachedTokens  int64      `sql:"cached_tokens"`
+	OutputTokens  int64      `sql:"output_tokens"`
+	GroundedCalls int        `sql:"grounded_calls"`
+	CostUSD       float64    `sql:"cost_usd"`
+	FirstCall     *time.Time `sql:"first_call"`
+	LastCall      *time.Time `sql:"last_call"`
+}
+
+func (r usageRow) totals() models.UsageTotals {
+	return models.UsageTotals{
+		Calls:         r.Calls,
+		InputTokens:   r.InputTokens,
+		CachedTokens:  r.CachedTokens,
+		OutputTokens:  r.OutputTokens,
+		GroundedCalls: r.GroundedCalls,
+		CostUSD:       r.CostUSD,
+	}
+}
+
+// usageAggregates are the sums computed for every group
+func usageAggregates() []ent.AggregateFunc {
+	return []ent.AggregateFunc{
+		ent.As(ent.Count(), "calls"),
+		ent.As(ent.Sum(tokenusage.FieldInputTokens), "input_tokens"),
+		ent.As(ent.Sum(tokenusage.FieldCachedTokens), "cached_tokens"),
+		ent.As(ent.Sum(tokenusage.FieldOutputTokens), "output_tokens"),
+		func(s *entsql.Selector) string {
+			return entsql.As(fmt.Sprintf("COUNT(*) FILTER (WHERE %s)", s.C(tokenusage.FieldGrounded)), "grounded_calls")
+		},
+		ent.As(ent.Sum(tokenusage.FieldCostUsd), "cost_usd"),
+		ent.As(ent.Min(tokenusage.FieldCreatedAt), "first_call"),
+		ent.As(ent.Max(tokenusage.FieldCreatedAt), "last_call"),
+	}
+}
+
+func usagePredicates(filter models.UsageFilter) []predicate.TokenUsage {
+	var predicates []predicate.TokenUsage
+	if filter.UserID != nil {
+		predicates = append(predicates, tokenusage.UserIDEQ(*filter.UserID))
+	}
+	if filter.SessionID != "" {
+		predicates = append(predicates, tokenusage.SessionIDEQ(filter.SessionID))
+	}
+	if filter.Model != "" {
+		predicates = append(predicates, tokenusage.ModelEQ(filter.Model))
+	}
+	if filter.From != "" {
+		predicates = append(predicates, tokenusage.DayGTE(filter.From))
+	}
+	if filter.To != "" {
+		predicates = append(predicates, tokenusage.DayLTE(filter.To))
+	}
+	return predicates
+}
+
+// GetTotals sums the usage matching filter
+func (s *UsageService) GetTotals(ctx context.Context, filter models.UsageFilter) (*models.UsageTotals, error) {
+	var rows []usageRow
+	err := s.client.TokenUsage.Query().
+		Where(usagePredicates(filter)...).
+		Aggregate(usageAggregates()...).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate usage: %w", err)
+	}
+
+	totals := models.UsageTotals{}
+	if len(rows) > 0 {
+		totals = rows[0].totals()
+	}
+	return &totals, nil
+}
+
+// Aggregate sums the usage matching filter per day, model or user. Days are
+// returned in chronological order, models and users by descending cost.
+func (s *UsageService) Aggregate(ctx context.Context, filter models.UsageFilter, groupBy string) ([]models.UsageBucket, error) {
+	var field string
+	switch groupBy {
+	case UsageGroupByDay:
+		field = tokenusage.FieldDay
+	case UsageGroupByModel:
+		field = tokenusage.FieldModel
+	case UsageGroupByUser:
+		field = tokenusage.FieldUserID
+	default:
+		return nil, fmt.Errorf("unsupported usage grouping: %s", groupBy)
+	}
+
+	var rows []usageRow
+	err := s.client.TokenUsage.Query().
+		Where(usagePredicates(filter)...).
+		GroupBy(field).
+		Aggregate(usageAggregates()...).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate usage by %s: %w", groupBy, err)
+	}
+
+	buckets := make([]models.UsageBucket, 0, len(rows))
+	for _, row := range rows {
+		bucket := models.UsageBucket{UsageTotals: row.totals()}
+		switch groupBy {
+		case UsageGroupByDay:
+			bucket.Key = row.Day
+		case UsageGroupByModel:
+			bucket.Key = row.Model
+		case UsageGroupByUser:
+			bucket.Key = "anonymous"
+			if row.UserID != nil {
+				bucket.Key = row.UserID.String()
+			}
+		}
+		buckets = append(buckets, bucket)
+	}
+
+	if groupBy == UsageGroupByDay {
+		sort.Slice(buckets, func(i, j int) bool { return buckets[i].Key < buckets[j].Key })
+	} else {
+		sort.Slice(buckets, func(i, j int) bool { return buckets[i].CostUSD > buckets[j].CostUSD })
+	}
+	return buckets, nil
+}
+
+// GetSessionCost returns the accumulated usage and cost of a chat session
+func (s *UsageService) GetSessionCost(ctx context.Context, sessionID string) (*models.SessionCost, error) {
+	filter := models.UsageFilter{SessionID: sessionID}
+
+	var rows []usageRow
+	err := s.client.TokenUsage.Query().
+		Where(usagePredicates(filter)...).
+		Aggregate(usageAggregates()...).
+		Scan(ctx, &rows)
+	if err != nil {
+		return nil, fmt.Errorf("failed to aggregate session usage: %w", err)
+	}
+
+	byModel, err := s.Aggregate(ctx, filter, UsageGroupByModel)
+	if err != nil {
+		return nil, err
+	}
+
+	cost := &models.SessionCost{
+		SessionID: sessionID,
+		ByModel:   byModel,
+	}
+	if len(rows) > 0 {
+		cost.Totals = rows[0].totals()
+		cost.FirstCall = rows[0].FirstCall
+		cost.LastCall = rows[0].LastCall
+	}
+	return cost, nil
+}
@@ -0,0 +1,426 @@
+package services
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/redis/go-redis/v9"
+
+	"mylittleprice/ent"
+	"mylittleprice/ent/watchlistitem"
+	"mylittleprice/internal/config"
+	"mylittleprice/internal/domain"
+	"mylittleprice/internal/models"
+)
+
+var (
+	// ErrWatchlistItemNotFound is returned for unknown items and items of another user
+	ErrWatchlistItemNotFound = errors.New("watchlist item not found")
+	// ErrWatchlistFull is returned when a user has WATCHLIST_MAX_ITEMS items
+	ErrWatchlistFull = errors.New("watchlist is full")
+	// ErrInvalidWatchlistItem is returned for items without a product or target price
+	ErrInvalidWatchlistItem = errors.New("invalid watchlist item")
+)
+
+// PriceAlertMessageType is the WebSocket frame type of price alerts
+const PriceAlertMessageType = "price_alert"
+
+const (
+	watchlistLockKey = "watchlist:recheck:lock"
+	watchlistLockTTL = 30 * time.Minute
+)
+
+// releaseWatchlistLock deletes the re-check lock only if it's still the one
+// this replica took: after watchlistLockTTL another replica may hold it
+var releaseWatchlistLock = redis.NewScript(`
+if redis.call("GET", KEYS[1]) == ARGV[1] then
+	return redis.call("DEL", KEYS[1])
+end
+return 0
+`)
+
+// priceAlertFrame is a price alert as the WebSocket handler forwards it
+type priceAlertFrame struct {
+	Type       string             `json:"type"`
+	PriceAlert *models.PriceAlert `json:"price_alert"`
+}
+
+// WatchlistService stores the products users watch for a price drop, and
+// re-checks their prices. Re-checks fetch the product details through the
+// product search (SerpService.GetProductDetailsByToken for SerpAPI page
+// tokens), so they count against a daily budget shared by all replicas.
+type WatchlistService struct {
+	client   *ent.Client
+	redis    *redis.Client
+	search   ProductSearchProvider
+	fx       *FXService // nil if disabled: targets are only compared to prices in their own currency
+	pubsub   *PubSubService
+	email    *EmailService
+	maxItems int
+	interval time.Duration // How long an item's last check stays fresh
+	budget   int64         // Details requests per UTC day
+}
+
+// NewWatchlistService creates a WatchlistService. fx may be nil.
+func NewWatchlistService(client *ent.Client, redisClient *redis.Client, search ProductSearchProvider, fx *FXService, email *EmailService, cfg *config.Config) *WatchlistService {
+	return &WatchlistService{
+		client:   client,
+		redis:    redisClient,
+		search:   search,
+		fx:       fx,
+		pubsub:   NewPubSubService(redisClient),
+		email:    email,
+		maxItems: cfg.WatchlistMaxItems,
+		interval: cfg.WatchlistRecheckInterval,
+		budget:   int64(cfg.WatchlistDailyBudget),
+	}
+}
+
+// List returns the user's watchlist, newest first
+func (s *WatchlistService) List(ctx context.Context, userID uuid.UUID) ([]models.WatchlistItem, error) {
+	items, err := s.client.WatchlistItem.Query().
+		Where(watchlistitem.UserIDEQ(userID)).
+		Order(ent.Desc(watchlistitem.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query watchlist: %w", err)
+	}
+
+	result := make([]models.WatchlistItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, toWatchlistItem(item))
+	}
+	return result, nil
+}
+
+// Add adds a product to the user's watchlist
+func (s *WatchlistService) Add(ctx context.Context, userID uuid.UUID, req models.CreateWatchlistItemRequest) (*models.WatchlistItem, error) {
+	target, err := watchlistTarget(req.TargetPrice, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+	if req.PageToken == "" || strings.TrimSpace(req.Title) == "" {
+		return nil, fmt.Errorf("%w: page_token and title are required", ErrInvalidWatchlistItem)
+	}
+
+	count, err := s.client.WatchlistItem.Query().
+		Where(watchlistitem.UserIDEQ(userID)).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count watchlist: %w", err)
+	}
+	if count >= s.maxItems {
+		return nil, ErrWatchlistFull
+	}
+
+	item, err := s.client.WatchlistItem.Create().
+		SetUserID(userID).
+		SetProductKey(req.ProductKey).
+		SetPageToken(req.PageToken).
+		SetTitle(strings.TrimSpace(req.Title)).
+		SetLink(req.Link).
+		SetImage(req.Image).
+		SetTargetAmount(target.Amount).
+		SetCurrency(string(target.Currency)).
+		SetCountry(strings.ToUpper(req.Country)).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create watchlist item: %w", err)
+	}
+
+	result := toWatchlistItem(item)
+	return &result, nil
+}
+
+// UpdateTarget changes an item's target price. The item is notified about
+// again once its price is at or below the new target.
+func (s *WatchlistService) UpdateTarget(ctx context.Context, userID, itemID uuid.UUID, req models.UpdateWatchlistItemRequest) (*models.WatchlistItem, error) {
+	target, err := watchlistTarget(req.TargetPrice, req.Currency)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.userItem(ctx, userID, itemID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The last price was compared to the old target: forget it, so a price
+	// already below the new target alerts on the next check
+	item, err = item.Update().
+		SetTargetAmount(target.Amount).
+		SetCurrency(string(target.Currency)).
+		ClearNotifiedAt().
+		ClearLastAmount().
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update watchlist item: %w", err)
+	}
+
+	result := toWatchlistItem(item)
+	return &result, nil
+}
+
+// Remove deletes an item from the user's watchlist
+func (s *WatchlistService) Remove(ctx context.Context, userID, itemID uuid.UUID) error {
+	deleted, err := s.client.WatchlistItem.Delete().
+		Where(
+			watchlistitem.IDEQ(itemID),
+			watchlistitem.UserIDEQ(userID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete watchlist item: %w", err)
+	}
+	if deleted == 0 {
+		return ErrWatchlistItemNotFound
+	}
+	return nil
+}
+
+func (s *WatchlistService) userItem(ctx context.Context, userID, itemID uuid.UUID) (*ent.WatchlistItem, error) {
+	item, err := s.client.WatchlistItem.Query().
+		Where(
+			watchlistitem.IDEQ(itemID),
+			watchlistitem.UserIDEQ(userID),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrWatchlistItemNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get watchlist item: %w", err)
+	}
+	return item, nil
+}
+
+// RecheckDue re-checks the prices of the items not checked for an
+// interval, least recently checked first, until the day's budget is spent.
+// Only one replica re-checks at a time. It returns the number of items
+// checked and of alerts sent.
+func (s *WatchlistService) RecheckDue(ctx context.Context) (checked, alerted int, err error) {
+	token := uuid.NewString()
+	locked, err := s.redis.SetNX(ctx, watchlistLockKey, token, watchlistLockTTL).Result()
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to take watchlist lock: %w", err)
+	}
+	if !locked {
+		return 0, 0, nil // Another replica is re-checking
+	}
+	defer func() {
+		if err := releaseWatchlistLock.Run(context.Background(), s.redis, []string{watchlistLockKey}, token).Err(); err != nil {
+			fmt.Printf("⚠️ Failed to release watchlist lock: %v\n", err)
+		}
+	}()
+
+	remaining, err := s.remainingBudget(ctx)
+	if err != nil {
+		return 0, 0, err
+	}
+	if remaining <= 0 {
+		return 0, 0, nil
+	}
+
+	items, err := s.client.WatchlistItem.Query().
+		Where(watchlistitem.Or(
+			watchlistitem.LastCheckedAtIsNil(),
+			watchlistitem.LastCheckedAtLT(time.Now().Add(-s.interval)),
+		)).
+		Order(watchlistitem.ByLastCheckedAt(sql.OrderNullsFirst())).
+		Limit(int(remaining)).
+		WithUser().
+		All(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to query due watchlist items: %w", err)
+	}
+
+	for _, item := range items {
+		if ctx.Err() != nil {
+			break
+		}
+		if !s.spendBudget(ctx) {
+			break
+		}
+		sent, err := s.recheck(ctx, item)
+		if err != nil {
+			fmt.Printf("⚠️ Failed to re-check watchlist item %s: %v\n", item.ID, err)
+		}
+		checked++
+		if sent {
+			alerted++
+		}
+	}
+	return checked, alerted, nil
+}
+
+func budgetKey(now time.Time) string {
+	return "watchlist:budget:" + now.UTC().Format("2006-01-02")
+}
+
+func (s *WatchlistService) remainingBudget(ctx context.Context) (int64, error) {
+	used, err := s.redis.Get(ctx, budgetKey(time.Now())).Int64()
+	if err != nil && !errors.Is(err, redis.Nil) {
+		return 0, fmt.Errorf("failed to read watchlist budget: %w", err)
+	}
+	return s.budget - used, nil
+}
+
+// spendBudget takes one details request from the day's budget, reporting
+// whether there was one left
+func (s *WatchlistService) spendBudget(ctx context.Context) bool {
+	key := budgetKey(time.Now())
+	pipe := s.redis.Pipeline()
+	used := pipe.Incr(ctx, key)
+	pipe.Expire(ctx, key, 48*time.Hour)
+	if _, err := pipe.Exec(ctx); err != nil {
+		fmt.Printf("⚠️ Failed to update watchlist budget: %v\n", err)
+		return false
+	}
+	return used.Val() <= s.budget
+}
+
+// recheck fetches the item's lowest price and sends an alert if it crossed
+// the target since the last check. The item is marked checked even if its
+// details couldn't be fetched, so that a dead page token doesn't take the
+// budget of the others.
+func (s *WatchlistService) recheck(ctx context.Context, item *ent.WatchlistItem) (bool, error) {
+	now := time.Now()
+	update := item.Update().SetLastCheckedAt(now)
+
+	target := domain.Money{Amount: item.TargetAmount, Currency: domain.Currency(item.Currency)}
+	data, err := s.search.GetDetails(ctx, item.PageToken, item.Country)
+	if err != nil {
+		if _, saveErr := update.Save(ctx); saveErr != nil {
+			return false, saveErr
+		}
+		return false, fmt.Errorf("failed to fetch product details: %w", err)
+	}
+
+	price, merchant, ok := s.lowestPrice(data, target.Currency)
+	if !ok {
+		_, err := update.Save(ctx)
+		return false, err
+	}
+
+	crossed := price.Amount <= target.Amount && (item.LastAmount == nil || *item.LastAmount > target.Amount)
+	update.SetLastAmount(price.Amount)
+	if crossed {
+		update.SetNotifiedAt(now)
+	}
+	if _, err := update.Save(ctx); err != nil {
+		return false, fmt.Errorf("failed to save watchlist check: %w", err)
+	}
+	if !crossed {
+		return false, nil
+	}
+
+	s.notify(item, models.PriceAlert{
+		ItemID:      item.ID,
+		ProductKey:  item.ProductKey,
+		PageToken:   item.PageToken,
+		Title:       item.Title,
+		Link:        item.Link,
+		Image:       item.Image,
+		Price:       price,
+		TargetPrice: target,
+		Merchant:    merchant,
+	})
+	return true, nil
+}
+
+// lowestPrice returns the lowest landed price of the stores that have the
+// product, in currency, or the product's own price if no store has one
+func (s *WatchlistService) lowestPrice(data map[string]interface{}, currency domain.Currency) (domain.Money, string, bool) {
+	product, err := domain.DecodeImmersiveProduct(data)
+	if err != nil {
+		return domain.Money{}, "", false
+	}
+
+	var lowest domain.Money
+	var merchant string
+	for _, store := range product.ProductResults.Offers() {
+		if offerAvailability(store.Availability) == AvailabilityOutOfStock {
+			continue
+		}
+		landed := landedPrice(models.Offer{
+			Amount:            domain.ParsePrice(store.Price, store.ExtractedPrice, domain.Currency(store.Currency)),
+			ShippingExtracted: store.ShippingExtracted,
+		})
+		if landed == nil {
+			continue
+		}
+		price, ok := convertMoney(s.fx, *landed, currency)
+		if ok && (lowest.IsZero() || price.Amount < lowest.Amount) {
+			lowest, merchant = price, store.Name
+		}
+	}
+	if !lowest.IsZero() {
+		return lowest, merchant, true
+	}
+
+	if own := domain.ParsePrice(product.ProductResults.Price, product.ProductResults.ExtractedPrice, currency); own != nil {
+		if price, ok := convertMoney(s.fx, *own, currency); ok && !price.IsZero() {
+			return price, "", true
+		}
+	}
+	return domain.Money{}, "", false
+}
+
+// notify sends the alert to the user's open WebSocket connections, on any
+// replica, and by email. Failures are logged: the check isn't repeated.
+func (s *WatchlistService) notify(item *ent.WatchlistItem, alert models.PriceAlert) {
+	frame := priceAlertFrame{Type: PriceAlertMessageType, PriceAlert: &alert}
+	if err := s.pubsub.BroadcastToUser(item.UserID, PriceAlertMessageType, frame); err != nil {
+		fmt.Printf("⚠️ Failed to broadcast price alert to user %s: %v\n", item.UserID, err)
+	}
+
+	if user := item.Edges.User; user != nil && user.Email != "" {
+		if err := s.email.SendPriceAlertEmail(user.Email, alert); err != nil {
+			fmt.Printf("⚠️ Failed to email price alert to user %s: %v\n", item.UserID, err)
+		}
+	}
+}
+
+// Close stops the service's Pub/Sub client
+func (s *WatchlistService) Close() {
+	s.pubsub.Close()
+}
+
+// watchlistTarget validates a target price in major units
+func watchlistTarget(major float64, currency string) (domain.Money, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	if len(currency) != 3 {
+		return domain.Money{}, fmt.Errorf("%w: currency must be an ISO 4217 code", ErrInvalidWatchlistItem)
+	}
+	target := domain.NewMoney(major, domain.Currency(currency))
+	if target.Amount <= 0 {
+		return domain.Money{}, fmt.Errorf("%w: target_price must be positive", ErrInvalidWatchlistItem)
+	}
+	return target, nil
+}
+
+func toWatchlistItem(item *ent.WatchlistItem) models.WatchlistItem {
+	currency := domain.Currency(item.Currency)
+	result := models.WatchlistItem{
+		ID:            item.ID,
+		ProductKey:    item.ProductKey,
+		PageToken:     item.PageToken,
+		Title:         item.Title,
+		Link:          item.Link,
+		Image:         item.Image,
+		TargetPrice:   domain.Money{Amount: item.TargetAmount, Currency: currency},
+		Country:       item.Country,
+		LastCheckedAt: item.LastCheckedAt,
+		NotifiedAt:    item.NotifiedAt,
+		CreatedAt:     item.CreatedAt,
+	}
+	if item.LastAmount != nil {
+		result.LastPrice = &domain.Money{Amount: *item.LastAmount, Currency: currency}
+	}
+	return result
+}
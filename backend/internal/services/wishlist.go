@@ -0,0 +1,416 @@
+package services
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+
+	"mylittleprice/ent"
+	"mylittleprice/ent/wishlist"
+	"mylittleprice/ent/wishlistitem"
+	"mylittleprice/internal/config"
+	"mylittleprice/internal/models"
+	"mylittleprice/internal/utils"
+)
+
+var (
+	// ErrWishlistNotFound is returned for unknown wishlists, wishlists of
+	// another user, and share links that are invalid or revoked
+	ErrWishlistNotFound = errors.New("wishlist not found")
+	// ErrWishlistItemNotFound is returned for unknown items of a wishlist
+	ErrWishlistItemNotFound = errors.New("wishlist item not found")
+	// ErrWishlistLimit is returned when a user has too many wishlists, or a
+	// wishlist too many items
+	ErrWishlistLimit = errors.New("wishlist limit reached")
+	// ErrInvalidWishlist is returned for wishlists without a name and items without a product
+	ErrInvalidWishlist = errors.New("invalid wishlist")
+)
+
+const (
+	maxWishlistsPerUser  = 20
+	maxWishlistItems     = 100
+	maxWishlistNameRunes = 100
+	maxWishlistNoteRunes = 500
+
+	wishlistShareKind = "wishlist"
+)
+
+// WishlistService manages users' named wishlists of saved products and
+// their public read-only share links
+type WishlistService struct {
+	client      *ent.Client
+	signer      *utils.ShareSignature
+	frontendURL string
+}
+
+// NewWishlistService creates a WishlistService. Share links are signed
+// with the JWT access secret, like signed session IDs.
+func NewWishlistService(client *ent.Client, cfg *config.Config) *WishlistService {
+	return &WishlistService{
+		client:      client,
+		signer:      utils.NewShareSignature(cfg.JWTAccessSecret),
+		frontendURL: cfg.FrontendURL,
+	}
+}
+
+// List returns the user's wishlists with their items, oldest first
+func (s *WishlistService) List(ctx context.Context, userID uuid.UUID) ([]models.Wishlist, error) {
+	lists, err := s.client.Wishlist.Query().
+		Where(wishlist.UserIDEQ(userID)).
+		WithItems(orderWishlistItems).
+		Order(ent.Asc(wishlist.FieldCreatedAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query wishlists: %w", err)
+	}
+
+	result := make([]models.Wishlist, 0, len(lists))
+	for _, list := range lists {
+		result = append(result, s.toWishlist(list))
+	}
+	return result, nil
+}
+
+// Get returns one of the user's wishlists with its items
+func (s *WishlistService) Get(ctx context.Context, userID, wishlistID uuid.UUID) (*models.Wishlist, error) {
+	list, err := s.client.Wishlist.Query().
+		Where(
+			wishlist.IDEQ(wishlistID),
+			wishlist.UserIDEQ(userID),
+		).
+		WithItems(orderWishlistItems).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrWishlistNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wishlist: %w", err)
+	}
+
+	result := s.toWishlist(list)
+	return &result, nil
+}
+
+// Create creates an empty wishlist
+func (s *WishlistService) Create(ctx context.Context, userID uuid.UUID, name string) (*models.Wishlist, error) {
+	name, err := wishlistName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := s.client.Wishlist.Query().
+		Where(wishlist.UserIDEQ(userID)).
+		Count(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to count wishlists: %w", err)
+	}
+	if count >= maxWishlistsPerUser {
+		return nil, fmt.Errorf("%w: at most %d wishlists", ErrWishlistLimit, maxWishlistsPerUser)
+	}
+
+	list, err := s.client.Wishlist.Create().
+		SetUserID(userID).
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create wishlist: %w", err)
+	}
+
+	result := s.toWishlist(list)
+	return &result, nil
+}
+
+// Rename renames one of the user's wishlists
+func (s *WishlistService) Rename(ctx context.Context, userID, wishlistID uuid.UUID, name string) (*models.Wishlist, error) {
+	name, err := wishlistName(name)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.client.Wishlist.Update().
+		Where(
+			wishlist.IDEQ(wishlistID),
+			wishlist.UserIDEQ(userID),
+		).
+		SetName(name).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to rename wishlist: %w", err)
+	}
+	if updated == 0 {
+		return nil, ErrWishlistNotFound
+	}
+	return s.Get(ctx, userID, wishlistID)
+}
+
+// Delete deletes one of the user's wishlists and its items
+func (s *WishlistService) Delete(ctx context.Context, userID, wishlistID uuid.UUID) error {
+	if _, err := s.userWishlist(ctx, userID, wishlistID); err != nil {
+		return err
+	}
+
+	tx, err := s.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+	if _, err := tx.WishlistItem.Delete().
+		Where(wishlistitem.WishlistIDEQ(wishlistID)).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to delete wishlist items: %w", err)
+	}
+	if err := tx.Wishlist.DeleteOneID(wishlistID).Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to delete wishlist: %w", err)
+	}
+	return tx.Commit()
+}
+
+// AddItem saves a product card to one of the user's wishlists. A product
+// that is already on the list isn't added again: its item is returned.
+func (s *WishlistService) AddItem(ctx context.Context, userID, wishlistID uuid.UUID, req models.AddWishlistItemRequest) (*models.WishlistItem, error) {
+	product := req.Product
+	if strings.TrimSpace(product.Name) == "" || (product.PageToken == "" && product.Link == "") {
+		return nil, fmt.Errorf("%w: the product needs a name and a page token or link", ErrInvalidWishlist)
+	}
+	note := strings.TrimSpace(req.Note)
+	if len([]rune(note)) > maxWishlistNoteRunes {
+		return nil, fmt.Errorf("%w: note must be at most %d characters", ErrInvalidWishlist, maxWishlistNoteRunes)
+	}
+	product.Ranking = nil // Debug output of the search that found it
+
+	list, err := s.userWishlist(ctx, userID, wishlistID)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := list.QueryItems().All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query wishlist items: %w", err)
+	}
+	for _, item := range items {
+		if sameWishlistProduct(item, product) {
+			result := toWishlistItem(item)
+			return &result, nil
+		}
+	}
+	if len(items) >= maxWishlistItems {
+		return nil, fmt.Errorf("%w: at most %d products per wishlist", ErrWishlistLimit, maxWishlistItems)
+	}
+
+	snapshot, err := productSnapshot(product)
+	if err != nil {
+		return nil, err
+	}
+
+	item, err := s.client.WishlistItem.Create().
+		SetWishlistID(wishlistID).
+		SetPageToken(product.PageToken).
+		SetProductKey(product.ProductKey).
+		SetProduct(snapshot).
+		SetNote(note).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to save wishlist item: %w", err)
+	}
+	s.touch(ctx, wishlistID)
+
+	result := toWishlistItem(item)
+	return &result, nil
+}
+
+// RemoveItem removes an item from one of the user's wishlists
+func (s *WishlistService) RemoveItem(ctx context.Context, userID, wishlistID, itemID uuid.UUID) error {
+	if _, err := s.userWishlist(ctx, userID, wishlistID); err != nil {
+		return err
+	}
+
+	deleted, err := s.client.WishlistItem.Delete().
+		Where(
+			wishlistitem.IDEQ(itemID),
+			wishlistitem.WishlistIDEQ(wishlistID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to delete wishlist item: %w", err)
+	}
+	if deleted == 0 {
+		return ErrWishlistItemNotFound
+	}
+	s.touch(ctx, wishlistID)
+	return nil
+}
+
+// Share creates a new share link for one of the user's wishlists. Links
+// shared before stop working.
+func (s *WishlistService) Share(ctx context.Context, userID, wishlistID uuid.UUID) (*models.Wishlist, error) {
+	if err := s.updateSharing(ctx, userID, wishlistID, true); err != nil {
+		return nil, err
+	}
+	return s.Get(ctx, userID, wishlistID)
+}
+
+// Unshare revokes the share link of one of the user's wishlists
+func (s *WishlistService) Unshare(ctx context.Context, userID, wishlistID uuid.UUID) error {
+	return s.updateSharing(ctx, userID, wishlistID, false)
+}
+
+func (s *WishlistService) updateSharing(ctx context.Context, userID, wishlistID uuid.UUID, shared bool) error {
+	update := s.client.Wishlist.Update().
+		Where(
+			wishlist.IDEQ(wishlistID),
+			wishlist.UserIDEQ(userID),
+		).
+		SetShared(shared)
+	if shared {
+		update.AddShareVersion(1)
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update wishlist sharing: %w", err)
+	}
+	if updated == 0 {
+		return ErrWishlistNotFound
+	}
+	return nil
+}
+
+// GetShared returns the wishlist behind a share link
+func (s *WishlistService) GetShared(ctx context.Context, token string) (*models.SharedWishlist, error) {
+	rawID, version, err := s.signer.Verify(wishlistShareKind, token)
+	if err != nil {
+		return nil, ErrWishlistNotFound
+	}
+	wishlistID, err := uuid.Parse(rawID)
+	if err != nil {
+		return nil, ErrWishlistNotFound
+	}
+
+	list, err := s.client.Wishlist.Query().
+		Where(
+			wishlist.IDEQ(wishlistID),
+			wishlist.Shared(true),
+			wishlist.ShareVersionEQ(version),
+		).
+		WithItems(orderWishlistItems).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrWishlistNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get shared wishlist: %w", err)
+	}
+
+	return &models.SharedWishlist{
+		Name:      list.Name,
+		Items:     toWishlistItems(list.Edges.Items),
+		UpdatedAt: list.UpdatedAt,
+	}, nil
+}
+
+func (s *WishlistService) userWishlist(ctx context.Context, userID, wishlistID uuid.UUID) (*ent.Wishlist, error) {
+	list, err := s.client.Wishlist.Query().
+		Where(
+			wishlist.IDEQ(wishlistID),
+			wishlist.UserIDEQ(userID),
+		).
+		Only(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrWishlistNotFound
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get wishlist: %w", err)
+	}
+	return list, nil
+}
+
+// touch marks the wishlist as updated when its items change
+func (s *WishlistService) touch(ctx context.Context, wishlistID uuid.UUID) {
+	if err := s.client.Wishlist.UpdateOneID(wishlistID).SetUpdatedAt(time.Now()).Exec(ctx); err != nil {
+		fmt.Printf("⚠️ Failed to update wishlist %s: %v\n", wishlistID, err)
+	}
+}
+
+func (s *WishlistService) toWishlist(list *ent.Wishlist) models.Wishlist {
+	result := models.Wishlist{
+		ID:        list.ID,
+		Name:      list.Name,
+		Items:     toWishlistItems(list.Edges.Items),
+		Shared:    list.Shared,
+		CreatedAt: list.CreatedAt,
+		UpdatedAt: list.UpdatedAt,
+	}
+	if list.Shared {
+		token := s.signer.Sign(wishlistShareKind, list.ID.String(), list.ShareVersion)
+		result.ShareURL = fmt.Sprintf("%s/wishlists/shared/%s", s.frontendURL, token)
+	}
+	return result
+}
+
+func orderWishlistItems(q *ent.WishlistItemQuery) {
+	q.Order(ent.Asc(wishlistitem.FieldCreatedAt))
+}
+
+func toWishlistItems(items []*ent.WishlistItem) []models.WishlistItem {
+	result := make([]models.WishlistItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, toWishlistItem(item))
+	}
+	return result
+}
+
+func toWishlistItem(item *ent.WishlistItem) models.WishlistItem {
+	result := models.WishlistItem{
+		ID:        item.ID,
+		Note:      item.Note,
+		CreatedAt: item.CreatedAt,
+	}
+	if raw, err := json.Marshal(item.Product); err == nil {
+		_ = json.Unmarshal(raw, &result.Product)
+	}
+	return result
+}
+
+// productSnapshot converts a card to the JSON stored in wishlist items
+func productSnapshot(product models.ProductCard) (map[string]interface{}, error) {
+	raw, err := json.Marshal(product)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode product: %w", err)
+	}
+	var snapshot map[string]interface{}
+	if err := json.Unmarshal(raw, &snapshot); err != nil {
+		return nil, fmt.Errorf("failed to encode product: %w", err)
+	}
+	return snapshot, nil
+}
+
+// sameWishlistProduct reports whether item already holds product, going by
+// its price history key, then its page token, then its link
+func sameWishlistProduct(item *ent.WishlistItem, product models.ProductCard) bool {
+	switch {
+	case product.ProductKey != "" && item.ProductKey != "":
+		return product.ProductKey == item.ProductKey
+	case product.PageToken != "" && item.PageToken != "":
+		return product.PageToken == item.PageToken
+	default:
+		link, _ := item.Product["link"].(string)
+		return product.Link != "" && product.Link == link
+	}
+}
+
+func wishlistName(name string) (string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return "", fmt.Errorf("%w: name is required", ErrInvalidWishlist)
+	}
+	if len([]rune(name)) > maxWishlistNameRunes {
+		return "", fmt.Errorf("%w: name must be at most %d characters", ErrInvalidWishlist, maxWishlistNameRunes)
+	}
+	return name, nil
+}
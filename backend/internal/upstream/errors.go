@@ -0,0 +1,163 @@
+// Package upstream classifies errors returned by external APIs (LLM
+// providers, SerpAPI) into a small set of kinds that retry logic, key
+// rotation and client error codes can act on.
+package upstream
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Kind is the class of an upstream failure
+type Kind string
+
+const (
+	KindRateLimited    Kind = "rate_limited"    // Too many requests right now - retry later or with another key
+	KindQuotaExhausted Kind = "quota_exhausted" // Key/plan quota used up - switch key, don't retry it today
+	KindAuthInvalid    Kind = "auth_invalid"    // Key rejected - switch key
+	KindTransient      Kind = "transient"       // 5xx, timeouts, network errors - retry with backoff
+	KindBadRequest     Kind = "bad_request"     // Request rejected as invalid - don't retry
+	KindSafetyBlocked  Kind = "safety_blocked"  // Prompt or response blocked by safety filters - don't retry
+	KindUnknown        Kind = "unknown"
+)
+
+// Error is an upstream failure with its classification
+type Error struct {
+	Provider   string        // "gemini", "openai", "serpapi"...
+	Kind       Kind          // Failure class
+	StatusCode int           // HTTP status if known, 0 otherwise
+	RetryAfter time.Duration // Server-suggested delay before retrying, 0 if none
+	Err        error         // Original error
+}
+
+func (e *Error) Error() string {
+	if e.StatusCode != 0 {
+		return fmt.Sprintf("%s %s (%d): %v", e.Provider, e.Kind, e.StatusCode, e.Err)
+	}
+	return fmt.Sprintf("%s %s: %v", e.Provider, e.Kind, e.Err)
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// New wraps err with a classification
+func New(provider string, kind Kind, statusCode int, err error) *Error {
+	return &Error{
+		Provider:   provider,
+		Kind:       kind,
+		StatusCode: statusCode,
+		Err:        err,
+	}
+}
+
+// NewSafetyBlocked reports a prompt or response blocked by the provider's
+// safety filters (reason is the provider's block or finish reason)
+func NewSafetyBlocked(provider, reason string) *Error {
+	return New(provider, KindSafetyBlocked, 0, fmt.Errorf("blocked by safety filters: %s", reason))
+}
+
+// As returns the upstream error in err's chain, if any
+func As(err error) (*Error, bool) {
+	var upstreamErr *Error
+	if errors.As(err, &upstreamErr) {
+		return upstreamErr, true
+	}
+	return nil, false
+}
+
+// KindOf returns the kind of err, or KindUnknown if it was not classified
+func KindOf(err error) Kind {
+	if upstreamErr, ok := As(err); ok {
+		return upstreamErr.Kind
+	}
+	return KindUnknown
+}
+
+// IsRetriable reports whether repeating the same request may succeed
+func IsRetriable(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	switch KindOf(err) {
+	case KindRateLimited, KindTransient:
+		return true
+	default:
+		return false
+	}
+}
+
+// ShouldRotateKey reports whether the API key used for the request should be
+// replaced before the next attempt
+func ShouldRotateKey(err error) bool {
+	switch KindOf(err) {
+	case KindRateLimited, KindQuotaExhausted, KindAuthInvalid:
+		return true
+	default:
+		return false
+	}
+}
+
+// FromHTTPStatus classifies a non-2xx HTTP response. body is used to tell
+// quota exhaustion apart from short-term rate limiting.
+func FromHTTPStatus(provider string, statusCode int, body string, err error) *Error {
+	kind := KindUnknown
+	lowerBody := strings.ToLower(body)
+
+	switch {
+	case statusCode == http.StatusTooManyRequests:
+		kind = KindRateLimited
+		if isQuotaMessage(lowerBody) {
+			kind = KindQuotaExhausted
+		}
+	case statusCode == http.StatusUnauthorized || statusCode == http.StatusForbidden:
+		kind = KindAuthInvalid
+	case statusCode == http.StatusPaymentRequired:
+		kind = KindQuotaExhausted
+	case statusCode == http.StatusRequestTimeout || statusCode >= 500:
+		kind = KindTransient
+	case statusCode >= 400:
+		kind = KindBadRequest
+		if strings.Contains(lowerBody, "content_filter") || strings.Contains(lowerBody, "content policy") {
+			kind = KindSafetyBlocked
+		}
+	}
+
+	return New(provider, kind, statusCode, err)
+}
+
+// classifyNetworkError returns KindTransient for timeouts and connection
+// failures, KindUnknown otherwise
+func classifyNetworkError(err error) Kind {
+	if errors.Is(err, context.DeadlineExceeded) || errors.Is(err, io.ErrUnexpectedEOF) || errors.Is(err, io.EOF) {
+		return KindTransient
+	}
+	var netErr net.Error
+	if errors.As(err, &netErr) {
+		return KindTransient
+	}
+	msg := strings.ToLower(err.Error())
+	if strings.Contains(msg, "timeout") ||
+		strings.Contains(msg, "deadline exceeded") ||
+		strings.Contains(msg, "connection reset") ||
+		strings.Contains(msg, "connection refused") {
+		return KindTransient
+	}
+	return KindUnknown
+}
+
+// isQuotaMessage reports whether a lowercased rate limit message refers to a
+// used-up quota rather than a momentary limit
+func isQuotaMessage(msg string) bool {
+	return strings.Contains(msg, "insufficient_quota") ||
+		strings.Contains(msg, "perday") ||
+		strings.Contains(msg, "per day") ||
+		strings.Contains(msg, "billing") ||
+		strings.Contains(msg, "run out of searches")
+}
@@ -0,0 +1,71 @@
+package upstream
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"google.golang.org/genai"
+)
+
+const ProviderGemini = "gemini"
+
+// FromGemini classifies an error returned by the genai client. Already
+// classified errors and nil are returned unchanged.
+func FromGemini(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := As(err); ok {
+		return err
+	}
+
+	var apiErr genai.APIError
+	if !errors.As(err, &apiErr) {
+		return New(ProviderGemini, classifyNetworkError(err), 0, err)
+	}
+
+	details := strings.ToLower(fmt.Sprint(apiErr.Details))
+	message := strings.ToLower(apiErr.Message)
+
+	kind := KindUnknown
+	switch {
+	case apiErr.Status == "RESOURCE_EXHAUSTED" || apiErr.Code == 429:
+		// The message mentions "quota" for per-minute limits too; only the
+		// QuotaFailure details tell whether the daily quota is gone
+		kind = KindRateLimited
+		if strings.Contains(details, "perday") {
+			kind = KindQuotaExhausted
+		}
+	case apiErr.Status == "UNAUTHENTICATED" || apiErr.Status == "PERMISSION_DENIED" ||
+		apiErr.Code == 401 || apiErr.Code == 403 ||
+		strings.Contains(details, "api_key_invalid") || strings.Contains(message, "api key not valid"):
+		kind = KindAuthInvalid
+	case apiErr.Status == "UNAVAILABLE" || apiErr.Status == "INTERNAL" || apiErr.Status == "DEADLINE_EXCEEDED" ||
+		apiErr.Code >= 500 || apiErr.Code == 408:
+		kind = KindTransient
+	case apiErr.Code >= 400:
+		kind = KindBadRequest
+	}
+
+	upstreamErr := New(ProviderGemini, kind, apiErr.Code, err)
+	upstreamErr.RetryAfter = geminiRetryDelay(apiErr.Details)
+	return upstreamErr
+}
+
+// geminiRetryDelay reads google.rpc.RetryInfo from the error details
+func geminiRetryDelay(details []map[string]any) time.Duration {
+	for _, detail := range details {
+		typeName, _ := detail["@type"].(string)
+		if !strings.HasSuffix(typeName, "RetryInfo") {
+			continue
+		}
+		if delay, ok := detail["retryDelay"].(string); ok {
+			if d, err := time.ParseDuration(delay); err == nil {
+				return d
+			}
+		}
+	}
+	return 0
+}
@@ -0,0 +1,43 @@
+package upstream
+
+import (
+	"strings"
+)
+
+const ProviderSerpAPI = "serpapi"
+
+// FromSerpAPI classifies an error returned by SerpAPI. Failures are mostly
+// described by the "error" message of the response, so classification is
+// based on the documented messages. Already classified errors and nil are
+// returned unchanged.
+func FromSerpAPI(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := As(err); ok {
+		return err
+	}
+
+	msg := strings.ToLower(err.Error())
+
+	kind := classifyNetworkError(err)
+	switch {
+	case strings.Contains(msg, "run out of searches") ||
+		strings.Contains(msg, "quota exceeded") ||
+		strings.Contains(msg, "limit exceeded"):
+		kind = KindQuotaExhausted
+	case strings.Contains(msg, "rate limit") || strings.Contains(msg, "too many requests") || strings.Contains(msg, "429"):
+		kind = KindRateLimited
+	case strings.Contains(msg, "invalid api key") || strings.Contains(msg, "401") || strings.Contains(msg, "403"):
+		kind = KindAuthInvalid
+	case strings.Contains(msg, "500") || strings.Contains(msg, "502") || strings.Contains(msg, "503"):
+		kind = KindTransient
+	case strings.Contains(msg, "missing query") ||
+		strings.Contains(msg, "unsupported") ||
+		strings.Contains(msg, "invalid") ||
+		strings.Contains(msg, "400"):
+		kind = KindBadRequest
+	}
+
+	return New(ProviderSerpAPI, kind, 0, err)
+}
@@ -7,8 +7,14 @@ import (
 	"time"
 
 	"github.com/redis/go-redis/v9"
+
+	"mylittleprice/internal/upstream"
 )
 
+// rateLimitCooldown is how long a rate-limited key is skipped when the
+// upstream doesn't say when to retry
+const rateLimitCooldown = time.Minute
+
 // KeyRotator manages API key rotation using Redis
 type KeyRotator struct {
 	keys        []string
@@ -100,6 +106,50 @@ func (kr *KeyRotator) MarkKeyAsExhausted(keyIndex int) error {
 	return nil
 }
 
+// MarkKeyCoolingDown takes a key out of rotation for ttl (short-term rate limit)
+func (kr *KeyRotator) MarkKeyCoolingDown(keyIndex int, ttl time.Duration) error {
+	exhaustedKey := fmt.Sprintf("keyrotator:%s:exhausted:%d", kr.serviceName, keyIndex)
+
+	err := kr.redis.Set(kr.ctx, exhaustedKey, "1", ttl).Err()
+	if err != nil {
+		return fmt.Errorf("failed to mark key as cooling down: %w", err)
+	}
+
+	fmt.Printf("   ⏸️  Key %d cooling down for %v\n", keyIndex, ttl.Round(time.Second))
+	return nil
+}
+
+// ReportFailure takes the key out of rotation according to the upstream
+// error kind and reports whether the caller should switch to another key:
+// quota-exhausted and auth-invalid keys are skipped until end of day,
+// rate-limited keys for the server-suggested delay (or a minute)
+func (kr *KeyRotator) ReportFailure(keyIndex int, err error) bool {
+	upstreamErr, ok := upstream.As(err)
+	if !ok || !upstream.ShouldRotateKey(err) {
+		return false
+	}
+
+	var markErr error
+	switch upstreamErr.Kind {
+	case upstream.KindRateLimited:
+		cooldown := upstreamErr.RetryAfter
+		if cooldown <= 0 {
+			cooldown = rateLimitCooldown
+		}
+		markErr = kr.MarkKeyCoolingDown(keyIndex, cooldown)
+	case upstream.KindAuthInvalid:
+		fmt.Printf("   🔑 Key %d of %s was rejected as invalid\n", keyIndex, kr.serviceName)
+		markErr = kr.MarkKeyAsExhausted(keyIndex)
+	default:
+		markErr = kr.MarkKeyAsExhausted(keyIndex)
+	}
+	if markErr != nil {
+		fmt.Printf("   ⚠️ %v\n", markErr)
+	}
+
+	return true
+}
+
 // GetKeyByIndex returns a specific key by index
 func (kr *KeyRotator) GetKeyByIndex(index int) (string, error) {
 	if index < 0 || index >= len(kr.keys) {
@@ -6,6 +6,8 @@ import (
 	"log"
 	"math"
 	"time"
+
+	"mylittleprice/internal/upstream"
 )
 
 // RetryConfig holds configuration for retry logic
@@ -75,20 +77,29 @@ func RetryWithBackoff(ctx context.Context, fn func() error, config RetryConfig)
 	return fmt.Errorf("operation failed after %d retries: %w", config.MaxRetries+1, lastErr)
 }
 
-// IsRetriableError determines if an error should trigger a retry
-// This can be extended to check for specific error types (network errors, timeouts, etc.)
+// IsRetriableError determines if an error should trigger a retry.
+// Classified upstream errors are retried only when rate-limited or
+// transient; other errors are left to the caller's config.
 func IsRetriableError(err error) bool {
 	if err == nil {
 		return false
 	}
 
-	// Add specific error type checks here
-	// For now, we'll retry on any error (let the config decide)
+	if _, ok := upstream.As(err); ok {
+		return upstream.IsRetriable(err)
+	}
+
 	return true
 }
 
-// RetryWithBackoffSelective is like RetryWithBackoff but only retries on specific errors
+// RetryWithBackoffSelective is like RetryWithBackoff but only retries on specific errors.
+// A nil isRetriable uses IsRetriableError. A Retry-After suggested by an
+// upstream error replaces the computed delay (still capped by MaxDelay).
 func RetryWithBackoffSelective(ctx context.Context, fn func() error, config RetryConfig, isRetriable func(error) bool) error {
+	if isRetriable == nil {
+		isRetriable = IsRetriableError
+	}
+
 	var lastErr error
 
 	for attempt := 0; attempt <= config.MaxRetries; attempt++ {
@@ -119,6 +130,9 @@ func RetryWithBackoffSelective(ctx context.Context, fn func() error, config Retr
 		}
 
 		delay := time.Duration(float64(config.InitialDelay) * math.Pow(config.BackoffFactor, float64(attempt)))
+		if upstreamErr, ok := upstream.As(err); ok && upstreamErr.RetryAfter > delay {
+			delay = upstreamErr.RetryAfter
+		}
 		if delay > config.MaxDelay {
 			delay = config.MaxDelay
 		}
@@ -0,0 +1,26 @@
+-- migrations/013_add_token_usage.sql
+-- Persistent token and cost accounting: one row per LLM generation or embedding call
+
+CREATE TABLE IF NOT EXISTS token_usages (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID,                 -- No FK: usage is kept after the user is deleted
+    session_id VARCHAR(255),
+
+    provider VARCHAR(50) NOT NULL,  -- "gemini", "openai"
+    model VARCHAR(255) NOT NULL,
+    operation VARCHAR(20) NOT NULL, -- "generate", "embed"
+
+    input_tokens BIGINT NOT NULL DEFAULT 0,
+    cached_tokens BIGINT NOT NULL DEFAULT 0, -- Part of input_tokens served from the prompt cache
+    output_tokens BIGINT NOT NULL DEFAULT 0,
+    grounded BOOLEAN NOT NULL DEFAULT false,
+    latency_ms BIGINT NOT NULL DEFAULT 0,
+    cost_usd DOUBLE PRECISION NOT NULL DEFAULT 0,
+
+    day VARCHAR(10) NOT NULL,     -- UTC date (YYYY-MM-DD) for daily aggregation
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS tokenusage_session_id ON token_usages(session_id);
+CREATE INDEX IF NOT EXISTS tokenusage_user_id_day ON token_usages(user_id, day);
+CREATE INDEX IF NOT EXISTS tokenusage_day_model ON token_usages(day, model);
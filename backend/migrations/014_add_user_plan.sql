@@ -0,0 +1,6 @@
+-- migrations/014_add_user_plan.sql
+-- Quota plan per user ("free", "pro"); anonymous visitors use the "anonymous" plan
+
+ALTER TABLE users ADD COLUMN IF NOT EXISTS plan VARCHAR(20) NOT NULL DEFAULT 'free';
+
+COMMENT ON COLUMN users.plan IS 'Quota plan name: daily/monthly budgets for searches, LLM tokens and product details';
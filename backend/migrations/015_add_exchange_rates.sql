@@ -0,0 +1,10 @@
+-- migrations/015_add_exchange_rates.sql
+-- Currency conversion rates, quoted against EUR like the ECB reference rates
+
+CREATE TABLE IF NOT EXISTS exchange_rates (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    currency VARCHAR(3) NOT NULL UNIQUE,  -- ISO 4217 code
+    rate DOUBLE PRECISION NOT NULL CHECK (rate > 0), -- Units of currency per 1 EUR
+    source VARCHAR(20) NOT NULL,          -- "file", "ecb"
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
@@ -0,0 +1,20 @@
+-- migrations/016_add_price_observations.sql
+-- Price history: one row per price a product search or product details call returned
+
+CREATE TABLE IF NOT EXISTS price_observations (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    product_key VARCHAR(255) NOT NULL, -- Stable product identity (provider product ID, page token or title + merchant)
+    provider VARCHAR(50) NOT NULL,     -- "serpapi", "fixture", "feed"
+    source VARCHAR(20) NOT NULL,       -- "search", "details"
+    product_id VARCHAR(255),
+    page_token TEXT,
+    title TEXT NOT NULL,
+    merchant VARCHAR(255),
+    country VARCHAR(2),                -- Empty for product details
+    amount BIGINT NOT NULL CHECK (amount > 0), -- Minor units of currency
+    currency VARCHAR(3) NOT NULL,      -- ISO 4217 code
+    observed_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS priceobservation_product_key_observed_at ON price_observations(product_key, observed_at);
+CREATE INDEX IF NOT EXISTS priceobservation_page_token ON price_observations(page_token);
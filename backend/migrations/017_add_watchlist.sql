@@ -0,0 +1,23 @@
+-- migrations/017_add_watchlist.sql
+-- Price-drop watchlist: products users want to be notified about when their price reaches a target
+
+CREATE TABLE IF NOT EXISTS watchlist_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    product_key VARCHAR(255),           -- Key of the product's price history, if known
+    page_token TEXT NOT NULL,           -- Re-checked by the watchlist job
+    title TEXT NOT NULL,
+    link TEXT,
+    image TEXT,
+    target_amount BIGINT NOT NULL CHECK (target_amount > 0), -- Minor units of currency
+    currency VARCHAR(3) NOT NULL,       -- ISO 4217 code
+    country VARCHAR(2),
+    last_amount BIGINT,                 -- Lowest price at the last check, in currency
+    last_checked_at TIMESTAMP WITH TIME ZONE,
+    notified_at TIMESTAMP WITH TIME ZONE, -- Last time the price crossed the target
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS watchlistitem_user_id_created_at ON watchlist_items(user_id, created_at);
+CREATE INDEX IF NOT EXISTS watchlistitem_last_checked_at ON watchlist_items(last_checked_at);
@@ -0,0 +1,26 @@
+-- migrations/018_add_wishlists.sql
+-- Named wishlists of saved products, shareable through signed read-only links
+
+CREATE TABLE IF NOT EXISTS wishlists (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(100) NOT NULL,
+    shared BOOLEAN NOT NULL DEFAULT false, -- Share links are valid
+    share_version INTEGER NOT NULL DEFAULT 0, -- Signed into share links; bumped on every share
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP,
+    updated_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS wishlist_user_id_created_at ON wishlists(user_id, created_at);
+
+CREATE TABLE IF NOT EXISTS wishlist_items (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    wishlist_id UUID NOT NULL REFERENCES wishlists(id) ON DELETE CASCADE,
+    page_token TEXT,                   -- Opens the product details
+    product_key VARCHAR(255),          -- Key of the product's price history, if known
+    product JSONB NOT NULL,            -- ProductCard snapshot
+    note VARCHAR(500),
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS wishlistitem_wishlist_id_created_at ON wishlist_items(wishlist_id, created_at);
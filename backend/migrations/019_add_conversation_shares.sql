@@ -0,0 +1,18 @@
+-- migrations/019_add_conversation_shares.sql
+-- Public read-only share links to snapshots of chat sessions
+
+CREATE TABLE IF NOT EXISTS conversation_shares (
+    id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    session_id UUID NOT NULL,          -- Shared chat session; no foreign key, shares outlive expired sessions
+    title VARCHAR(200),
+    messages JSONB NOT NULL,           -- Snapshot of the messages and their products, without personal data
+    message_count INTEGER NOT NULL,
+    currency VARCHAR(3),
+    view_count INTEGER NOT NULL DEFAULT 0,
+    expires_at TIMESTAMP WITH TIME ZONE, -- NULL for links that don't expire
+    revoked_at TIMESTAMP WITH TIME ZONE,
+    created_at TIMESTAMP WITH TIME ZONE NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+
+CREATE INDEX IF NOT EXISTS conversationshare_user_id_created_at ON conversation_shares(user_id, created_at);